@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/gc"
+	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// gc-builds deletes the data of the builds not used by any template, snapshot or volume.
+// It is meant to be run periodically as a batch job.
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only report the builds that would be deleted")
+	gracePeriod := flag.Duration("grace-period", 72*time.Hour, "keep the unused builds changed or marked for the garbage collection in this period, it has to be longer than the sandbox lifetime")
+
+	flag.Parse()
+
+	// The chunks reused by the builds are uploaded again after the refresh age, so the chunk reused by the build being uploaded is recent.
+	if *gracePeriod <= storage.ContentChunkRefreshAge {
+		log.Fatalf("grace period has to be longer than the content-addressed chunk refresh age %s", storage.ContentChunkRefreshAge)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	database, err := db.NewClient(3, 1)
+	if err != nil {
+		log.Fatalf("failed to create database client: %s", err)
+	}
+
+	defer database.Close()
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	registry, err := artifactsregistry.GetArtifactsRegistryProvider()
+	if err != nil {
+		log.Fatalf("failed to get artifacts registry provider: %s", err)
+	}
+
+	report, err := gc.NewCollector(database, persistence, registry, *gracePeriod, *dryRun).Run(ctx)
+	if err != nil {
+		log.Fatalf("failed to collect builds: %s", err)
+	}
+
+	verb := "Deleted"
+	if *dryRun {
+		verb = "Would delete"
+	}
+
+	for _, buildID := range report.Deleted {
+		fmt.Printf("%-16s %s\n", verb, buildID)
+	}
+
+	for buildID, err := range report.Failed {
+		fmt.Printf("%-16s %s: %s\n", "Failed", buildID, err)
+	}
+
+	for _, chunk := range report.DeletedChunks {
+		fmt.Printf("%-16s chunk %s\n", verb, chunk)
+	}
+
+	for chunk, err := range report.FailedChunks {
+		fmt.Printf("%-16s chunk %s: %s\n", "Failed", chunk, err)
+	}
+
+	fmt.Printf("\nSUMMARY\n")
+	fmt.Printf("=======\n")
+	fmt.Printf("Reachable builds   %d\n", report.Reachable)
+	fmt.Printf("Recent builds      %d (kept for the grace period of %s)\n", len(report.Recent), *gracePeriod)
+	fmt.Printf("%-18s %d (%d B)\n", verb+" builds", len(report.Deleted), report.DeletedBytes)
+	fmt.Printf("Failed builds      %d\n", len(report.Failed))
+	fmt.Printf("Reachable chunks   %d\n", report.ReachableChunks)
+	fmt.Printf("Recent chunks      %d (kept for the grace period of %s)\n", len(report.RecentChunks), *gracePeriod)
+	fmt.Printf("%-18s %d (%d B)\n", verb+" chunks", len(report.DeletedChunks), report.DeletedChunksBytes)
+	fmt.Printf("Failed chunks      %d\n", len(report.FailedChunks))
+
+	if len(report.Failed) > 0 || len(report.FailedChunks) > 0 {
+		os.Exit(1)
+	}
+}
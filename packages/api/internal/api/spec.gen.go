@@ -18,77 +18,108 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd3W/bOpb/VwjtPuwCbuzmdi52AsxDmvbOFrftBE3aWaA3WNDSccyJROqSVBIj8P8+",
-	"4JdESdSHHcdJ2jy1kSjy8Jwfzwd5Dn0XxSzLGQUqRXR0F+WY4wwkcP0XjmMQ4pxdAf3wTj0gNDqKciyX",
-	"0SSiOIPoqNFmEnH4syAckuhI8gImkYiXkGH1sVzl6gMhOaGX0Xo9iXBOfodVd9fu9Wa9zguSJp2dureb",
-	"9UlZAp1d2peb9SgwTebstrPT6v1m/UrI8hTLbmq9Bpv0vFaNRc6oAI2NN7OZ+idmVAKVGi15npIYS8Lo",
-	"9F+CUfWs6u8/OSyio+g/phXgpuatmL7nnHEzRgIi5iRXnURH0VucIEUiCBmtJ9Gb2euHH/O4kEug0vaK",
-	"wLRTg795+ME/M4kWrKCJGfGvDz/iCaOLlMSav3/Zh0zPgF8Dd3xdO8xpUJ2cfj1hhRm6QebpVxQzDgIt",
-	"GEdyCcgukGgSLRjPsIyOIkLlL4fRJMoIJVmRRUevJw7HhEq4BC3IEw5YQnJcKS6t+TjLgUti0B3bNgFK",
-	"zkkGQuIsR2yBjPZDUvWC9EeqkUdSgiW8kiSDaNJcUpOIJO3uPyQKfAsCXPWvJuqP4XddFCQJ9ZphcTUk",
-	"nWqUT1hcEXr5DiQmqVDfGz3RpOszzqCDohYF0jG1wbkloEWRpitk2TvQ0drXT98jPVtNnBvBznXiieui",
-	"EvA54Oz49MPvsNpevsenH9AVrDYXrR3grR4bp+k/FtHR936ZKHq/CoXRi0lEizTF8xSMYh6NFUvvGJhc",
-	"Gb7Ue/yCb9A1Tgtod9jqIMVCfhUQoOsjFhIpziC5JKJk4g0WqFAfdDCxPudHQXbndENYNA0tBC0w60h8",
-	"T6+/YetLJQlRA+L0tIbEOi3v6TXhjGZAJbrGnCh2hFRemzqjb9tAZ0lgyrox0u8C6rOtMjMQAl92dTTI",
-	"LTuQ60VxplNMrRko/kLyTYHylMOC3LapMM81thChyHyBroELZcGtaI2OZrwLzt44Z8UiOI55fs9x8v5J",
-	"yCWWiDjuiFaXSHcY6Fcv249AL+UysCL1834SS3k3pGcJro8wCcglxEMl649ESEjOLHhbAsYpwYGlcKwe",
-	"lxRbpzWoalMCVBp/d0g/mrbBXvKidD369EvpoqwnEdBOC4JulkD9RYtuSJoiuM0Jh9FWJIOM8dWnt0NE",
-	"fXLt9DcSJ1gOOmhWHp9c82ZMMsTKTmU0iYTEvMe6BniDBbIfjeaNkAoP4yZ5ptu2oqOhKbrWaMFZhm6W",
-	"JF4iImqUW30/qAJrUZcf25Xo9dnmwdEDgQOcm7taW588hNSnY940bYfSW7qrHqf58H9CNuAz3PS6zPd1",
-	"GxsM091dmHG7VUch2SkuhB14gYtURkcLnAoIhHUswyqsUw5orj6qSxIvJBhWKcSxwtMSc8ZSwNQs+dKi",
-	"90Y/ttk91yPEBQ8w9Uw/RzhNkVgJCRmKWZYV1MWsN0Qu2+vTm8Vmy8CJpVcLO6b5gnj9l0lo+UuGUnIN",
-	"IWgKiBlNxEEvQGeDJsubn4VQXzCwO7ewAq11u5qWLmWxUhcnp18DAxbZ3DC9bIfKsHecn1Z+aNUCCeiF",
-	"40zplfowRsVo3UDejhtKIY6EAnX93DGO8XgJQnIsQ26ic5d/c55fF0Pq2hYtdHs/xiFU/vomSGe1czcE",
-	"c2q81LahMYN3bEy0iASBeEEpoZeIUb/jEUwVpbniktDL4SFtQ3Tmxm6MEx5FYlkMqjAF4TPTUnmXxslt",
-	"E/Ot7v32C7y5XNzGqaWowetJfcEE4V2HUAcHK/JL3LpFasKOQNyE4yUkbwuSJgFkKo9Wzdi0QnpPWSCS",
-	"NCROJGQisJla8gVzjlc/5WqCHq4OLaSSrX3wrQcdAZY//VWgV3MNiE20d8L6rJxcw3PQzxsyAqqs6veI",
-	"A06UjUs4JortultKIZbmj4IuAadyuYouAoKthj1ZYnoZMH+bc7zBKduBmuQXEEXWF1Tu3TN8ZAdI8cSs",
-	"FsuTfxK5/ASSk1i8xNxPN+bOKhGN0mtVF5zEQb32nIL4HyIeV/roie9uAb1uHng16PEPsvQGp1JO6rN6",
-	"bNnV+beRllH36Mw6oYMg3BGYnzTOfP55WOpyTV90d6c6ea4wf9nnfdnn7d/ntRP8yC4DcRO7REAlX5mN",
-	"P1keYGOaoJRQxda6CtEPg/2oN8glgHRs8+nOBw7OFe9SR9dIWTdZWQ41MQTX+RDwalP7tDUt0QbzJs6W",
-	"4nrL02pQq8f2KPzkLc9xZ7/ui8GFVxtEeYGhrjiJNwSFr9G7tg423I+M8+KrgOQ07sjoKQS+BJQDj4FK",
-	"fFlT9IuUYQ+CVNNgleU5kzgN7m7qN737mR07GxlkitRgp/YoxZ37ju5zk8WSeSK7/3rx1Iong9os64z0",
-	"kHvmFG576wDa2Cw3D4yxU6jCOskjtEdwDjgLODQ6zzNgrG3eiAuTpfo6lEYl3rmAu9nFP5cgl1B97tS6",
-	"jdAbXXrR/PCZQBc16vlYsxPqoWVQdHdlgolllj/rC8vZl2SnzmSnnz5XyaInmC9XyqKFHMhsBNLI+lGP",
-	"HRmF+nLr5Eb79YAAQzMytBn6bbATDpWgK1iCULg0fs9e7/oPWkytletepsKW+liOM6JePvsQNxXMkSh0",
-	"kLMoUnMwofXnJblWvnhfWLhFQDegWaoAoDb3ypd+JPWi2HSW4xu6MemawX16YSehXV7M05BbV7dmliwi",
-	"kGmPGEeMpiubZ0HmKaD5KmBpPDMnFBe2xXCTDz2O0FbhWIidRZ5sgTgjNvPpls6VH9dVNSTh8M3Kz18f",
-	"PuU+optgrImkpmN8TaePg9rqbgNNoZsGLWUZQ1mX6vtFqypDaxXdcBN9KUadSXnCd66lptX4ljeY2MMo",
-	"d1hl6ggudrbnty0SysO7MhCsCeuLrWLZ/W7eFmo7YfEV8AVJAx7Gu/Kd53d3D7+NetOiO8kC/sEX9QbF",
-	"S4iv9H6cilUlQ3ALcaFUXWNpVwdznXDWey3BsXSKwI5G2bHP78nHB9JXrUE6kbQvq6GoNaRskVQFN0i9",
-	"KbG1YWaVS4ojcnWmkGXG8rZ8jwuT/jwHzIH/5hS9mdz/u9RDjUo9Kd2sGn0pZa4EepxkhNY61JV0S8CJ",
-	"bm5r6f7vlW746rye0mhjNNWP/t9QH6cfXv3u86D6/qzI8RwLeD2GFte4mxzX4lBLbmxvNRi4zpQoCF0w",
-	"rfqJVKoken/4VgnUS0w4imYHrw9mamyWA8U5iY6iXw5mBzO9USCXWn5TI55XWjwGy0yEtoxMwgpGFG6a",
-	"2aQKezpq/ZBER9EpE9JDhbCljiDkW5asdlbk1siJXddRa73NWtnk4Q5LGAOFbKF6xlaJGiRejJCuvMrK",
-	"0Ggl+VPVqKoS7G+rGvmrVXvsITR/v1AuusTK6/ge1YGg13sdHNO7WsHx2oAkhdCO1Tv9HGHajxXTzEfL",
-	"caOm2a+K7gg8qibTekW0ml0DAW8GzqXMfO4nJFutOtT2zaMINCevrmCluXEJsiMRDKep2bKzJkK0BPd3",
-	"kEa/muVd4/FmhayjDgM8a9c+DGiXuXrCQxxkwSkkgUk98uIL2oSGCJ24lC8yQjH78wsrZk9oD6KTfUk9",
-	"ikpuEtAIVD0GPUmNvBko/CU9vXOXNozSzP1YsYrZoOW4ugxiQ3XsPhyniWvCee6aeOPVjWUcqB403v6Q",
-	"uE7VxzuW1u7VQytyGaUhZgNAsZs7PwlQ1Io3qcCdJvx/9WsTyocMt3kfjWG0DXhN8kjJ3824q4U8pSyB",
-	"EV6HaRYg+rN9sRtfY9xOtq4kWl/cy+MwE9qbUWkGzw0cqbcWRJqw6Z3JgF93SubvIPUckA44uwTz2eXR",
-	"b6ZxbPp9wDrs7jIUr9JktODKBP0nqUbGybjTX9QVAkiUO7/Y1SK0vcWdyfYBXM1mycO6fU9S2MmwsnUc",
-	"0KdiuovnYELGr+9asU+/0nXJkn7tS2ud+2VuDSR05FL9WYDL3JEMLUjq9nKrIqP/goPLA/RHVAjgf8Pz",
-	"+I9iNjv8Fef533LOkj+i/z5A73G81HYe08TcjyJQVgiJ5oC+fvmIgMYsgeQgmpjdND1qtZlWpmr2XbB1",
-	"sV+70qiPup+BaQtPg3E2BoyzPRombzf2+8V6cg9vqJrpiKjYJZHq9NLGiUpb4fkgf6AAuRT7fqPj2rBt",
-	"jehnCXeHxT8JqGrqc+pVCW2oRk36p/u+T6d+Ktu8qNZ7qdbuOrxdq9m6cJ/D8hiF9rsycb53F+l3kqaV",
-	"du3YPirhfeYl42/mRVZp/CO3kBq67Iqk6fNw7B7KPnZGdZVtnK+QTuvr1k8PJMDZrs3bNoGeqOrUfxpY",
-	"dK75qctB6oSNA43NQRqBmY+m5da4mQTTR5QyloGyF2GucRNLVqSJsmSlsAlFGUlTYuu8O6yazlqpmbRW",
-	"kl3/bTgto41vVWtEy5y+Pio7qEpJRupUVYXus9ls04r1PSxFLfVtFqJB1stqVKtxyPf0F+QYP7Nck50O",
-	"5/7U+S5K7reBV81l++kRlruLOsJxtL7Ho1F21BM2l/gy93/s29sz5U81b0/HNjGmRsfqi0seUvL23vCh",
-	"tn99ZijhsOAgltCTIPbFNKktNbiVQBNdhy2FrfQx96+MhNGXctz7Qmm7vZx6XmVSGIIDh+r2jc6tNEXM",
-	"Ph8qM38FuURY30BT3Tiji4NujbX+5VdlzQfu27OP2PxfEMvRO98N1Wg4uycf+AkgWK39Pviq91toOvPh",
-	"I+Gzdyukfk3T091vtGp5b0H6D6qjvUuwwhA/A+nfpNW8AusAnYevAEG3TlF5u+ikKoa04D1AJzhNdVi2",
-	"JEK5WUuWoKxIJclTsIVM7Br4DSfS1jSdn3+cIMCxKZJHhTCfA4oLzoFKv3beXgrhYr+cEfWeoQywKDjU",
-	"puY09cHIRXxeXi72+FamdplZs8hKTa4yHJU8fH7ZqolOM9S+2GWba14tlRc7sUbCQtNR6nr/2Xx0CTgb",
-	"mZsbDPTO7Yt9brnrGoV77q6bCe1vY7xZtNInxtoBu3rmRGXOEUeJyzUNiqx62VA+oY2g8k4Cfydoq7qn",
-	"i33DxJ673hsqjl9PHy4VraPzt3sOp32kPITDGCyaHOU2Hu6chi6/0VTVK68RxzHk0kXzT+7obReQqamZ",
-	"6V1V/To2wbsDTKZFCadzv6p2M//HK8gdv2NTKw/fRZr346/s3tzt7kWtPnsQMTyccqgXwm6dwN26z6Az",
-	"ifuHXNmTzl0Io+AwHWkKngdonqNF+QGsxNRcjj+9s1clrHv2BnRFvl9oPwp05m70t+VNDNsjcDLY2t33",
-	"EDA0h2ENY0S79C6+/GElO61u+Og8KCwVruFLV0L/kJjP3G0bexF26zj9A03gtrwLz+0Gzd29KJ2n/+Yq",
-	"xsaFU6GTdnYp/rFYCOg4bn9SZ+31S2k2Og4t2fA091h2tX6uDzfJhO/NgP92+CPnwLcW2m+G2IrQ+Qox",
-	"CohxlDFu6ic0J+A2T/VvLtmfeOjIqtErbrvj/+pu4da9Rit9+4VakQFdcVJwwbjivCjtW6pkveAs62AW",
-	"hVt57t9VMo5b7SwfPUE1tll2KAeOcnPR6o4yfNyZoXnf98vLLxUOzzcZXY/Dr52iKXhqL8sRR9MpzskB",
-	"HM4PcJ5HXg93zV+cF3p11H/fvv5Qb236f9duj/BfuGLU9cX63wEAAP//EslEScSAAAA=",
+	"H4sIAAAAAAAC/+w9XW/cOJJ/hdDdw92hY3c82cGugX1InGTHmDjrs53MAYkR0FK1m2uJ1JCU7V7D//3A",
+	"L4mSqJbUbnfaiZ8St/hRrC8Wq4rFuyhmWc4oUCmi/bsoxxxnIIHrv3AcgxBn7Aro4Vv1A6HRfpRjOY8m",
+	"EcUZRPuNNpOIw58F4ZBE+5IXMIlEPIcMq85ykasOQnJCL6P7+0mEc/I7LLqHdp/HjXpRkDTpHNR9HTdm",
+	"PIf4KmeEys6Ba03GjU5ZAp3j2o/jRhSYJhfstnPQ6vu4cSVkeYplN7Reg3EjX7O0yOCjHiU4stdgzMj3",
+	"qrHIGRWgefrVdKr+iRmVQKX6L87zlMRYEkZ3/yUYVb9V4/0nh1m0H/3HbiUou+ar2H3HOeNmjgREzEmu",
+	"Bon2ozc4QQpEEDK6n0Svpi8ff87XhZwDlXZUBKadmvzV40/+kUk0YwVNzIx/e/wZDxidpSTW+P3LJmh6",
+	"CvwauMPrveM5zVQHx58OWGGmboB5/AnFjINAM8aRnAOyohdNohnjGZbRfkSo/GUvmkQZoSQrsmj/5cTx",
+	"MaESLkET8qBUMFpTc5YDl8RwdVM/1YE4TBRjzAhwxGYaiKp9NGmKzCSKOWAJyevAes5IBuhmDrQxDLrB",
+	"Atl+/tISLOGFJBmE5slA4gTLXpqcGpQdueb3Tik0oVPaYdgSawqyD1u2cWjJEl8BRTPOstAsdXXZN41r",
+	"rYdDN3MSz2vT11HcVqGVUvzS3I2oUZu+2q9p6ori54rT7F/V1h7guOU8IiTOcrUwYx8gqUYx0KtGQzmE",
+	"JEMQ58/hD10UJAnyHRZXfTxXzXKExRWhl29BYpKKYczXgKjNGA6pDczNAc2KNF04OvcM1CC6Xq0lteuh",
+	"19pB4DPA2evjw99hsTp9Xx8foitYjCetneCNnhun6T9n0f6X5TRR8H4SShueTyJapCm+SMGYAIN5xcI7",
+	"hE2uYNEe8QTfoGucFtAesDVAioX8JCAA1wcsJFKYQXJORIlEJeGF6Nag9TV/F87uXG6IF01Dy4KWMeuc",
+	"+I5ef8b2tJEkRE2I0+MaJ9ZheUevCWc0AyrRNeZEoSO0ubahMzt7m9FZEliyboz0t8BG3d6cMxACX3YN",
+	"1K+uzURuFIWZ94xfQWK3vhDYpb0xw0UqtdHQIFuRXRjGt2gBpUiQkJhLs8colM0YvwoaI/jWGCN70z7L",
+	"RHEnKxrQ/GUSMh0kQym5hpJgM71KD0BCkYCY0UTsLDWRpm1AFFI7mbuFQMWVkHxWonzMYUZu27Qzv2uJ",
+	"VHCZHugauFAWttu19c7GeJcS8OY5LWbBeczvD5wnX74IOccSEYcd0RoS6QED42pl9wHopZwH9Jj+fTmI",
+	"FX3qPG8Brs8wCdAlhEMlIR+IkEskBKcEBxTIa/Vz0+QKblApgcH2tG4bHCUvyqPBMq1cHiHuJxHQAbZ3",
+	"aRWSNEVwmxMOIwzvjPHF0Zs+oI5cu4cZ66sY2yGwteaCMbjBAtlOg3EjJJYwcJGnuu1aDH0iapAPtfNr",
+	"Vrxv3pfc66PNY0ePCRzDubUr2TryOKS+HPOlueMqvXX0xkdy+1C799fQ5vERbpYeNB5qbDcQpoc7N/Mu",
+	"O1GPOWBOUEHJnwWgHPhSE6QLkG4dVkh2jAsBtb11hlMBAf8Py7Dy/6jzQ6461VkKzyQYmrn9ugTxgrEU",
+	"MFXkUDIrQAYU5wng5AWj6QK5NghLieM5JGpbbzCDs+bcp7JPW/MSCZkYKHFvzTBmY709ND1flQvBnOOF",
+	"UaGlXbnU22ObPdAZAfJGWVHDen40rZUDixiNA3HBA8x2qn9HOE2RWAgJGYpZlhXUufhuiJy32c2j5Tit",
+	"5Jhz6ab4AFPPY45VLTznJg5wpzobIvu1iy8n6g/CNS8qWRVESOHJRQmhQFckTSEZyZyf9fRHbhuvGPRl",
+	"k0G7tXhdI5zZD+u2bu7NLMscAOs7CvqazqBoldkMbWuqVgIOut0E+TeEtq5T8u/GcHrbIs1969dXPc7Y",
+	"0ALLafVKWRJYJE5TFqtt/eD4U2Cx5XGtbIdK9/GwU2jZ0W7fJICE15niz/o0xhQII6NjKqWKSMjhrX93",
+	"WGY8noOQHMvQIdg5A967E1rP+dVaRWim2/seHEe0NpxVbK1P/1FzBu9yFXc4+EOHbF5QSuglYtQfeABS",
+	"RWlWcknoZf+UtiE6dXM35gnPIrEsenWaYuFT01LpXXMYbQPzuX5KXU7wpty40KaFqIHrSV1gguxdZ6EO",
+	"DFbgl3zrhNS4BwLuFb1/vFHB4gBnqpOnWrFphXRMWSCSNChe7h3tbbRhrvx00gRLsNonSIO25LpzIIDy",
+	"7ZcCLc01Rmxyezdbc0zoUdCz+Ru7MeaexW2F7RTwNehviequPirYdtAfytD8Gt1gIr9GqsFC9UYFlSQ1",
+	"fwJNJsYe/Rpl5JJjCa4l5oAydl0ZY0zOgeuRRdkHrnFclJ30R+8IkyHBqsE4iCKDUta84Xb0UVbt2V80",
+	"sNHEARNNyimi8wBXemRuWw369wZfu2k44GQRTSKHMU0KSiGW5o+CzgGncr7omfZgjullwGRIfEL2MWpF",
+	"9ZX4u8GXdgDFUicG5Vt0TP2+5xCFqhMjPxYnSkSOQHISi2dP5PZ6IrOKRGMOdoaywV3kKbk2fwgvpdJH",
+	"W+7zB3rdTJ5owOMnReiwj1JOqlvdxdM1+OeBdoge0W30hPYy4ZqYeav5zMefx0vOsdhiKZ2F2KbghWBp",
+	"IZWVIucN1Cph5eB7PdV69LEbEsSdG/UhmOMIh1ga3cyZADQjKViPIfGcYVj4MI1DpsaCj66Oc9PT3eoS",
+	"Iq5OOxxHb4m4Qsq9Myjs0XF2evS99KmqHbglcuBG/E41fQ5gPgcwlwYwa8LsKa13ltHqKgtoMoYkuvlw",
+	"mbwl8iCcYXRLpE4wamaZ5pwpMZ0gAbKa3v6K1IDm8EukQOyGPjA/yebHNIFQ04TWwwHbdOqB0npiOjTJ",
+	"bsepAJuUdGgQ7KScsQ74H/NFkC7uZJ5ACpYBy3OkReI3g0SdoMYppN9yTEkcTSJWyG9s9s0wVmTcXd/0",
+	"EVwf5a+ownfoIG/h/cAuA24tdomASr4wzg5ZZk9imqCUUIgmDY7UPwbHUV+Qy3PvCM/pwXuyNhXiUgfX",
+	"QGZuCm451cQAfF7DQ+AYnNpfW8sSbdU55nT2gZm1L4uw6bk9CI+8zWBY4qHr0avma5OoY2NoKE7ikUzh",
+	"2zRdnt2R4aI4L1R66nHccXGhUJKJcuAxUGmEtBx1ljLssSDVMNit+Y1y1DMaDD/Z9BEOcYqJcuOViYhO",
+	"kC+MXNuY1IUZa0mgLqTssvccIDi/+uDG5pAztbG4KX2jbsRcZ0ziNDiZ/rI0utY9qqLMMgy6bMHBY1KQ",
+	"7y45CPFmIUMMrn9GAqhs4kMQGgMiMmSKDJjvGMdXwYwS+2G9cx7S/kVyiIFcQ7LuSXtXut6Jx2j7zNM5",
+	"D1f4nhXmKZEa39ZFo6kXakLa4s0A87RpG0K8r+G1598eVxrJBqOiWM3UEBtSUPEMnVCi4iZCootCLEx7",
+	"IqxwzhBlEnWdte0v7OJfYK6xBfODgkkEN5AckIQHGO3w+PoVOjh8e9KwxjFFOL3BC8WCOJ5PEFwDraxL",
+	"DqKMMoLGKJIcz2YkVqtJgJJ6MkxvQNNC+ZZlmNAAnPZDC0gbPNG4/Rr9zw7c4ixPYSdm2dcIZVjGcxAm",
+	"Jaq4SMwgO+gPtQ4BcoLefjxFKWNXRa6NChMcsu1s9GimiaP2WZ3MpiA4PFZ4ESy9tmdi9aNdQtWbJrXf",
+	"DZYV0BdgsArJDjowCyCMChfwUpuMQH+d6hFevfpFw+FG0TCQmc1M+u3s7Bj9xoREc8CJ8fScfThFwlw+",
+	"pDjT7IVp2d9A15rXLNzMrKabkVQCrxRPuVq1dAXDBAnXyy1YT1rboDODKoNHHM/V5Qsbh8t20P9+OjxQ",
+	"0F2kLL4ayS+GxUYztZIvDcnYyRav07Q/WPUW6EIzW0Mm4DaGXLa5IRCq8szBU3cwb8cXoW1VlhFG40fR",
+	"Pjh7N8gcdZacQ/xEtBVSrWSVSxe8aVB6RC3uol1tSk9W85G6TKzSRTo8oUzlrwU0pK4bEHB/2VtWTsC7",
+	"0seIeOtW1j50ghaREkvW62FR0RjSi1cOQ3rYJYyzoV4ZnPXizg5XXseyyPJX7TD7fDWw82rgT3+zz3JP",
+	"8HZpSYsW50BmgwYNV5j62YFRqJ4rXwW2vXsIGFqRgc3AvzTpFrriG7A0ub13V9JZZL1HfG2FN4I+WOgU",
+	"NDns1O/VR+nDpmJzJAodNpgVqZ7FGEiXRNmPSyM5K8RgBhceqK19bNmBtasXhabTHN/Q0aBrBBdiBPCr",
+	"RD7y4iIlcd9uZsEiApn2yvbU1qm5X0OUrWetx85tTigsrMrDhRh88F0pWhFCZ5EnWK5INtN1xcO0H/ao",
+	"ahKFoxuWfr58+JD7HN1kxhpJajrG13Q6vbCt7kZoCt00uFOWTl9rUn05b1XLUX2RbjhGX4pB+Xoe8Z1F",
+	"rWE1JrXKEjT/c4l8pr7L+dqyGlblhDIZtPRc14h1YqsLrT8Av0rofNU7Y/ocX+afWt9XdeB0cFo3C5ag",
+	"/Sw62douxQ5bNjWHU6tJHuNiWaLOtlxlWIScGu6bd8boRvUqqlyz6UGWhFG9MJcSdTRfuysYgluI1RmM",
+	"NNRYlWbZKbqaHsG5dHr9mmZZ8/nGo48vNJ+0tuyUmk3tkApaA8oKV6/gxjiAHG+Nvn/VdflqsNllj+qr",
+	"GF3rveC1avWmytfgqaFHu0LWdX1xGNxdDpgGdcshJ417aPVjmbtnSuTiVOkVQ3kvXUjVj9ObPmAO/L1b",
+	"i2Htb+5ytdZJmqV1swq6uZS5WuHrJCO0NiBRyzPuzKqa3/+90A1fnNUvbVtvhBpH/69vjOPDF7/DItT/",
+	"tMjxBRbwcggsrnE3OK7FnpbboaPV2NcNpkhB6IypESSRaiOJ3u29UeLsXenYj6Y7L3emam6WA8U5ifaj",
+	"X3amO1Objafpt2vI80KTR/+SMxGK5pqrPhhRuGnel1eaQPtnDhMVqWJCelwhbLFFEPINSxZrK7PXuPV/",
+	"X+dqe66qFW7cW2MRxUCBs1BFxVbpMki803C68Go7hmYrwd9Vjao6hcvbqka+tOqzaYibv5yrw6jEyr7+",
+	"EtUZQct7nTl272qlWu8Nk6Qgg0lB6ncdZljGK6aZzy2vG9Vg/XqyHUfsqsluDUB91G5wwKuenEaznocR",
+	"ydbL7Gv76rsQNCcvrmChsXEJsuMKnbKqtXPaGgiiRbh/gDT61Yh3DcfjSmkOMrE9W6edp9MutOkRD3GQ",
+	"BaeQBBb1nYUvuCc0SOjIpYyvAYrZX19YMXtEexSd7FPqu6jkJgCBIg+1sMKWaeRxTOGL9O6dK3c9SDMv",
+	"5xWrmA23vK7KaI9Ux67jME1cI85T18SjpVtlJrRxYs56feQ6Vp3XTK31q4fWuXWQhpj2MIp1Y/4kjKIk",
+	"3lwI7tzCf9OfjSMntHGb79EQRFt3h7lFUOJ3HHY1kXf19ep+q8M0CwD90X5Yj60xLGbzUV+EPn+QxWEW",
+	"tLFNpXl4bvCR+mqZSAO2e2cy2e47KfMPkDYnTR04uwjz0VUgGKdxzOSh3WF95di9Gh2DCVde099KNTKM",
+	"xp32oq4TgEQZ48CuIkHbWlwbbR/B1GwWPrhvv9QQNjIsbR0GtCtSD/EUtpDh8l0rk7Jc6bYKeYTk3C8Q",
+	"1OCEjmsOfxbgsswls/mDvj8VBPov2LncQV+jQgD/O76IvxbT6d6vOM//nnOWfI3+ewe9w/Fc7/MqUKAL",
+	"vQqUFSqMA+jTyQcENGYJJKoCg/am6VkrZ1p5Z2/ZEx/nm91XGpVlHrbBtImnmXE6hBmnG9yYPG/sl/P7",
+	"yQOsoWqlA07FtnE7JBhUeD6TP9IBuST7Zk/HtWnbGjHwQkRAG/4kTFVTn7texY+RatTczHL9l+nUo7LN",
+	"s2p9kGrtrqmzbjVbJ+5TEI9B3H5XRkaXepF+V9UFsJdsHnIflex96t3KHmdFltAMdSE1dJmpwvoUDLvH",
+	"2h87T3XYvy9JkhYNff30SAScrnt7W+WgJ6oKfz8NW3TK/G5Vk7xnx/Matq+eDOCkA2+i78hUg8JPFawP",
+	"Cz8twdkPb4groyHxMOAQ0NhYl5vlj8I9j2Lm+yyz4ThYY+b2Bhl+dG9j9v4YzWlfguxr+7enq2V37/yX",
+	"9oaE7lpvEw6zvTyROai/7bey+Ex6G/trG2HCNTj06cQCt4iTFKiSmWchwrrZJOGazIGWn2QJh/WqZJ+/",
+	"TiwUm2az9Wv1Rg3b7XXgtNLgPXHaRiX/hMRtZl9pCQuUenEvZNQgQiXzxax5V4FIgeKCc6C6gEefd7KU",
+	"uffm6b1ts3/qLw8+gqCMuZcxxGRvSpB7VPBZWB4iLO7mVqcLwqFcNxx0avxgWj5kKwnsgUpUZaC6mTCP",
+	"Hoo5K9LEFOmwpzlCUUbSlNj63x0eUi3jNfdo9yMxoSLhLWiPzJOaiJY3IZdB2QFVSjJSh6oqgD6dTsdW",
+	"Mt+AW0dTfRWnjuGsZ2lU0tgXx/AFckjMopTJzuDFlnlxekqxr8JeNff/M4fZpzI67SNTUQuCJpJkCNPq",
+	"KY6BBpAdcQttoFYRsTEJKr4tUlYL+8GskfGZLDVm855MzAsZSl3MUxyDX5BsRjjcmGBeWpXa6HVAFgG+",
+	"syXWtpfvGm9EDjHBe3jRItwUWujMuX02kEcozNy9eBNWl/pBnKWRlbBS1P02HmrVi6mzhE4sKOvbae/J",
+	"s7N4NJdwmHEQc1hyO/PENGkU4JZAE11AXwokvYeMBrLRSTnv99FyjXesiqoYZ8Mtbr/oa+2mlLyPh+pc",
+	"dAW5ytRRTzlVTzfpGlS35njzy6/Tac9pp1V1c+Cu3rAlDWY3FIDeAg52D6N0cK8AWaOZe3XXL2xYVU+Q",
+	"LS/nBF0B5I7XD99qxcMhs4/QVfXbh/G9Kbi6WfWpcfQc4Qgyj6s50cU9RbbKNmk6bqEJ92QCDe4hxU1x",
+	"7Q+6wUu/XODyLIr6uymK553Z5R4or0cZrPElTDkvF2wYKCBnVar0FmZYNB8437CY1Odty0mowOBzisUG",
+	"hap637Mj+m1tDtuw+TDXDjoLv6aFbp3pWAmhGqP0Jxh230EHOE219TEnAmUg5yxBWZFKkqemh0DsGvgN",
+	"J9IWMzw7+zBBoLKy9YCFMN2hjA16r3wIZyGZ8IWJ7kqGMsCisIWR3dKc7bwzVPCr926+u91fe6e1WVhK",
+	"LY7QNj18fNkSYp0Hg/YbaYPCHu2nDRSU52s5H7TMYTv6z2YQSsDZwFIlwVjFmf2wyRsIas6HXjYwC9pc",
+	"SmizhtcyMta8tIWcl6Qy290gcrmmQZJVHxvKJxTLLIuR+8HMlYoAnm+aTZz58FBWcfjafnapYB1czmbJ",
+	"XT2fUx7DxAxWSx1kZO5tzMg05bSVhYnjGHLp/KtbdxNpHSxTUzO7d1XZ26H1bjqYybQo2ems/mrtGPun",
+	"AmmEE6h2UFhHpuv3l+ylpWy6hVp1exQyPJ5yqFeFXbmeTauQ+Ybja1u6GZyAUXCYDtwKngbTPMUd5QfY",
+	"JXb12sTuna2Rft+XGe9XnR7EdJqw4k1Zgn11DuxPebeLCG00e2ENY0g7r0InPy5ld6vS/p25bqXCNXjp",
+	"qm/UR2ZThGZTxG5lhB7SBG7Ly4TOG3ThHkToTGA1j8Y2XpoJJYuyS/HP2cxExAIZo1uVLlpTsOMy+ko0",
+	"bKePZV3yc703pjDQ0oJAn/d+5JJALUF7b4CtAL1YIEYBMY4yxm2sWmECbvNUv9duHwTsSAyXUJt/TAZr",
+	"9eZ+60GTRap+UBIZ0BUHBRfKyc2MojBZ7orWym/agSwKt/LML90+DFvtRHW9QDW3ETtdnT83T0KvKUnd",
+	"ZXGY76USevkISui54NP3dAubBwuG1rB2rUMqrPz0+NnnZq41lK9263mKlHSwjylnXb5i0TbBffo9SozZ",
+	"EW2zoWV/1rYZ335CZYNh5ScWKq74zdMbu3fmP+rpmKH37S3SlcFBpEDWfAj5ES1Pfi6nGH00qKAb4UX0",
+	"uGLTt+WfMlfoWdTL2IYyBU/tSzRif1cVxN6BvYsdnOeR1/+uCj5VsZfyR39bLn/UgTL/79rTDP4HV+nZ",
+	"+61U+Of3/z8AieS/VG65AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
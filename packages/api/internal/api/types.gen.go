@@ -17,6 +17,13 @@ const (
 	Supabase2TeamAuthScopes  = "Supabase2TeamAuth.Scopes"
 )
 
+// Defines values for NodeDrainMode.
+const (
+	Evacuate NodeDrainMode = "evacuate"
+	Migrate  NodeDrainMode = "migrate"
+	Wait     NodeDrainMode = "wait"
+)
+
 // Defines values for NodeStatus.
 const (
 	NodeStatusConnecting NodeStatus = "connecting"
@@ -25,8 +32,20 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for SandboxExitReason.
+const (
+	Deleted       SandboxExitReason = "deleted"
+	KernelPanic   SandboxExitReason = "kernel_panic"
+	NodeDrain     SandboxExitReason = "node_drain"
+	OutOfMemory   SandboxExitReason = "out_of_memory"
+	ProcessExited SandboxExitReason = "process_exited"
+	Timeout       SandboxExitReason = "timeout"
+	Unknown       SandboxExitReason = "unknown"
+)
+
 // Defines values for SandboxState.
 const (
+	Ended   SandboxState = "ended"
 	Paused  SandboxState = "paused"
 	Running SandboxState = "running"
 )
@@ -42,6 +61,25 @@ const (
 // CPUCount CPU cores for the sandbox
 type CPUCount = int32
 
+// Checkpoint defines model for Checkpoint.
+type Checkpoint struct {
+	// CheckpointID Identifier of the checkpoint
+	CheckpointID string `json:"checkpointID"`
+
+	// CreatedAt Time when the checkpoint was created
+	CreatedAt time.Time        `json:"createdAt"`
+	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Name Name of the checkpoint
+	Name string `json:"name"`
+
+	// SandboxID Identifier of the sandbox the checkpoint was taken from
+	SandboxID string `json:"sandboxID"`
+
+	// TemplateID Identifier of the template from which the sandbox was created
+	TemplateID string `json:"templateID"`
+}
+
 // CreatedAccessToken defines model for CreatedAccessToken.
 type CreatedAccessToken struct {
 	// CreatedAt Timestamp of access token creation
@@ -90,6 +128,15 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ForkedSandbox defines model for ForkedSandbox.
+type ForkedSandbox struct {
+	// Count Number of sandboxes to start from the fork
+	Count *int32 `json:"count,omitempty"`
+
+	// Timeout Time to live for the forked sandboxes in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
 // IdentifierMaskingDetails defines model for IdentifierMaskingDetails.
 type IdentifierMaskingDetails struct {
 	// MaskedValuePrefix Prefix used in masked version of the token or key
@@ -145,12 +192,22 @@ type NewAccessToken struct {
 	Name string `json:"name"`
 }
 
+// NewCheckpoint defines model for NewCheckpoint.
+type NewCheckpoint struct {
+	// Name Name of the checkpoint, unique per sandbox
+	Name string `json:"name"`
+}
+
 // NewSandbox defines model for NewSandbox.
 type NewSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool            `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars         `json:"envVars,omitempty"`
-	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// Datasets Read-only datasets attached to the sandbox in addition to the datasets of the template
+	Datasets *[]SandboxDataset     `json:"datasets,omitempty"`
+	EnvVars  *EnvVars              `json:"envVars,omitempty"`
+	Metadata *SandboxMetadata      `json:"metadata,omitempty"`
+	Network  *SandboxNetworkConfig `json:"network,omitempty"`
 
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
@@ -160,6 +217,15 @@ type NewSandbox struct {
 
 	// Timeout Time to live for the sandbox in seconds.
 	Timeout *int32 `json:"timeout,omitempty"`
+
+	// Volumes Team volume attached to the sandbox, at most one is supported, its data persists after the sandbox is killed
+	Volumes *[]SandboxVolumeMount `json:"volumes,omitempty"`
+}
+
+// NewSandboxTemplate defines model for NewSandboxTemplate.
+type NewSandboxTemplate struct {
+	// Alias Alias of the template
+	Alias *string `json:"alias,omitempty"`
 }
 
 // NewTeamAPIKey defines model for NewTeamAPIKey.
@@ -168,6 +234,15 @@ type NewTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// NewVolume defines model for NewVolume.
+type NewVolume struct {
+	// Name Name of the volume, unique per team
+	Name string `json:"name"`
+
+	// SizeMB Size of the volume in MiB
+	SizeMB int64 `json:"sizeMB"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// AllocatedCPU Number of allocated CPU cores
@@ -222,11 +297,17 @@ type NodeDetail struct {
 	Version string `json:"version"`
 }
 
+// NodeDrainMode How the running sandboxes leave the draining node. With "wait" they run until they end, with "migrate" they are moved to the other nodes, with "evacuate" the node pauses them so they are resumed on the other nodes.
+type NodeDrainMode string
+
 // NodeStatus Status of the node
 type NodeStatus string
 
 // NodeStatusChange defines model for NodeStatusChange.
 type NodeStatusChange struct {
+	// DrainMode How the running sandboxes leave the draining node. With "wait" they run until they end, with "migrate" they are moved to the other nodes, with "evacuate" the node pauses them so they are resumed on the other nodes.
+	DrainMode *NodeDrainMode `json:"drainMode,omitempty"`
+
 	// Status Status of the node
 	Status NodeStatus `json:"status"`
 }
@@ -290,6 +371,15 @@ type Sandbox struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxDataset defines model for SandboxDataset.
+type SandboxDataset struct {
+	// Path Absolute path in the sandbox where the dataset is mounted read-only
+	Path string `json:"path"`
+
+	// TemplateID Identifier or alias of the template whose filesystem is attached as the dataset
+	TemplateID string `json:"templateID"`
+}
+
 // SandboxDetail defines model for SandboxDetail.
 type SandboxDetail struct {
 	// Alias Alias of the template
@@ -311,7 +401,8 @@ type SandboxDetail struct {
 	EnvdAccessToken *string `json:"envdAccessToken,omitempty"`
 
 	// EnvdVersion Version of the envd running in the sandbox
-	EnvdVersion *string `json:"envdVersion,omitempty"`
+	EnvdVersion *string      `json:"envdVersion,omitempty"`
+	Exit        *SandboxExit `json:"exit,omitempty"`
 
 	// MemoryMB Memory for the sandbox in MB
 	MemoryMB MemoryMB         `json:"memoryMB"`
@@ -330,6 +421,24 @@ type SandboxDetail struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxExit defines model for SandboxExit.
+type SandboxExit struct {
+	// EndedAt Time when the sandbox ended
+	EndedAt time.Time `json:"endedAt"`
+
+	// ExitCode Exit code of the sandbox process, set when the process exited on its own
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Message Details of the sandbox exit
+	Message string `json:"message"`
+
+	// Reason Why the sandbox ended
+	Reason SandboxExitReason `json:"reason"`
+}
+
+// SandboxExitReason Why the sandbox ended
+type SandboxExitReason string
+
 // SandboxLog Log entry with timestamp and line
 type SandboxLog struct {
 	// Line Log line content
@@ -356,19 +465,67 @@ type SandboxMetric struct {
 	// CpuUsedPct CPU usage percentage
 	CpuUsedPct float32 `json:"cpuUsedPct"`
 
+	// MemBalloonMiB Memory reclaimed from the sandbox by the memory balloon in MiB
+	MemBalloonMiB int64 `json:"memBalloonMiB"`
+
+	// MemFreeMiB Free memory reported by the sandbox in MiB
+	MemFreeMiB int64 `json:"memFreeMiB"`
+
 	// MemTotalMiB Total memory in MiB
 	MemTotalMiB int64 `json:"memTotalMiB"`
 
 	// MemUsedMiB Memory used in MiB
 	MemUsedMiB int64 `json:"memUsedMiB"`
 
+	// NetEgressBytes Bytes sent by the sandbox since it started
+	NetEgressBytes int64 `json:"netEgressBytes"`
+
+	// NetEgressPackets Packets sent by the sandbox since it started
+	NetEgressPackets int64 `json:"netEgressPackets"`
+
+	// NetIngressBytes Bytes received by the sandbox since it started
+	NetIngressBytes int64 `json:"netIngressBytes"`
+
+	// NetIngressPackets Packets received by the sandbox since it started
+	NetIngressPackets int64 `json:"netIngressPackets"`
+
 	// Timestamp Timestamp of the metric entry
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// SandboxMigration defines model for SandboxMigration.
+type SandboxMigration struct {
+	// NodeID Identifier of the node the sandbox is migrated to, the least busy node is used if not set
+	NodeID *string `json:"nodeID,omitempty"`
+}
+
+// SandboxNetworkConfig defines model for SandboxNetworkConfig.
+type SandboxNetworkConfig struct {
+	// AllowedCidrs IPv4 CIDRs the sandbox can always reach, even when the rest of the egress traffic is denied
+	AllowedCidrs *[]string `json:"allowedCidrs,omitempty"`
+
+	// AllowedDomains Domains the sandbox can connect to, "*.example.com" matches all subdomains. When set, DNS lookups of other domains are refused and only the IPs resolved for the allowed domains and the allowed CIDRs can be reached. Connections to the ports 80 and 443 are allowed only if their HTTP Host header or TLS server name is an allowed domain. Connections to other ports are filtered by the resolved IPs only, so other domains served from the same IPs are reachable on them. QUIC is blocked
+	AllowedDomains *[]string `json:"allowedDomains,omitempty"`
+
+	// DeniedCidrs IPv4 CIDRs the sandbox cannot reach
+	DeniedCidrs *[]string `json:"deniedCidrs,omitempty"`
+
+	// DenyAll Deny all egress traffic except the allowed CIDRs
+	DenyAll *bool `json:"denyAll,omitempty"`
+}
+
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxVolumeMount defines model for SandboxVolumeMount.
+type SandboxVolumeMount struct {
+	// Name Name of the team volume
+	Name string `json:"name"`
+
+	// Path Absolute path in the sandbox where the volume is mounted
+	Path *string `json:"path,omitempty"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	// ApiKey API key for the team
@@ -473,6 +630,9 @@ type TemplateBuildRequest struct {
 	// CpuCount CPU cores for the sandbox
 	CpuCount *CPUCount `json:"cpuCount,omitempty"`
 
+	// Datasets Read-only datasets attached to all sandboxes started from the template, the latest builds of the dataset templates are used
+	Datasets *[]SandboxDataset `json:"datasets,omitempty"`
+
 	// Dockerfile Dockerfile for the template
 	Dockerfile string `json:"dockerfile"`
 
@@ -501,6 +661,24 @@ type UpdateTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// Volume defines model for Volume.
+type Volume struct {
+	// CreatedAt Time when the volume was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the volume, unique per team
+	Name string `json:"name"`
+
+	// SandboxID Identifier of the sandbox the volume is attached to
+	SandboxID *string `json:"sandboxID,omitempty"`
+
+	// SizeMB Size of the volume in MiB
+	SizeMB int64 `json:"sizeMB"`
+
+	// VolumeID Identifier of the volume
+	VolumeID string `json:"volumeID"`
+}
+
 // AccessTokenID defines model for accessTokenID.
 type AccessTokenID = string
 
@@ -510,6 +688,9 @@ type ApiKeyID = string
 // BuildID defines model for buildID.
 type BuildID = string
 
+// CheckpointID defines model for checkpointID.
+type CheckpointID = string
+
 // NodeID defines model for nodeID.
 type NodeID = string
 
@@ -519,6 +700,9 @@ type SandboxID = string
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// VolumeName defines model for volumeName.
+type VolumeName = string
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -608,12 +792,30 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PostSandboxesSandboxIDCheckpointsJSONRequestBody defines body for PostSandboxesSandboxIDCheckpoints for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsJSONRequestBody = NewCheckpoint
+
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody defines body for PostSandboxesSandboxIDCheckpointsCheckpointIDRestore for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody = ResumedSandbox
+
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = ForkedSandbox
+
+// PostSandboxesSandboxIDMigrateJSONRequestBody defines body for PostSandboxesSandboxIDMigrate for application/json ContentType.
+type PostSandboxesSandboxIDMigrateJSONRequestBody = SandboxMigration
+
+// PutSandboxesSandboxIDNetworkJSONRequestBody defines body for PutSandboxesSandboxIDNetwork for application/json ContentType.
+type PutSandboxesSandboxIDNetworkJSONRequestBody = SandboxNetworkConfig
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
+// PostSandboxesSandboxIDTemplateJSONRequestBody defines body for PostSandboxesSandboxIDTemplate for application/json ContentType.
+type PostSandboxesSandboxIDTemplateJSONRequestBody = NewSandboxTemplate
+
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
 
@@ -625,3 +827,6 @@ type PatchTemplatesTemplateIDJSONRequestBody = TemplateUpdateRequest
 
 // PostTemplatesTemplateIDJSONRequestBody defines body for PostTemplatesTemplateID for application/json ContentType.
 type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
+
+// PostVolumesJSONRequestBody defines body for PostVolumes for application/json ContentType.
+type PostVolumesJSONRequestBody = NewVolume
@@ -28,6 +28,15 @@ const (
 
 var ErrPausingInstanceNotFound = errors.New("pausing instance not found")
 
+// Exit describes why the instance ended, it is stored so the recently ended sandboxes can be inspected.
+type Exit struct {
+	Reason  api.SandboxExitReason
+	Message string
+	// ExitCode is set when the sandbox process exited on its own.
+	ExitCode *int32
+	EndedAt  time.Time
+}
+
 func NewInstanceInfo(
 	Instance *api.Sandbox,
 	ExecutionID string,
@@ -95,7 +104,14 @@ type InstanceInfo struct {
 	EnvdAccessToken    *string
 	Node               *node.NodeInfo
 	AutoPause          atomic.Bool
+	Migrating          atomic.Bool // paused to be resumed on another node, the routing is moved instead of removed
+	Resetting          atomic.Bool // restarted on the node with the same execution ID, the exit of the replaced sandbox isn't its stop
 	Pausing            *utils.SetOnce[*node.NodeInfo]
+	HasVolumes         bool
+	Datasets           map[string]string
+	exit               *Exit
+	evacuatedBuildID   *uuid.UUID
+	network            *api.SandboxNetworkConfig
 	mu                 sync.RWMutex
 }
 
@@ -132,6 +148,70 @@ func (i *InstanceInfo) SetExpired() {
 	i.SetEndTime(time.Now())
 }
 
+// SetExit records why the instance ended, only the first recorded exit is kept.
+func (i *InstanceInfo) SetExit(exit Exit) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.exit != nil {
+		return
+	}
+
+	if exit.EndedAt.IsZero() {
+		exit.EndedAt = time.Now()
+	}
+
+	i.exit = &exit
+}
+
+// GetExit returns why the instance ended, the instance without the recorded exit reached its end time.
+func (i *InstanceInfo) GetExit() Exit {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if i.exit == nil {
+		return Exit{
+			Reason:  api.Timeout,
+			Message: "sandbox reached its end time",
+			EndedAt: i.endTime,
+		}
+	}
+
+	return *i.exit
+}
+
+// SetEvacuated records the snapshot build the node paused the instance to when it was evacuated.
+func (i *InstanceInfo) SetEvacuated(buildID uuid.UUID) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.evacuatedBuildID = &buildID
+}
+
+// GetEvacuatedBuildID returns the snapshot build of the evacuated instance, the pause of the instance is then only recorded.
+func (i *InstanceInfo) GetEvacuatedBuildID() *uuid.UUID {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.evacuatedBuildID
+}
+
+// SetNetwork records the egress rules the instance is running with.
+func (i *InstanceInfo) SetNetwork(network *api.SandboxNetworkConfig) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.network = network
+}
+
+// GetNetwork returns the egress rules of the instance, nil if the instance has the default egress.
+func (i *InstanceInfo) GetNetwork() *api.SandboxNetworkConfig {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.network
+}
+
 type InstanceCache struct {
 	reservations *ReservationCache
 	pausing      *smap.Map[*InstanceInfo]
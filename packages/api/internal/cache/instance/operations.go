@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 )
 
@@ -107,7 +109,13 @@ func (c *InstanceCache) Add(ctx context.Context, instance *InstanceInfo, newlyCr
 }
 
 // Delete the instance and remove it from the cache.
+// The instance that isn't paused is recorded as deleted, unless it already has the exit recorded.
 func (c *InstanceCache) Delete(instanceID string, pause bool) bool {
+	// The exit has to be set before the instance expires, the eviction reads it.
+	if value, ok := c.cache.Get(instanceID); ok && !pause {
+		value.SetExit(Exit{Reason: api.Deleted, Message: "sandbox killed"})
+	}
+
 	value, found := c.cache.GetAndRemove(instanceID)
 	if found {
 		value.AutoPause.Store(pause)
@@ -120,6 +128,33 @@ func (c *InstanceCache) Delete(instanceID string, pause bool) bool {
 	return found
 }
 
+// RemoveStopped removes the instance that already stopped on the node without pausing it.
+// The instance is removed only if it is the same execution, the sandbox could have been started again since.
+// The reset keeps the execution ID, so the instance being reset is kept, the node sync removes it if the reset sandbox didn't start.
+func (c *InstanceCache) RemoveStopped(instanceID string, executionID string, exit Exit) bool {
+	value, found := c.cache.Get(instanceID)
+	if !found || value.ExecutionID != executionID || value.Resetting.Load() {
+		return false
+	}
+
+	value.SetExit(exit)
+
+	return c.Delete(instanceID, false)
+}
+
+// RemoveEvacuated removes the instance the node paused when it was evacuated, the removal records the pause to the snapshot build.
+// The instance is removed only if it is the same execution, the sandbox could have been started again since.
+func (c *InstanceCache) RemoveEvacuated(instanceID string, executionID string, buildID uuid.UUID) bool {
+	value, found := c.cache.Get(instanceID)
+	if !found || value.ExecutionID != executionID {
+		return false
+	}
+
+	value.SetEvacuated(buildID)
+
+	return c.Delete(instanceID, true)
+}
+
 func (c *InstanceCache) Items() []*InstanceInfo {
 	return c.cache.Items()
 }
@@ -0,0 +1,47 @@
+package instance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+func TestInstanceCache_RemoveStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	noopInsert := func(*InstanceInfo, bool) error { return nil }
+	noopDelete := func(*InstanceInfo) error { return nil }
+	cache := NewCache(ctx, noop.MeterProvider{}, noopInsert, noopDelete)
+
+	info := &InstanceInfo{
+		Instance:          &api.Sandbox{SandboxID: sandboxID, ClientID: "node", TemplateID: "template"},
+		ExecutionID:       "execution",
+		TeamID:            &teamID,
+		StartTime:         time.Now(),
+		endTime:           time.Now().Add(time.Hour),
+		MaxInstanceLength: time.Hour,
+	}
+	require.NoError(t, cache.Add(ctx, info, false))
+
+	exit := Exit{Reason: api.ProcessExited}
+
+	assert.False(t, cache.RemoveStopped(sandboxID, "other-execution", exit), "the stop of the previous execution")
+
+	// The sandbox replaced by the reset exits with the same execution ID.
+	info.Resetting.Store(true)
+	assert.False(t, cache.RemoveStopped(sandboxID, "execution", exit))
+	assert.True(t, cache.Exists(sandboxID))
+
+	info.Resetting.Store(false)
+	assert.True(t, cache.RemoveStopped(sandboxID, "execution", exit))
+
+	_, err := cache.Get(sandboxID)
+	assert.Error(t, err)
+}
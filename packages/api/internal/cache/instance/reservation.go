@@ -78,6 +78,13 @@ func (e *ErrSandboxLimitExceeded) Error() string {
 	return fmt.Sprintf("sandbox %s has exceeded the limit", e.teamID)
 }
 
+// IsReserved reports whether the instance is being started, it is added to the cache by the start.
+func (c *InstanceCache) IsReserved(instanceID string) bool {
+	_, ok := c.reservations.reservations.Get(instanceID)
+
+	return ok
+}
+
 func (c *InstanceCache) Reserve(instanceID string, team uuid.UUID, limit int64) (release func(), err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
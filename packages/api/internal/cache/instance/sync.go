@@ -55,7 +55,9 @@ func (c *InstanceCache) KeepAliveFor(instanceID string, duration time.Duration,
 	return instance, nil
 }
 
-func (c *InstanceCache) Sync(ctx context.Context, instances []*InstanceInfo, nodeID string) {
+// Sync the instances of the node with the cache.
+// The instances that are not running on the node anymore are removed with the missing exit recorded.
+func (c *InstanceCache) Sync(ctx context.Context, instances []*InstanceInfo, nodeID string, missing Exit) {
 	instanceMap := make(map[string]*InstanceInfo)
 
 	// Use a map for faster lookup
@@ -73,6 +75,7 @@ func (c *InstanceCache) Sync(ctx context.Context, instances []*InstanceInfo, nod
 		}
 		_, found := instanceMap[item.Instance.SandboxID]
 		if !found {
+			item.SetExit(missing)
 			c.cache.Remove(item.Instance.SandboxID)
 		}
 	}
@@ -0,0 +1,392 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// headersConcurrency is the number of the builds whose headers are read at once.
+const headersConcurrency = 16
+
+// headerNames are the headers of all the files that can be stored in the build directory.
+var headerNames = []string{
+	storage.MemfileName + storage.HeaderSuffix,
+	storage.RootfsName + storage.HeaderSuffix,
+	storage.VolumeName + storage.HeaderSuffix,
+}
+
+// Store is the part of the database the collector reads the used builds from.
+type Store interface {
+	GetEnvBuildsForGC(ctx context.Context) ([]*models.EnvBuild, error)
+	GetVolumeGenerations(ctx context.Context) ([]uuid.UUID, error)
+	DeleteMarkedEnvBuilds(ctx context.Context, buildIDs []uuid.UUID) error
+}
+
+// Collector deletes the data of the builds that are not used by any template, snapshot or volume.
+// The builds referenced from the database are used, together with all the builds their headers map data from.
+// The data of the other builds are deleted from the storage and their images from the artifacts registry.
+// The content-addressed chunks are deleted when no kept build maps data from them.
+type Collector struct {
+	db          Store
+	persistence storage.StorageProvider
+	registry    artifactsregistry.ArtifactsRegistry
+
+	// gracePeriod is how long the unused builds are kept after they were changed or marked for the garbage collection.
+	// It has to be longer than the lifetime of the sandboxes, the running sandboxes may still read from the unused builds.
+	gracePeriod time.Duration
+	dryRun      bool
+}
+
+// Report is the result of the garbage collection run.
+type Report struct {
+	// Reachable is the number of the builds used directly or through the headers of the used builds.
+	Reachable int
+	// Recent are the unused builds kept until the grace period ends.
+	Recent []uuid.UUID
+	// Deleted are the unused builds whose data were deleted, or would be deleted in the dry run.
+	Deleted []uuid.UUID
+	// DeletedBytes is the size of the deleted data in the storage.
+	DeletedBytes int64
+	// Failed are the unused builds whose data couldn't be deleted.
+	Failed map[uuid.UUID]error
+
+	// ReachableChunks is the number of the content-addressed chunks the kept builds map data from.
+	ReachableChunks int
+	// RecentChunks are the unreferenced chunks kept until the grace period ends.
+	RecentChunks []string
+	// DeletedChunks are the unreferenced chunks deleted, or that would be deleted in the dry run.
+	DeletedChunks []string
+	// DeletedChunksBytes is the size of the deleted chunks in the storage.
+	DeletedChunksBytes int64
+	// FailedChunks are the unreferenced chunks that couldn't be deleted.
+	FailedChunks map[string]error
+}
+
+func NewCollector(db Store, persistence storage.StorageProvider, registry artifactsregistry.ArtifactsRegistry, gracePeriod time.Duration, dryRun bool) *Collector {
+	return &Collector{
+		db:          db,
+		persistence: persistence,
+		registry:    registry,
+		gracePeriod: gracePeriod,
+		dryRun:      dryRun,
+	}
+}
+
+func (c *Collector) Run(ctx context.Context) (*Report, error) {
+	cutoff := time.Now().Add(-c.gracePeriod)
+
+	builds, err := c.db.GetEnvBuildsForGC(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[uuid.UUID]string, len(builds))
+	marked := make(map[uuid.UUID]bool)
+
+	var roots []uuid.UUID
+
+	for _, b := range builds {
+		if b.EnvID != nil {
+			templates[b.ID] = *b.EnvID
+		}
+
+		if b.MarkedForGcAt != nil {
+			marked[b.ID] = true
+		}
+
+		if b.Status == envbuild.StatusFailed || (b.MarkedForGcAt != nil && b.MarkedForGcAt.Before(cutoff)) {
+			continue
+		}
+
+		roots = append(roots, b.ID)
+
+		for _, dataset := range b.Datasets {
+			datasetID, err := uuid.Parse(dataset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse dataset build of build '%s': %w", b.ID, err)
+			}
+
+			roots = append(roots, datasetID)
+		}
+	}
+
+	generations, err := c.db.GetVolumeGenerations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roots = append(roots, generations...)
+
+	reachable, chunks, err := c.reachable(ctx, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	directories, err := c.persistence.ListDirectories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list build directories: %w", err)
+	}
+
+	report := &Report{
+		Reachable:    len(reachable),
+		Failed:       make(map[uuid.UUID]error),
+		FailedChunks: make(map[string]error),
+	}
+
+	var deletedMarked []uuid.UUID
+
+	for _, directory := range directories {
+		// The directories not named by the build IDs are the shared data, the content-addressed chunks are collected separately and the data keys never.
+		buildID, err := uuid.Parse(directory)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := reachable[buildID]; ok {
+			continue
+		}
+
+		objects, err := c.persistence.ListObjectsWithPrefix(ctx, directory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects of build '%s': %w", buildID, err)
+		}
+
+		var size int64
+
+		recent := false
+
+		for _, object := range objects {
+			size += object.Size
+			recent = recent || object.UpdatedAt.After(cutoff)
+		}
+
+		// The build can be still uploading, before its row is created.
+		if recent {
+			report.Recent = append(report.Recent, buildID)
+
+			continue
+		}
+
+		if !c.dryRun {
+			err = c.delete(ctx, templates[buildID], buildID)
+			if err != nil {
+				report.Failed[buildID] = err
+
+				continue
+			}
+
+			if marked[buildID] {
+				deletedMarked = append(deletedMarked, buildID)
+			}
+		}
+
+		report.Deleted = append(report.Deleted, buildID)
+		report.DeletedBytes += size
+	}
+
+	// The marked builds are hidden anyway, the rows of the other builds are kept for the build history.
+	if len(deletedMarked) > 0 {
+		err = c.db.DeleteMarkedEnvBuilds(ctx, deletedMarked)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	// The unused builds that are kept still read from their chunks.
+	kept := make([]uuid.UUID, 0, len(report.Recent)+len(report.Failed))
+	kept = append(kept, report.Recent...)
+	for buildID := range report.Failed {
+		kept = append(kept, buildID)
+	}
+
+	_, keptChunks, err := c.reachable(ctx, kept)
+	if err != nil {
+		return report, err
+	}
+
+	for chunk := range keptChunks {
+		chunks[chunk] = struct{}{}
+	}
+
+	err = c.collectChunks(ctx, chunks, cutoff, report)
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// collectChunks deletes the content-addressed chunks that are not referenced by the kept builds.
+// The chunks updated in the grace period are kept, the build referencing them can be still uploading.
+func (c *Collector) collectChunks(ctx context.Context, reachable map[string]struct{}, cutoff time.Time, report *Report) error {
+	report.ReachableChunks = len(reachable)
+
+	objects, err := c.persistence.ListObjectsWithPrefix(ctx, storage.ContentChunksDir+"/")
+	if err != nil {
+		return fmt.Errorf("failed to list content-addressed chunks: %w", err)
+	}
+
+	for _, object := range objects {
+		name := path.Base(object.Path)
+
+		if _, ok := reachable[name]; ok {
+			continue
+		}
+
+		if object.UpdatedAt.After(cutoff) {
+			report.RecentChunks = append(report.RecentChunks, name)
+
+			continue
+		}
+
+		if !c.dryRun {
+			err = c.deleteChunk(ctx, object.Path)
+			if err != nil {
+				report.FailedChunks[name] = err
+
+				continue
+			}
+		}
+
+		report.DeletedChunks = append(report.DeletedChunks, name)
+		report.DeletedChunksBytes += object.Size
+	}
+
+	return nil
+}
+
+// reachable returns the root builds and all the builds their headers map data from,
+// together with the content-addressed chunks the headers map data from.
+func (c *Collector) reachable(ctx context.Context, roots []uuid.UUID) (map[uuid.UUID]struct{}, map[string]struct{}, error) {
+	reachable := make(map[uuid.UUID]struct{}, len(roots))
+	chunks := make(map[string]struct{})
+
+	var mu sync.Mutex
+
+	next := make([]uuid.UUID, 0, len(roots))
+	for _, root := range roots {
+		if _, ok := reachable[root]; !ok {
+			reachable[root] = struct{}{}
+			next = append(next, root)
+		}
+	}
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+
+		eg, egCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(headersConcurrency)
+
+		for _, buildID := range current {
+			eg.Go(func() error {
+				referenced, referencedChunks, err := c.references(egCtx, buildID)
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, chunk := range referencedChunks {
+					chunks[chunk] = struct{}{}
+				}
+
+				for _, id := range referenced {
+					if _, ok := reachable[id]; !ok {
+						reachable[id] = struct{}{}
+						next = append(next, id)
+					}
+				}
+
+				return nil
+			})
+		}
+
+		err := eg.Wait()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return reachable, chunks, nil
+}
+
+// references returns the builds and the content-addressed chunks the headers of the build map data from.
+// The builds without the headers, like the old templates, reference only their own data.
+func (c *Collector) references(ctx context.Context, buildID uuid.UUID) ([]uuid.UUID, []string, error) {
+	var referenced []uuid.UUID
+	var chunks []string
+
+	for _, name := range headerNames {
+		object, err := c.persistence.OpenObject(ctx, buildID.String()+"/"+name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		h, err := header.Deserialize(object)
+		if errors.Is(err, storage.ErrorObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read header '%s' of build '%s': %w", name, buildID, err)
+		}
+
+		for _, mapping := range h.Mapping {
+			if mapping.BuildId != uuid.Nil {
+				referenced = append(referenced, mapping.BuildId)
+			}
+
+			if !mapping.Hash.IsZero() {
+				chunks = append(chunks, mapping.ChunkName())
+			}
+		}
+	}
+
+	return referenced, chunks, nil
+}
+
+func (c *Collector) delete(ctx context.Context, templateID string, buildID uuid.UUID) error {
+	err := c.persistence.DeleteObjectsWithPrefix(ctx, buildID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete build data: %w", err)
+	}
+
+	// Only the template builds have images, the snapshot builds don't.
+	err = c.registry.Delete(ctx, templateID, buildID.String())
+	if err != nil && !errors.Is(err, artifactsregistry.ErrImageNotExists) {
+		zap.L().Warn("Failed to delete build image", zap.String("buildID", buildID.String()), zap.String("templateID", templateID), zap.Error(err))
+	}
+
+	return nil
+}
+
+func (c *Collector) deleteChunk(ctx context.Context, objectPath string) error {
+	// The chunk is deleted as the single object, its path is a prefix of the chunks with the same hash encrypted with other keys.
+	object, err := c.persistence.OpenObject(ctx, objectPath)
+	if err != nil {
+		return err
+	}
+
+	err = object.Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete chunk: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package gc
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+type fakeStore struct {
+	builds      []*models.EnvBuild
+	generations []uuid.UUID
+
+	deleted []uuid.UUID
+}
+
+func (f *fakeStore) GetEnvBuildsForGC(context.Context) ([]*models.EnvBuild, error) {
+	return f.builds, nil
+}
+
+func (f *fakeStore) GetVolumeGenerations(context.Context) ([]uuid.UUID, error) {
+	return f.generations, nil
+}
+
+func (f *fakeStore) DeleteMarkedEnvBuilds(_ context.Context, buildIDs []uuid.UUID) error {
+	f.deleted = append(f.deleted, buildIDs...)
+
+	return nil
+}
+
+func writeBuild(t *testing.T, basePath string, p storage.StorageProvider, buildID uuid.UUID, referenced []uuid.UUID, updatedAt time.Time) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, buildID.String()+"/"+storage.MemfileName)
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(strings.NewReader("data"))
+	require.NoError(t, err)
+
+	mappings := []*header.BuildMap{{Offset: 0, Length: 4096, BuildId: buildID}}
+	for i, id := range referenced {
+		mappings = append(mappings, &header.BuildMap{Offset: uint64(i+1) * 4096, Length: 4096, BuildId: id})
+	}
+
+	serialized, err := header.SerializeHeader(header.NewHeader(header.NewTemplateMetadata(buildID, 4096, uint64(len(mappings))*4096), mappings))
+	require.NoError(t, err)
+
+	obj, err = p.OpenObject(ctx, buildID.String()+"/"+storage.MemfileName+storage.HeaderSuffix)
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(serialized)
+	require.NoError(t, err)
+
+	for _, name := range []string{storage.MemfileName, storage.MemfileName + storage.HeaderSuffix} {
+		require.NoError(t, os.Chtimes(filepath.Join(basePath, buildID.String(), name), updatedAt, updatedAt))
+	}
+}
+
+func TestCollector_Run(t *testing.T) {
+	ctx := context.Background()
+	basePath := t.TempDir()
+
+	p, err := storage.NewFileSystemStorageProvider(basePath)
+	require.NoError(t, err)
+
+	registry, err := artifactsregistry.NewLocalArtifactsRegistry()
+	require.NoError(t, err)
+
+	old := time.Now().Add(-48 * time.Hour)
+	markedLongAgo := time.Now().Add(-48 * time.Hour)
+	templateID := "template"
+
+	template := uuid.New()
+	snapshot := uuid.New()
+	compacted := uuid.New()
+	failed := uuid.New()
+	orphan := uuid.New()
+	uploading := uuid.New()
+	volume := uuid.New()
+	volumeBase := uuid.New()
+
+	// The snapshot was compacted, but the last pause still references it.
+	writeBuild(t, basePath, p, template, nil, old)
+	writeBuild(t, basePath, p, snapshot, []uuid.UUID{template}, old)
+	writeBuild(t, basePath, p, compacted, []uuid.UUID{template, snapshot}, old)
+	writeBuild(t, basePath, p, failed, nil, old)
+	writeBuild(t, basePath, p, orphan, nil, old)
+	writeBuild(t, basePath, p, uploading, nil, time.Now())
+	writeBuild(t, basePath, p, volume, []uuid.UUID{volumeBase}, old)
+	writeBuild(t, basePath, p, volumeBase, nil, old)
+
+	// The shared data are never collected.
+	obj, err := p.OpenObject(ctx, "keys/"+uuid.NewString())
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(strings.NewReader("key"))
+	require.NoError(t, err)
+
+	store := &fakeStore{
+		builds: []*models.EnvBuild{
+			{ID: template, EnvID: &templateID, Status: envbuild.StatusUploaded},
+			{ID: snapshot, EnvID: &templateID, Status: envbuild.StatusSuccess, MarkedForGcAt: &markedLongAgo},
+			{ID: compacted, EnvID: &templateID, Status: envbuild.StatusSuccess},
+			{ID: failed, EnvID: &templateID, Status: envbuild.StatusFailed},
+		},
+		generations: []uuid.UUID{volume},
+	}
+
+	report, err := NewCollector(store, p, registry, 24*time.Hour, true).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, report.Reachable)
+	require.ElementsMatch(t, []uuid.UUID{failed, orphan}, report.Deleted)
+	require.Equal(t, []uuid.UUID{uploading}, report.Recent)
+
+	// The dry run doesn't delete anything.
+	directories, err := p.ListDirectories(ctx)
+	require.NoError(t, err)
+	require.Len(t, directories, 9)
+
+	report, err = NewCollector(store, p, registry, 24*time.Hour, false).Run(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{failed, orphan}, report.Deleted)
+	require.Empty(t, report.Failed)
+
+	directories, err = p.ListDirectories(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, directories, failed.String())
+	require.NotContains(t, directories, orphan.String())
+	require.Contains(t, directories, snapshot.String())
+	require.Contains(t, directories, "keys")
+
+	// The snapshot is collected once the compacted build doesn't reference it.
+	require.NoError(t, p.DeleteObjectsWithPrefix(ctx, compacted.String()))
+	writeBuild(t, basePath, p, compacted, []uuid.UUID{template}, old)
+
+	report, err = NewCollector(store, p, registry, 24*time.Hour, false).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{snapshot}, report.Deleted)
+	require.Equal(t, []uuid.UUID{snapshot}, store.deleted)
+}
+
+func writeChunkBuild(t *testing.T, basePath string, p storage.StorageProvider, buildID uuid.UUID, chunks []*header.BuildMap, updatedAt time.Time) {
+	t.Helper()
+
+	mappings := make([]*header.BuildMap, 0, len(chunks))
+	for i, chunk := range chunks {
+		mappings = append(mappings, &header.BuildMap{
+			Offset:  uint64(i) * header.ContentChunkSize,
+			Length:  header.ContentChunkSize,
+			BuildId: buildID,
+			Hash:    chunk.Hash,
+			KeyId:   chunk.KeyId,
+		})
+	}
+
+	metadata := header.NewTemplateMetadata(buildID, 4096, uint64(len(mappings))*header.ContentChunkSize)
+	metadata.Version = header.EncryptedVersion
+
+	serialized, err := header.SerializeHeader(header.NewHeader(metadata, mappings))
+	require.NoError(t, err)
+
+	obj, err := p.OpenObject(context.Background(), buildID.String()+"/"+storage.MemfileName+storage.HeaderSuffix)
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(serialized)
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chtimes(filepath.Join(basePath, buildID.String(), storage.MemfileName+storage.HeaderSuffix), updatedAt, updatedAt))
+
+	for _, chunk := range chunks {
+		writeChunk(t, basePath, p, chunk, updatedAt)
+	}
+}
+
+func writeChunk(t *testing.T, basePath string, p storage.StorageProvider, chunk *header.BuildMap, updatedAt time.Time) {
+	t.Helper()
+
+	obj, err := p.OpenObject(context.Background(), storage.StorageContentChunkPath(chunk.ChunkName()))
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(strings.NewReader("chunk"))
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chtimes(filepath.Join(basePath, storage.StorageContentChunkPath(chunk.ChunkName())), updatedAt, updatedAt))
+}
+
+func testChunk(data string, keyID uuid.UUID) *header.BuildMap {
+	return &header.BuildMap{Hash: header.ChunkHash(sha256.Sum256([]byte(data))), KeyId: keyID}
+}
+
+func TestCollector_RunChunks(t *testing.T) {
+	ctx := context.Background()
+	basePath := t.TempDir()
+
+	p, err := storage.NewFileSystemStorageProvider(basePath)
+	require.NoError(t, err)
+
+	registry, err := artifactsregistry.NewLocalArtifactsRegistry()
+	require.NoError(t, err)
+
+	old := time.Now().Add(-48 * time.Hour)
+	keyID := uuid.New()
+
+	template := uuid.New()
+	orphan := uuid.New()
+	uploading := uuid.New()
+
+	shared := testChunk("shared", uuid.Nil)
+	used := testChunk("used", uuid.Nil)
+	orphaned := testChunk("orphaned", uuid.Nil)
+	uploaded := testChunk("uploaded", uuid.Nil)
+	unreferenced := testChunk("unreferenced", uuid.Nil)
+	recent := testChunk("recent", uuid.Nil)
+	// The chunk with the same data encrypted with another key has the name prefixed by the unencrypted chunk name.
+	encrypted := testChunk("orphaned", keyID)
+
+	writeChunkBuild(t, basePath, p, template, []*header.BuildMap{shared, used, encrypted}, old)
+	writeChunkBuild(t, basePath, p, orphan, []*header.BuildMap{shared, orphaned}, old)
+	writeChunkBuild(t, basePath, p, uploading, []*header.BuildMap{uploaded}, time.Now())
+	// The chunk of the build being uploaded is old, it was uploaded by an earlier build.
+	writeChunk(t, basePath, p, uploaded, old)
+	writeChunk(t, basePath, p, unreferenced, old)
+	writeChunk(t, basePath, p, recent, time.Now())
+
+	store := &fakeStore{
+		builds: []*models.EnvBuild{
+			{ID: template, Status: envbuild.StatusUploaded},
+		},
+	}
+
+	report, err := NewCollector(store, p, registry, 24*time.Hour, true).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{orphan}, report.Deleted)
+	require.Equal(t, []uuid.UUID{uploading}, report.Recent)
+	require.Equal(t, 4, report.ReachableChunks)
+	require.ElementsMatch(t, []string{orphaned.ChunkName(), unreferenced.ChunkName()}, report.DeletedChunks)
+	require.Equal(t, []string{recent.ChunkName()}, report.RecentChunks)
+
+	// The dry run doesn't delete anything.
+	objects, err := p.ListObjectsWithPrefix(ctx, storage.ContentChunksDir)
+	require.NoError(t, err)
+	require.Len(t, objects, 7)
+
+	report, err = NewCollector(store, p, registry, 24*time.Hour, false).Run(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{orphaned.ChunkName(), unreferenced.ChunkName()}, report.DeletedChunks)
+	require.Empty(t, report.FailedChunks)
+
+	objects, err = p.ListObjectsWithPrefix(ctx, storage.ContentChunksDir)
+	require.NoError(t, err)
+
+	var remaining []string
+	for _, object := range objects {
+		remaining = append(remaining, object.Path)
+	}
+
+	require.ElementsMatch(t, []string{
+		storage.StorageContentChunkPath(shared.ChunkName()),
+		storage.StorageContentChunkPath(used.ChunkName()),
+		storage.StorageContentChunkPath(encrypted.ChunkName()),
+		storage.StorageContentChunkPath(uploaded.ChunkName()),
+		storage.StorageContentChunkPath(recent.ChunkName()),
+	}, remaining)
+}
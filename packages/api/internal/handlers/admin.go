@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"net/http"
 	"slices"
@@ -46,13 +47,21 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 		return
 	}
 
+	migrate := body.DrainMode != nil && *body.DrainMode == api.Migrate
+	evacuate := body.DrainMode != nil && *body.DrainMode == api.Evacuate
+	if (migrate || evacuate) && body.Status != api.NodeStatusDraining {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Drain mode can be set only for the draining status")
+
+		return
+	}
+
 	node := a.orchestrator.GetNode(nodeId)
 	if node == nil {
 		c.Status(http.StatusNotFound)
 		return
 	}
 
-	err = node.SendStatusChange(ctx, body.Status)
+	err = node.SendStatusChange(ctx, body.Status, evacuate)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when sending status change: %s", err))
 
@@ -60,5 +69,10 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 		return
 	}
 
+	if migrate {
+		// The migration outlives the request, the failures are logged.
+		go a.orchestrator.MigrateNodeInstances(context.WithoutCancel(ctx), nodeId)
+	}
+
 	c.Status(http.StatusNoContent)
 }
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+)
+
+// maxDatasets is the number of the read-only drives the sandbox has for the datasets.
+const maxDatasets = 4
+
+// resolveDatasets returns the mount paths of the datasets mapped to the latest builds of the dataset templates.
+// The team has to have access to the dataset templates the same way as to the templates it starts sandboxes from.
+func (a *APIStore) resolveDatasets(ctx context.Context, teamID uuid.UUID, datasets []api.SandboxDataset) (map[string]string, *api.APIError) {
+	result := make(map[string]string, len(datasets))
+	for _, dataset := range datasets {
+		err := validateMountPath(dataset.Path)
+		if err != nil {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid dataset: %s", err),
+				Err:       err,
+			}
+		}
+
+		if _, ok := result[dataset.Path]; ok {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid dataset: mount path '%s' is used more than once", dataset.Path),
+				Err:       fmt.Errorf("duplicate dataset mount path '%s'", dataset.Path),
+			}
+		}
+
+		templateID, err := id.CleanEnvID(dataset.TemplateID)
+		if err != nil {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid dataset template ID: %s", err),
+				Err:       err,
+			}
+		}
+
+		_, build, apiErr := a.templateCache.Get(ctx, templateID, teamID, true)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		result[dataset.Path] = build.ID.String()
+	}
+
+	if len(result) > maxDatasets {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("At most %d datasets can be attached to the sandbox", maxDatasets),
+			Err:       fmt.Errorf("too many datasets: %d", len(result)),
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// createTestDatasetTemplate creates a template of the team with an uploaded build and returns the template ID and the build.
+func createTestDatasetTemplate(t *testing.T, a *APIStore, team *models.Team, public bool) (string, *models.EnvBuild) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	envID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(envID).SetTeamID(team.ID).SetPublic(public).Exec(ctx))
+
+	build, err := a.db.Client.EnvBuild.
+		Create().
+		SetEnvID(envID).
+		SetStatus(envbuild.StatusUploaded).
+		SetFinishedAt(time.Now()).
+		SetVcpu(2).
+		SetRAMMB(512).
+		SetFreeDiskSizeMB(512).
+		SetKernelVersion("vmlinux-6.1.102").
+		SetFirecrackerVersion("v1.10.1_1fcdaec").
+		Save(ctx)
+	require.NoError(t, err)
+
+	return envID, build
+}
+
+func TestAPIStore_ResolveDatasets(t *testing.T) {
+	a := newTestStore(t)
+	a.templateCache = templatecache.NewTemplateCache(a.sqlcDB)
+
+	team := createTestTeam(t, a)
+	otherTeam := createTestTeam(t, a)
+
+	ctx := context.Background()
+
+	t.Run("datasets are mapped to the builds", func(t *testing.T) {
+		templateID, build := createTestDatasetTemplate(t, a, team, false)
+		publicTemplateID, publicBuild := createTestDatasetTemplate(t, a, otherTeam, true)
+
+		datasets, apiErr := a.resolveDatasets(ctx, team.ID, []api.SandboxDataset{
+			{Path: "/data", TemplateID: templateID},
+			{Path: "/public", TemplateID: publicTemplateID},
+		})
+		require.Nil(t, apiErr)
+		assert.Equal(t, map[string]string{
+			"/data":   build.ID.String(),
+			"/public": publicBuild.ID.String(),
+		}, datasets)
+	})
+
+	t.Run("template of another team is rejected", func(t *testing.T) {
+		templateID, _ := createTestDatasetTemplate(t, a, otherTeam, false)
+
+		_, apiErr := a.resolveDatasets(ctx, team.ID, []api.SandboxDataset{{Path: "/data", TemplateID: templateID}})
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusForbidden, apiErr.Code)
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		_, apiErr := a.resolveDatasets(ctx, team.ID, []api.SandboxDataset{{Path: "/data", TemplateID: id.Generate()}})
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.Code)
+	})
+
+	t.Run("invalid datasets", func(t *testing.T) {
+		templateID, _ := createTestDatasetTemplate(t, a, team, false)
+
+		tooMany := make([]api.SandboxDataset, 0, maxDatasets+1)
+		for i := range maxDatasets + 1 {
+			tooMany = append(tooMany, api.SandboxDataset{Path: fmt.Sprintf("/data-%d", i), TemplateID: templateID})
+		}
+
+		tests := []struct {
+			name     string
+			datasets []api.SandboxDataset
+		}{
+			{name: "relative path", datasets: []api.SandboxDataset{{Path: "data", TemplateID: templateID}}},
+			{name: "duplicate path", datasets: []api.SandboxDataset{{Path: "/data", TemplateID: templateID}, {Path: "/data", TemplateID: templateID}}},
+			{name: "invalid template ID", datasets: []api.SandboxDataset{{Path: "/data", TemplateID: "Invalid ID!"}}},
+			{name: "too many datasets", datasets: tooMany},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, apiErr := a.resolveDatasets(ctx, team.ID, tt.datasets)
+				require.NotNil(t, apiErr)
+				assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+			})
+		}
+	})
+}
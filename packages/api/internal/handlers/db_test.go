@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+
+	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const testMigrationsDir = "../../../db/migrations"
+
+// newTestStore returns an API store backed by the database from POSTGRES_CONNECTION_STRING with all migrations applied.
+// The database should be a disposable one, the tests are skipped when it isn't configured.
+func newTestStore(t *testing.T) *APIStore {
+	t.Helper()
+
+	connectionString := os.Getenv("POSTGRES_CONNECTION_STRING")
+	if connectionString == "" {
+		t.Skip("POSTGRES_CONNECTION_STRING is not set")
+	}
+
+	ctx := context.Background()
+
+	migrationDB, err := sql.Open("postgres", connectionString)
+	require.NoError(t, err)
+	defer migrationDB.Close()
+
+	goose.SetTableName("_migrations")
+	require.NoError(t, goose.SetDialect("postgres"))
+	require.NoError(t, goose.UpContext(ctx, migrationDB, testMigrationsDir))
+
+	dbClient, err := db.NewClient(5, 5)
+	require.NoError(t, err)
+	t.Cleanup(func() { dbClient.Close() })
+
+	sqlcDB, err := sqlcdb.NewClient(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlcDB.Close() })
+
+	posthogClient, err := analyticscollector.NewPosthogClient()
+	require.NoError(t, err)
+
+	return &APIStore{
+		db:      dbClient,
+		sqlcDB:  sqlcDB,
+		posthog: posthogClient,
+	}
+}
+
+// createTestTeam creates a team in the base tier.
+func createTestTeam(t *testing.T, a *APIStore) *models.Team {
+	t.Helper()
+
+	team, err := a.db.Client.Team.
+		Create().
+		SetName("test").
+		SetEmail(uuid.NewString() + "@e2b.dev").
+		SetTier("base_v1").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	return team
+}
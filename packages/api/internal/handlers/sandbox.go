@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -31,6 +32,9 @@ func (a *APIStore) startSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAccessToken *string,
+	network *api.SandboxNetworkConfig,
+	volumes []*orchestratorgrpc.SandboxVolume,
+	datasets map[string]string,
 ) (*api.Sandbox, *api.APIError) {
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
@@ -54,6 +58,10 @@ func (a *APIStore) startSandbox(
 		baseTemplateID,
 		autoPause,
 		envdAccessToken,
+		network,
+		volumes,
+		datasets,
+		nil,
 	)
 	if instanceErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when creating instance", instanceErr.Err)
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	checkpoints, err := a.sqlcDB.GetCheckpoints(ctx, queries.GetCheckpointsParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		zap.L().Error("Error getting checkpoints", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting checkpoints")
+
+		return
+	}
+
+	result := make([]api.Checkpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		item := api.Checkpoint{
+			CheckpointID: checkpoint.ID.String(),
+			Name:         checkpoint.Name,
+			SandboxID:    checkpoint.SandboxID,
+			TemplateID:   checkpoint.BaseEnvID,
+			CreatedAt:    checkpoint.CreatedAt.Time,
+		}
+
+		if checkpoint.Metadata != nil {
+			meta := api.SandboxMetadata(checkpoint.Metadata)
+			item.Metadata = &meta
+		}
+
+		result = append(result, item)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if body.Name == "" {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Checkpoint name cannot be empty")
+
+		return
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error creating checkpoint - sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error creating checkpoint - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	checkpoint, err := a.orchestrator.CheckpointInstance(ctx, sbx, teamID, body.Name)
+	if err != nil {
+		if models.IsConstraintError(err) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", body.Name, sandboxID))
+
+			return
+		}
+
+		zap.L().Error("Error creating checkpoint", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating checkpoint")
+
+		return
+	}
+
+	result := api.Checkpoint{
+		CheckpointID: checkpoint.ID.String(),
+		Name:         checkpoint.Name,
+		SandboxID:    checkpoint.SandboxID,
+		TemplateID:   checkpoint.BaseEnvID,
+		CreatedAt:    checkpoint.CreatedAt,
+	}
+
+	if checkpoint.Metadata != nil {
+		meta := api.SandboxMetadata(checkpoint.Metadata)
+		result.Metadata = &meta
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (a *APIStore) DeleteSandboxesSandboxIDCheckpointsCheckpointID(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	parsedCheckpointID, err := uuid.Parse(checkpointID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+		return
+	}
+
+	err = a.db.DeleteCheckpoint(ctx, parsedCheckpointID, sandboxID, teamID)
+	if err != nil {
+		if errors.Is(err, db.CheckpointNotFound{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+			return
+		}
+
+		zap.L().Error("Error deleting checkpoint", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting checkpoint")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	parsedCheckpointID, err := uuid.Parse(checkpointID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+		return
+	}
+
+	checkpoint, err := a.sqlcDB.GetCheckpoint(ctx, queries.GetCheckpointParams{
+		CheckpointID: parsedCheckpointID,
+		SandboxID:    sandboxID,
+		TeamID:       teamInfo.Team.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+			return
+		}
+
+		zap.L().Error("Error getting checkpoint", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting checkpoint")
+
+		return
+	}
+
+	cp := checkpoint.Checkpoint
+	build := checkpoint.EnvBuild
+
+	alias := ""
+	if len(checkpoint.Aliases) > 0 {
+		alias = checkpoint.Aliases[0]
+	}
+
+	newSandboxID := InstanceIDPrefix + id.Generate()
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  newSandboxID,
+		TemplateID: *build.EnvID,
+		TeamID:     teamInfo.Team.ID.String(),
+	}).Debug("Started restoring sandbox from checkpoint", zap.String("checkpoint_id", checkpointID))
+
+	// The memory of the checkpoint contains envd initialized with the token of the original sandbox.
+	var envdAccessToken *string = nil
+	if cp.EnvSecure {
+		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, cp.SandboxID)
+		if tokenErr != nil {
+			zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithTemplateID(*build.EnvID), logger.WithBuildID(build.ID.String()))
+			a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
+			return
+		}
+
+		envdAccessToken = &accessToken
+	}
+
+	sbx, createErr := a.startSandbox(
+		ctx,
+		newSandboxID,
+		timeout,
+		nil,
+		cp.Metadata,
+		alias,
+		teamInfo,
+		build,
+		&c.Request.Header,
+		true,
+		nil,
+		cp.BaseEnvID,
+		autoPause,
+		envdAccessToken,
+		orchestrator.NetworkFromSnapshot(cp.Network),
+		nil,
+		build.Datasets,
+	)
+	if createErr != nil {
+		zap.L().Error("Failed to restore sandbox from checkpoint", zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &sbx)
+}
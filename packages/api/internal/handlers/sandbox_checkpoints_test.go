@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// createTestCheckpoint creates a finished checkpoint of the sandbox.
+func createTestCheckpoint(t *testing.T, a *APIStore, team *models.Team, baseEnvID string, sandboxID string, name string, network *types.SandboxNetworkConfig) (*models.Checkpoint, *models.EnvBuild) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	checkpoint, build, err := a.db.NewCheckpointBuild(ctx, name, &db.SnapshotInfo{
+		SandboxID:          sandboxID,
+		SandboxStartedAt:   time.Now(),
+		BaseTemplateID:     baseEnvID,
+		VCPU:               2,
+		RAMMB:              512,
+		Metadata:           map[string]string{"key": "value"},
+		KernelVersion:      "vmlinux-6.1.102",
+		FirecrackerVersion: "v1.10.1_1fcdaec",
+		EnvdVersion:        "0.2.0",
+		Network:            network,
+	}, team.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, a.db.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess))
+
+	return checkpoint, build
+}
+
+func TestCheckpointNetworkConfig(t *testing.T) {
+	a := newTestStore(t)
+	team := createTestTeam(t, a)
+
+	ctx := context.Background()
+
+	baseEnvID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	sandboxID := "i" + id.Generate()
+
+	network := &types.SandboxNetworkConfig{DeniedCidrs: []string{"8.8.8.8/32"}, AllowedDomains: []string{"*.amazonaws.com"}}
+
+	restricted, _ := createTestCheckpoint(t, a, team, baseEnvID, sandboxID, "restricted", network)
+	open, _ := createTestCheckpoint(t, a, team, baseEnvID, sandboxID, "open", nil)
+
+	row, err := a.sqlcDB.GetCheckpoint(ctx, queries.GetCheckpointParams{CheckpointID: restricted.ID, SandboxID: sandboxID, TeamID: team.ID})
+	require.NoError(t, err)
+	assert.Equal(t, network, row.Checkpoint.Network)
+
+	restored := orchestrator.NetworkFromSnapshot(row.Checkpoint.Network)
+	require.NotNil(t, restored)
+	assert.Equal(t, []string{"8.8.8.8/32"}, *restored.DeniedCidrs)
+
+	row, err = a.sqlcDB.GetCheckpoint(ctx, queries.GetCheckpointParams{CheckpointID: open.ID, SandboxID: sandboxID, TeamID: team.ID})
+	require.NoError(t, err)
+	assert.Nil(t, row.Checkpoint.Network)
+}
+
+// checkpointRequest calls the checkpoint handler as the team.
+func checkpointRequest(t *testing.T, team *models.Team, method string, path string, body any, handler func(c *gin.Context)) *httptest.ResponseRecorder {
+	t.Helper()
+
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, path, bytes.NewReader(data))
+	c.Set(auth.TeamContextKey, authcache.AuthTeamInfo{Team: team})
+
+	handler(c)
+
+	return w
+}
+
+func TestAPIStore_Checkpoints(t *testing.T) {
+	a := newTestStore(t)
+	team := createTestTeam(t, a)
+	otherTeam := createTestTeam(t, a)
+
+	ctx := context.Background()
+
+	baseEnvID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	sandboxID := "i" + id.Generate()
+
+	first, _ := createTestCheckpoint(t, a, team, baseEnvID, sandboxID, "first", nil)
+	second, secondBuild := createTestCheckpoint(t, a, team, baseEnvID, sandboxID, "second", nil)
+
+	list := func(team *models.Team) []api.Checkpoint {
+		w := checkpointRequest(t, team, http.MethodGet, "/sandboxes/"+sandboxID+"/checkpoints", nil, func(c *gin.Context) {
+			a.GetSandboxesSandboxIDCheckpoints(c, sandboxID)
+		})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var checkpoints []api.Checkpoint
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &checkpoints))
+
+		return checkpoints
+	}
+
+	t.Run("list returns the checkpoints of the team", func(t *testing.T) {
+		checkpoints := list(team)
+		require.Len(t, checkpoints, 2)
+
+		ids := []string{checkpoints[0].CheckpointID, checkpoints[1].CheckpointID}
+		assert.ElementsMatch(t, []string{first.ID.String(), second.ID.String()}, ids)
+
+		for _, checkpoint := range checkpoints {
+			assert.Equal(t, sandboxID, checkpoint.SandboxID)
+			assert.Equal(t, baseEnvID, checkpoint.TemplateID)
+			require.NotNil(t, checkpoint.Metadata)
+			assert.Equal(t, api.SandboxMetadata{"key": "value"}, *checkpoint.Metadata)
+		}
+
+		assert.Empty(t, list(otherTeam))
+	})
+
+	t.Run("create requires the name", func(t *testing.T) {
+		w := checkpointRequest(t, team, http.MethodPost, "/sandboxes/"+sandboxID+"/checkpoints", api.NewCheckpoint{}, func(c *gin.Context) {
+			a.PostSandboxesSandboxIDCheckpoints(c, sandboxID)
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("restore of a missing checkpoint", func(t *testing.T) {
+		for _, tc := range []struct {
+			name         string
+			team         *models.Team
+			sandboxID    string
+			checkpointID string
+		}{
+			{name: "invalid ID", team: team, sandboxID: sandboxID, checkpointID: "invalid"},
+			{name: "unknown ID", team: team, sandboxID: sandboxID, checkpointID: uuid.NewString()},
+			{name: "another sandbox", team: team, sandboxID: "i" + id.Generate(), checkpointID: first.ID.String()},
+			{name: "another team", team: otherTeam, sandboxID: sandboxID, checkpointID: first.ID.String()},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				w := checkpointRequest(t, tc.team, http.MethodPost, "/sandboxes/"+tc.sandboxID+"/checkpoints/"+tc.checkpointID+"/restore", api.ResumedSandbox{}, func(c *gin.Context) {
+					a.PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c, tc.sandboxID, tc.checkpointID)
+				})
+				assert.Equal(t, http.StatusNotFound, w.Code)
+			})
+		}
+	})
+
+	t.Run("delete queues the checkpoint data for the garbage collection", func(t *testing.T) {
+		deleteCheckpoint := func(team *models.Team, checkpointID string) int {
+			w := checkpointRequest(t, team, http.MethodDelete, "/sandboxes/"+sandboxID+"/checkpoints/"+checkpointID, nil, func(c *gin.Context) {
+				a.DeleteSandboxesSandboxIDCheckpointsCheckpointID(c, sandboxID, checkpointID)
+			})
+
+			return w.Code
+		}
+
+		assert.Equal(t, http.StatusNotFound, deleteCheckpoint(otherTeam, second.ID.String()))
+		assert.Equal(t, http.StatusNoContent, deleteCheckpoint(team, second.ID.String()))
+		assert.Equal(t, http.StatusNotFound, deleteCheckpoint(team, second.ID.String()))
+
+		checkpoints := list(team)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, first.ID.String(), checkpoints[0].CheckpointID)
+
+		_, err := a.sqlcDB.GetCheckpoint(ctx, queries.GetCheckpointParams{CheckpointID: second.ID, SandboxID: sandboxID, TeamID: team.ID})
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		exists, err := a.db.Client.Env.Query().Where(env.ID(*secondBuild.EnvID)).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// The build is kept for the garbage collection, the sandboxes restored from the checkpoint can still read from it.
+		build, err := a.db.Client.EnvBuild.Get(ctx, secondBuild.ID)
+		require.NoError(t, err)
+		assert.Nil(t, build.EnvID)
+		assert.NotNil(t, build.MarkedForGcAt)
+	})
+}
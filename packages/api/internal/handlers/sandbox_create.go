@@ -3,6 +3,7 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"time"
 
@@ -16,7 +17,9 @@ import (
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/middleware/otel/metrics"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
@@ -136,6 +139,62 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		autoPause = *body.AutoPause
 	}
 
+	if body.Network != nil {
+		err = validateNetworkConfig(body.Network)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network configuration: %s", err))
+
+			return
+		}
+	}
+
+	if body.Volumes != nil {
+		err = validateVolumeMounts(*body.Volumes)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid volumes: %s", err))
+
+			return
+		}
+	}
+
+	// The datasets requested for the sandbox replace the datasets of the template mounted at the same path.
+	datasets := maps.Clone(build.Datasets)
+	if body.Datasets != nil {
+		requested, datasetsErr := a.resolveDatasets(ctx, teamInfo.Team.ID, *body.Datasets)
+		if datasetsErr != nil {
+			a.sendAPIStoreError(c, datasetsErr.Code, datasetsErr.ClientMsg)
+
+			return
+		}
+
+		if datasets == nil {
+			datasets = requested
+		} else {
+			maps.Copy(datasets, requested)
+		}
+	}
+
+	if len(datasets) > maxDatasets {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("At most %d datasets can be attached to the sandbox, including the datasets of the template", maxDatasets))
+
+		return
+	}
+
+	if body.Volumes != nil {
+		for _, mount := range *body.Volumes {
+			mountPath := orchestrator.DefaultVolumeMountPath
+			if mount.Path != nil {
+				mountPath = *mount.Path
+			}
+
+			if _, ok := datasets[mountPath]; ok {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Volume '%s' can't be mounted at '%s', the path is used by a dataset", mount.Name, mountPath))
+
+				return
+			}
+		}
+	}
+
 	var envdAccessToken *string = nil
 	if body.Secure != nil && *body.Secure == true {
 		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
@@ -148,6 +207,18 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		envdAccessToken = &accessToken
 	}
 
+	var volumes []*orchestratorgrpc.SandboxVolume
+	if body.Volumes != nil {
+		var volumesErr *api.APIError
+		volumes, volumesErr = a.orchestrator.AttachVolumes(ctx, teamInfo.Team.ID, sandboxID, *body.Volumes)
+		if volumesErr != nil {
+			zap.L().Error("Failed to attach volumes", logger.WithSandboxID(sandboxID), zap.Error(volumesErr.Err))
+			a.sendAPIStoreError(c, volumesErr.Code, volumesErr.ClientMsg)
+
+			return
+		}
+	}
+
 	sbx, createErr := a.startSandbox(
 		ctx,
 		sandboxID,
@@ -163,8 +234,15 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		env.TemplateID,
 		autoPause,
 		envdAccessToken,
+		body.Network,
+		volumes,
+		datasets,
 	)
 	if createErr != nil {
+		if len(volumes) > 0 {
+			a.orchestrator.ReleaseVolumes(ctx, teamInfo.Team.ID, sandboxID)
+		}
+
 		zap.L().Error("Failed to create sandbox", zap.Error(createErr.Err))
 		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
 		return
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	forkCountDefault = 1
+	forkCountMax     = 20
+)
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	count := forkCountDefault
+	if body.Count != nil {
+		count = int(*body.Count)
+
+		if count < 1 || count > forkCountMax {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Count must be between 1 and %d", forkCountMax))
+
+			return
+		}
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		zap.L().Debug("Sandbox not found", logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error forking sandbox - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamInfo.Team.ID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error forking sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		return
+	}
+
+	sandboxIDs := make([]string, 0, count)
+	for range count {
+		sandboxIDs = append(sandboxIDs, InstanceIDPrefix+id.Generate())
+	}
+
+	sandboxes, forkErr := a.orchestrator.ForkInstance(ctx, sbx, teamInfo, sandboxIDs, timeout)
+	if forkErr != nil {
+		zap.L().Error("Failed to fork sandbox", logger.WithSandboxID(sandboxID), zap.Error(forkErr.Err))
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	for _, child := range sandboxes {
+		sbxlogger.E(&sbxlogger.SandboxMetadata{
+			SandboxID:  child.SandboxID,
+			TemplateID: child.TemplateID,
+			TeamID:     teamInfo.Team.ID.String(),
+		}).Info("Sandbox forked", zap.String("parent_sandbox_id", sandboxID))
+	}
+
+	c.JSON(http.StatusCreated, sandboxes)
+}
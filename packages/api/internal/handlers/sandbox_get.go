@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -63,6 +65,18 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 
 	// If sandbox not found try to get the latest snapshot
 	lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxId, TeamID: team.ID})
+
+	// The recently ended sandbox is returned with the exit, unless it was paused again after it had ended.
+	exit, exitErr := a.orchestrator.GetSandboxExit(ctx, sandboxId, team.ID)
+	if exitErr != nil && !errors.Is(exitErr, sql.ErrNoRows) {
+		zap.L().Error("error getting sandbox exit", logger.WithSandboxID(id), zap.Error(exitErr))
+	}
+
+	if exitErr == nil && (err != nil || exit.StartedAt.Time.After(lastSnapshot.Snapshot.SandboxStartedAt.Time)) {
+		c.JSON(http.StatusOK, endedSandboxDetail(exit))
+		return
+	}
+
 	if err != nil {
 		zap.L().Error("error getting last snapshot for sandbox", logger.WithSandboxID(id), zap.Error(err))
 		c.JSON(http.StatusNotFound, fmt.Sprintf("sandbox \"%s\" doesn't exist or you don't have access to it", id))
@@ -110,3 +124,32 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 
 	c.JSON(http.StatusOK, sandbox)
 }
+
+func endedSandboxDetail(exit *queries.SandboxExit) api.SandboxDetail {
+	sandbox := api.SandboxDetail{
+		ClientID:    "00000000", // for backwards compatibility we need to return a client id
+		TemplateID:  exit.EnvID,
+		Alias:       exit.Alias,
+		SandboxID:   exit.SandboxID,
+		StartedAt:   exit.StartedAt.Time,
+		CpuCount:    api.CPUCount(exit.Vcpu),
+		MemoryMB:    api.MemoryMB(exit.RamMb),
+		DiskSizeMB:  exit.TotalDiskSizeMb,
+		EndAt:       exit.EndedAt.Time,
+		State:       api.Ended,
+		EnvdVersion: exit.EnvdVersion,
+		Exit: &api.SandboxExit{
+			Reason:   api.SandboxExitReason(exit.Reason),
+			Message:  exit.Message,
+			ExitCode: exit.ExitCode,
+			EndedAt:  exit.EndedAt.Time,
+		},
+	}
+
+	if exit.Metadata != nil {
+		metadata := api.SandboxMetadata(exit.Metadata)
+		sandbox.Metadata = &metadata
+	}
+
+	return sandbox
+}
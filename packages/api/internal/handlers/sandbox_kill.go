@@ -24,11 +24,33 @@ func (a *APIStore) deleteSnapshot(ctx context.Context, sandboxID string, teamID
 		return err
 	}
 
+	buildIDs := make([]uuid.UUID, 0, len(builds))
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.ID)
+	}
+
+	// Templates created from the snapshot reference its diffs, so the files have to be kept
+	linked, err := a.db.HasLinkedBuilds(ctx, buildIDs)
+	if err != nil {
+		return err
+	}
+
 	dbErr := a.db.DeleteEnv(ctx, env.ID)
 	if dbErr != nil {
 		return fmt.Errorf("error deleting env from db: %w", dbErr)
 	}
 
+	a.templateCache.Invalidate(env.ID)
+
+	// The paused sandbox kept its volumes attached for the resume.
+	a.orchestrator.ReleaseVolumes(ctx, teamID, sandboxID)
+
+	if linked {
+		telemetry.ReportEvent(ctx, "keeping snapshot builds referenced by templates", telemetry.WithSandboxID(sandboxID))
+
+		return nil
+	}
+
 	go func() {
 		// remove any snapshots when the sandbox is not running
 		deleteCtx, span := a.Tracer.Start(context.Background(), "delete-snapshot")
@@ -60,8 +82,6 @@ func (a *APIStore) deleteSnapshot(ctx context.Context, sandboxID string, teamID
 		}
 	}()
 
-	a.templateCache.Invalidate(env.ID)
-
 	return nil
 }
 
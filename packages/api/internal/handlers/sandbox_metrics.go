@@ -116,6 +116,14 @@ func (a *APIStore) GetSandboxesSandboxIDMetricsFromClickhouse(
 			CpuCount:    int32(m.CPUCount),
 			MemTotalMiB: int64(m.MemTotalMiB),
 			MemUsedMiB:  int64(m.MemUsedMiB),
+
+			MemBalloonMiB: int64(m.MemBalloonMiB),
+			MemFreeMiB:    int64(m.MemFreeMiB),
+
+			NetEgressBytes:    int64(m.NetEgressBytes),
+			NetEgressPackets:  int64(m.NetEgressPackets),
+			NetIngressBytes:   int64(m.NetIngressBytes),
+			NetIngressPackets: int64(m.NetIngressPackets),
 		}
 	}
 
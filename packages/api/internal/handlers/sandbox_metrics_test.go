@@ -82,6 +82,44 @@ func TestAPIStore_getSandboxesSandboxIDMetricsClickhouse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test network",
+			fields: fields{
+				clickhouseStore: &fakeClickhouseStore{
+					chdb.NewMockStore(),
+					[]chmodels.Metrics{
+						{
+							SandboxID:         "sandbox1",
+							TeamID:            "team1",
+							CPUCount:          1,
+							Timestamp:         aTimestamp,
+							NetEgressBytes:    2048,
+							NetEgressPackets:  4,
+							NetIngressBytes:   1024,
+							NetIngressPackets: 2,
+						},
+					},
+					nil,
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				sandboxID: "sandbox1",
+				teamID:    "team1",
+				limit:     10,
+				duration:  time.Hour * 24,
+			},
+			want: []api.SandboxMetric{
+				{
+					CpuCount:          1,
+					Timestamp:         aTimestamp,
+					NetEgressBytes:    2048,
+					NetEgressPackets:  4,
+					NetIngressBytes:   1024,
+					NetIngressPackets: 2,
+				},
+			},
+		},
 		{
 			name: "test error",
 			fields: fields{
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PostSandboxesSandboxIDMigrate(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDMigrateJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	apiErr := a.orchestrator.MigrateInstance(ctx, sandboxID, body.NodeID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, fmt.Sprintf("Error migrating sandbox: %s", apiErr.ClientMsg))
+
+		telemetry.ReportCriticalError(ctx, "error when migrating sandbox", apiErr.Err)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
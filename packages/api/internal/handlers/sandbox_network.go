@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PutSandboxesSandboxIDNetwork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PutSandboxesSandboxIDNetworkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	err = validateNetworkConfig(&body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network configuration: %s", err))
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		zap.L().Debug("Sandbox not found", logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error updating sandbox network - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error updating sandbox network - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	err = a.orchestrator.UpdateSandboxNetwork(ctx, sbx, &body)
+	if err != nil {
+		zap.L().Error("Error updating sandbox network", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error updating sandbox network")
+
+		return
+	}
+
+	sbxlogger.E(sbx).Info("Sandbox network updated")
+
+	c.Status(http.StatusNoContent)
+}
+
+// validateNetworkConfig checks that all the CIDRs are valid IPv4 prefixes, the slot firewall supports only IPv4,
+// and that the domains contain a wildcard only as the first label.
+func validateNetworkConfig(network *api.SandboxNetworkConfig) error {
+	if network.AllowedDomains != nil {
+		for _, domain := range *network.AllowedDomains {
+			name := strings.TrimPrefix(domain, "*.")
+			if name == "" || strings.ContainsAny(name, "* ") {
+				return fmt.Errorf("invalid domain '%s'", domain)
+			}
+		}
+	}
+
+	var cidrs []string
+	if network.AllowedCidrs != nil {
+		cidrs = append(cidrs, *network.AllowedCidrs...)
+	}
+	if network.DeniedCidrs != nil {
+		cidrs = append(cidrs, *network.DeniedCidrs...)
+	}
+
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR '%s'", cidr)
+		}
+
+		if !prefix.Addr().Is4() {
+			return fmt.Errorf("CIDR '%s' is not an IPv4 range", cidr)
+		}
+	}
+
+	return nil
+}
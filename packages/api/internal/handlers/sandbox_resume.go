@@ -16,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -143,6 +144,14 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		clientIDPtr = &clientID
 	}
 
+	volumes, volumesErr := a.orchestrator.ReattachVolumes(ctx, teamInfo.Team.ID, sandboxID)
+	if volumesErr != nil {
+		zap.L().Error("Failed to attach volumes", logger.WithSandboxID(sandboxID), zap.Error(volumesErr.Err))
+		a.sendAPIStoreError(c, volumesErr.Code, volumesErr.ClientMsg)
+
+		return
+	}
+
 	sbx, createErr := a.startSandbox(
 		ctx,
 		snap.SandboxID,
@@ -158,6 +167,9 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		snap.BaseEnvID,
 		autoPause,
 		envdAccessToken,
+		orchestrator.NetworkFromSnapshot(snap.Network),
+		volumes,
+		build.Datasets,
 	)
 
 	if createErr != nil {
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func TestResumeNetworkConfig(t *testing.T) {
+	a := newTestStore(t)
+	team := createTestTeam(t, a)
+
+	ctx := context.Background()
+
+	baseEnvID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	sandboxID := "i" + id.Generate()
+
+	pause := func(network *types.SandboxNetworkConfig) {
+		t.Helper()
+
+		build, err := a.db.NewSnapshotBuild(ctx, &db.SnapshotInfo{
+			SandboxID:          sandboxID,
+			SandboxStartedAt:   time.Now(),
+			BaseTemplateID:     baseEnvID,
+			VCPU:               2,
+			RAMMB:              512,
+			Metadata:           map[string]string{},
+			KernelVersion:      "vmlinux-6.1.102",
+			FirecrackerVersion: "v1.10.1_1fcdaec",
+			EnvdVersion:        "0.2.0",
+			Network:            network,
+		}, team.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, a.db.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess))
+	}
+
+	lastSnapshotNetwork := func() *types.SandboxNetworkConfig {
+		t.Helper()
+
+		lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: team.ID})
+		require.NoError(t, err)
+
+		return lastSnapshot.Snapshot.Network
+	}
+
+	network := &types.SandboxNetworkConfig{
+		AllowedCidrs:   []string{"1.1.1.1/32"},
+		DenyAll:        true,
+		AllowedDomains: []string{"pypi.org"},
+	}
+
+	pause(network)
+	assert.Equal(t, network, lastSnapshotNetwork())
+
+	resumed := orchestrator.NetworkFromSnapshot(lastSnapshotNetwork())
+	require.NotNil(t, resumed)
+	assert.True(t, *resumed.DenyAll)
+	assert.Equal(t, []string{"pypi.org"}, *resumed.AllowedDomains)
+
+	// The rules were removed while the sandbox was running after the resume.
+	pause(nil)
+	assert.Nil(t, lastSnapshotNetwork())
+	assert.Nil(t, orchestrator.NetworkFromSnapshot(lastSnapshotNetwork()))
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// templateBuildLinker links the files of an existing build to a new build in the storage.
+type templateBuildLinker interface {
+	LinkBuild(ctx context.Context, templateID string, buildID uuid.UUID, sourceBuildID uuid.UUID, clusterID *uuid.UUID, clusterNodeID *string) error
+}
+
+// PostSandboxesSandboxIDTemplate creates a new template from the last snapshot of the paused sandbox.
+// The template build references the snapshot diffs, so no data is copied.
+func (a *APIStore) PostSandboxesSandboxIDTemplate(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	team := a.GetTeamInfo(c).Team
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDTemplateJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	var alias string
+	if body.Alias != nil {
+		alias, err = id.CleanEnvID(*body.Alias)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid alias: %s", *body.Alias))
+
+			telemetry.ReportCriticalError(ctx, "invalid alias", err)
+
+			return
+		}
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: team.ID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error creating template - snapshot for sandbox '%s' was not found", sandboxID))
+
+			return
+		}
+
+		zap.L().Error("Error getting last snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting snapshot")
+
+		return
+	}
+
+	source := lastSnapshot.EnvBuild
+
+	templateID := id.Generate()
+	buildID, err := uuid.NewRandom()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when generating build id", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to generate build id")
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		telemetry.WithTemplateID(templateID),
+		telemetry.WithBuildID(buildID.String()),
+		attribute.String("env.source_build_id", source.ID.String()),
+		attribute.String("env.alias", alias),
+	)
+
+	tx, err := a.db.Client.Tx(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when starting transaction: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when starting transaction", err)
+
+		return
+	}
+	defer tx.Rollback()
+
+	template, err := tx.
+		Env.
+		Create().
+		SetID(templateID).
+		SetTeamID(team.ID).
+		SetPublic(false).
+		SetNillableClusterID(team.ClusterID).
+		Save(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when creating template: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when creating env", err)
+
+		return
+	}
+
+	// The build stays in building state until its files are linked in the storage
+	build, err := tx.
+		EnvBuild.
+		Create().
+		SetID(buildID).
+		SetEnvID(templateID).
+		SetStatus(envbuild.StatusBuilding).
+		SetVcpu(source.Vcpu).
+		SetRAMMB(source.RamMb).
+		SetFreeDiskSizeMB(source.FreeDiskSizeMb).
+		SetNillableTotalDiskSizeMB(source.TotalDiskSizeMb).
+		SetKernelVersion(source.KernelVersion).
+		SetFirecrackerVersion(source.FirecrackerVersion).
+		SetNillableEnvdVersion(source.EnvdVersion).
+		SetNillableClusterNodeID(source.ClusterNodeID).
+		SetSourceBuildID(source.ID).
+		SetDatasets(source.Datasets).
+		Save(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when inserting build: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when inserting build", err)
+
+		return
+	}
+
+	// Template the alias pointed to before, it has to be invalidated in the cache
+	var previousTemplateID string
+	if alias != "" {
+		envs, err := tx.
+			Env.
+			Query().
+			Where(env.ID(alias)).
+			All(ctx)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when querying alias '%s': %s", alias, err))
+			telemetry.ReportCriticalError(ctx, "error when checking alias", err, attribute.String("alias", alias))
+
+			return
+		}
+
+		if len(envs) > 0 {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Alias '%s' is already used", alias))
+			telemetry.ReportCriticalError(ctx, "conflict of alias", err, attribute.String("alias", alias))
+
+			return
+		}
+
+		aliasDB, err := tx.EnvAlias.Query().Where(envalias.ID(alias)).Only(ctx)
+		if err != nil {
+			if !models.IsNotFound(err) {
+				a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when querying for alias: %s", err))
+				telemetry.ReportCriticalError(ctx, "error when checking alias", err, attribute.String("alias", alias))
+
+				return
+			}
+		} else {
+			if !aliasDB.IsRenamable {
+				a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("Alias '%s' already used", alias))
+				telemetry.ReportCriticalError(ctx, "alias already used", err, attribute.String("alias", alias))
+
+				return
+			}
+
+			// Check if the old env belongs to the same team
+			oldEnv, oldEnvErr := tx.Env.Query().Where(env.ID(aliasDB.EnvID), env.TeamID(team.ID)).Only(ctx)
+			if oldEnvErr != nil || oldEnv == nil {
+				a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("Alias '%s' already used", alias))
+				telemetry.ReportCriticalError(ctx, "alias already used by another team", oldEnvErr, attribute.String("alias", alias))
+
+				return
+			}
+
+			// Same team — re-claim alias to point to the new template
+			_, delErr := tx.EnvAlias.Delete().Where(envalias.ID(alias)).Exec(ctx)
+			if delErr != nil {
+				a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when deleting old alias: %s", delErr))
+				telemetry.ReportCriticalError(ctx, "error when deleting old alias", delErr, attribute.String("alias", alias))
+
+				return
+			}
+
+			previousTemplateID = aliasDB.EnvID
+			telemetry.ReportEvent(ctx, "re-claimed alias from previous template", attribute.String("env.alias", alias), attribute.String("env.old_env_id", aliasDB.EnvID))
+		}
+
+		err = tx.
+			EnvAlias.
+			Create().
+			SetEnvID(templateID).SetIsRenamable(true).SetID(alias).
+			Exec(ctx)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when inserting alias '%s': %s", alias, err))
+			telemetry.ReportCriticalError(ctx, "error when inserting alias", err, attribute.String("alias", alias))
+
+			return
+		}
+
+		telemetry.ReportEvent(ctx, "inserted alias", attribute.String("env.alias", alias))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when committing transaction: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when committing transaction", err)
+
+		return
+	}
+
+	if previousTemplateID != "" {
+		a.templateCache.Invalidate(previousTemplateID)
+	}
+
+	err = a.buildLinker.LinkBuild(ctx, templateID, buildID, source.ID, team.ClusterID, source.ClusterNodeID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when linking build", err)
+
+		deleteErr := a.db.DeleteEnv(ctx, templateID)
+		if deleteErr != nil {
+			zap.L().Error("Error deleting template after failed link", logger.WithTemplateID(templateID), zap.Error(deleteErr))
+		}
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating template from snapshot")
+
+		return
+	}
+
+	// Only uploaded builds are resolved when spawning sandboxes from a template
+	err = a.db.EnvBuildSetStatus(ctx, templateID, buildID, envbuild.StatusUploaded)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when updating build status: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when updating build status", err)
+
+		return
+	}
+
+	a.posthog.IdentifyAnalyticsTeam(team.ID.String(), team.Name)
+	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
+	a.posthog.CreateAnalyticsTeamEvent(team.ID.String(), "created template from snapshot", properties.
+		Set("environment", templateID).
+		Set("build_id", buildID).
+		Set("sandbox_id", sandboxID).
+		Set("alias", alias),
+	)
+
+	zap.L().Info("Created template from snapshot", logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()), logger.WithSandboxID(sandboxID))
+
+	var aliases *[]string
+	if alias != "" {
+		aliases = &[]string{alias}
+	}
+
+	c.JSON(http.StatusCreated, &api.Template{
+		TemplateID:    templateID,
+		BuildID:       buildID.String(),
+		CpuCount:      int32(build.Vcpu),
+		MemoryMB:      int32(build.RAMMB),
+		Public:        template.Public,
+		Aliases:       aliases,
+		CreatedAt:     template.CreatedAt,
+		UpdatedAt:     template.UpdatedAt,
+		LastSpawnedAt: template.LastSpawnedAt,
+		SpawnCount:    template.SpawnCount,
+		BuildCount:    template.BuildCount,
+		CreatedBy:     nil,
+	})
+}
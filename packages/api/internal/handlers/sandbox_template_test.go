@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+type fakeBuildLinker struct {
+	linked map[uuid.UUID]uuid.UUID
+	err    error
+}
+
+func (f *fakeBuildLinker) LinkBuild(_ context.Context, _ string, buildID uuid.UUID, sourceBuildID uuid.UUID, _ *uuid.UUID, _ *string) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	f.linked[buildID] = sourceBuildID
+
+	return nil
+}
+
+// createTestSnapshot creates a paused sandbox snapshot of a new base template and returns the snapshot build.
+func createTestSnapshot(t *testing.T, a *APIStore, team *models.Team, sandboxID string) *models.EnvBuild {
+	t.Helper()
+
+	ctx := context.Background()
+
+	baseEnvID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	snapshotEnvID := id.Generate()
+	require.NoError(t, a.db.Client.Env.Create().SetID(snapshotEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	err := a.db.Client.Snapshot.
+		Create().
+		SetSandboxID(sandboxID).
+		SetBaseEnvID(baseEnvID).
+		SetEnvID(snapshotEnvID).
+		SetMetadata(map[string]string{}).
+		SetSandboxStartedAt(time.Now()).
+		SetEnvSecure(false).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	build, err := a.db.Client.EnvBuild.
+		Create().
+		SetEnvID(snapshotEnvID).
+		SetStatus(envbuild.StatusSuccess).
+		SetFinishedAt(time.Now()).
+		SetVcpu(2).
+		SetRAMMB(512).
+		SetFreeDiskSizeMB(512).
+		SetKernelVersion("vmlinux-6.1.102").
+		SetFirecrackerVersion("v1.10.1_1fcdaec").
+		Save(ctx)
+	require.NoError(t, err)
+
+	return build
+}
+
+func postSandboxTemplate(t *testing.T, a *APIStore, team *models.Team, sandboxID string, body api.NewSandboxTemplate) *httptest.ResponseRecorder {
+	t.Helper()
+
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/sandboxes/"+sandboxID+"/template", bytes.NewReader(data))
+	c.Set(auth.TeamContextKey, authcache.AuthTeamInfo{Team: team})
+
+	a.PostSandboxesSandboxIDTemplate(c, sandboxID)
+
+	return w
+}
+
+func TestAPIStore_PostSandboxesSandboxIDTemplate(t *testing.T) {
+	a := newTestStore(t)
+	team := createTestTeam(t, a)
+
+	t.Run("template is resolvable for spawning", func(t *testing.T) {
+		linker := &fakeBuildLinker{linked: map[uuid.UUID]uuid.UUID{}}
+		a.buildLinker = linker
+
+		sandboxID := "i" + id.Generate()
+		source := createTestSnapshot(t, a, team, sandboxID)
+
+		alias := "snap-" + id.Generate()
+		w := postSandboxTemplate(t, a, team, sandboxID, api.NewSandboxTemplate{Alias: &alias})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		var template api.Template
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &template))
+
+		buildID := uuid.MustParse(template.BuildID)
+		assert.Equal(t, source.ID, linker.linked[buildID])
+
+		for _, ref := range []string{template.TemplateID, alias} {
+			row, err := a.sqlcDB.GetEnvWithBuild(context.Background(), ref)
+			require.NoError(t, err, ref)
+
+			assert.Equal(t, template.TemplateID, row.Env.ID)
+			assert.Equal(t, buildID, row.EnvBuild.ID)
+			assert.Equal(t, string(envbuild.StatusUploaded), row.EnvBuild.Status)
+			assert.NotNil(t, row.EnvBuild.FinishedAt)
+			assert.Equal(t, []string{alias}, row.Aliases)
+		}
+	})
+
+	t.Run("failed link removes the template", func(t *testing.T) {
+		a.buildLinker = &fakeBuildLinker{err: assert.AnError}
+
+		ctx := context.Background()
+
+		sandboxID := "i" + id.Generate()
+		createTestSnapshot(t, a, team, sandboxID)
+
+		before, err := a.db.Client.Env.Query().Where(env.TeamID(team.ID)).Count(ctx)
+		require.NoError(t, err)
+
+		w := postSandboxTemplate(t, a, team, sandboxID, api.NewSandboxTemplate{})
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+
+		after, err := a.db.Client.Env.Query().Where(env.TeamID(team.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("missing snapshot", func(t *testing.T) {
+		a.buildLinker = &fakeBuildLinker{linked: map[uuid.UUID]uuid.UUID{}}
+
+		w := postSandboxTemplate(t, a, team, "i"+id.Generate(), api.NewSandboxTemplate{})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
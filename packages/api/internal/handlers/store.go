@@ -56,6 +56,7 @@ type APIStore struct {
 	Telemetry                *telemetry.Client
 	orchestrator             *orchestrator.Orchestrator
 	templateManager          *template_manager.TemplateManager
+	buildLinker              templateBuildLinker
 	db                       *db.DB
 	sqlcDB                   *sqlcdb.Client
 	lokiClient               *loki.DefaultClient
@@ -150,7 +151,7 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 		zap.L().Info("Connected to Redis cluster")
 	}
 
-	orch, err := orchestrator.New(ctx, tel, tracer, nomadClient, posthogClient, redisClient, dbClient)
+	orch, err := orchestrator.New(ctx, tel, tracer, nomadClient, posthogClient, redisClient, dbClient, sqlcDB)
 	if err != nil {
 		zap.L().Fatal("Initializing Orchestrator client", zap.Error(err))
 	}
@@ -203,10 +204,18 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 	// Start the periodic sync of template builds statuses
 	go templateManager.BuildsStatusPeriodicalSync(ctx)
 
+	// Start the periodic compaction of the long snapshot diff chains
+	go templateManager.SnapshotsCompactionPeriodicalSync(ctx, func(sandboxID string) bool {
+		_, err := orch.GetSandbox(sandboxID)
+
+		return err == nil
+	})
+
 	a := &APIStore{
 		Healthy:                   false,
 		orchestrator:              orch,
 		templateManager:           templateManager,
+		buildLinker:               templateManager,
 		db:                        dbClient,
 		sqlcDB:                    sqlcDB,
 		Telemetry:                 tel,
@@ -192,6 +192,20 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		}
 	}
 
+	// The datasets are pinned to the current builds of the dataset templates
+	var datasets map[string]string
+	if body.Datasets != nil {
+		var datasetsErr *api.APIError
+		datasets, datasetsErr = a.resolveDatasets(ctx, team.ID, *body.Datasets)
+		if datasetsErr != nil {
+			a.sendAPIStoreError(c, datasetsErr.Code, datasetsErr.ClientMsg)
+
+			telemetry.ReportError(ctx, "invalid datasets", datasetsErr.Err)
+
+			return nil
+		}
+	}
+
 	// Start a transaction to prevent partial updates
 	tx, err := a.db.Client.Tx(ctx)
 	if err != nil {
@@ -272,6 +286,7 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		SetNillableReadyCmd(body.ReadyCmd).
 		SetNillableClusterNodeID(builderNodeID).
 		SetDockerfile(body.Dockerfile).
+		SetDatasets(datasets).
 		Exec(ctx)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when inserting build: %s", err))
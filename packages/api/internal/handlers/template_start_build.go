@@ -214,6 +214,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		build.FreeDiskSizeMB,
 		build.RAMMB,
 		readyCmd,
+		team.ID,
 		team.ClusterID,
 		build.ClusterNodeID,
 	)
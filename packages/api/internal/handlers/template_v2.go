@@ -688,7 +688,7 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context) {
 			a.Tracer, buildContext, templateID, buildUUID,
 			build.KernelVersion, build.FirecrackerVersion,
 			startCmd, build.Vcpu, build.FreeDiskSizeMB, build.RAMMB,
-			readyCmd, team.ClusterID, build.ClusterNodeID,
+			readyCmd, team.ID, team.ClusterID, build.ClusterNodeID,
 		)
 		if buildErr != nil {
 			zap.L().Error("Build dispatch failed (v2)",
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// maxVolumeSizeMB is the maximum size of a team volume.
+const maxVolumeSizeMB = 100 * 1024
+
+func (a *APIStore) GetVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	volumes, err := a.db.GetVolumes(ctx, teamID)
+	if err != nil {
+		zap.L().Error("Error getting volumes", logger.WithTeamID(teamID.String()), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting volumes")
+
+		return
+	}
+
+	result := make([]api.Volume, 0, len(volumes))
+	for _, v := range volumes {
+		result = append(result, apiVolume(v))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.PostVolumesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if body.Name == "" || strings.Contains(body.Name, "/") {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Volume name cannot be empty or contain '/'")
+
+		return
+	}
+
+	if body.SizeMB < 1 || body.SizeMB > maxVolumeSizeMB {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Volume size must be between 1 and %d MiB", maxVolumeSizeMB))
+
+		return
+	}
+
+	v, err := a.db.CreateVolume(ctx, teamID, body.Name, body.SizeMB)
+	if err != nil {
+		if errors.Is(err, db.VolumeAlreadyExists{}) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' already exists", body.Name))
+
+			return
+		}
+
+		zap.L().Error("Error creating volume", logger.WithTeamID(teamID.String()), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating volume")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, apiVolume(v))
+}
+
+func (a *APIStore) DeleteVolumesVolumeName(c *gin.Context, volumeName api.VolumeName) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	err := a.db.DeleteVolume(ctx, teamID, volumeName)
+	if err != nil {
+		var inUseErr db.VolumeInUse
+		switch {
+		case errors.Is(err, db.VolumeNotFound{}):
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Volume '%s' not found", volumeName))
+		case errors.As(err, &inUseErr):
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' is attached to sandbox '%s', kill the sandbox first", volumeName, inUseErr.SandboxID))
+		default:
+			zap.L().Error("Error deleting volume", logger.WithTeamID(teamID.String()), zap.Error(err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting volume")
+		}
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// validateVolumeMounts checks that at most one volume is mounted and the mount path is a clean absolute path.
+// The sandbox supports only a single volume device.
+func validateVolumeMounts(mounts []api.SandboxVolumeMount) error {
+	if len(mounts) > 1 {
+		return fmt.Errorf("only one volume can be attached, got %d", len(mounts))
+	}
+
+	for _, mount := range mounts {
+		if mount.Name == "" {
+			return errors.New("volume name cannot be empty")
+		}
+
+		if mount.Path == nil {
+			continue
+		}
+
+		err := validateMountPath(*mount.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMountPath checks that the path is a clean absolute path other than the root.
+func validateMountPath(mountPath string) error {
+	if !path.IsAbs(mountPath) || path.Clean(mountPath) != mountPath || mountPath == "/" {
+		return fmt.Errorf("invalid mount path '%s', it has to be a clean absolute path", mountPath)
+	}
+
+	return nil
+}
+
+func apiVolume(v *models.Volume) api.Volume {
+	return api.Volume{
+		VolumeID:  v.ID.String(),
+		Name:      v.Name,
+		SizeMB:    v.SizeMB,
+		SandboxID: v.SandboxID,
+		CreatedAt: v.CreatedAt,
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+func TestValidateVolumeMounts(t *testing.T) {
+	path := func(p string) *string { return &p }
+
+	tests := []struct {
+		name    string
+		mounts  []api.SandboxVolumeMount
+		wantErr bool
+	}{
+		{
+			name: "no volumes",
+		},
+		{
+			name:   "default mount path",
+			mounts: []api.SandboxVolumeMount{{Name: "data"}},
+		},
+		{
+			name:   "custom mount path",
+			mounts: []api.SandboxVolumeMount{{Name: "data", Path: path("/mnt/data")}},
+		},
+		{
+			name:    "more than one volume",
+			mounts:  []api.SandboxVolumeMount{{Name: "data", Path: path("/data")}, {Name: "cache", Path: path("/cache")}},
+			wantErr: true,
+		},
+		{
+			name:    "empty name",
+			mounts:  []api.SandboxVolumeMount{{Name: ""}},
+			wantErr: true,
+		},
+		{
+			name:    "relative path",
+			mounts:  []api.SandboxVolumeMount{{Name: "data", Path: path("data")}},
+			wantErr: true,
+		},
+		{
+			name:    "root path",
+			mounts:  []api.SandboxVolumeMount{{Name: "data", Path: path("/")}},
+			wantErr: true,
+		},
+		{
+			name:    "unclean path",
+			mounts:  []api.SandboxVolumeMount{{Name: "data", Path: path("/data/../etc")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVolumeMounts(tt.mounts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -46,12 +46,16 @@ func (o *Orchestrator) GetSandbox(sandboxID string) (*instance.InstanceInfo, err
 	return item, nil
 }
 
-// keepInSync the cache with the actual instances in Orchestrator to handle instances that died.
+// keepInSync the cache with the actual instances in Orchestrator.
+// The cache is updated by the sandbox events of the nodes, the sync reconciles the events missed while the stream was disconnected.
 func (o *Orchestrator) keepInSync(ctx context.Context, instanceCache *instance.InstanceCache) {
 	// Run the first sync immediately
 	zap.L().Info("Running the initial node sync")
 	o.syncNodes(ctx, instanceCache)
 
+	// The volumes are reconciled only after the sync, so the sandboxes running on the nodes are known.
+	staleVolumes := o.reconcileVolumes(ctx, nil)
+
 	// Sync the nodes every cacheSyncTime
 	ticker := time.NewTicker(cacheSyncTime)
 	defer ticker.Stop()
@@ -64,6 +68,7 @@ func (o *Orchestrator) keepInSync(ctx context.Context, instanceCache *instance.I
 			return
 		case <-ticker.C:
 			o.syncNodes(ctx, instanceCache)
+			staleVolumes = o.reconcileVolumes(ctx, staleVolumes)
 		}
 	}
 }
@@ -152,13 +157,30 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 		}
 		node.setStatus(nodeStatus)
 
+		// The paused sandboxes are reported by the events, the sync records those missed while the stream was disconnected.
+		evacuation := nodeInfo.GetEvacuation()
+		for _, sbx := range evacuation.GetSandboxesPaused() {
+			o.removeEvacuated(node, sbx.GetSandboxId(), sbx.GetExecutionId(), sbx.GetBuildId())
+		}
+
+		// The evacuated sandboxes are listed neither as running nor as paused until their snapshot is uploaded.
+		if evacuation != nil && !evacuation.GetFinished() {
+			syncRetrySuccess = true
+			break
+		}
+
 		activeInstances, instancesErr := o.getSandboxes(ctx, node.Info)
 		if instancesErr != nil {
 			zap.L().Error("Error getting instances", zap.Error(instancesErr))
 			continue
 		}
 
-		instanceCache.Sync(ctx, activeInstances, node.Info.ID)
+		missing := instance.Exit{Reason: api.Unknown, Message: "sandbox is not running on the node anymore"}
+		if nodeStatus == api.NodeStatusDraining {
+			missing = instance.Exit{Reason: api.NodeDrain, Message: "sandbox stopped on the drained node"}
+		}
+
+		instanceCache.Sync(ctx, activeInstances, node.Info.ID, missing)
 
 		syncRetrySuccess = true
 		break
@@ -184,7 +206,7 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 	posthogClient *analyticscollector.PosthogClient,
 	timeout time.Duration,
 ) func(info *instance.InstanceInfo) error {
-	return func(info *instance.InstanceInfo) error {
+	return func(info *instance.InstanceInfo) (err error) {
 		ctx, cancel := context.WithTimeout(parentCtx, timeout)
 		defer cancel()
 
@@ -206,6 +228,20 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			ct = CloseDelete
 		}
 
+		exit := info.GetExit()
+		if ct == CloseDelete {
+			sbxlogger.I(info).Info("Sandbox ended",
+				zap.String("exit_reason", string(exit.Reason)),
+				zap.String("exit_message", exit.Message),
+			)
+
+			go o.recordExit(parentCtx, info, exit)
+		}
+
+		defer func() {
+			o.closeVolumes(ctx, info, ct == ClosePause, err)
+		}()
+
 		// Run in separate goroutine to not block sandbox deletion
 		// Also use parentCtx to not cancel the request with this hook timeout
 		go reportInstanceStopAnalytics(
@@ -234,7 +270,10 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 		node.CPUUsage.Add(-info.VCpu)
 		node.RamUsage.Add(-info.RamMB)
 
-		o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.IPAddress)
+		// The routing of the migrated sandbox is replaced when it starts on the target node.
+		if !info.Migrating.Load() {
+			o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.IPAddress)
+		}
 
 		if node.Client == nil {
 			zap.L().Error("client for node not found", zap.String("node_id", info.Instance.ClientID))
@@ -245,7 +284,7 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 		if ct == ClosePause {
 			o.instanceCache.MarkAsPausing(info)
 
-			err := o.PauseInstance(ctx, o.tracer, info, *info.TeamID)
+			err = o.PauseInstance(ctx, o.tracer, info, *info.TeamID)
 			if err != nil {
 				info.PauseDone(err)
 
@@ -257,13 +296,19 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			o.instanceCache.UnmarkAsPausing(info)
 			info.PauseDone(nil)
 		} else {
-			req := &orchestrator.SandboxDeleteRequest{SandboxId: info.Instance.SandboxID}
-			_, err := node.Client.Sandbox.Delete(ctx, req)
+			req := &orchestrator.SandboxDeleteRequest{
+				SandboxId: info.Instance.SandboxID,
+				Reason:    apiToOrchestratorExitReasonMapper[exit.Reason],
+			}
+			_, err = node.Client.Sandbox.Delete(ctx, req)
 			if err != nil {
 				return fmt.Errorf("failed to delete sandbox '%s': %w", info.Instance.SandboxID, err)
 			}
 		}
 
+		// The snapshot of the paused child maps data from the fork build, the build is kept by the garbage collection.
+		o.releaseForkEnv(ctx, info)
+
 		sbxlogger.I(info).Debug("Deleted sandbox from cache hook",
 			zap.Time("start_time", info.StartTime),
 			zap.Time("end_time", info.GetEndTime()),
@@ -0,0 +1,104 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// CheckpointInstance creates a named checkpoint of the running sandbox, the sandbox keeps running.
+func (o *Orchestrator) CheckpointInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	teamID uuid.UUID,
+	name string,
+) (*models.Checkpoint, error) {
+	ctx, span := o.tracer.Start(ctx, "checkpoint-sandbox")
+	defer span.End()
+
+	checkpoint, envBuild, err := o.dbClient.NewCheckpointBuild(
+		ctx,
+		name,
+		&db.SnapshotInfo{
+			BaseTemplateID:     sbx.BaseTemplateID,
+			SandboxID:          sbx.Instance.SandboxID,
+			SandboxStartedAt:   sbx.StartTime,
+			VCPU:               sbx.VCpu,
+			RAMMB:              sbx.RamMB,
+			TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+			Metadata:           sbx.Metadata,
+			KernelVersion:      sbx.KernelVersion,
+			FirecrackerVersion: sbx.FirecrackerVersion,
+			EnvdVersion:        sbx.EnvdVersion,
+			EnvdSecured:        sbx.EnvdAccessToken != nil,
+			Datasets:           sbx.Datasets,
+			Network:            snapshotNetworkConfig(sbx.GetNetwork()),
+		},
+		teamID,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating checkpoint", err)
+
+		return nil, err
+	}
+
+	err = checkpointInstance(ctx, o, sbx, *envBuild.EnvID, envBuild.ID.String())
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error checkpointing sandbox", err)
+
+		// Remove the checkpoint so the name can be used again
+		deleteErr := o.dbClient.DeleteEnv(ctx, *envBuild.EnvID)
+		if deleteErr != nil {
+			zap.L().Error("error deleting failed checkpoint", logger.WithSandboxID(sbx.Instance.SandboxID), zap.Error(deleteErr))
+		}
+
+		return nil, fmt.Errorf("error checkpointing sandbox: %w", err)
+	}
+
+	err = o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusSuccess)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error checkpointing sandbox", err)
+
+		return nil, fmt.Errorf("error checkpointing sandbox: %w", err)
+	}
+
+	// The checkpoint should be cached on the node now
+	if node := o.GetNode(sbx.Instance.ClientID); node != nil {
+		node.InsertBuild(envBuild.ID.String())
+	}
+
+	return checkpoint, nil
+}
+
+func checkpointInstance(ctx context.Context, orch *Orchestrator, sbx *instance.InstanceInfo, templateID, buildID string) error {
+	_, childSpan := orch.tracer.Start(ctx, "checkpoint-instance")
+	defer childSpan.End()
+
+	client, err := orch.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	_, err = client.Sandbox.Checkpoint(ctx, &orchestrator.SandboxCheckpointRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: templateID,
+		BuildId:    buildID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to checkpoint sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(ctx, "Checkpointed sandbox")
+
+	return nil
+}
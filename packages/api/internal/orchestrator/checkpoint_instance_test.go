@@ -0,0 +1,130 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	grpclient "github.com/e2b-dev/infra/packages/api/internal/grpc"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+type fakeSandboxClient struct {
+	orchestrator.SandboxServiceClient
+
+	checkpoints []*orchestrator.SandboxCheckpointRequest
+	err         error
+}
+
+func (f *fakeSandboxClient) Checkpoint(_ context.Context, req *orchestrator.SandboxCheckpointRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.checkpoints = append(f.checkpoints, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// addTestNode adds the node whose sandbox service is the fake client.
+func addTestNode(o *Orchestrator, client *fakeSandboxClient) *Node {
+	n := &Node{
+		Client:     &grpclient.GRPCClient{Sandbox: client},
+		Info:       &node.NodeInfo{ID: id.Generate()},
+		buildCache: ttlcache.New[string, interface{}](),
+	}
+
+	o.nodes.Insert(n.Info.ID, n)
+
+	return n
+}
+
+func testCheckpointSandbox(t *testing.T, o *Orchestrator, n *Node) (uuid.UUID, *instance.InstanceInfo) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	team, err := o.dbClient.Client.Team.
+		Create().
+		SetName("test").
+		SetEmail(uuid.NewString() + "@e2b.dev").
+		SetTier("base_v1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	baseEnvID := id.Generate()
+	require.NoError(t, o.dbClient.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	return team.ID, &instance.InstanceInfo{
+		Instance:           &api.Sandbox{SandboxID: "i" + id.Generate(), ClientID: n.Info.ID},
+		BaseTemplateID:     baseEnvID,
+		TeamID:             &team.ID,
+		StartTime:          time.Now(),
+		VCpu:               2,
+		RamMB:              512,
+		Metadata:           map[string]string{"key": "value"},
+		KernelVersion:      "vmlinux-6.1.102",
+		FirecrackerVersion: "v1.10.1_1fcdaec",
+		EnvdVersion:        "0.2.0",
+	}
+}
+
+func TestOrchestrator_CheckpointInstance(t *testing.T) {
+	o := newTestOrchestrator(t)
+	ctx := context.Background()
+
+	t.Run("checkpoint is stored and cached on the node", func(t *testing.T) {
+		client := &fakeSandboxClient{}
+		n := addTestNode(o, client)
+		teamID, sbx := testCheckpointSandbox(t, o, n)
+
+		checkpoint, err := o.CheckpointInstance(ctx, sbx, teamID, "first")
+		require.NoError(t, err)
+		assert.Equal(t, "first", checkpoint.Name)
+		assert.Equal(t, sbx.Instance.SandboxID, checkpoint.SandboxID)
+		assert.Equal(t, sbx.BaseTemplateID, checkpoint.BaseEnvID)
+
+		require.Len(t, client.checkpoints, 1)
+		assert.Equal(t, sbx.Instance.SandboxID, client.checkpoints[0].GetSandboxId())
+		assert.Equal(t, checkpoint.EnvID, client.checkpoints[0].GetTemplateId())
+
+		build, err := o.dbClient.GetEnvBuild(ctx, uuid.MustParse(client.checkpoints[0].GetBuildId()))
+		require.NoError(t, err)
+		assert.Equal(t, envbuild.StatusSuccess, build.Status)
+		assert.True(t, n.buildCache.Has(build.ID.String()))
+
+		// The name is unique per sandbox.
+		_, err = o.CheckpointInstance(ctx, sbx, teamID, "first")
+		require.Error(t, err)
+		assert.True(t, models.IsConstraintError(err))
+		assert.Len(t, client.checkpoints, 1)
+	})
+
+	t.Run("failed checkpoint is removed", func(t *testing.T) {
+		client := &fakeSandboxClient{err: assert.AnError}
+		n := addTestNode(o, client)
+		teamID, sbx := testCheckpointSandbox(t, o, n)
+
+		_, err := o.CheckpointInstance(ctx, sbx, teamID, "failed")
+		require.ErrorIs(t, err, assert.AnError)
+
+		// The name can be used again.
+		client.err = nil
+
+		_, err = o.CheckpointInstance(ctx, sbx, teamID, "failed")
+		require.NoError(t, err)
+	})
+}
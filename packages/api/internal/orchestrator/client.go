@@ -106,19 +106,22 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 		orchestratorID = nodeInfo.NodeId
 	}
 
-	o.nodes.Insert(
-		node.ID, &Node{
-			Client:         client,
-			Info:           node,
-			orchestratorID: orchestratorID,
-			buildCache:     buildCache,
-			status:         nodeStatus,
-			version:        nodeVersion,
-			commit:         nodeCommit,
-			sbxsInProgress: smap.New[*sbxInProgress](),
-			createFails:    atomic.Uint64{},
-		},
-	)
+	n := &Node{
+		Client:         client,
+		Info:           node,
+		orchestratorID: orchestratorID,
+		buildCache:     buildCache,
+		status:         nodeStatus,
+		version:        nodeVersion,
+		commit:         nodeCommit,
+		sbxsInProgress: smap.New[*sbxInProgress](),
+		createFails:    atomic.Uint64{},
+	}
+
+	o.nodes.Insert(node.ID, n)
+
+	// The stream outlives the connecting request.
+	go o.watchEvents(context.WithoutCancel(ctx), n)
 
 	return nil
 }
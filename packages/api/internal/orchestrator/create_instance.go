@@ -21,6 +21,7 @@ import (
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -29,6 +30,9 @@ const (
 	leastBusyNodeTimeout = 60 * time.Second
 
 	maxStartingInstancesPerNode = 3
+
+	// maxBuildPeers is the maximum number of the nodes the new node reads the build chunks from.
+	maxBuildPeers = 3
 )
 
 var errSandboxCreateFailed = fmt.Errorf("failed to create a new sandbox, if the problem persists, contact us")
@@ -50,6 +54,10 @@ func (o *Orchestrator) CreateSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAuthToken *string,
+	network *api.SandboxNetworkConfig,
+	volumes []*orchestrator.SandboxVolume,
+	datasets map[string]string,
+	migratedFrom *Node,
 ) (*api.Sandbox, *api.APIError) {
 	childCtx, childSpan := o.tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
@@ -125,11 +133,19 @@ func (o *Orchestrator) CreateSandbox(
 			Vcpu:               build.Vcpu,
 			Snapshot:           isResume,
 			AutoPause:          &autoPause,
+			Network:            networkConfig(network),
+			RateLimits:         tierRateLimits(team.Tier),
+			Volumes:            volumes,
+			Datasets:           sandboxDatasets(datasets),
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
 	}
 
+	if migratedFrom != nil {
+		sbxRequest.MigrationSource = migratedFrom.Info.OrchestratorAddress
+	}
+
 	var node *Node
 
 	if isResume && clientID != nil {
@@ -143,6 +159,15 @@ func (o *Orchestrator) CreateSandbox(
 
 	attempt := 1
 	nodesExcluded := make(map[string]*Node)
+
+	// The migrated sandbox must not be resumed on the node it was paused on.
+	if migratedFrom != nil {
+		nodesExcluded[migratedFrom.Info.ID] = migratedFrom
+
+		if node != nil && node.Info.ID == migratedFrom.Info.ID {
+			node = nil
+		}
+	}
 	for {
 		select {
 		case <-childCtx.Done():
@@ -182,6 +207,8 @@ func (o *Orchestrator) CreateSandbox(
 			CPUs:      build.Vcpu,
 		})
 
+		sbxRequest.BuildPeers = o.buildPeers(build.ID.String(), node.Info.ID)
+
 		_, err = node.Client.Sandbox.Create(childCtx, sbxRequest)
 		// The request is done, we will either add it to the cache or remove it from the node
 		if err == nil {
@@ -243,6 +270,9 @@ func (o *Orchestrator) CreateSandbox(
 		envdAuthToken,
 		baseTemplateID,
 	)
+	instanceInfo.HasVolumes = len(volumes) > 0
+	instanceInfo.Datasets = datasets
+	instanceInfo.SetNetwork(network)
 
 	cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
 	if cacheErr != nil {
@@ -333,3 +363,12 @@ func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastB
 
 	return nil, fmt.Errorf("no node available")
 }
+
+// tierRateLimits converts the tier limits to the per-second limits of the sandbox.
+func tierRateLimits(tier *models.Tier) *orchestrator.SandboxRateLimits {
+	return &orchestrator.SandboxRateLimits{
+		DiskBandwidthBytes:    tier.DiskBandwidthMB << 20,
+		DiskIops:              tier.DiskIops,
+		NetworkBandwidthBytes: tier.NetworkBandwidthMB << 20,
+	}
+}
@@ -0,0 +1,23 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestTierRateLimits(t *testing.T) {
+	limits := tierRateLimits(&models.Tier{DiskBandwidthMB: 100, DiskIops: 1000, NetworkBandwidthMB: 10})
+
+	assert.Equal(t, int64(100<<20), limits.GetDiskBandwidthBytes())
+	assert.Equal(t, int64(1000), limits.GetDiskIops())
+	assert.Equal(t, int64(10<<20), limits.GetNetworkBandwidthBytes())
+
+	// The tier without the limits leaves the devices unlimited.
+	unlimited := tierRateLimits(&models.Tier{})
+	assert.Zero(t, unlimited.GetDiskBandwidthBytes())
+	assert.Zero(t, unlimited.GetDiskIops())
+	assert.Zero(t, unlimited.GetNetworkBandwidthBytes())
+}
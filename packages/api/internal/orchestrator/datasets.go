@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// sandboxDatasets returns the datasets ordered by the mount path.
+// The order decides which drive the dataset is attached to, so it has to be the same when the sandbox is resumed.
+func sandboxDatasets(datasets map[string]string) []*orchestrator.SandboxDataset {
+	if len(datasets) == 0 {
+		return nil
+	}
+
+	result := make([]*orchestrator.SandboxDataset, 0, len(datasets))
+	for mountPath, buildID := range datasets {
+		result = append(result, &orchestrator.SandboxDataset{
+			BuildId:   buildID,
+			MountPath: mountPath,
+		})
+	}
+
+	slices.SortFunc(result, func(a, b *orchestrator.SandboxDataset) int {
+		return strings.Compare(a.GetMountPath(), b.GetMountPath())
+	})
+
+	return result
+}
+
+func datasetsFromConfig(datasets []*orchestrator.SandboxDataset) map[string]string {
+	if len(datasets) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(datasets))
+	for _, d := range datasets {
+		result[d.GetMountPath()] = d.GetBuildId()
+	}
+
+	return result
+}
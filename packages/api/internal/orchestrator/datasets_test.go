@@ -0,0 +1,37 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxDatasets(t *testing.T) {
+	t.Run("datasets are ordered by the mount path", func(t *testing.T) {
+		datasets := map[string]string{
+			"/mnt/b": "build-b",
+			"/data":  "build-data",
+			"/mnt/a": "build-a",
+		}
+
+		result := sandboxDatasets(datasets)
+		require.Len(t, result, 3)
+
+		mountPaths := make([]string, 0, len(result))
+		for _, d := range result {
+			mountPaths = append(mountPaths, d.GetMountPath())
+			assert.Equal(t, datasets[d.GetMountPath()], d.GetBuildId())
+		}
+		assert.Equal(t, []string{"/data", "/mnt/a", "/mnt/b"}, mountPaths)
+
+		// The resumed sandbox gets the same datasets back from the config.
+		assert.Equal(t, datasets, datasetsFromConfig(result))
+	})
+
+	t.Run("sandbox without datasets", func(t *testing.T) {
+		assert.Nil(t, sandboxDatasets(nil))
+		assert.Nil(t, sandboxDatasets(map[string]string{}))
+		assert.Nil(t, datasetsFromConfig(nil))
+	})
+}
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+const eventsReconnectDelay = 5 * time.Second
+
+// watchEvents keeps the instance cache in sync with the sandbox lifecycle events of the node.
+// The periodic node sync only reconciles the events missed while the stream was disconnected.
+// The watching stops when the node is removed, its connection is closed then.
+func (o *Orchestrator) watchEvents(ctx context.Context, node *Node) {
+	for {
+		if o.GetNode(node.Info.ID) != node {
+			return
+		}
+
+		err := o.consumeEvents(ctx, node)
+		if o.GetNode(node.Info.ID) != node {
+			return
+		}
+
+		zap.L().Warn("Sandbox events stream disconnected", zap.String("node_id", node.Info.ID), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventsReconnectDelay):
+		}
+	}
+}
+
+func (o *Orchestrator) consumeEvents(ctx context.Context, node *Node) error {
+	stream, err := node.Client.Sandbox.WatchEvents(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		o.handleEvent(ctx, node, event)
+	}
+}
+
+func (o *Orchestrator) handleEvent(ctx context.Context, node *Node, event *orchestrator.SandboxEvent) {
+	switch event.GetType() {
+	case orchestrator.SandboxEventType_SandboxCreated, orchestrator.SandboxEventType_SandboxResumed:
+		// The sandboxes started by this API are added to the cache when their start finishes.
+		if o.instanceCache.Exists(event.GetSandboxId()) || o.instanceCache.IsReserved(event.GetSandboxId()) {
+			return
+		}
+
+		info, err := sandboxInfoFromRunning(event.GetSandbox(), node.Info)
+		if err != nil {
+			zap.L().Error("Error parsing started sandbox event", zap.String("node_id", node.Info.ID), zap.Error(err))
+
+			return
+		}
+
+		err = o.instanceCache.Add(ctx, info, false)
+		if err != nil {
+			zap.L().Error("Error adding started sandbox to cache", zap.String("node_id", node.Info.ID), zap.Error(err))
+		}
+	case orchestrator.SandboxEventType_SandboxStopped, orchestrator.SandboxEventType_SandboxCrashed:
+		// The sandboxes stopped by this API are already removed from the cache.
+		info, err := o.instanceCache.Get(event.GetSandboxId())
+		if err != nil || info.Instance.ClientID != node.Info.ID {
+			return
+		}
+
+		exit := exitFromEvent(event)
+		if o.instanceCache.RemoveStopped(event.GetSandboxId(), event.GetExecutionId(), exit) {
+			sbxlogger.I(info).Info("Sandbox stopped on the node",
+				zap.String("event", event.GetType().String()),
+				zap.String("exit_reason", string(exit.Reason)),
+				zap.String("exit_message", exit.Message),
+				zap.Time("stopped_at", exit.EndedAt),
+			)
+		}
+	case orchestrator.SandboxEventType_SandboxPaused:
+		// The sandboxes paused by this API are removed from the cache before the pause, the others were evacuated from the drained node.
+		o.removeEvacuated(node, event.GetSandboxId(), event.GetExecutionId(), event.GetBuildId())
+	}
+}
+
+// removeEvacuated records the sandbox paused by the evacuation of the node as paused, so it can be resumed on the other nodes.
+func (o *Orchestrator) removeEvacuated(node *Node, sandboxID, executionID, buildID string) {
+	info, err := o.instanceCache.Get(sandboxID)
+	if err != nil || info.Instance.ClientID != node.Info.ID {
+		return
+	}
+
+	snapshotBuildID, err := uuid.Parse(buildID)
+	if err != nil {
+		sbxlogger.I(info).Error("Error parsing evacuated sandbox build", zap.String("node_id", node.Info.ID), zap.Error(err))
+
+		return
+	}
+
+	if o.instanceCache.RemoveEvacuated(sandboxID, executionID, snapshotBuildID) {
+		sbxlogger.I(info).Info("Sandbox evacuated from the node", zap.String("node_id", node.Info.ID), zap.String("build_id", buildID))
+	}
+}
@@ -0,0 +1,125 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+const (
+	// SandboxExitRetention is how long the ended sandboxes are kept, so users can find out why they ended.
+	SandboxExitRetention = 24 * time.Hour
+
+	exitsCleanupInterval = time.Hour
+	exitRecordTimeout    = 10 * time.Second
+)
+
+var (
+	orchestratorToApiExitReasonMapper = map[orchestrator.SandboxExitReason]api.SandboxExitReason{
+		orchestrator.SandboxExitReason_ExitUnknown:       api.Unknown,
+		orchestrator.SandboxExitReason_ExitDeleted:       api.Deleted,
+		orchestrator.SandboxExitReason_ExitTimeout:       api.Timeout,
+		orchestrator.SandboxExitReason_ExitProcessExited: api.ProcessExited,
+		orchestrator.SandboxExitReason_ExitKernelPanic:   api.KernelPanic,
+		orchestrator.SandboxExitReason_ExitOutOfMemory:   api.OutOfMemory,
+		orchestrator.SandboxExitReason_ExitNodeDrain:     api.NodeDrain,
+	}
+
+	apiToOrchestratorExitReasonMapper = map[api.SandboxExitReason]orchestrator.SandboxExitReason{
+		api.Unknown:       orchestrator.SandboxExitReason_ExitUnknown,
+		api.Deleted:       orchestrator.SandboxExitReason_ExitDeleted,
+		api.Timeout:       orchestrator.SandboxExitReason_ExitTimeout,
+		api.ProcessExited: orchestrator.SandboxExitReason_ExitProcessExited,
+		api.KernelPanic:   orchestrator.SandboxExitReason_ExitKernelPanic,
+		api.OutOfMemory:   orchestrator.SandboxExitReason_ExitOutOfMemory,
+		api.NodeDrain:     orchestrator.SandboxExitReason_ExitNodeDrain,
+	}
+)
+
+func exitFromEvent(event *orchestrator.SandboxEvent) instance.Exit {
+	reason, ok := orchestratorToApiExitReasonMapper[event.GetExit().GetReason()]
+	if !ok {
+		reason = api.Unknown
+	}
+
+	return instance.Exit{
+		Reason:   reason,
+		Message:  event.GetExit().GetMessage(),
+		ExitCode: event.GetExit().ExitCode,
+		EndedAt:  event.GetTimestamp().AsTime(),
+	}
+}
+
+// recordExit stores why the sandbox ended, the failure is only logged as the sandbox is already gone.
+func (o *Orchestrator) recordExit(ctx context.Context, info *instance.InstanceInfo, exit instance.Exit) {
+	ctx, cancel := context.WithTimeout(ctx, exitRecordTimeout)
+	defer cancel()
+
+	err := o.sqlcDB.InsertSandboxExit(ctx, queries.InsertSandboxExitParams{
+		SandboxID:       info.Instance.SandboxID,
+		ExecutionID:     info.ExecutionID,
+		TeamID:          *info.TeamID,
+		EnvID:           info.Instance.TemplateID,
+		Alias:           info.Instance.Alias,
+		Vcpu:            info.VCpu,
+		RamMb:           info.RamMB,
+		TotalDiskSizeMb: info.TotalDiskSizeMB,
+		EnvdVersion:     &info.EnvdVersion,
+		Metadata:        types.JSONBStringMap(info.Metadata),
+		StartedAt:       pgtype.Timestamptz{Time: info.StartTime, Valid: true},
+		EndedAt:         pgtype.Timestamptz{Time: exit.EndedAt, Valid: true},
+		Reason:          string(exit.Reason),
+		Message:         exit.Message,
+		ExitCode:        exit.ExitCode,
+	})
+	if err != nil {
+		sbxlogger.I(info).Error("failed to record sandbox exit", zap.String("exit_reason", string(exit.Reason)), zap.Error(err))
+	}
+}
+
+// GetSandboxExit returns the last exit of the sandbox that ended within the retention.
+func (o *Orchestrator) GetSandboxExit(ctx context.Context, sandboxID string, teamID uuid.UUID) (*queries.SandboxExit, error) {
+	exit, err := o.sqlcDB.GetLastSandboxExit(ctx, queries.GetLastSandboxExitParams{
+		SandboxID:  sandboxID,
+		TeamID:     teamID,
+		EndedAfter: pgtype.Timestamptz{Time: time.Now().Add(-SandboxExitRetention), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exit of sandbox '%s': %w", sandboxID, err)
+	}
+
+	return &exit, nil
+}
+
+// cleanupExits removes the exits older than the retention.
+func (o *Orchestrator) cleanupExits(ctx context.Context) {
+	ticker := time.NewTicker(exitsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := o.sqlcDB.DeleteSandboxExitsBefore(ctx, pgtype.Timestamptz{Time: time.Now().Add(-SandboxExitRetention), Valid: true})
+			if err != nil {
+				zap.L().Error("failed to clean up sandbox exits", zap.Error(err))
+
+				continue
+			}
+
+			zap.L().Debug("cleaned up sandbox exits", zap.Int64("deleted", deleted))
+		}
+	}
+}
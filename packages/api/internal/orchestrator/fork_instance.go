@@ -0,0 +1,284 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkInstance takes a live snapshot of the sandbox and starts the children from it on the same node.
+// The parent sandbox keeps running.
+func (o *Orchestrator) ForkInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	team authcache.AuthTeamInfo,
+	sandboxIDs []string,
+	timeout time.Duration,
+) ([]*api.Sandbox, *api.APIError) {
+	childCtx, childSpan := o.tracer.Start(ctx, "fork-sandbox")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(sbx.Instance.SandboxID),
+		attribute.Int("fork.count", len(sandboxIDs)),
+	)
+
+	node := o.GetNode(sbx.Instance.ClientID)
+	if node == nil || node.Status() != api.NodeStatusReady {
+		return nil, &api.APIError{
+			Code:      http.StatusServiceUnavailable,
+			ClientMsg: "The node running the sandbox is not available",
+			Err:       fmt.Errorf("node '%s' is not available", sbx.Instance.ClientID),
+		}
+	}
+
+	for _, sandboxID := range sandboxIDs {
+		releaseTeamSandboxReservation, err := o.instanceCache.Reserve(sandboxID, team.Team.ID, team.Tier.ConcurrentInstances)
+		if err != nil {
+			var limitErr *instance.ErrSandboxLimitExceeded
+
+			telemetry.ReportCriticalError(ctx, "failed to reserve sandbox for team", err)
+
+			if errors.As(err, &limitErr) {
+				return nil, &api.APIError{
+					Code: http.StatusTooManyRequests,
+					ClientMsg: fmt.Sprintf(
+						"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
+							"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
+					Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
+				}
+			}
+
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: fmt.Sprintf("Failed to fork sandbox: %s", err),
+				Err:       err,
+			}
+		}
+
+		defer releaseTeamSandboxReservation()
+	}
+
+	telemetry.ReportEvent(childCtx, "Reserved sandboxes for team")
+
+	features, err := sandbox.NewVersionInfo(sbx.FirecrackerVersion)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get build information for the sandbox",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", sbx.FirecrackerVersion, err),
+		}
+	}
+
+	envBuild, err := o.dbClient.NewForkBuild(
+		childCtx,
+		&db.SnapshotInfo{
+			BaseTemplateID:     sbx.BaseTemplateID,
+			SandboxID:          sbx.Instance.SandboxID,
+			SandboxStartedAt:   sbx.StartTime,
+			VCPU:               sbx.VCpu,
+			RAMMB:              sbx.RamMB,
+			TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+			Metadata:           sbx.Metadata,
+			KernelVersion:      sbx.KernelVersion,
+			FirecrackerVersion: sbx.FirecrackerVersion,
+			EnvdVersion:        sbx.EnvdVersion,
+			EnvdSecured:        sbx.EnvdAccessToken != nil,
+			Datasets:           sbx.Datasets,
+		},
+		team.Team.ID,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating fork build", err)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       err,
+		}
+	}
+
+	templateID := *envBuild.EnvID
+	buildID := envBuild.ID
+
+	startTime := time.Now()
+	endTime := startTime.Add(timeout)
+
+	children := make([]*orchestrator.SandboxCreateRequest, 0, len(sandboxIDs))
+	executionIDs := make(map[string]string, len(sandboxIDs))
+	for _, sandboxID := range sandboxIDs {
+		autoPause := sbx.AutoPause.Load()
+		executionID := uuid.New().String()
+		executionIDs[sandboxID] = executionID
+
+		children = append(children, &orchestrator.SandboxCreateRequest{
+			Sandbox: &orchestrator.SandboxConfig{
+				BaseTemplateId:     sbx.BaseTemplateID,
+				TemplateId:         templateID,
+				Alias:              sbx.Instance.Alias,
+				TeamId:             team.Team.ID.String(),
+				BuildId:            buildID.String(),
+				SandboxId:          sandboxID,
+				ExecutionId:        executionID,
+				KernelVersion:      sbx.KernelVersion,
+				FirecrackerVersion: sbx.FirecrackerVersion,
+				EnvdVersion:        sbx.EnvdVersion,
+				Metadata:           sbx.Metadata,
+				// The children share the memory of the parent, so they have to use the same access token.
+				EnvdAccessToken:  sbx.EnvdAccessToken,
+				MaxSandboxLength: team.Tier.MaxLengthHours,
+				HugePages:        features.HasHugePages(),
+				RamMb:            sbx.RamMB,
+				Vcpu:             sbx.VCpu,
+				Snapshot:         true,
+				AutoPause:        &autoPause,
+				RateLimits:       tierRateLimits(team.Tier),
+				Datasets:         sandboxDatasets(sbx.Datasets),
+			},
+			StartTime: timestamppb.New(startTime),
+			EndTime:   timestamppb.New(endTime),
+		})
+
+		node.sbxsInProgress.Insert(sandboxID, &sbxInProgress{
+			MiBMemory: sbx.RamMB,
+			CPUs:      sbx.VCpu,
+		})
+		defer node.sbxsInProgress.Remove(sandboxID)
+	}
+
+	res, err := node.Client.Sandbox.Fork(childCtx, &orchestrator.SandboxForkRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: templateID,
+		BuildId:    buildID.String(),
+		Children:   children,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error forking sandbox", err)
+
+		o.deleteForkEnv(ctx, sbx.Instance.SandboxID, templateID)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("failed to fork sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	telemetry.ReportEvent(childCtx, "Forked sandbox")
+
+	err = o.dbClient.EnvBuildSetStatus(ctx, templateID, buildID, envbuild.StatusSuccess)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error setting fork build status", err)
+	}
+
+	// The snapshot should be cached on the node now
+	node.InsertBuild(buildID.String())
+
+	// This is to compensate for the time it takes to start the instances
+	startTime = time.Now()
+	endTime = startTime.Add(timeout)
+
+	sandboxes := make([]*api.Sandbox, 0, len(res.SandboxIds))
+	for _, sandboxID := range res.SandboxIds {
+		sbxInfo := api.Sandbox{
+			ClientID:        node.Info.ID,
+			SandboxID:       sandboxID,
+			TemplateID:      templateID,
+			Alias:           sbx.Instance.Alias,
+			EnvdVersion:     sbx.EnvdVersion,
+			EnvdAccessToken: sbx.EnvdAccessToken,
+		}
+
+		instanceInfo := instance.NewInstanceInfo(
+			&sbxInfo,
+			executionIDs[sandboxID],
+			&team.Team.ID,
+			&buildID,
+			sbx.Metadata,
+			time.Duration(team.Tier.MaxLengthHours)*time.Hour,
+			startTime,
+			endTime,
+			sbx.VCpu,
+			sbx.TotalDiskSizeMB,
+			sbx.RamMB,
+			sbx.KernelVersion,
+			sbx.FirecrackerVersion,
+			sbx.EnvdVersion,
+			node.Info,
+			sbx.AutoPause.Load(),
+			sbx.EnvdAccessToken,
+			sbx.BaseTemplateID,
+		)
+		instanceInfo.Datasets = sbx.Datasets
+		instanceInfo.SetNetwork(sbx.GetNetwork())
+
+		cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
+		if cacheErr != nil {
+			telemetry.ReportError(ctx, "error when adding instance to cache", cacheErr)
+
+			deleted := o.DeleteInstance(childCtx, sandboxID, false)
+			if !deleted {
+				telemetry.ReportEvent(ctx, "instance wasn't found in cache when deleting")
+			}
+
+			continue
+		}
+
+		sandboxes = append(sandboxes, &sbxInfo)
+	}
+
+	if len(sandboxes) == 0 {
+		o.deleteForkEnv(ctx, sbx.Instance.SandboxID, templateID)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("none of the children of sandbox '%s' were started", sbx.Instance.SandboxID),
+		}
+	}
+
+	return sandboxes, nil
+}
+
+// deleteForkEnv removes the template of the fork that has no running children, nothing can be started from it.
+func (o *Orchestrator) deleteForkEnv(ctx context.Context, sandboxID, templateID string) {
+	err := o.dbClient.DeleteForkEnv(ctx, templateID)
+	if err != nil {
+		zap.L().Error("error deleting fork env", logger.WithSandboxID(sandboxID), zap.String("template_id", templateID), zap.Error(err))
+	}
+}
+
+// releaseForkEnv deletes the template of the fork after its last child was killed or paused.
+// Only the sandboxes started from a snapshot can run from a fork, the database decides whether the template is a fork.
+func (o *Orchestrator) releaseForkEnv(ctx context.Context, info *instance.InstanceInfo) {
+	templateID := info.Instance.TemplateID
+	if templateID == info.BaseTemplateID {
+		return
+	}
+
+	for _, other := range o.instanceCache.GetInstances(info.TeamID) {
+		if other.Instance.SandboxID != info.Instance.SandboxID && other.Instance.TemplateID == templateID {
+			return
+		}
+	}
+
+	o.deleteForkEnv(ctx, info.Instance.SandboxID, templateID)
+}
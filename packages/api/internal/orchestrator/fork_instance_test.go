@@ -0,0 +1,137 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// addTestChild adds the running sandbox started from the template to the cache.
+func addTestChild(t *testing.T, o *Orchestrator, teamID uuid.UUID, baseTemplateID, templateID string, buildID uuid.UUID) *instance.InstanceInfo {
+	t.Helper()
+
+	startTime := time.Now()
+
+	info := instance.NewInstanceInfo(
+		&api.Sandbox{SandboxID: "i" + id.Generate(), ClientID: id.Generate(), TemplateID: templateID},
+		uuid.NewString(),
+		&teamID,
+		&buildID,
+		nil,
+		time.Hour,
+		startTime,
+		startTime.Add(time.Minute),
+		2,
+		512,
+		512,
+		"vmlinux-6.1.102",
+		"v1.10.1_1fcdaec",
+		"0.2.0",
+		&node.NodeInfo{},
+		false,
+		nil,
+		baseTemplateID,
+	)
+	require.NoError(t, o.instanceCache.Add(context.Background(), info, true))
+
+	return info
+}
+
+func TestOrchestrator_ReleaseForkEnv(t *testing.T) {
+	o := newTestOrchestrator(t)
+	ctx := context.Background()
+
+	team, err := o.dbClient.Client.Team.
+		Create().
+		SetName("test").
+		SetEmail(uuid.NewString() + "@e2b.dev").
+		SetTier("base_v1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	baseEnvID := id.Generate()
+	require.NoError(t, o.dbClient.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	newFork := func(t *testing.T) *models.EnvBuild {
+		t.Helper()
+
+		build, err := o.dbClient.NewForkBuild(ctx, &db.SnapshotInfo{
+			BaseTemplateID:     baseEnvID,
+			SandboxID:          "i" + id.Generate(),
+			VCPU:               2,
+			RAMMB:              512,
+			KernelVersion:      "vmlinux-6.1.102",
+			FirecrackerVersion: "v1.10.1_1fcdaec",
+			EnvdVersion:        "0.2.0",
+		}, team.ID)
+		require.NoError(t, err)
+		require.NoError(t, o.dbClient.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess))
+
+		return build
+	}
+
+	t.Run("fork env is deleted after the last child", func(t *testing.T) {
+		build := newFork(t)
+
+		first := addTestChild(t, o, team.ID, baseEnvID, *build.EnvID, build.ID)
+		second := addTestChild(t, o, team.ID, baseEnvID, *build.EnvID, build.ID)
+
+		o.instanceCache.Delete(first.Instance.SandboxID, false)
+		o.releaseForkEnv(ctx, first)
+
+		_, err := o.dbClient.Client.Env.Get(ctx, *build.EnvID)
+		require.NoError(t, err, "the other child still runs from the fork")
+
+		o.instanceCache.Delete(second.Instance.SandboxID, false)
+		o.releaseForkEnv(ctx, second)
+
+		_, err = o.dbClient.Client.Env.Get(ctx, *build.EnvID)
+		assert.True(t, models.IsNotFound(err))
+
+		// The snapshots of the paused children can still map data from the build.
+		marked, err := o.dbClient.Client.EnvBuild.Get(ctx, build.ID)
+		require.NoError(t, err)
+		assert.Nil(t, marked.EnvID)
+		assert.NotNil(t, marked.MarkedForGcAt)
+	})
+
+	t.Run("template that isn't a fork is kept", func(t *testing.T) {
+		templateID := id.Generate()
+		require.NoError(t, o.dbClient.Client.Env.Create().SetID(templateID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+		build, err := o.dbClient.Client.EnvBuild.
+			Create().
+			SetEnvID(templateID).
+			SetStatus(envbuild.StatusUploaded).
+			SetVcpu(2).
+			SetRAMMB(512).
+			SetFreeDiskSizeMB(512).
+			SetKernelVersion("vmlinux-6.1.102").
+			SetFirecrackerVersion("v1.10.1_1fcdaec").
+			Save(ctx)
+		require.NoError(t, err)
+
+		sbx := addTestChild(t, o, team.ID, baseEnvID, templateID, build.ID)
+
+		o.instanceCache.Delete(sbx.Instance.SandboxID, false)
+		o.releaseForkEnv(ctx, sbx)
+
+		_, err = o.dbClient.Client.Env.Get(ctx, templateID)
+		require.NoError(t, err)
+		kept, err := o.dbClient.Client.EnvBuild.Get(ctx, build.ID)
+		require.NoError(t, err)
+		assert.Nil(t, kept.MarkedForGcAt)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	nNode "github.com/e2b-dev/infra/packages/api/internal/node"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 )
 
 func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) ([]*instance.InstanceInfo, error) {
@@ -36,63 +37,75 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 	sandboxesInfo := make([]*instance.InstanceInfo, 0, len(sandboxes))
 
 	for _, sbx := range sandboxes {
-		config := sbx.GetConfig()
-
-		if config == nil {
-			return nil, fmt.Errorf("sandbox config is nil when listing sandboxes: %#v", sbx)
+		sandboxInfo, err := sandboxInfoFromRunning(sbx, node)
+		if err != nil {
+			return nil, err
 		}
 
-		teamID, parseErr := uuid.Parse(config.TeamId)
-		if parseErr != nil {
-			return nil, fmt.Errorf("failed to parse team ID '%s' for job: %w", config.TeamId, parseErr)
-		}
+		sandboxesInfo = append(sandboxesInfo, sandboxInfo)
+	}
 
-		buildID, parseErr := uuid.Parse(config.BuildId)
-		if parseErr != nil {
-			return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, err)
-		}
+	return sandboxesInfo, nil
+}
 
-		autoPause := instance.InstanceAutoPauseDefault
-		if config.AutoPause != nil {
-			autoPause = *config.AutoPause
-		}
+// sandboxInfoFromRunning returns the instance info of the sandbox running on the node.
+func sandboxInfoFromRunning(sbx *orchestrator.RunningSandbox, node *nNode.NodeInfo) (*instance.InstanceInfo, error) {
+	config := sbx.GetConfig()
 
-		// TODO: Temporary workaround, until all orchestrators report this
-		if config.ExecutionId == "" {
-			config.ExecutionId = uuid.New().String()
-		}
+	if config == nil {
+		return nil, fmt.Errorf("sandbox config is nil when listing sandboxes: %#v", sbx)
+	}
 
-		sandboxesInfo = append(
-			sandboxesInfo,
-			instance.NewInstanceInfo(
-				&api.Sandbox{
-					SandboxID:  config.SandboxId,
-					TemplateID: config.TemplateId,
-					Alias:      config.Alias,
-					ClientID:   node.ID, // to prevent mismatch use the node ID which we use for the request
-				},
-				config.ExecutionId,
-				&teamID,
-				&buildID,
-				config.Metadata,
-				time.Duration(config.MaxSandboxLength)*time.Hour,
-				sbx.StartTime.AsTime(),
-				sbx.EndTime.AsTime(),
-				config.Vcpu,
-				config.TotalDiskSizeMb,
-				config.RamMb,
-				config.KernelVersion,
-				config.FirecrackerVersion,
-				config.EnvdVersion,
-				node,
-				autoPause,
-				config.EnvdAccessToken,
-				config.BaseTemplateId,
-			),
-		)
+	teamID, parseErr := uuid.Parse(config.TeamId)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse team ID '%s' for job: %w", config.TeamId, parseErr)
 	}
 
-	return sandboxesInfo, nil
+	buildID, parseErr := uuid.Parse(config.BuildId)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, parseErr)
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if config.AutoPause != nil {
+		autoPause = *config.AutoPause
+	}
+
+	// TODO: Temporary workaround, until all orchestrators report this
+	if config.ExecutionId == "" {
+		config.ExecutionId = uuid.New().String()
+	}
+
+	sandboxInfo := instance.NewInstanceInfo(
+		&api.Sandbox{
+			SandboxID:  config.SandboxId,
+			TemplateID: config.TemplateId,
+			Alias:      config.Alias,
+			ClientID:   node.ID, // to prevent mismatch use the node ID which we use for the request
+		},
+		config.ExecutionId,
+		&teamID,
+		&buildID,
+		config.Metadata,
+		time.Duration(config.MaxSandboxLength)*time.Hour,
+		sbx.StartTime.AsTime(),
+		sbx.EndTime.AsTime(),
+		config.Vcpu,
+		config.TotalDiskSizeMb,
+		config.RamMb,
+		config.KernelVersion,
+		config.FirecrackerVersion,
+		config.EnvdVersion,
+		node,
+		autoPause,
+		config.EnvdAccessToken,
+		config.BaseTemplateId,
+	)
+	sandboxInfo.HasVolumes = len(config.Volumes) > 0
+	sandboxInfo.Datasets = datasetsFromConfig(config.Datasets)
+	sandboxInfo.SetNetwork(apiNetworkConfig(config.Network))
+
+	return sandboxInfo, nil
 }
 
 // GetSandboxes returns all instances for a given node.
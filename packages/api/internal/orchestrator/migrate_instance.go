@@ -0,0 +1,213 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// maxConcurrentMigrations limits the sandboxes migrated from the drained node at once.
+	maxConcurrentMigrations = 4
+
+	// migrationTimeout is the time for pausing the sandbox and resuming it on the target node.
+	migrationTimeout = 5 * time.Minute
+)
+
+// MigrateInstance moves the running sandbox to another node, the least busy node is used if the target is not set.
+// The sandbox is paused and resumed on the target right away, the target reads the snapshot from the source node while it is uploaded.
+// The routing is kept on the source node until the sandbox runs on the target, then it is replaced.
+func (o *Orchestrator) MigrateInstance(ctx context.Context, sandboxID string, targetNodeID *string) *api.APIError {
+	ctx, span := o.tracer.Start(ctx, "migrate-sandbox")
+	defer span.End()
+
+	sbx, err := o.instanceCache.Get(sandboxID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' is not running", sandboxID),
+			Err:       err,
+		}
+	}
+
+	if sbx.HasVolumes {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Sandbox with volumes can't be migrated",
+			Err:       fmt.Errorf("sandbox '%s' has volumes", sandboxID),
+		}
+	}
+
+	source := o.GetNode(sbx.Instance.ClientID)
+	if source == nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Node of the sandbox was not found",
+			Err:       fmt.Errorf("node '%s' of sandbox '%s' not found", sbx.Instance.ClientID, sandboxID),
+		}
+	}
+
+	if targetNodeID != nil {
+		target := o.GetNode(*targetNodeID)
+		if target == nil {
+			return &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Node '%s' was not found", *targetNodeID),
+				Err:       fmt.Errorf("node '%s' not found", *targetNodeID),
+			}
+		}
+
+		if target.Info.ID == source.Info.ID || target.Status() != api.NodeStatusReady {
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Sandbox can't be migrated to node '%s'", *targetNodeID),
+				Err:       fmt.Errorf("node '%s' is the source or it is not ready", *targetNodeID),
+			}
+		}
+	}
+
+	team, tier, err := o.dbClient.GetTeamByID(ctx, *sbx.TeamID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get the team of the sandbox",
+			Err:       err,
+		}
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.String("source.node.id", source.Info.ID),
+	)
+
+	// The pause sets the auto pause, so it is read before.
+	autoPause := sbx.AutoPause.Load()
+	timeout := max(time.Until(sbx.GetEndTime()), instance.InstanceExpiration)
+
+	sbx.Migrating.Store(true)
+
+	found := o.DeleteInstance(ctx, sandboxID, true)
+	if !found {
+		return &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' is not running", sandboxID),
+			Err:       fmt.Errorf("sandbox '%s' was removed before the migration", sandboxID),
+		}
+	}
+
+	_, err = sbx.Pausing.WaitWithContext(ctx)
+	if err != nil {
+		o.dns.Remove(context.WithoutCancel(ctx), sandboxID, source.Info.IPAddress)
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Failed to pause sandbox '%s'", sandboxID),
+			Err:       fmt.Errorf("failed to pause sandbox for migration: %w", err),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Paused sandbox for migration")
+
+	lastSnapshot, err := o.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: team.ID})
+	if err != nil {
+		o.dns.Remove(context.WithoutCancel(ctx), sandboxID, source.Info.IPAddress)
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Sandbox was paused, but it couldn't be resumed on another node",
+			Err:       fmt.Errorf("failed to get the snapshot of the migrated sandbox: %w", err),
+		}
+	}
+
+	alias := ""
+	if len(lastSnapshot.Aliases) > 0 {
+		alias = lastSnapshot.Aliases[0]
+	}
+
+	startTime := time.Now()
+
+	_, apiErr := o.CreateSandbox(
+		ctx,
+		sandboxID,
+		uuid.New().String(),
+		alias,
+		authcache.AuthTeamInfo{Team: team, Tier: tier},
+		lastSnapshot.EnvBuild,
+		lastSnapshot.Snapshot.Metadata,
+		nil,
+		startTime,
+		startTime.Add(timeout),
+		timeout,
+		true,
+		targetNodeID,
+		lastSnapshot.Snapshot.BaseEnvID,
+		autoPause,
+		sbx.EnvdAccessToken,
+		sbx.GetNetwork(),
+		nil,
+		lastSnapshot.EnvBuild.Datasets,
+		source,
+	)
+	if apiErr != nil {
+		// The sandbox stays paused, it is resumed from the storage as any other paused sandbox.
+		o.dns.Remove(context.WithoutCancel(ctx), sandboxID, source.Info.IPAddress)
+
+		return &api.APIError{
+			Code:      apiErr.Code,
+			ClientMsg: "Sandbox was paused, but it couldn't be resumed on another node",
+			Err:       fmt.Errorf("failed to resume migrated sandbox: %w", apiErr.Err),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Resumed migrated sandbox")
+
+	return nil
+}
+
+// MigrateNodeInstances migrates all the sandboxes running on the node to the other nodes.
+// The sandboxes started on the node after the listing are not migrated, the node is expected to be draining.
+func (o *Orchestrator) MigrateNodeInstances(ctx context.Context, nodeID string) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentMigrations)
+
+	for _, sbx := range o.instanceCache.Items() {
+		if sbx.Instance.ClientID != nodeID {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(sandboxID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			migrateCtx, cancel := context.WithTimeout(ctx, migrationTimeout)
+			defer cancel()
+
+			apiErr := o.MigrateInstance(migrateCtx, sandboxID, nil)
+			if apiErr != nil {
+				zap.L().Error("Failed to migrate sandbox from the drained node", logger.WithSandboxID(sandboxID), zap.String("node_id", nodeID), zap.Error(apiErr.Err))
+			}
+		}(sbx.Instance.SandboxID)
+	}
+
+	wg.Wait()
+
+	zap.L().Info("Finished migrating sandboxes from the drained node", zap.String("node_id", nodeID))
+}
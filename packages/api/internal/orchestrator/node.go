@@ -77,14 +77,20 @@ func (n *Node) setStatus(status api.NodeStatus) {
 	}
 }
 
-func (n *Node) SendStatusChange(ctx context.Context, s api.NodeStatus) error {
+// SendStatusChange overrides the status of the node, the evacuate drain mode makes the node pause its sandboxes.
+func (n *Node) SendStatusChange(ctx context.Context, s api.NodeStatus, evacuate bool) error {
 	nodeStatus, ok := ApiNodeToOrchestratorStateMapper[s]
 	if !ok {
 		zap.L().Error("Unknown service info status", zap.Any("status", s), zap.String("node_id", n.Info.ID))
 		return fmt.Errorf("unknown service info status: %s", s)
 	}
 
-	_, err := n.Client.Info.ServiceStatusOverride(ctx, &orchestratorinfo.ServiceStatusChangeRequest{ServiceStatus: nodeStatus})
+	drainMode := orchestratorinfo.ServiceDrainMode_DrainWait
+	if evacuate {
+		drainMode = orchestratorinfo.ServiceDrainMode_DrainEvacuate
+	}
+
+	_, err := n.Client.Info.ServiceStatusOverride(ctx, &orchestratorinfo.ServiceStatusChangeRequest{ServiceStatus: nodeStatus, DrainMode: drainMode})
 	if err != nil {
 		zap.L().Error("Failed to send status change", zap.Error(err))
 		return err
@@ -217,6 +223,31 @@ func (n *Node) InsertBuild(buildID string) {
 	n.buildCache.Set(buildID, struct{}{}, 2*time.Minute)
 }
 
+// buildPeers returns the addresses of the other nodes that have the build cached, the node reads the build chunks from them.
+// The map iteration order is random, so the reads are spread over the peers.
+func (o *Orchestrator) buildPeers(buildID string, nodeID string) []string {
+	var peers []string
+
+	for _, n := range o.nodes.Items() {
+		if len(peers) >= maxBuildPeers {
+			break
+		}
+
+		if n.Info.ID == nodeID || !n.buildCache.Has(buildID) {
+			continue
+		}
+
+		status := n.Status()
+		if status != api.NodeStatusReady && status != api.NodeStatusDraining {
+			continue
+		}
+
+		peers = append(peers, n.Info.OrchestratorAddress)
+	}
+
+	return peers
+}
+
 func (o *Orchestrator) NodeCount() int {
 	return o.nodes.Count()
 }
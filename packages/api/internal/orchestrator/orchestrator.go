@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/dns"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -41,6 +42,7 @@ type Orchestrator struct {
 	analytics           *analyticscollector.Analytics
 	dns                 *dns.DNS
 	dbClient            *db.DB
+	sqlcDB              *sqlcdb.Client
 	tel                 *telemetry.Client
 	metricsRegistration metric.Registration
 }
@@ -53,6 +55,7 @@ func New(
 	posthogClient *analyticscollector.PosthogClient,
 	redisClient redis.UniversalClient,
 	dbClient *db.DB,
+	sqlcDB *sqlcdb.Client,
 ) (*Orchestrator, error) {
 	analyticsInstance, err := analyticscollector.NewAnalytics()
 	if err != nil {
@@ -80,6 +83,7 @@ func New(
 		nodes:       smap.New[*Node](),
 		dns:         dnsServer,
 		dbClient:    dbClient,
+		sqlcDB:      sqlcDB,
 		tel:         tel,
 	}
 
@@ -118,6 +122,7 @@ func New(
 	o.metricsRegistration = registration
 
 	go o.startStatusLogging(ctx)
+	go o.cleanupExits(ctx)
 
 	return &o, nil
 }
@@ -44,6 +44,9 @@ func (o *Orchestrator) PauseInstance(
 		FirecrackerVersion: sbx.FirecrackerVersion,
 		EnvdVersion:        sbx.Instance.EnvdVersion,
 		EnvdSecured:        sbx.EnvdAccessToken != nil,
+		Datasets:           sbx.Datasets,
+		BuildID:            sbx.GetEvacuatedBuildID(),
+		Network:            snapshotNetworkConfig(sbx.GetNetwork()),
 	}
 
 	envBuild, err := o.dbClient.NewSnapshotBuild(
@@ -57,17 +60,20 @@ func (o *Orchestrator) PauseInstance(
 		return err
 	}
 
-	err = snapshotInstance(ctx, o, sbx, *envBuild.EnvID, envBuild.ID.String())
-	if errors.Is(err, ErrPauseQueueExhausted{}) {
-		telemetry.ReportCriticalError(ctx, "pause queue exhausted", err)
+	// The evacuated sandbox is already paused and its snapshot uploaded by the node.
+	if snapshotConfig.BuildID == nil {
+		err = snapshotInstance(ctx, o, sbx, *envBuild.EnvID, envBuild.ID.String())
+		if errors.Is(err, ErrPauseQueueExhausted{}) {
+			telemetry.ReportCriticalError(ctx, "pause queue exhausted", err)
 
-		return ErrPauseQueueExhausted{}
-	}
+			return ErrPauseQueueExhausted{}
+		}
 
-	if err != nil && !errors.Is(err, ErrPauseQueueExhausted{}) {
-		telemetry.ReportCriticalError(ctx, "error pausing sandbox", err)
+		if err != nil && !errors.Is(err, ErrPauseQueueExhausted{}) {
+			telemetry.ReportCriticalError(ctx, "error pausing sandbox", err)
 
-		return fmt.Errorf("error pausing sandbox: %w", err)
+			return fmt.Errorf("error pausing sandbox: %w", err)
+		}
 	}
 
 	err = o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusSuccess)
@@ -0,0 +1,39 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ResetInstance restarts the sandbox from its template on the same node, the sandbox keeps its ID and the remaining timeout.
+func (o *Orchestrator) ResetInstance(ctx context.Context, sbx *instance.InstanceInfo) error {
+	ctx, span := o.tracer.Start(ctx, "reset-sandbox")
+	defer span.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	// The stop events of the replaced sandbox carry the same execution ID as the reset one.
+	sbx.Resetting.Store(true)
+	defer sbx.Resetting.Store(false)
+
+	_, err = client.Sandbox.Reset(ctx, &orchestrator.SandboxResetRequest{
+		SandboxId: sbx.Instance.SandboxID,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to reset sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(ctx, "Reset sandbox")
+
+	return nil
+}
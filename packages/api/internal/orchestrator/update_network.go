@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// UpdateSandboxNetwork replaces the egress firewall rules of the running sandbox.
+func (o *Orchestrator) UpdateSandboxNetwork(ctx context.Context, sbx *instance.InstanceInfo, network *api.SandboxNetworkConfig) error {
+	ctx, span := o.tracer.Start(ctx, "update-sandbox-network")
+	defer span.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	_, err = client.Sandbox.UpdateNetwork(ctx, &orchestrator.SandboxUpdateNetworkRequest{
+		SandboxId: sbx.Instance.SandboxID,
+		Network:   networkConfig(network),
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to update network of sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	sbx.SetNetwork(network)
+
+	telemetry.ReportEvent(ctx, "Updated sandbox network")
+
+	return nil
+}
+
+func networkConfig(network *api.SandboxNetworkConfig) *orchestrator.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	config := &orchestrator.SandboxNetworkConfig{}
+	if network.AllowedCidrs != nil {
+		config.AllowedCidrs = *network.AllowedCidrs
+	}
+	if network.AllowedDomains != nil {
+		config.AllowedDomains = *network.AllowedDomains
+	}
+	if network.DeniedCidrs != nil {
+		config.DeniedCidrs = *network.DeniedCidrs
+	}
+	if network.DenyAll != nil {
+		config.DenyAll = *network.DenyAll
+	}
+
+	return config
+}
+
+// apiNetworkConfig returns the egress rules reported by the node.
+func apiNetworkConfig(network *orchestrator.SandboxNetworkConfig) *api.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	return &api.SandboxNetworkConfig{
+		AllowedCidrs:   &network.AllowedCidrs,
+		AllowedDomains: &network.AllowedDomains,
+		DeniedCidrs:    &network.DeniedCidrs,
+		DenyAll:        &network.DenyAll,
+	}
+}
+
+// snapshotNetworkConfig returns the egress rules stored with the snapshot of the sandbox.
+func snapshotNetworkConfig(network *api.SandboxNetworkConfig) *types.SandboxNetworkConfig {
+	config := networkConfig(network)
+	if config == nil {
+		return nil
+	}
+
+	return &types.SandboxNetworkConfig{
+		AllowedCidrs:   config.AllowedCidrs,
+		DeniedCidrs:    config.DeniedCidrs,
+		DenyAll:        config.DenyAll,
+		AllowedDomains: config.AllowedDomains,
+	}
+}
+
+// NetworkFromSnapshot returns the egress rules the sandbox had when its snapshot was taken.
+func NetworkFromSnapshot(network *types.SandboxNetworkConfig) *api.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	return &api.SandboxNetworkConfig{
+		AllowedCidrs:   &network.AllowedCidrs,
+		AllowedDomains: &network.AllowedDomains,
+		DeniedCidrs:    &network.DeniedCidrs,
+		DenyAll:        &network.DenyAll,
+	}
+}
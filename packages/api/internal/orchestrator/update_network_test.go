@@ -0,0 +1,72 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestSnapshotNetworkConfig(t *testing.T) {
+	denyAll := true
+
+	tests := []struct {
+		name    string
+		network *api.SandboxNetworkConfig
+	}{
+		{
+			name: "default egress",
+		},
+		{
+			name:    "deny all",
+			network: &api.SandboxNetworkConfig{DenyAll: &denyAll, AllowedCidrs: &[]string{"1.1.1.1/32"}},
+		},
+		{
+			name:    "denied CIDRs",
+			network: &api.SandboxNetworkConfig{DeniedCidrs: &[]string{"8.8.8.8/32", "1.0.0.0/8"}},
+		},
+		{
+			name:    "allowed domains",
+			network: &api.SandboxNetworkConfig{AllowedDomains: &[]string{"pypi.org", "*.amazonaws.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The rules are stored in the database as JSON.
+			data, err := json.Marshal(snapshotNetworkConfig(tt.network))
+			require.NoError(t, err)
+
+			var stored *types.SandboxNetworkConfig
+			require.NoError(t, json.Unmarshal(data, &stored))
+
+			resumed := NetworkFromSnapshot(stored)
+			if tt.network == nil {
+				assert.Nil(t, resumed)
+
+				return
+			}
+
+			assert.True(t, proto.Equal(networkConfig(tt.network), networkConfig(resumed)))
+		})
+	}
+}
+
+func TestApiNetworkConfig(t *testing.T) {
+	assert.Nil(t, apiNetworkConfig(nil))
+
+	config := &orchestrator.SandboxNetworkConfig{
+		AllowedCidrs:   []string{"1.1.1.1/32"},
+		DeniedCidrs:    []string{"8.8.8.8/32"},
+		DenyAll:        true,
+		AllowedDomains: []string{"pypi.org"},
+	}
+
+	assert.True(t, proto.Equal(config, networkConfig(apiNetworkConfig(config))))
+}
@@ -0,0 +1,216 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// DefaultVolumeMountPath is the path in the sandbox where the volume is mounted if the path isn't specified.
+const DefaultVolumeMountPath = "/data"
+
+// staleVolumeTimeout is how long the volume has to be attached to an unknown sandbox to be released.
+// It's longer than the cache hooks, so the volume isn't released while the sandbox is being paused or killed.
+const staleVolumeTimeout = 2 * cacheHookTimeout
+
+// AttachVolumes reserves the team volumes for the new sandbox.
+// If the sandbox isn't created, the volumes have to be released with ReleaseVolumes.
+func (o *Orchestrator) AttachVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string, mounts []api.SandboxVolumeMount) ([]*orchestrator.SandboxVolume, *api.APIError) {
+	volumes := make([]*orchestrator.SandboxVolume, 0, len(mounts))
+	for _, mount := range mounts {
+		v, err := o.dbClient.AttachVolume(ctx, teamID, mount.Name, sandboxID)
+		if err != nil {
+			o.ReleaseVolumes(ctx, teamID, sandboxID)
+
+			var inUseErr db.VolumeInUse
+			switch {
+			case errors.Is(err, db.VolumeNotFound{}):
+				return nil, &api.APIError{
+					Code:      http.StatusNotFound,
+					ClientMsg: fmt.Sprintf("Volume '%s' not found", mount.Name),
+					Err:       err,
+				}
+			case errors.As(err, &inUseErr):
+				return nil, &api.APIError{
+					Code:      http.StatusConflict,
+					ClientMsg: fmt.Sprintf("Volume '%s' is attached to sandbox '%s'", mount.Name, inUseErr.SandboxID),
+					Err:       err,
+				}
+			default:
+				return nil, &api.APIError{
+					Code:      http.StatusInternalServerError,
+					ClientMsg: "Failed to attach volume",
+					Err:       err,
+				}
+			}
+		}
+
+		mountPath := DefaultVolumeMountPath
+		if mount.Path != nil {
+			mountPath = *mount.Path
+		}
+
+		volumes = append(volumes, sandboxVolume(v, mountPath))
+	}
+
+	return volumes, nil
+}
+
+// ReattachVolumes returns the volumes of the paused sandbox that is being resumed.
+func (o *Orchestrator) ReattachVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) ([]*orchestrator.SandboxVolume, *api.APIError) {
+	attached, err := o.dbClient.ReattachSandboxVolumes(ctx, teamID, sandboxID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to attach volumes",
+			Err:       err,
+		}
+	}
+
+	volumes := make([]*orchestrator.SandboxVolume, 0, len(attached))
+	for _, v := range attached {
+		// The volume is already mounted in the memory of the paused sandbox.
+		volumes = append(volumes, sandboxVolume(v, ""))
+	}
+
+	return volumes, nil
+}
+
+// ReleaseVolumes detaches the volumes from the sandbox without keeping the changes that weren't written back.
+func (o *Orchestrator) ReleaseVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) {
+	err := o.dbClient.ReleaseSandboxVolumes(ctx, teamID, sandboxID)
+	if err != nil {
+		zap.L().Error("failed to release sandbox volumes", logger.WithSandboxID(sandboxID), zap.Error(err))
+	}
+}
+
+// closeVolumes keeps the volume generations written back when the sandbox was paused or killed.
+// The paused sandbox keeps its volumes attached, so they are not used by other sandboxes until it's resumed or killed.
+func (o *Orchestrator) closeVolumes(ctx context.Context, info *instance.InstanceInfo, paused bool, closeErr error) {
+	if !info.HasVolumes {
+		return
+	}
+
+	if closeErr != nil {
+		// The sandbox that failed to stop could still write to the volume, it can't be attached to another sandbox yet.
+		stopped, err := o.sandboxStopped(ctx, info)
+		if err != nil || !stopped {
+			zap.L().Warn("keeping the volumes of the sandbox that wasn't confirmed as stopped", logger.WithSandboxID(info.Instance.SandboxID), zap.Error(err))
+
+			return
+		}
+
+		o.ReleaseVolumes(ctx, *info.TeamID, info.Instance.SandboxID)
+
+		return
+	}
+
+	err := o.dbClient.CommitSandboxVolumes(ctx, *info.TeamID, info.Instance.SandboxID, !paused)
+	if err != nil {
+		zap.L().Error("failed to commit sandbox volumes", logger.WithSandboxID(info.Instance.SandboxID), zap.Error(err))
+	}
+}
+
+// sandboxStopped checks that the sandbox is no longer running on its node.
+func (o *Orchestrator) sandboxStopped(ctx context.Context, info *instance.InstanceInfo) (bool, error) {
+	node := o.GetNode(info.Instance.ClientID)
+	if node == nil {
+		return false, fmt.Errorf("node '%s' not found", info.Instance.ClientID)
+	}
+
+	sandboxes, err := o.getSandboxes(ctx, node.Info)
+	if err != nil {
+		return false, err
+	}
+
+	for _, sbx := range sandboxes {
+		if sbx.Instance.SandboxID == info.Instance.SandboxID && sbx.ExecutionID == info.ExecutionID {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// staleVolume is a volume attached to a sandbox that isn't known to the API.
+type staleVolume struct {
+	nextGenerationID uuid.UUID
+	since            time.Time
+}
+
+// reconcileVolumes releases the volumes kept attached to the sandboxes that are gone, e.g. after a failed kill.
+// The volume is released only after it's stale for staleVolumeTimeout, so the sandboxes that are being started or stopped keep their volumes.
+// The stale volumes found by the previous run are passed in and the current ones are returned.
+func (o *Orchestrator) reconcileVolumes(ctx context.Context, stale map[uuid.UUID]staleVolume) map[uuid.UUID]staleVolume {
+	volumes, err := o.dbClient.GetWritableVolumes(ctx)
+	if err != nil {
+		zap.L().Error("failed to list attached volumes", zap.Error(err))
+
+		return stale
+	}
+
+	current := make(map[uuid.UUID]staleVolume)
+	for _, v := range volumes {
+		if o.instanceCache.Exists(*v.SandboxID) || o.instanceCache.IsReserved(*v.SandboxID) {
+			continue
+		}
+
+		previous, ok := stale[v.ID]
+		// The volume was reattached since, it is stale from now on.
+		if !ok || previous.nextGenerationID != *v.NextGenerationID {
+			current[v.ID] = staleVolume{nextGenerationID: *v.NextGenerationID, since: time.Now()}
+
+			continue
+		}
+
+		if time.Since(previous.since) < staleVolumeTimeout {
+			current[v.ID] = previous
+
+			continue
+		}
+
+		// The changes that weren't written back are discarded, the same as when the sandbox failed to stop.
+		err := o.dbClient.ReleaseVolume(ctx, v.ID, previous.nextGenerationID)
+		if err != nil {
+			zap.L().Error("failed to release stale volume", logger.WithSandboxID(*v.SandboxID), zap.String("volume_id", v.ID.String()), zap.Error(err))
+
+			current[v.ID] = previous
+
+			continue
+		}
+
+		zap.L().Info("released stale volume", logger.WithSandboxID(*v.SandboxID), zap.String("volume_id", v.ID.String()))
+	}
+
+	return current
+}
+
+func sandboxVolume(v *models.Volume, mountPath string) *orchestrator.SandboxVolume {
+	config := &orchestrator.SandboxVolume{
+		VolumeId:  v.ID.String(),
+		SizeMb:    v.SizeMB,
+		MountPath: mountPath,
+	}
+
+	if v.GenerationID != nil {
+		config.GenerationId = v.GenerationID.String()
+	}
+
+	if v.NextGenerationID != nil {
+		config.NextGenerationId = v.NextGenerationID.String()
+	}
+
+	return config
+}
@@ -0,0 +1,272 @@
+package orchestrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+const testMigrationsDir = "../../../db/migrations"
+
+// newTestOrchestrator returns an orchestrator without nodes backed by the database from POSTGRES_CONNECTION_STRING with all migrations applied.
+// The database should be a disposable one, the tests are skipped when it isn't configured.
+func newTestOrchestrator(t *testing.T) *Orchestrator {
+	t.Helper()
+
+	connectionString := os.Getenv("POSTGRES_CONNECTION_STRING")
+	if connectionString == "" {
+		t.Skip("POSTGRES_CONNECTION_STRING is not set")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	migrationDB, err := sql.Open("postgres", connectionString)
+	require.NoError(t, err)
+	defer migrationDB.Close()
+
+	goose.SetTableName("_migrations")
+	require.NoError(t, goose.SetDialect("postgres"))
+	require.NoError(t, goose.UpContext(ctx, migrationDB, testMigrationsDir))
+
+	dbClient, err := db.NewClient(5, 5)
+	require.NoError(t, err)
+	t.Cleanup(func() { dbClient.Close() })
+
+	noopHook := func(*instance.InstanceInfo, bool) error { return nil }
+	noopDelete := func(*instance.InstanceInfo) error { return nil }
+
+	return &Orchestrator{
+		dbClient:      dbClient,
+		nodes:         smap.New[*Node](),
+		instanceCache: instance.NewCache(ctx, noop.NewMeterProvider(), noopHook, noopDelete),
+		tracer:        tracenoop.NewTracerProvider().Tracer(""),
+	}
+}
+
+func createTestVolume(t *testing.T, o *Orchestrator) (uuid.UUID, *models.Volume) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	team, err := o.dbClient.Client.Team.
+		Create().
+		SetName("test").
+		SetEmail(uuid.NewString() + "@e2b.dev").
+		SetTier("base_v1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	v, err := o.dbClient.CreateVolume(ctx, team.ID, "data", 512)
+	require.NoError(t, err)
+
+	return team.ID, v
+}
+
+func getTestVolume(t *testing.T, o *Orchestrator, volumeID uuid.UUID) *models.Volume {
+	t.Helper()
+
+	v, err := o.dbClient.Client.Volume.Query().Where(volume.ID(volumeID)).Only(context.Background())
+	require.NoError(t, err)
+
+	return v
+}
+
+func testVolumeInfo(teamID uuid.UUID, sandboxID string) *instance.InstanceInfo {
+	return &instance.InstanceInfo{
+		Instance:    &api.Sandbox{SandboxID: sandboxID, ClientID: "missing-node"},
+		ExecutionID: uuid.NewString(),
+		TeamID:      &teamID,
+		HasVolumes:  true,
+	}
+}
+
+func TestOrchestrator_AttachVolumes(t *testing.T) {
+	o := newTestOrchestrator(t)
+	ctx := context.Background()
+
+	teamID, v := createTestVolume(t, o)
+
+	volumes, apiErr := o.AttachVolumes(ctx, teamID, "sbx-1", []api.SandboxVolumeMount{{Name: v.Name}})
+	require.Nil(t, apiErr)
+	require.Len(t, volumes, 1)
+
+	attached := getTestVolume(t, o, v.ID)
+	require.NotNil(t, attached.SandboxID)
+	assert.Equal(t, "sbx-1", *attached.SandboxID)
+	require.NotNil(t, attached.NextGenerationID)
+	assert.Equal(t, attached.NextGenerationID.String(), volumes[0].GetNextGenerationId())
+	assert.Equal(t, DefaultVolumeMountPath, volumes[0].GetMountPath())
+	assert.Empty(t, volumes[0].GetGenerationId(), "the volume wasn't written back yet")
+
+	_, apiErr = o.AttachVolumes(ctx, teamID, "sbx-2", []api.SandboxVolumeMount{{Name: v.Name}})
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusConflict, apiErr.Code)
+
+	_, apiErr = o.AttachVolumes(ctx, teamID, "sbx-2", []api.SandboxVolumeMount{{Name: "missing"}})
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.Code)
+
+	// The failed attach doesn't release the volume of the other sandbox.
+	attached = getTestVolume(t, o, v.ID)
+	require.NotNil(t, attached.SandboxID)
+	assert.Equal(t, "sbx-1", *attached.SandboxID)
+}
+
+func TestOrchestrator_CloseVolumes(t *testing.T) {
+	o := newTestOrchestrator(t)
+	ctx := context.Background()
+
+	t.Run("killed sandbox commits the generation and releases the volume", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		volumes, apiErr := o.AttachVolumes(ctx, teamID, "sbx", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		o.closeVolumes(ctx, testVolumeInfo(teamID, "sbx"), false, nil)
+
+		closed := getTestVolume(t, o, v.ID)
+		assert.Nil(t, closed.SandboxID)
+		assert.Nil(t, closed.NextGenerationID)
+		require.NotNil(t, closed.GenerationID)
+		assert.Equal(t, volumes[0].GetNextGenerationId(), closed.GenerationID.String())
+	})
+
+	t.Run("paused sandbox keeps the volume for the resume", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		volumes, apiErr := o.AttachVolumes(ctx, teamID, "sbx", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		o.closeVolumes(ctx, testVolumeInfo(teamID, "sbx"), true, nil)
+
+		paused := getTestVolume(t, o, v.ID)
+		require.NotNil(t, paused.SandboxID)
+		assert.Equal(t, "sbx", *paused.SandboxID)
+		assert.Nil(t, paused.NextGenerationID)
+		require.NotNil(t, paused.GenerationID)
+		assert.Equal(t, volumes[0].GetNextGenerationId(), paused.GenerationID.String())
+
+		// The resumed sandbox writes the volume back to a new generation.
+		resumed, apiErr := o.ReattachVolumes(ctx, teamID, "sbx")
+		require.Nil(t, apiErr)
+		require.Len(t, resumed, 1)
+		assert.Equal(t, paused.GenerationID.String(), resumed[0].GetGenerationId())
+		assert.NotEmpty(t, resumed[0].GetNextGenerationId())
+		assert.NotEqual(t, volumes[0].GetNextGenerationId(), resumed[0].GetNextGenerationId())
+	})
+
+	t.Run("sandbox not confirmed as stopped keeps the volume", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		volumes, apiErr := o.AttachVolumes(ctx, teamID, "sbx", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		// The node of the sandbox is not known, the sandbox could still be running.
+		o.closeVolumes(ctx, testVolumeInfo(teamID, "sbx"), false, errors.New("delete failed"))
+
+		kept := getTestVolume(t, o, v.ID)
+		require.NotNil(t, kept.SandboxID)
+		assert.Equal(t, "sbx", *kept.SandboxID)
+		require.NotNil(t, kept.NextGenerationID)
+		assert.Equal(t, volumes[0].GetNextGenerationId(), kept.NextGenerationID.String())
+		assert.Nil(t, kept.GenerationID)
+	})
+
+	t.Run("release discards the generation", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		_, apiErr := o.AttachVolumes(ctx, teamID, "sbx", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		o.ReleaseVolumes(ctx, teamID, "sbx")
+
+		released := getTestVolume(t, o, v.ID)
+		assert.Nil(t, released.SandboxID)
+		assert.Nil(t, released.NextGenerationID)
+		assert.Nil(t, released.GenerationID)
+	})
+}
+
+func TestOrchestrator_ReconcileVolumes(t *testing.T) {
+	o := newTestOrchestrator(t)
+	ctx := context.Background()
+
+	t.Run("stale volume is released after the timeout", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		_, apiErr := o.AttachVolumes(ctx, teamID, "sbx-stale", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		stale := o.reconcileVolumes(ctx, nil)
+		require.Contains(t, stale, v.ID)
+
+		// The volume is kept until the timeout passes.
+		stale = o.reconcileVolumes(ctx, stale)
+		require.Contains(t, stale, v.ID)
+		assert.NotNil(t, getTestVolume(t, o, v.ID).SandboxID)
+
+		previous := stale[v.ID]
+		previous.since = time.Now().Add(-staleVolumeTimeout)
+		stale[v.ID] = previous
+
+		stale = o.reconcileVolumes(ctx, stale)
+		assert.NotContains(t, stale, v.ID)
+
+		released := getTestVolume(t, o, v.ID)
+		assert.Nil(t, released.SandboxID)
+		assert.Nil(t, released.NextGenerationID)
+	})
+
+	t.Run("reattached volume is kept", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		_, apiErr := o.AttachVolumes(ctx, teamID, "sbx-reattached", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		stale := o.reconcileVolumes(ctx, nil)
+		previous := stale[v.ID]
+		previous.since = time.Now().Add(-staleVolumeTimeout)
+		stale[v.ID] = previous
+
+		_, apiErr = o.AttachVolumes(ctx, teamID, "sbx-reattached", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		stale = o.reconcileVolumes(ctx, stale)
+		require.Contains(t, stale, v.ID)
+		assert.NotEqual(t, previous.nextGenerationID, stale[v.ID].nextGenerationID)
+		assert.NotNil(t, getTestVolume(t, o, v.ID).SandboxID)
+	})
+
+	t.Run("volume of the sandbox being created is not stale", func(t *testing.T) {
+		teamID, v := createTestVolume(t, o)
+
+		release, err := o.instanceCache.Reserve("sbx-starting", teamID, 10)
+		require.NoError(t, err)
+		defer release()
+
+		_, apiErr := o.AttachVolumes(ctx, teamID, "sbx-starting", []api.SandboxVolumeMount{{Name: v.Name}})
+		require.Nil(t, apiErr)
+
+		stale := o.reconcileVolumes(ctx, nil)
+		assert.NotContains(t, stale, v.ID)
+	})
+}
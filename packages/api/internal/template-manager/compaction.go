@@ -0,0 +1,144 @@
+package template_manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+const (
+	compactionInterval = time.Minute * 10
+	compactionTimeout  = time.Minute * 30
+
+	// compactionBatchSize is the maximum number of the snapshots compacted in one run.
+	compactionBatchSize = 10
+)
+
+// SnapshotsCompactionPeriodicalSync compacts the diff chains of the paused sandboxes that were paused and resumed many times.
+// Each pause adds a build to the chain, so the resume has to read through all of them.
+// The snapshot builds are folded into one build, the builds of the base template stay referenced.
+// The sandboxes for which isRunning returns true are skipped, they are going to add to their chain anyway.
+func (tm *TemplateManager) SnapshotsCompactionPeriodicalSync(ctx context.Context, isRunning func(sandboxID string) bool) {
+	minChainLength, err := env.GetEnvAsInt("SNAPSHOT_COMPACTION_MIN_CHAIN_LENGTH", 10)
+	if err != nil {
+		zap.L().Error("Invalid snapshot compaction chain length, the compaction is disabled", zap.Error(err))
+
+		return
+	}
+
+	if minChainLength <= 1 {
+		zap.L().Info("Snapshot compaction is disabled")
+
+		return
+	}
+
+	ticker := time.NewTicker(compactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			dbCtx, dbCtxCancel := context.WithTimeout(ctx, 5*time.Second)
+			snapshots, err := tm.sqlcDB.GetSnapshotsToCompact(dbCtx, queries.GetSnapshotsToCompactParams{
+				MinChainLength: int32(minChainLength),
+				MaxSnapshots:   compactionBatchSize,
+			})
+			dbCtxCancel()
+			if err != nil {
+				zap.L().Error("Error getting snapshots for compaction", zap.Error(err))
+
+				continue
+			}
+
+			zap.L().Info("Running periodical compaction of snapshots", zap.Int("count", len(snapshots)))
+
+			for _, s := range snapshots {
+				if isRunning(s.Snapshot.SandboxID) {
+					continue
+				}
+
+				err := tm.compactSnapshot(ctx, s)
+				if err != nil {
+					zap.L().Error("Error compacting snapshot", zap.Error(err), zap.String("sandboxID", s.Snapshot.SandboxID))
+				}
+			}
+		}
+	}
+}
+
+// compactSnapshot folds all the builds of the snapshot into a new build and makes it the last build of the snapshot.
+func (tm *TemplateManager) compactSnapshot(ctx context.Context, s queries.GetSnapshotsToCompactRow) error {
+	ctx, cancel := context.WithTimeout(ctx, compactionTimeout)
+	defer cancel()
+
+	_, builds, err := tm.db.GetSnapshotBuilds(ctx, s.Snapshot.SandboxID, s.TeamID)
+	if err != nil {
+		return err
+	}
+
+	var source *models.EnvBuild
+
+	foldBuildIDs := make([]uuid.UUID, 0, len(builds))
+
+	for _, b := range builds {
+		switch b.Status {
+		case envbuild.StatusWaiting, envbuild.StatusBuilding, envbuild.StatusSnapshotting:
+			// The sandbox is being paused, the chain is compacted on the next run.
+			return nil
+		case envbuild.StatusSuccess:
+			if b.MarkedForGcAt == nil && b.FinishedAt != nil && (source == nil || b.FinishedAt.After(*source.FinishedAt)) {
+				source = b
+			}
+		}
+
+		foldBuildIDs = append(foldBuildIDs, b.ID)
+	}
+
+	if source == nil {
+		return nil
+	}
+
+	buildID := uuid.New()
+
+	zap.L().Info("Compacting snapshot",
+		zap.String("sandboxID", s.Snapshot.SandboxID),
+		zap.String("sourceBuildID", source.ID.String()),
+		zap.String("buildID", buildID.String()),
+		zap.Int32("chainLength", s.ChainLength),
+	)
+
+	err = tm.CompactBuild(ctx, s.Snapshot.EnvID, buildID, source.ID, foldBuildIDs, s.TeamID, s.ClusterID, source.ClusterNodeID)
+	if err != nil {
+		return err
+	}
+
+	err = tm.db.CommitCompactedSnapshotBuild(ctx, source, buildID, foldBuildIDs)
+	if err == nil {
+		return nil
+	}
+
+	// The compacted data are not referenced by any build, so they are removed right away.
+	deleteErr := tm.DeleteBuild(ctx, tm.tracer, buildID, s.Snapshot.EnvID, s.ClusterID, source.ClusterNodeID)
+	if deleteErr != nil {
+		zap.L().Error("Error deleting unused compacted build", zap.Error(deleteErr), zap.String("buildID", buildID.String()))
+	}
+
+	if errors.Is(err, db.SnapshotChanged{}) {
+		zap.L().Info("Snapshot changed during the compaction, the compacted build is discarded", zap.String("sandboxID", s.Snapshot.SandboxID))
+
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,253 @@
+package template_manager
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+)
+
+const testMigrationsDir = "../../../db/migrations"
+
+// newTestTemplateManager returns a template manager without the template manager clients backed by the database from POSTGRES_CONNECTION_STRING with all migrations applied.
+// The database should be a disposable one, the tests are skipped when it isn't configured.
+func newTestTemplateManager(t *testing.T) *TemplateManager {
+	t.Helper()
+
+	connectionString := os.Getenv("POSTGRES_CONNECTION_STRING")
+	if connectionString == "" {
+		t.Skip("POSTGRES_CONNECTION_STRING is not set")
+	}
+
+	ctx := context.Background()
+
+	migrationDB, err := sql.Open("postgres", connectionString)
+	require.NoError(t, err)
+	defer migrationDB.Close()
+
+	goose.SetTableName("_migrations")
+	require.NoError(t, goose.SetDialect("postgres"))
+	require.NoError(t, goose.UpContext(ctx, migrationDB, testMigrationsDir))
+
+	dbClient, err := db.NewClient(5, 5)
+	require.NoError(t, err)
+	t.Cleanup(func() { dbClient.Close() })
+
+	sqlcDB, err := sqlcdb.NewClient(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlcDB.Close() })
+
+	return &TemplateManager{
+		db:     dbClient,
+		sqlcDB: sqlcDB,
+	}
+}
+
+type testSnapshot struct {
+	teamID    uuid.UUID
+	sandboxID string
+	envID     string
+}
+
+// createTestSnapshot creates a paused sandbox snapshot without any builds.
+func createTestSnapshot(t *testing.T, tm *TemplateManager) testSnapshot {
+	t.Helper()
+
+	ctx := context.Background()
+
+	team, err := tm.db.Client.Team.
+		Create().
+		SetName("test").
+		SetEmail(uuid.NewString() + "@e2b.dev").
+		SetTier("base_v1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	baseEnvID := id.Generate()
+	require.NoError(t, tm.db.Client.Env.Create().SetID(baseEnvID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	envID := id.Generate()
+	require.NoError(t, tm.db.Client.Env.Create().SetID(envID).SetTeamID(team.ID).SetPublic(false).Exec(ctx))
+
+	sandboxID := "i" + id.Generate()
+	err = tm.db.Client.Snapshot.
+		Create().
+		SetSandboxID(sandboxID).
+		SetBaseEnvID(baseEnvID).
+		SetEnvID(envID).
+		SetMetadata(map[string]string{}).
+		SetSandboxStartedAt(time.Now()).
+		SetEnvSecure(false).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	return testSnapshot{teamID: team.ID, sandboxID: sandboxID, envID: envID}
+}
+
+// addTestSnapshotBuild adds the build of the next pause of the snapshot.
+func addTestSnapshotBuild(t *testing.T, tm *TemplateManager, s testSnapshot, status envbuild.Status) *models.EnvBuild {
+	t.Helper()
+
+	create := tm.db.Client.EnvBuild.
+		Create().
+		SetEnvID(s.envID).
+		SetStatus(status).
+		SetVcpu(2).
+		SetRAMMB(512).
+		SetFreeDiskSizeMB(512).
+		SetKernelVersion("vmlinux-6.1.102").
+		SetFirecrackerVersion("v1.10.1_1fcdaec")
+	if status == envbuild.StatusSuccess {
+		create = create.SetFinishedAt(time.Now())
+	}
+
+	build, err := create.Save(context.Background())
+	require.NoError(t, err)
+
+	return build
+}
+
+func getTestBuild(t *testing.T, tm *TemplateManager, buildID uuid.UUID) *models.EnvBuild {
+	t.Helper()
+
+	build, err := tm.db.Client.EnvBuild.Get(context.Background(), buildID)
+	require.NoError(t, err)
+
+	return build
+}
+
+// chainLength returns the length of the diff chain of the snapshot counted for the compaction, it is zero if the snapshot isn't selected.
+func chainLength(t *testing.T, tm *TemplateManager, s testSnapshot, minChainLength int32) int32 {
+	t.Helper()
+
+	snapshots, err := tm.sqlcDB.GetSnapshotsToCompact(context.Background(), queries.GetSnapshotsToCompactParams{
+		MinChainLength: minChainLength,
+		MaxSnapshots:   1000,
+	})
+	require.NoError(t, err)
+
+	for _, row := range snapshots {
+		if row.Snapshot.SandboxID == s.sandboxID {
+			assert.Equal(t, s.teamID, row.TeamID)
+
+			return row.ChainLength
+		}
+	}
+
+	return 0
+}
+
+func TestDB_CommitCompactedSnapshotBuild(t *testing.T) {
+	tm := newTestTemplateManager(t)
+	ctx := context.Background()
+
+	t.Run("compacted build replaces the chain", func(t *testing.T) {
+		s := createTestSnapshot(t, tm)
+
+		first := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+		second := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+		third := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+
+		assert.Equal(t, int32(3), chainLength(t, tm, s, 3))
+
+		buildID := uuid.New()
+		err := tm.db.CommitCompactedSnapshotBuild(ctx, third, buildID, []uuid.UUID{first.ID, second.ID, third.ID})
+		require.NoError(t, err)
+
+		compacted := getTestBuild(t, tm, buildID)
+		assert.Equal(t, envbuild.StatusSuccess, compacted.Status)
+		require.NotNil(t, compacted.EnvID)
+		assert.Equal(t, s.envID, *compacted.EnvID)
+		assert.Nil(t, compacted.MarkedForGcAt)
+
+		for _, replaced := range []*models.EnvBuild{first, second, third} {
+			assert.NotNil(t, getTestBuild(t, tm, replaced.ID).MarkedForGcAt)
+		}
+
+		// The resumed sandbox starts from the compacted build.
+		last, err := tm.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: s.sandboxID, TeamID: s.teamID})
+		require.NoError(t, err)
+		assert.Equal(t, buildID, last.EnvBuild.ID)
+
+		assert.Zero(t, chainLength(t, tm, s, 2), "the replaced builds are not counted")
+	})
+
+	t.Run("newer pause discards the compacted build", func(t *testing.T) {
+		s := createTestSnapshot(t, tm)
+
+		first := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+		second := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+
+		// The sandbox was resumed and paused again while the chain was being compacted.
+		addTestSnapshotBuild(t, tm, s, envbuild.StatusSnapshotting)
+
+		buildID := uuid.New()
+		err := tm.db.CommitCompactedSnapshotBuild(ctx, second, buildID, []uuid.UUID{first.ID, second.ID})
+		require.ErrorIs(t, err, db.SnapshotChanged{})
+
+		_, err = tm.db.Client.EnvBuild.Get(ctx, buildID)
+		assert.True(t, models.IsNotFound(err))
+
+		assert.Nil(t, getTestBuild(t, tm, first.ID).MarkedForGcAt)
+		assert.Nil(t, getTestBuild(t, tm, second.ID).MarkedForGcAt)
+	})
+
+	t.Run("deleted snapshot discards the compacted build", func(t *testing.T) {
+		s := createTestSnapshot(t, tm)
+
+		first := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+		second := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+
+		_, err := tm.db.Client.Snapshot.Delete().Where(snapshot.SandboxID(s.sandboxID)).Exec(ctx)
+		require.NoError(t, err)
+
+		err = tm.db.CommitCompactedSnapshotBuild(ctx, second, uuid.New(), []uuid.UUID{first.ID, second.ID})
+		require.ErrorIs(t, err, db.SnapshotChanged{})
+	})
+}
+
+func TestTemplateManager_CompactSnapshot(t *testing.T) {
+	tm := newTestTemplateManager(t)
+	ctx := context.Background()
+
+	compactionRow := func(s testSnapshot) queries.GetSnapshotsToCompactRow {
+		return queries.GetSnapshotsToCompactRow{
+			Snapshot: queries.Snapshot{SandboxID: s.sandboxID, EnvID: s.envID},
+			TeamID:   s.teamID,
+		}
+	}
+
+	// The template manager has no clients, so the compaction of the build would fail.
+	t.Run("snapshot being paused is skipped", func(t *testing.T) {
+		s := createTestSnapshot(t, tm)
+
+		first := addTestSnapshotBuild(t, tm, s, envbuild.StatusSuccess)
+		addTestSnapshotBuild(t, tm, s, envbuild.StatusSnapshotting)
+
+		require.NoError(t, tm.compactSnapshot(ctx, compactionRow(s)))
+		assert.Nil(t, getTestBuild(t, tm, first.ID).MarkedForGcAt)
+	})
+
+	t.Run("snapshot without finished builds is skipped", func(t *testing.T) {
+		s := createTestSnapshot(t, tm)
+
+		failed := addTestSnapshotBuild(t, tm, s, envbuild.StatusFailed)
+
+		require.NoError(t, tm.compactSnapshot(ctx, compactionRow(s)))
+		assert.Nil(t, getTestBuild(t, tm, failed.ID).MarkedForGcAt)
+	})
+}
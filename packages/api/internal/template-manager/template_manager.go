@@ -217,7 +217,79 @@ func (tm *TemplateManager) DeleteBuilds(ctx context.Context, builds []DeleteBuil
 	return nil
 }
 
-func (tm *TemplateManager) CreateTemplate(t trace.Tracer, ctx context.Context, templateID string, buildID uuid.UUID, kernelVersion, firecrackerVersion, startCommand string, vCpuCount, diskSizeMB, memoryMB int64, readyCommand string, clusterID *uuid.UUID, clusterNodeID *string) error {
+// LinkBuild creates the build that references the data of the source build, so no data is copied.
+func (tm *TemplateManager) LinkBuild(ctx context.Context, templateID string, buildID uuid.UUID, sourceBuildID uuid.UUID, clusterID *uuid.UUID, clusterNodeID *string) error {
+	ctx, span := tm.tracer.Start(ctx, "link-template",
+		trace.WithAttributes(
+			telemetry.WithTemplateID(templateID),
+			telemetry.WithBuildID(buildID.String()),
+		),
+	)
+	defer span.End()
+
+	client, clientMd, _, err := tm.getBuilderClient(clusterID, clusterNodeID, false)
+	if err != nil {
+		return fmt.Errorf("failed to get builder edgeHttpClient: %w", err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, clientMd)
+	_, err = client.Template.TemplateBuildLink(
+		reqCtx, &templatemanagergrpc.TemplateBuildLinkRequest{
+			TemplateID:    templateID,
+			BuildID:       buildID.String(),
+			SourceBuildID: sourceBuildID.String(),
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to link env build '%s': %w", buildID, err)
+	}
+
+	return nil
+}
+
+// CompactBuild creates the build with the data of the fold builds from the source build chain copied into its own diffs.
+// If no fold builds are passed, the new build doesn't reference any other build.
+func (tm *TemplateManager) CompactBuild(ctx context.Context, templateID string, buildID uuid.UUID, sourceBuildID uuid.UUID, foldBuildIDs []uuid.UUID, teamID uuid.UUID, clusterID *uuid.UUID, clusterNodeID *string) error {
+	ctx, span := tm.tracer.Start(ctx, "compact-template",
+		trace.WithAttributes(
+			telemetry.WithTemplateID(templateID),
+			telemetry.WithBuildID(buildID.String()),
+		),
+	)
+	defer span.End()
+
+	client, clientMd, _, err := tm.getBuilderClient(clusterID, clusterNodeID, false)
+	if err != nil {
+		return fmt.Errorf("failed to get builder edgeHttpClient: %w", err)
+	}
+
+	folds := make([]string, len(foldBuildIDs))
+	for i, foldBuildID := range foldBuildIDs {
+		folds[i] = foldBuildID.String()
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, clientMd)
+	_, err = client.Template.TemplateBuildCompact(
+		reqCtx, &templatemanagergrpc.TemplateBuildCompactRequest{
+			TemplateID:    templateID,
+			BuildID:       buildID.String(),
+			SourceBuildID: sourceBuildID.String(),
+			FoldBuildIDs:  folds,
+			TeamID:        teamID.String(),
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to compact env build '%s': %w", sourceBuildID, err)
+	}
+
+	return nil
+}
+
+func (tm *TemplateManager) CreateTemplate(t trace.Tracer, ctx context.Context, templateID string, buildID uuid.UUID, kernelVersion, firecrackerVersion, startCommand string, vCpuCount, diskSizeMB, memoryMB int64, readyCommand string, teamID uuid.UUID, clusterID *uuid.UUID, clusterNodeID *string) error {
 	ctx, span := t.Start(ctx, "create-template",
 		trace.WithAttributes(
 			telemetry.WithTemplateID(templateID),
@@ -271,6 +343,7 @@ func (tm *TemplateManager) CreateTemplate(t trace.Tracer, ctx context.Context, t
 				HugePages:          features.HasHugePages(),
 				StartCommand:       startCommand,
 				ReadyCommand:       readyCommand,
+				TeamID:             teamID.String(),
 			},
 		},
 	)
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."checkpoints" (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    name               TEXT NOT NULL,
+    base_env_id        TEXT NOT NULL REFERENCES "public"."envs"(id) ON DELETE CASCADE,
+    env_id             TEXT NOT NULL REFERENCES "public"."envs"(id) ON DELETE CASCADE,
+    sandbox_id         TEXT NOT NULL,
+    metadata           JSONB NULL,
+    sandbox_started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    env_secure         BOOLEAN NOT NULL DEFAULT FALSE
+);
+ALTER TABLE "public"."checkpoints" ENABLE ROW LEVEL SECURITY;
+
+CREATE UNIQUE INDEX IF NOT EXISTS checkpoints_sandbox_id_name_uq
+    ON "public"."checkpoints" (sandbox_id, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS checkpoints_sandbox_id_name_uq;
+
+DROP TABLE IF EXISTS "public"."checkpoints" CASCADE;
+-- +goose StatementEnd
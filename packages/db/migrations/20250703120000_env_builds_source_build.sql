@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.env_builds
+    ADD COLUMN IF NOT EXISTS source_build_id UUID NULL;
+
+CREATE INDEX IF NOT EXISTS env_builds_source_build_id_idx
+    ON public.env_builds (source_build_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.env_builds_source_build_id_idx;
+
+ALTER TABLE public.env_builds DROP COLUMN IF EXISTS source_build_id;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.tiers
+    ADD COLUMN IF NOT EXISTS disk_bandwidth_mb bigint NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS disk_iops bigint NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS network_bandwidth_mb bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN public.tiers.disk_bandwidth_mb IS 'The rootfs disk bandwidth limit of the team sandboxes in MB/s, 0 means unlimited';
+COMMENT ON COLUMN public.tiers.disk_iops IS 'The rootfs disk IOPS limit of the team sandboxes, 0 means unlimited';
+COMMENT ON COLUMN public.tiers.network_bandwidth_mb IS 'The network bandwidth limit of the team sandboxes in MB/s for each direction, 0 means unlimited';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.tiers
+    DROP COLUMN IF EXISTS disk_bandwidth_mb,
+    DROP COLUMN IF EXISTS disk_iops,
+    DROP COLUMN IF EXISTS network_bandwidth_mb;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."volumes" (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    team_id            UUID NOT NULL REFERENCES "public"."teams"(id) ON DELETE CASCADE,
+    name               TEXT NOT NULL,
+    size_mb            BIGINT NOT NULL,
+    generation_id      UUID NULL,
+    sandbox_id         TEXT NULL,
+    next_generation_id UUID NULL
+);
+ALTER TABLE "public"."volumes" ENABLE ROW LEVEL SECURITY;
+
+CREATE UNIQUE INDEX IF NOT EXISTS volumes_team_id_name_uq
+    ON "public"."volumes" (team_id, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS volumes_team_id_name_uq;
+
+DROP TABLE IF EXISTS "public"."volumes" CASCADE;
+-- +goose StatementEnd
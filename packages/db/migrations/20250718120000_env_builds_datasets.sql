@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Mount paths of the datasets attached to the sandboxes started from the build, mapped to the builds the datasets are made from.
+ALTER TABLE public.env_builds
+    ADD COLUMN IF NOT EXISTS datasets JSONB NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.env_builds DROP COLUMN IF EXISTS datasets;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Time the build was replaced by the compacted build of its snapshot, the marked builds are candidates for the garbage collection.
+ALTER TABLE public.env_builds
+    ADD COLUMN IF NOT EXISTS marked_for_gc_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.env_builds DROP COLUMN IF EXISTS marked_for_gc_at;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Why the sandboxes ended, they are kept only for a limited time so the recently ended sandboxes can be inspected.
+CREATE TABLE IF NOT EXISTS "public"."sandbox_exits" (
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sandbox_id         TEXT NOT NULL,
+    execution_id       TEXT NOT NULL,
+    team_id            UUID NOT NULL REFERENCES "public"."teams"(id) ON DELETE CASCADE,
+    env_id             TEXT NOT NULL,
+    alias              TEXT NULL,
+    vcpu               BIGINT NOT NULL,
+    ram_mb             BIGINT NOT NULL,
+    total_disk_size_mb BIGINT NOT NULL,
+    envd_version       TEXT NULL,
+    metadata           JSONB NULL,
+    started_at         TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason             TEXT NOT NULL,
+    message            TEXT NOT NULL DEFAULT '',
+    exit_code          INTEGER NULL
+);
+ALTER TABLE "public"."sandbox_exits" ENABLE ROW LEVEL SECURITY;
+
+CREATE INDEX IF NOT EXISTS sandbox_exits_sandbox_id_ended_at_idx
+    ON "public"."sandbox_exits" (sandbox_id, ended_at DESC);
+
+CREATE INDEX IF NOT EXISTS sandbox_exits_ended_at_idx
+    ON "public"."sandbox_exits" (ended_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS sandbox_exits_ended_at_idx;
+DROP INDEX IF EXISTS sandbox_exits_sandbox_id_ended_at_idx;
+
+DROP TABLE IF EXISTS "public"."sandbox_exits" CASCADE;
+-- +goose StatementEnd
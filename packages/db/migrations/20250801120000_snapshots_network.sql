@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Egress rules of the paused sandbox, they are applied again when the sandbox is resumed.
+ALTER TABLE public.snapshots
+    ADD COLUMN IF NOT EXISTS network JSONB NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.snapshots DROP COLUMN IF EXISTS network;
+-- +goose StatementEnd
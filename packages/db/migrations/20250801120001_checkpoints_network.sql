@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Egress rules of the checkpointed sandbox, they are applied to the sandboxes restored from the checkpoint.
+ALTER TABLE public.checkpoints
+    ADD COLUMN IF NOT EXISTS network JSONB NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.checkpoints DROP COLUMN IF EXISTS network;
+-- +goose StatementEnd
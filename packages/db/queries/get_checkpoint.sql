@@ -0,0 +1,13 @@
+-- name: GetCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, sqlc.embed(c), sqlc.embed(eb)
+FROM "public"."checkpoints" c
+JOIN "public"."envs" e ON c.env_id = e.id
+JOIN "public"."env_builds" eb ON e.id = eb.env_id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = c.base_env_id
+) ea ON TRUE
+WHERE c.id = @checkpoint_id AND c.sandbox_id = @sandbox_id AND eb.status = 'success' AND e.team_id = @team_id
+ORDER BY eb.finished_at DESC
+LIMIT 1;
@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_checkpoint.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getCheckpoint = `-- name: GetCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, c.id, c.created_at, c.name, c.base_env_id, c.env_id, c.sandbox_id, c.metadata, c.sandbox_started_at, c.env_secure, c.network, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.source_build_id, eb.datasets, eb.marked_for_gc_at
+FROM "public"."checkpoints" c
+JOIN "public"."envs" e ON c.env_id = e.id
+JOIN "public"."env_builds" eb ON e.id = eb.env_id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = c.base_env_id
+) ea ON TRUE
+WHERE c.id = $1 AND c.sandbox_id = $2 AND eb.status = 'success' AND e.team_id = $3
+ORDER BY eb.finished_at DESC
+LIMIT 1
+`
+
+type GetCheckpointParams struct {
+	CheckpointID uuid.UUID
+	SandboxID    string
+	TeamID       uuid.UUID
+}
+
+type GetCheckpointRow struct {
+	Aliases    []string
+	Checkpoint Checkpoint
+	EnvBuild   EnvBuild
+}
+
+func (q *Queries) GetCheckpoint(ctx context.Context, arg GetCheckpointParams) (GetCheckpointRow, error) {
+	row := q.db.QueryRow(ctx, getCheckpoint, arg.CheckpointID, arg.SandboxID, arg.TeamID)
+	var i GetCheckpointRow
+	err := row.Scan(
+		&i.Aliases,
+		&i.Checkpoint.ID,
+		&i.Checkpoint.CreatedAt,
+		&i.Checkpoint.Name,
+		&i.Checkpoint.BaseEnvID,
+		&i.Checkpoint.EnvID,
+		&i.Checkpoint.SandboxID,
+		&i.Checkpoint.Metadata,
+		&i.Checkpoint.SandboxStartedAt,
+		&i.Checkpoint.EnvSecure,
+		&i.Checkpoint.Network,
+		&i.EnvBuild.ID,
+		&i.EnvBuild.CreatedAt,
+		&i.EnvBuild.UpdatedAt,
+		&i.EnvBuild.FinishedAt,
+		&i.EnvBuild.Status,
+		&i.EnvBuild.Dockerfile,
+		&i.EnvBuild.StartCmd,
+		&i.EnvBuild.Vcpu,
+		&i.EnvBuild.RamMb,
+		&i.EnvBuild.FreeDiskSizeMb,
+		&i.EnvBuild.TotalDiskSizeMb,
+		&i.EnvBuild.KernelVersion,
+		&i.EnvBuild.FirecrackerVersion,
+		&i.EnvBuild.EnvID,
+		&i.EnvBuild.EnvdVersion,
+		&i.EnvBuild.ReadyCmd,
+		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.SourceBuildID,
+		&i.EnvBuild.Datasets,
+		&i.EnvBuild.MarkedForGcAt,
+	)
+	return i, err
+}
@@ -0,0 +1,13 @@
+-- name: GetCheckpoints :many
+SELECT c.*
+FROM "public"."checkpoints" c
+JOIN "public"."envs" e ON c.env_id = e.id
+WHERE
+    c.sandbox_id = @sandbox_id
+    AND e.team_id = @team_id
+    AND EXISTS (
+        SELECT 1
+        FROM "public"."env_builds" eb
+        WHERE eb.env_id = c.env_id AND eb.status = 'success'
+    )
+ORDER BY c.created_at DESC;
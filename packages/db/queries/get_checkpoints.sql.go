@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_checkpoints.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getCheckpoints = `-- name: GetCheckpoints :many
+SELECT c.id, c.created_at, c.name, c.base_env_id, c.env_id, c.sandbox_id, c.metadata, c.sandbox_started_at, c.env_secure, c.network
+FROM "public"."checkpoints" c
+JOIN "public"."envs" e ON c.env_id = e.id
+WHERE
+    c.sandbox_id = $1
+    AND e.team_id = $2
+    AND EXISTS (
+        SELECT 1
+        FROM "public"."env_builds" eb
+        WHERE eb.env_id = c.env_id AND eb.status = 'success'
+    )
+ORDER BY c.created_at DESC
+`
+
+type GetCheckpointsParams struct {
+	SandboxID string
+	TeamID    uuid.UUID
+}
+
+func (q *Queries) GetCheckpoints(ctx context.Context, arg GetCheckpointsParams) ([]Checkpoint, error) {
+	rows, err := q.db.Query(ctx, getCheckpoints, arg.SandboxID, arg.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Checkpoint
+	for rows.Next() {
+		var i Checkpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Name,
+			&i.BaseEnvID,
+			&i.EnvID,
+			&i.SandboxID,
+			&i.Metadata,
+			&i.SandboxStartedAt,
+			&i.EnvSecure,
+			&i.Network,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -18,7 +18,7 @@ WITH s AS NOT MATERIALIZED (
     SELECT $1 as env_id
 )
 
-SELECT e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, aliases
+SELECT e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.source_build_id, eb.datasets, eb.marked_for_gc_at, aliases
 FROM s
 JOIN public.envs AS e ON e.id = s.env_id
 JOIN public.env_builds AS eb ON eb.env_id = e.id
@@ -70,6 +70,9 @@ func (q *Queries) GetEnvWithBuild(ctx context.Context, aliasOrEnvID string) (Get
 		&i.EnvBuild.EnvdVersion,
 		&i.EnvBuild.ReadyCmd,
 		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.SourceBuildID,
+		&i.EnvBuild.Datasets,
+		&i.EnvBuild.MarkedForGcAt,
 		&i.Aliases,
 	)
 	return i, err
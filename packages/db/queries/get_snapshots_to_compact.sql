@@ -0,0 +1,12 @@
+-- name: GetSnapshotsToCompact :many
+-- The builds replaced by the compaction are not counted, they are only kept until the garbage collection.
+SELECT sqlc.embed(s), t.id AS team_id, t.cluster_id, COUNT(eb.id)::int AS chain_length
+FROM "public"."snapshots" s
+JOIN "public"."envs" e ON s.env_id = e.id
+JOIN "public"."teams" t ON e.team_id = t.id
+JOIN "public"."env_builds" eb ON e.id = eb.env_id
+WHERE eb.status = 'success' AND eb.marked_for_gc_at IS NULL
+GROUP BY s.id, t.id
+HAVING COUNT(eb.id) >= @min_chain_length::int
+ORDER BY chain_length DESC
+LIMIT @max_snapshots::int;
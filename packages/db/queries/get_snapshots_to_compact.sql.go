@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_snapshots_to_compact.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getSnapshotsToCompact = `-- name: GetSnapshotsToCompact :many
+SELECT s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, s.network, t.id AS team_id, t.cluster_id, COUNT(eb.id)::int AS chain_length
+FROM "public"."snapshots" s
+JOIN "public"."envs" e ON s.env_id = e.id
+JOIN "public"."teams" t ON e.team_id = t.id
+JOIN "public"."env_builds" eb ON e.id = eb.env_id
+WHERE eb.status = 'success' AND eb.marked_for_gc_at IS NULL
+GROUP BY s.id, t.id
+HAVING COUNT(eb.id) >= $1::int
+ORDER BY chain_length DESC
+LIMIT $2::int
+`
+
+type GetSnapshotsToCompactParams struct {
+	MinChainLength int32
+	MaxSnapshots   int32
+}
+
+type GetSnapshotsToCompactRow struct {
+	Snapshot    Snapshot
+	TeamID      uuid.UUID
+	ClusterID   *uuid.UUID
+	ChainLength int32
+}
+
+// The builds replaced by the compaction are not counted, they are only kept until the garbage collection.
+func (q *Queries) GetSnapshotsToCompact(ctx context.Context, arg GetSnapshotsToCompactParams) ([]GetSnapshotsToCompactRow, error) {
+	rows, err := q.db.Query(ctx, getSnapshotsToCompact, arg.MinChainLength, arg.MaxSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSnapshotsToCompactRow
+	for rows.Next() {
+		var i GetSnapshotsToCompactRow
+		if err := rows.Scan(
+			&i.Snapshot.CreatedAt,
+			&i.Snapshot.EnvID,
+			&i.Snapshot.SandboxID,
+			&i.Snapshot.ID,
+			&i.Snapshot.Metadata,
+			&i.Snapshot.BaseEnvID,
+			&i.Snapshot.SandboxStartedAt,
+			&i.Snapshot.EnvSecure,
+			&i.Snapshot.Network,
+			&i.TeamID,
+			&i.ClusterID,
+			&i.ChainLength,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/db/types"
+	sharedtypes "github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -27,6 +28,19 @@ type AccessToken struct {
 	AccessTokenMaskSuffix *string
 }
 
+type Checkpoint struct {
+	ID               uuid.UUID
+	CreatedAt        pgtype.Timestamptz
+	Name             string
+	BaseEnvID        string
+	EnvID            string
+	SandboxID        string
+	Metadata         types.JSONBStringMap
+	SandboxStartedAt pgtype.Timestamptz
+	EnvSecure        bool
+	Network          *sharedtypes.SandboxNetworkConfig
+}
+
 type Cluster struct {
 	ID          uuid.UUID
 	Endpoint    string
@@ -73,6 +87,28 @@ type EnvBuild struct {
 	EnvdVersion        *string
 	ReadyCmd           *string
 	ClusterNodeID      *string
+	SourceBuildID      *uuid.UUID
+	Datasets           types.JSONBStringMap
+	MarkedForGcAt      *time.Time
+}
+
+type SandboxExit struct {
+	ID              uuid.UUID
+	SandboxID       string
+	ExecutionID     string
+	TeamID          uuid.UUID
+	EnvID           string
+	Alias           *string
+	Vcpu            int64
+	RamMb           int64
+	TotalDiskSizeMb int64
+	EnvdVersion     *string
+	Metadata        types.JSONBStringMap
+	StartedAt       pgtype.Timestamptz
+	EndedAt         pgtype.Timestamptz
+	Reason          string
+	Message         string
+	ExitCode        *int32
 }
 
 type Snapshot struct {
@@ -84,6 +120,7 @@ type Snapshot struct {
 	BaseEnvID        string
 	SandboxStartedAt pgtype.Timestamptz
 	EnvSecure        bool
+	Network          *sharedtypes.SandboxNetworkConfig
 }
 
 type Team struct {
@@ -125,6 +162,12 @@ type Tier struct {
 	MaxLengthHours      int64
 	MaxVcpu             int64
 	MaxRamMb            int64
+	// The rootfs disk bandwidth limit of the team sandboxes in MB/s, 0 means unlimited
+	DiskBandwidthMb int64
+	// The rootfs disk IOPS limit of the team sandboxes, 0 means unlimited
+	DiskIops int64
+	// The network bandwidth limit of the team sandboxes in MB/s for each direction, 0 means unlimited
+	NetworkBandwidthMb int64
 }
 
 type UsersTeam struct {
@@ -135,3 +178,14 @@ type UsersTeam struct {
 	AddedBy   *uuid.UUID
 	CreatedAt pgtype.Timestamp
 }
+
+type Volume struct {
+	ID               uuid.UUID
+	CreatedAt        pgtype.Timestamptz
+	TeamID           uuid.UUID
+	Name             string
+	SizeMb           int64
+	GenerationID     *uuid.UUID
+	SandboxID        *string
+	NextGenerationID *uuid.UUID
+}
@@ -0,0 +1,19 @@
+-- name: InsertSandboxExit :exec
+INSERT INTO "public"."sandbox_exits" (
+    sandbox_id, execution_id, team_id, env_id, alias, vcpu, ram_mb, total_disk_size_mb,
+    envd_version, metadata, started_at, ended_at, reason, message, exit_code
+) VALUES (
+    @sandbox_id, @execution_id, @team_id, @env_id, @alias, @vcpu, @ram_mb, @total_disk_size_mb,
+    @envd_version, @metadata, @started_at, @ended_at, @reason, @message, @exit_code
+);
+
+-- name: GetLastSandboxExit :one
+SELECT *
+FROM "public"."sandbox_exits"
+WHERE sandbox_id = @sandbox_id AND team_id = @team_id AND ended_at > @ended_after
+ORDER BY ended_at DESC
+LIMIT 1;
+
+-- name: DeleteSandboxExitsBefore :execrows
+DELETE FROM "public"."sandbox_exits"
+WHERE ended_at < @ended_before;
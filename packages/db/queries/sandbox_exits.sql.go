@@ -0,0 +1,114 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: sandbox_exits.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteSandboxExitsBefore = `-- name: DeleteSandboxExitsBefore :execrows
+DELETE FROM "public"."sandbox_exits"
+WHERE ended_at < $1
+`
+
+func (q *Queries) DeleteSandboxExitsBefore(ctx context.Context, endedBefore pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSandboxExitsBefore, endedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLastSandboxExit = `-- name: GetLastSandboxExit :one
+SELECT id, sandbox_id, execution_id, team_id, env_id, alias, vcpu, ram_mb, total_disk_size_mb, envd_version, metadata, started_at, ended_at, reason, message, exit_code
+FROM "public"."sandbox_exits"
+WHERE sandbox_id = $1 AND team_id = $2 AND ended_at > $3
+ORDER BY ended_at DESC
+LIMIT 1
+`
+
+type GetLastSandboxExitParams struct {
+	SandboxID  string
+	TeamID     uuid.UUID
+	EndedAfter pgtype.Timestamptz
+}
+
+func (q *Queries) GetLastSandboxExit(ctx context.Context, arg GetLastSandboxExitParams) (SandboxExit, error) {
+	row := q.db.QueryRow(ctx, getLastSandboxExit, arg.SandboxID, arg.TeamID, arg.EndedAfter)
+	var i SandboxExit
+	err := row.Scan(
+		&i.ID,
+		&i.SandboxID,
+		&i.ExecutionID,
+		&i.TeamID,
+		&i.EnvID,
+		&i.Alias,
+		&i.Vcpu,
+		&i.RamMb,
+		&i.TotalDiskSizeMb,
+		&i.EnvdVersion,
+		&i.Metadata,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Reason,
+		&i.Message,
+		&i.ExitCode,
+	)
+	return i, err
+}
+
+const insertSandboxExit = `-- name: InsertSandboxExit :exec
+INSERT INTO "public"."sandbox_exits" (
+    sandbox_id, execution_id, team_id, env_id, alias, vcpu, ram_mb, total_disk_size_mb,
+    envd_version, metadata, started_at, ended_at, reason, message, exit_code
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8,
+    $9, $10, $11, $12, $13, $14, $15
+)
+`
+
+type InsertSandboxExitParams struct {
+	SandboxID       string
+	ExecutionID     string
+	TeamID          uuid.UUID
+	EnvID           string
+	Alias           *string
+	Vcpu            int64
+	RamMb           int64
+	TotalDiskSizeMb int64
+	EnvdVersion     *string
+	Metadata        types.JSONBStringMap
+	StartedAt       pgtype.Timestamptz
+	EndedAt         pgtype.Timestamptz
+	Reason          string
+	Message         string
+	ExitCode        *int32
+}
+
+func (q *Queries) InsertSandboxExit(ctx context.Context, arg InsertSandboxExitParams) error {
+	_, err := q.db.Exec(ctx, insertSandboxExit,
+		arg.SandboxID,
+		arg.ExecutionID,
+		arg.TeamID,
+		arg.EnvID,
+		arg.Alias,
+		arg.Vcpu,
+		arg.RamMb,
+		arg.TotalDiskSizeMb,
+		arg.EnvdVersion,
+		arg.Metadata,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.Reason,
+		arg.Message,
+		arg.ExitCode,
+	)
+	return err
+}
@@ -1,7 +1,11 @@
 package host
 
 import (
+	"fmt"
 	"math"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shirou/gopsutil/v4/cpu"
@@ -14,6 +18,7 @@ type Metrics struct {
 	CPUUsedPercent float32 `json:"cpu_used_pct"`  // Percent rounded to 2 decimal places
 	MemTotalMiB    uint64  `json:"mem_total_mib"` // Total virtual memory in MiB
 	MemUsedMiB     uint64  `json:"mem_used_mib"`  // Used virtual memory in MiB
+	MemOOMKills    uint64  `json:"mem_oom_kills"` // Processes killed by the OOM killer since the boot
 }
 
 func GetMetrics() (*Metrics, error) {
@@ -41,11 +46,41 @@ func GetMetrics() (*Metrics, error) {
 		cpuUsedPctRounded = float32(math.Round(cpuUsedPct*100) / 100)
 	}
 
+	oomKills, err := getOOMKills()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Metrics{
 		Timestamp:      time.Now().UTC().Unix(),
 		CPUCount:       uint32(cpuTotal),
 		CPUUsedPercent: cpuUsedPctRounded,
 		MemUsedMiB:     memUsedMiB,
 		MemTotalMiB:    memTotalMiB,
+		MemOOMKills:    oomKills,
 	}, nil
 }
+
+// getOOMKills reads the OOM kill counter from the vmstat, the kernels older than 4.13 don't have it.
+func getOOMKills() (uint64, error) {
+	data, err := os.ReadFile("/proc/vmstat")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read vmstat: %w", err)
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		name, value, ok := strings.Cut(line, " ")
+		if !ok || name != "oom_kill" {
+			continue
+		}
+
+		kills, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse oom_kill: %w", err)
+		}
+
+		return kills, nil
+	}
+
+	return 0, nil
+}
@@ -31,7 +31,7 @@ const (
 )
 
 var (
-	Version = "0.2.0"
+	Version = "0.2.1"
 
 	commitSHA string
 
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
@@ -15,6 +16,7 @@ func main() {
 	kind := flag.String("kind", "", "'memfile' or 'rootfs'")
 	start := flag.Int64("start", 0, "start block")
 	end := flag.Int64("end", 0, "end block")
+	verify := flag.Bool("verify", false, "verify the whole build data against the checksums in the header")
 
 	flag.Parse()
 
@@ -26,14 +28,17 @@ func main() {
 	)
 
 	var storagePath string
+	var headerPath string
 	var blockSize int64
 
 	switch *kind {
 	case "memfile":
 		storagePath = template.StorageMemfilePath()
+		headerPath = template.StorageMemfileHeaderPath()
 		blockSize = 2097152
 	case "rootfs":
 		storagePath = template.StorageRootfsPath()
+		headerPath = template.StorageRootfsHeaderPath()
 		blockSize = 4096
 	default:
 		log.Fatalf("invalid kind: %s", *kind)
@@ -50,6 +55,23 @@ func main() {
 		log.Fatalf("failed to get storage provider: %s", err)
 	}
 
+	if *verify {
+		corrupted, err := verifyBuild(ctx, storage, storagePath, headerPath)
+		if err != nil {
+			log.Fatalf("failed to verify build: %s", err)
+		}
+
+		fmt.Printf("\nSUMMARY\n")
+		fmt.Printf("=======\n")
+		fmt.Printf("Corrupted ranges: %d\n", corrupted)
+
+		if corrupted > 0 {
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	obj, err := storage.OpenObject(ctx, storagePath)
 	if err != nil {
 		log.Fatalf("failed to open object: %s", err)
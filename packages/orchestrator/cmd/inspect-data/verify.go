@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// verifyBuild reads the whole data of the build and prints the ranges that don't match the checksums in the header.
+// It returns the number of the corrupted ranges.
+func verifyBuild(ctx context.Context, persistence storage.StorageProvider, storagePath, headerPath string) (int, error) {
+	headerObj, err := persistence.OpenObject(ctx, headerPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open header: %w", err)
+	}
+
+	h, err := header.Deserialize(headerObj)
+	if err != nil {
+		return 0, fmt.Errorf("failed to deserialize header: %w", err)
+	}
+
+	fmt.Printf("\nVERIFY\n")
+	fmt.Printf("======\n")
+	fmt.Printf("Header             %s/%s (version %d)\n", persistence.GetDetails(), headerPath, h.Metadata.Version)
+
+	chunks := make(map[header.ChunkHash]*header.BuildMap)
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == h.Metadata.BuildId && !mapping.Hash.IsZero() {
+			chunks[mapping.Hash] = mapping
+		}
+	}
+
+	if len(chunks) > 0 {
+		return verifyContentChunks(ctx, persistence, h, chunks)
+	}
+
+	if h.Checksums == nil {
+		return 0, fmt.Errorf("header has no checksums, the build was uploaded before the checksums were added")
+	}
+
+	obj, err := persistence.OpenObject(ctx, storagePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open diff: %w", err)
+	}
+
+	size, err := obj.Size()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get diff size: %w", err)
+	}
+
+	var diff io.ReaderAt = obj
+
+	if h.Compression != nil {
+		aead, err := dataKey(ctx, persistence, h.Metadata.KeyId)
+		if err != nil {
+			return 0, err
+		}
+
+		diff = header.NewFrameReader(h.Compression, aead, header.FileScope(h.Metadata.BuildId.String(), path.Base(storagePath)), obj)
+		size = int64(h.Compression.Size)
+	}
+
+	fmt.Printf("Diff               %s/%s (%d B, %d checksums)\n", persistence.GetDetails(), storagePath, size, len(h.Checksums))
+
+	corrupted, err := header.VerifyDiff(h.Checksums, size, diff)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, c := range corrupted {
+		fmt.Printf("CORRUPTED diff [%11d,%11d)\n", c.Offset, c.Offset+c.Length)
+
+		for _, mapping := range h.Mapping {
+			start := max(int64(mapping.BuildStorageOffset), c.Offset)
+			end := min(int64(mapping.BuildStorageOffset+mapping.Length), c.Offset+c.Length)
+
+			if mapping.BuildId != h.Metadata.BuildId || start >= end {
+				continue
+			}
+
+			deviceStart := int64(mapping.Offset) + start - int64(mapping.BuildStorageOffset)
+			fmt.Printf("          device [%11d,%11d)\n", deviceStart, deviceStart+end-start)
+		}
+	}
+
+	return len(corrupted), nil
+}
+
+func verifyContentChunks(ctx context.Context, persistence storage.StorageProvider, h *header.Header, chunks map[header.ChunkHash]*header.BuildMap) (int, error) {
+	fmt.Printf("Content chunks     %d\n", len(chunks))
+
+	corrupted := 0
+	b := make([]byte, header.ContentChunkSize)
+
+	for hash, mapping := range chunks {
+		path := storage.StorageContentChunkPath(mapping.ChunkName())
+
+		obj, err := persistence.OpenObject(ctx, path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open chunk %s: %w", path, err)
+		}
+
+		var chunk io.ReaderAt = obj
+
+		if mapping.Compression != header.CompressionNone || mapping.KeyId != uuid.Nil {
+			size, err := obj.Size()
+			if err != nil {
+				return 0, fmt.Errorf("failed to get chunk %s size: %w", path, err)
+			}
+
+			aead, err := dataKey(ctx, persistence, mapping.KeyId)
+			if err != nil {
+				return 0, err
+			}
+
+			chunk = header.NewFrameReader(&header.CompressionIndex{
+				Type:    mapping.Compression,
+				Size:    header.ContentChunkSize,
+				Offsets: []uint64{0, uint64(size)},
+			}, aead, header.ChunkScope(hash), obj)
+		}
+
+		_, err = header.NewContentChunkReader(hash, chunk).ReadAt(b, 0)
+
+		var mismatch *header.ChecksumMismatchError
+		if errors.As(err, &mismatch) {
+			corrupted++
+
+			fmt.Printf("CORRUPTED chunk %s\n", path)
+
+			for _, m := range h.Mapping {
+				if m.Hash == hash {
+					fmt.Printf("          device [%11d,%11d)\n", m.Offset, m.Offset+m.Length)
+				}
+			}
+
+			continue
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("failed to read chunk %s: %w", path, err)
+		}
+	}
+
+	return corrupted, nil
+}
+
+func dataKey(ctx context.Context, persistence storage.StorageProvider, keyID uuid.UUID) (cipher.AEAD, error) {
+	if keyID == uuid.Nil {
+		return nil, nil
+	}
+
+	keys, err := storage.GetDataKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	if keys == nil {
+		return nil, fmt.Errorf("data are encrypted with data key %s, but no KMS is configured", keyID)
+	}
+
+	return keys.Key(ctx, persistence, keyID)
+}
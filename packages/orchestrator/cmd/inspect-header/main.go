@@ -1,11 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"fmt"
 	"log"
-	"unsafe"
+
+	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -60,9 +62,27 @@ func main() {
 	fmt.Printf("Base build ID      %s\n", h.Metadata.BaseBuildId)
 	fmt.Printf("Size               %d B (%d MiB)\n", h.Metadata.Size, h.Metadata.Size/1024/1024)
 	fmt.Printf("Block size         %d B\n", h.Metadata.BlockSize)
+	if h.Checksums != nil {
+		fmt.Printf("Checksums          %d (by %d B)\n", len(h.Checksums), header.ChecksumChunkSize)
+	}
+	if h.Metadata.KeyId != uuid.Nil {
+		fmt.Printf("Data key ID        %s\n", h.Metadata.KeyId)
+	}
 	fmt.Printf("Blocks             %d\n", (h.Metadata.Size+h.Metadata.BlockSize-1)/h.Metadata.BlockSize)
 
-	totalSize := int64(unsafe.Sizeof(header.BuildMap{})) * int64(len(h.Mapping)) / 1024
+	if h.Compression != nil {
+		compressedSize := h.Compression.Offsets[len(h.Compression.Offsets)-1]
+
+		fmt.Printf("Compression        %s, %d frames\n", h.Compression.Type, len(h.Compression.Offsets)-1)
+		fmt.Printf("Compressed size    %d B (%d MiB, %0.2f%% of %d MiB)\n", compressedSize, compressedSize/1024/1024, float64(compressedSize)/float64(max(h.Compression.Size, 1))*100, h.Compression.Size/1024/1024)
+	}
+
+	serialized, err := header.SerializeHeader(h)
+	if err != nil {
+		log.Fatalf("failed to serialize header: %s", err)
+	}
+
+	totalSize := int64(serialized.(*bytes.Buffer).Len()) / 1024
 	var sizeMessage string
 
 	if totalSize == 0 {
@@ -82,9 +102,16 @@ func main() {
 	fmt.Printf("===============\n")
 
 	builds := make(map[string]int64)
+	chunks := make(map[header.ChunkHash]struct{})
+	var chunksSize int64
 
 	for _, mapping := range h.Mapping {
 		builds[mapping.BuildId.String()] += int64(mapping.Length)
+
+		if !mapping.Hash.IsZero() {
+			chunks[mapping.Hash] = struct{}{}
+			chunksSize += int64(mapping.Length)
+		}
 	}
 
 	for build, size := range builds {
@@ -98,4 +125,11 @@ func main() {
 
 		fmt.Printf("%s%s: %d blocks, %d MiB (%0.2f%%)\n", build, additionalInfo, uint64(size)/h.Metadata.BlockSize, uint64(size)/1024/1024, float64(size)/float64(h.Metadata.Size)*100)
 	}
+
+	if len(chunks) > 0 {
+		fmt.Printf("\nCONTENT-ADDRESSED CHUNKS\n")
+		fmt.Printf("========================\n")
+		fmt.Printf("Chunks             %d\n", len(chunks))
+		fmt.Printf("Data in chunks     %d blocks, %d MiB (%0.2f%%)\n", uint64(chunksSize)/h.Metadata.BlockSize, chunksSize/1024/1024, float64(chunksSize)/float64(h.Metadata.Size)*100)
+	}
 }
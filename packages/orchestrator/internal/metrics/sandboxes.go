@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,7 +18,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -31,6 +34,8 @@ const (
 	metricsParallelismFactor = 5 // Used to calculate number of concurrently sandbox metrics requests
 
 	shiftFromMiBToBytes = 20 // Shift to convert MiB to bytes
+
+	timeoutInsertClickhouseMetrics = 10 * time.Second
 )
 
 type (
@@ -44,14 +49,25 @@ type SandboxObserver struct {
 
 	sandboxes *smap.Map[*sandbox.Sandbox]
 
+	// clickhouseStore is optional, when set the sampled metrics are also written to the ClickHouse metrics table.
+	clickhouseStore chdb.Store
+
 	meter       metric.Meter
 	cpuTotal    metric.Int64ObservableGauge
 	cpuUsed     metric.Float64ObservableGauge
 	memoryTotal metric.Int64ObservableGauge
 	memoryUsed  metric.Int64ObservableGauge
+
+	networkEgressBytes    metric.Int64ObservableGauge
+	networkEgressPackets  metric.Int64ObservableGauge
+	networkIngressBytes   metric.Int64ObservableGauge
+	networkIngressPackets metric.Int64ObservableGauge
+
+	memoryBalloon metric.Int64ObservableGauge
+	memoryFree    metric.Int64ObservableGauge
 }
 
-func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandboxMetricsExportPeriod time.Duration, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxObserver, error) {
+func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandboxMetricsExportPeriod time.Duration, sandboxes *smap.Map[*sandbox.Sandbox], clickhouseStore chdb.Store) (*SandboxObserver, error) {
 	deltaTemporality := otlpmetricgrpc.WithTemporalitySelector(func(kind sdkmetric.InstrumentKind) metricdata.Temporality {
 		// Use delta temporality for gauges and cumulative for all other instrument kinds.
 		// This is used to prevent reporting sandbox metrics indefinitely.
@@ -92,15 +108,52 @@ func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandbox
 		return nil, fmt.Errorf("failed to create memory used gauge: %w", err)
 	}
 
+	networkEgressBytes, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkEgressBytesGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network egress bytes gauge: %w", err)
+	}
+
+	networkEgressPackets, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkEgressPacketsGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network egress packets gauge: %w", err)
+	}
+
+	networkIngressBytes, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkIngressBytesGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network ingress bytes gauge: %w", err)
+	}
+
+	networkIngressPackets, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkIngressPacketsGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network ingress packets gauge: %w", err)
+	}
+
+	memoryBalloon, err := telemetry.GetGaugeInt(meter, telemetry.SandboxRamBalloonGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create memory balloon gauge: %w", err)
+	}
+
+	memoryFree, err := telemetry.GetGaugeInt(meter, telemetry.SandboxRamFreeGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create memory free gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
-		exportInterval: sandboxMetricsExportPeriod,
-		meterExporter:  externalMeterExporter,
-		sandboxes:      sandboxes,
-		meter:          meter,
-		cpuTotal:       cpuTotal,
-		cpuUsed:        cpuUsed,
-		memoryTotal:    memoryTotal,
-		memoryUsed:     memoryUsed,
+		exportInterval:        sandboxMetricsExportPeriod,
+		meterExporter:         externalMeterExporter,
+		sandboxes:             sandboxes,
+		clickhouseStore:       clickhouseStore,
+		meter:                 meter,
+		cpuTotal:              cpuTotal,
+		cpuUsed:               cpuUsed,
+		memoryTotal:           memoryTotal,
+		memoryUsed:            memoryUsed,
+		networkEgressBytes:    networkEgressBytes,
+		networkEgressPackets:  networkEgressPackets,
+		networkIngressBytes:   networkIngressBytes,
+		networkIngressPackets: networkIngressPackets,
+		memoryBalloon:         memoryBalloon,
+		memoryFree:            memoryFree,
 	}
 
 	registration, err := so.startObserving()
@@ -124,16 +177,64 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 			limit := math.Ceil(float64(sbxCount) / metricsParallelismFactor)
 			wg.SetLimit(int(limit))
 
-			for _, sbx := range so.sandboxes.Items() {
-				if !utils.IsGTEVersion(sbx.Config.EnvdVersion, minEnvdVersionForMetrics) {
-					continue
-				}
+			var rowsMu sync.Mutex
+			rows := make([]chmodels.Metrics, 0, sbxCount)
 
+			for _, sbx := range so.sandboxes.Items() {
 				if !sbx.Checks.UseClickhouseMetrics {
 					continue
 				}
 
 				wg.Go(func() error {
+					attributes := metric.WithAttributes(attribute.String("sandbox_id", sbx.Config.SandboxId), attribute.String("team_id", sbx.Config.TeamId))
+					row := chmodels.Metrics{
+						Timestamp: time.Now().UTC(),
+						SandboxID: sbx.Config.SandboxId,
+						TeamID:    sbx.Config.TeamId,
+					}
+
+					// The network counters don't depend on envd, they are read from the slot firewall
+					counters, err := sbx.Checks.GetNetworkCounters()
+					if err != nil {
+						// Sandbox has stopped
+						if errors.Is(err, sandbox.ErrChecksStopped) {
+							return nil
+						}
+
+						return err
+					}
+
+					o.ObserveInt64(so.networkEgressBytes, int64(counters.EgressBytes), attributes)
+					o.ObserveInt64(so.networkEgressPackets, int64(counters.EgressPackets), attributes)
+					o.ObserveInt64(so.networkIngressBytes, int64(counters.IngressBytes), attributes)
+					o.ObserveInt64(so.networkIngressPackets, int64(counters.IngressPackets), attributes)
+
+					row.NetEgressBytes = counters.EgressBytes
+					row.NetEgressPackets = counters.EgressPackets
+					row.NetIngressBytes = counters.IngressBytes
+					row.NetIngressPackets = counters.IngressPackets
+
+					// The balloon stats are reported by the guest balloon driver, they don't depend on envd either
+					balloonStats, err := sbx.Checks.GetBalloonStats()
+					switch {
+					case errors.Is(err, sandbox.ErrChecksStopped):
+						// Sandbox has stopped
+						return nil
+					case errors.Is(err, sandbox.ErrBalloonNotSupported):
+					case err != nil:
+						return err
+					default:
+						o.ObserveInt64(so.memoryBalloon, balloonStats.ActualMiB<<shiftFromMiBToBytes, attributes)
+						o.ObserveInt64(so.memoryFree, balloonStats.FreeMemory, attributes)
+
+						row.MemBalloonMiB = uint64(balloonStats.ActualMiB)
+						row.MemFreeMiB = uint64(balloonStats.FreeMemory >> shiftFromMiBToBytes)
+					}
+
+					if !utils.IsGTEVersion(sbx.Config.EnvdVersion, minEnvdVersionForMetrics) {
+						return nil
+					}
+
 					// Make sure the sandbox doesn't change while we are getting metrics (the slot could be assigned to another sandbox)
 					sbxMetrics, err := sbx.Checks.GetMetrics(timeoutGetMetrics)
 					if err != nil {
@@ -145,7 +246,16 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						return err
 					}
 
-					attributes := metric.WithAttributes(attribute.String("sandbox_id", sbx.Config.SandboxId), attribute.String("team_id", sbx.Config.TeamId))
+					row.CPUCount = uint32(sbxMetrics.CPUCount)
+					row.CPUUsedPercent = float32(sbxMetrics.CPUUsedPercent)
+					row.MemTotalMiB = uint64(sbxMetrics.MemTotalMiB)
+					row.MemUsedMiB = uint64(sbxMetrics.MemUsedMiB)
+
+					// The row is stored only with the guest metrics, so the stopped sandboxes and the failed reads don't export zero usage
+					rowsMu.Lock()
+					rows = append(rows, row)
+					rowsMu.Unlock()
+
 					o.ObserveInt64(so.cpuTotal, sbxMetrics.CPUCount, attributes)
 					o.ObserveFloat64(so.cpuUsed, sbxMetrics.CPUUsedPercent, attributes)
 					// Save as bytes for the future, so we can return more accurate values
@@ -178,8 +288,17 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 				zap.L().Warn("error during observing sandbox metrics", zap.Error(err))
 			}
 
+			if so.clickhouseStore != nil && len(rows) > 0 {
+				// Don't block the export of the other metrics
+				go so.insertClickhouseMetrics(rows)
+			}
+
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed)
+		},
+		so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed,
+		so.networkEgressBytes, so.networkEgressPackets, so.networkIngressBytes, so.networkIngressPackets,
+		so.memoryBalloon, so.memoryFree,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +306,16 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 	return unregister, nil
 }
 
+func (so *SandboxObserver) insertClickhouseMetrics(rows []chmodels.Metrics) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutInsertClickhouseMetrics)
+	defer cancel()
+
+	err := so.clickhouseStore.InsertMetricsBatch(ctx, rows)
+	if err != nil {
+		zap.L().Warn("error inserting sandbox metrics to ClickHouse", zap.Int("count", len(rows)), zap.Error(err))
+	}
+}
+
 func (so *SandboxObserver) Close(ctx context.Context) error {
 	if so.meterExporter == nil {
 		return nil
@@ -0,0 +1,302 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"syscall"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// AdoptSandbox takes over the sandbox started by the previous orchestrator process, the sandbox keeps running.
+// The resources that can't be taken over are released by the cleanup, the sandbox is then stopped.
+// IMPORTANT: You have to run cleanup functions even if there is any error, or after you are done with the adopted sandbox.
+func AdoptSandbox(
+	ctx context.Context,
+	tracer trace.Tracer,
+	networkPool *network.Pool,
+	templateCache *template.Cache,
+	devicePool *nbd.DevicePool,
+	state *State,
+	allowInternet,
+	useClickhouseMetrics bool,
+) (*Sandbox, *Cleanup, error) {
+	childCtx, childSpan := tracer.Start(ctx, "adopt-sandbox", trace.WithAttributes(
+		telemetry.WithSandboxID(state.Config.SandboxId),
+		attribute.Int("sandbox.pid", state.Pid),
+	))
+	defer childSpan.End()
+
+	config := state.Config
+	cleanup := NewCleanup()
+
+	// The state is removed last, so the resources are released again if the orchestrator restarts during the cleanup.
+	cleanup.Add(func(ctx context.Context) error {
+		return state.Remove()
+	})
+
+	sandboxFiles := storage.NewTemplateFiles(
+		config.TemplateId,
+		config.BuildId,
+		config.KernelVersion,
+		config.FirecrackerVersion,
+	).OpenSandboxFiles(config.SandboxId, state.FilesID)
+	cleanup.Add(func(ctx context.Context) error {
+		filesErr := cleanupFiles(sandboxFiles)
+		if filesErr != nil {
+			return fmt.Errorf("failed to cleanup files: %w", filesErr)
+		}
+
+		return nil
+	})
+
+	// Until the rootfs provider takes over the device, the device and the cache are released directly.
+	var rootfsOverlay *rootfs.NBDProvider
+	cleanup.Add(func(ctx context.Context) error {
+		if rootfsOverlay != nil {
+			childCtx, span := tracer.Start(ctx, "rootfs-overlay-close")
+			defer span.End()
+
+			if rootfsOverlayErr := rootfsOverlay.Close(childCtx); rootfsOverlayErr != nil {
+				return fmt.Errorf("failed to close overlay file: %w", rootfsOverlayErr)
+			}
+
+			return nil
+		}
+
+		var errs []error
+
+		disconnectErr := nbd.DisconnectDevice(ctx, devicePool, state.RootfsDevice)
+		if disconnectErr != nil {
+			errs = append(errs, fmt.Errorf("failed to disconnect rootfs device: %w", disconnectErr))
+		}
+
+		removeErr := os.Remove(sandboxFiles.SandboxCacheRootfsPath())
+		if removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("failed to remove rootfs cache: %w", removeErr))
+		}
+
+		return errors.Join(errs...)
+	})
+
+	// Until the sandbox close is registered, the process is killed directly. The FC has to stop before its devices are released.
+	var fcHandle *fc.Process
+	closeRegistered := false
+	cleanup.AddPriority(func(ctx context.Context) error {
+		if closeRegistered {
+			return nil
+		}
+
+		if fcHandle != nil {
+			return fcHandle.Stop()
+		}
+
+		// The process could have exited and its pid could be reused.
+		if verifyErr := fc.VerifyProcess(state.Pid, sandboxFiles.SandboxFirecrackerSocketPath()); verifyErr != nil {
+			return nil
+		}
+
+		killErr := syscall.Kill(state.Pid, syscall.SIGKILL)
+		if killErr != nil && !errors.Is(killErr, syscall.ESRCH) {
+			return fmt.Errorf("failed to kill fc process: %w", killErr)
+		}
+
+		return nil
+	})
+
+	if len(config.GetVolumes()) > 0 || len(config.GetDatasets()) > 0 {
+		return nil, cleanup, fmt.Errorf("sandbox with volumes or datasets can't be adopted")
+	}
+
+	slot, err := networkPool.Adopt(state.SlotKey, state.SlotIdx)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to adopt network slot: %w", err)
+	}
+
+	keepSlot := &atomic.Bool{}
+	ips := <-getNetworkSlotAsync(childCtx, tracer, networkPool, slot, keepSlot, cleanup, allowInternet)
+	if ips.err != nil {
+		return nil, cleanup, fmt.Errorf("failed to register network slot: %w", ips.err)
+	}
+
+	// The egress rules are set on the new firewall before the previous one is removed, so the sandbox is never unrestricted.
+	if !allowInternet {
+		err = slot.ConfigureInternet(childCtx, tracer, false)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to configure sandbox internet access: %w", err)
+		}
+	}
+
+	if config.Network != nil {
+		allowed, denied := egressRules(allowInternet, config.Network)
+
+		err = slot.ConfigureEgress(childCtx, tracer, allowed, denied, config.Network.GetAllowedDomains(), sbxlogger.E(sbxlogger.SandboxMetadata{
+			SandboxID:  config.SandboxId,
+			TemplateID: config.TemplateId,
+			TeamID:     config.TeamId,
+		}).Logger)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to configure sandbox egress: %w", err)
+		}
+	}
+
+	err = slot.RemoveStaleFirewall()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to remove stale firewall: %w", err)
+	}
+
+	t, err := getTemplate(templateCache, config, state.BuildPeers, state.MigrationSource)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
+	}
+
+	readonlyRootfs, err := t.Rootfs()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get rootfs: %w", err)
+	}
+
+	rootfsOverlay, err = rootfs.AdoptNBDProvider(
+		tracer,
+		readonlyRootfs,
+		sandboxFiles.SandboxCacheRootfsPath(),
+		devicePool,
+		state.RootfsDevice,
+	)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to adopt rootfs overlay: %w", err)
+	}
+
+	rootfsPath, err := rootfsOverlay.Path()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get rootfs path: %w", err)
+	}
+
+	process, err := fc.AdoptProcess(childCtx, slot, sandboxFiles, rootfsPath, config.TemplateId, config.TeamId, state.Pid)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to adopt FC: %w", err)
+	}
+	fcHandle = process
+
+	memfile, err := t.Memfile()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get memfile: %w", err)
+	}
+
+	uffdFd, err := fcHandle.Userfaultfd()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get FC userfaultfd: %w", err)
+	}
+
+	fcUffd, err := uffd.Adopt(memfile, memfile.BlockSize(), &uffd.UffdSetup{
+		Mappings: state.MemoryMappings,
+		Fd:       uintptr(uffdFd),
+	})
+	if err != nil {
+		return nil, cleanup, errors.Join(fmt.Errorf("failed to adopt uffd: %w", err), syscall.Close(uffdFd))
+	}
+
+	err = fcUffd.Start(config.SandboxId)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to start uffd: %w", err)
+	}
+
+	cleanup.Add(func(ctx context.Context) error {
+		_, span := tracer.Start(ctx, "uffd-stop")
+		defer span.End()
+
+		stopErr := fcUffd.Stop()
+		if stopErr != nil {
+			return fmt.Errorf("failed to stop uffd: %w", stopErr)
+		}
+
+		return nil
+	})
+
+	uffdExit := make(chan error, 1)
+	go func() {
+		uffdExit <- <-fcUffd.Exit()
+	}()
+
+	telemetry.ReportEvent(childCtx, "adopted FC")
+
+	resources := &Resources{
+		Slot:     slot,
+		rootfs:   rootfsOverlay,
+		memory:   fcUffd,
+		uffdExit: uffdExit,
+		keepSlot: keepSlot,
+	}
+
+	metadata := &Metadata{
+		Config: config,
+
+		StartedAt: state.StartedAt,
+		EndAt:     state.EndAt,
+	}
+
+	sbx := &Sandbox{
+		Resources: resources,
+		Metadata:  metadata,
+
+		template:   t,
+		files:      sandboxFiles,
+		process:    fcHandle,
+		balloon:    newMemoryBalloon(childCtx, fcHandle, config.RamMb),
+		buildPeers: state.BuildPeers,
+
+		migrationSource: state.MigrationSource,
+
+		cleanup: cleanup,
+	}
+
+	checks, err := NewChecks(ctx, tracer, sbx, useClickhouseMetrics)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to create health check: %w", err)
+	}
+
+	sbx.Checks = checks
+
+	cleanup.AddPriority(func(ctx context.Context) error {
+		return sbx.Close(ctx, tracer)
+	})
+	closeRegistered = true
+
+	// The state is removed first, so the sandbox being stopped is not adopted after a restart.
+	cleanup.AddPriority(func(ctx context.Context) error {
+		return sbx.removeState()
+	})
+
+	// The envd is already initialized, the sandbox only has to get its page faults served again.
+	select {
+	case <-fcUffd.Ready():
+	case <-childCtx.Done():
+		return nil, cleanup, fmt.Errorf("failed to wait for uffd: %w", context.Cause(childCtx))
+	}
+
+	err = sbx.SaveState()
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to save adopted sandbox state", zap.Error(err))
+	}
+
+	go sbx.Checks.Start()
+
+	return sbx, cleanup, nil
+}
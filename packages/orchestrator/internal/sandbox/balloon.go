@@ -0,0 +1,162 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	// The sandbox is idle when its CPU usage stays below the threshold for the number of consecutive health checks.
+	balloonIdleCPUPct = 5
+	balloonIdleChecks = 3
+
+	// balloonHeadroomMiB is the available memory left to the idle guest, so it can handle small spikes without deflating the balloon.
+	balloonHeadroomMiB = 128
+	// balloonMinGuestMiB is the memory that is never reclaimed from the guest.
+	balloonMinGuestMiB = 256
+	// balloonMinStepMiB prevents resizing the balloon on small changes of the guest memory usage.
+	balloonMinStepMiB = 64
+
+	balloonTimeout = 1 * time.Second
+
+	minEnvdVersionForBalloon = "0.1.5"
+)
+
+var ErrBalloonNotSupported = errors.New("sandbox was created without the balloon device")
+
+// memoryBalloon reclaims the memory of the idle sandbox through the Firecracker balloon device.
+// The balloon is inflated when the sandbox is idle and deflated when the sandbox becomes active again.
+// The guest can also deflate the balloon on its own when it runs out of memory.
+type memoryBalloon struct {
+	process *fc.Process
+	ramMB   int64
+
+	mu         sync.Mutex
+	idleChecks int
+	targetMiB  int64
+}
+
+// newMemoryBalloon returns nil if the sandbox doesn't have the balloon device, e.g. the template was built before it was added.
+func newMemoryBalloon(ctx context.Context, process *fc.Process, ramMB int64) *memoryBalloon {
+	ctx, cancel := context.WithTimeout(ctx, balloonTimeout)
+	defer cancel()
+
+	stats, err := process.BalloonStats(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return &memoryBalloon{
+		process:   process,
+		ramMB:     ramMB,
+		targetMiB: stats.TargetMiB,
+	}
+}
+
+// observe resizes the balloon based on the CPU usage of the sandbox.
+func (b *memoryBalloon) observe(ctx context.Context, tracer trace.Tracer, cpuUsedPct float64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if cpuUsedPct >= balloonIdleCPUPct {
+		b.idleChecks = 0
+
+		return b.resize(ctx, tracer, 0)
+	}
+
+	b.idleChecks++
+	if b.idleChecks < balloonIdleChecks {
+		return nil
+	}
+
+	stats, err := b.process.BalloonStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get balloon stats: %w", err)
+	}
+
+	// The guest memory that is available on top of the headroom can be moved to the balloon.
+	target := stats.ActualMiB + stats.AvailableMemory>>20 - balloonHeadroomMiB
+	target = min(max(target, 0), max(b.ramMB-balloonMinGuestMiB, 0))
+
+	if target-b.targetMiB < balloonMinStepMiB {
+		return nil
+	}
+
+	return b.resize(ctx, tracer, target)
+}
+
+// deflate returns all the memory held by the balloon to the guest.
+func (b *memoryBalloon) deflate(ctx context.Context, tracer trace.Tracer) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.idleChecks = 0
+
+	return b.resize(ctx, tracer, 0)
+}
+
+func (b *memoryBalloon) resize(ctx context.Context, tracer trace.Tracer, targetMiB int64) error {
+	if b.targetMiB == targetMiB {
+		return nil
+	}
+
+	err := b.process.UpdateBalloon(ctx, tracer, targetMiB)
+	if err != nil {
+		return err
+	}
+
+	b.targetMiB = targetMiB
+
+	return nil
+}
+
+// DeflateBalloon returns the memory reclaimed from the idle sandbox to the guest.
+func (s *Sandbox) DeflateBalloon(ctx context.Context, tracer trace.Tracer) error {
+	if s.balloon == nil {
+		return ErrBalloonNotSupported
+	}
+
+	return s.balloon.deflate(ctx, tracer)
+}
+
+// BalloonStats returns the size of the balloon and the guest memory statistics.
+func (s *Sandbox) BalloonStats(ctx context.Context) (*fc.BalloonStats, error) {
+	if s.balloon == nil {
+		return nil, ErrBalloonNotSupported
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, balloonTimeout)
+	defer cancel()
+
+	return s.process.BalloonStats(ctx)
+}
+
+func (c *Checks) observeBalloon() {
+	if c.sandbox.balloon == nil || !utils.IsGTEVersion(c.sandbox.Config.EnvdVersion, minEnvdVersionForBalloon) {
+		return
+	}
+
+	sbxMetrics, err := c.GetMetrics(balloonTimeout)
+	if err != nil {
+		// The sandbox could be stopped or paused in the meantime
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, balloonTimeout)
+	defer cancel()
+
+	err = c.sandbox.balloon.observe(ctx, c.tracer, sbxMetrics.CPUUsedPercent)
+	if err != nil && !errors.Is(context.Cause(c.ctx), ErrChecksStopped) {
+		sbxlogger.I(c.sandbox).Warn("failed to resize memory balloon", zap.Error(err))
+	}
+}
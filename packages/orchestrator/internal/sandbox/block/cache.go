@@ -72,6 +72,76 @@ func NewCache(size, blockSize int64, filePath string, dirtyFile bool) (*Cache, e
 	}, nil
 }
 
+// OpenCache opens the cache file of the device that was served by the previous orchestrator process.
+// The dirty blocks are not known anymore, the blocks with the data allocated in the sparse file are marked as dirty instead.
+func OpenCache(size, blockSize int64, filePath string) (*Cache, error) {
+	f, err := os.OpenFile(filePath, os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error getting file info: %w", err)
+	}
+
+	if info.Size() != size {
+		return nil, fmt.Errorf("cache file has size %d, expected %d", info.Size(), size)
+	}
+
+	mm, err := mmap.MapRegion(f, int(size), unix.PROT_READ|unix.PROT_WRITE, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error mapping file: %w", err)
+	}
+
+	cache := &Cache{
+		mmap:      &mm,
+		filePath:  filePath,
+		size:      size,
+		blockSize: blockSize,
+	}
+
+	err = cache.markAllocatedAsDirty(f)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error finding written blocks: %w", err), mm.Unmap())
+	}
+
+	return cache, nil
+}
+
+// markAllocatedAsDirty marks the blocks overlapping the data extents of the file as dirty.
+func (m *Cache) markAllocatedAsDirty(f *os.File) error {
+	fd := int(f.Fd())
+
+	for off := int64(0); off < m.size; {
+		start, err := unix.Seek(fd, off, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			// There is no data after the offset.
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error seeking data: %w", err)
+		}
+
+		end, err := unix.Seek(fd, start, unix.SEEK_HOLE)
+		if err != nil {
+			return fmt.Errorf("error seeking hole: %w", err)
+		}
+
+		end = min(end, m.size)
+		blockStart := header.BlockOffset(header.BlockIdx(start, m.blockSize), m.blockSize)
+
+		m.setIsCached(blockStart, end-blockStart)
+
+		off = end
+	}
+
+	return nil
+}
+
 func (m *Cache) isClosed() bool {
 	return m.closed.Load()
 }
@@ -92,6 +162,8 @@ func (m *Cache) ExportToDiff(out io.Writer) (*header.DiffMetadata, error) {
 	dirty := bitset.New(uint(header.TotalBlocks(m.size, m.blockSize)))
 	empty := bitset.New(0)
 
+	checksums := header.NewChecksumWriter(out)
+
 	for _, key := range m.dirtySortedKeys() {
 		blockIdx := header.BlockIdx(key, m.blockSize)
 
@@ -106,7 +178,7 @@ func (m *Cache) ExportToDiff(out io.Writer) (*header.DiffMetadata, error) {
 		}
 
 		dirty.Set(uint(blockIdx))
-		_, err = out.Write(block)
+		_, err = checksums.Write(block)
 		if err != nil {
 			zap.L().Error("error writing to out", zap.Error(err))
 
@@ -119,6 +191,7 @@ func (m *Cache) ExportToDiff(out io.Writer) (*header.DiffMetadata, error) {
 		Empty: empty,
 
 		BlockSize: m.blockSize,
+		Checksums: checksums.Checksums(),
 	}, nil
 }
 
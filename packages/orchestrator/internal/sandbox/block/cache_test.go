@@ -0,0 +1,64 @@
+package block
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenCache(t *testing.T) {
+	const (
+		blockSize = 4096
+		size      = 64 * blockSize
+	)
+
+	path := filepath.Join(t.TempDir(), "rootfs.cow")
+
+	cache, err := NewCache(size, blockSize, path, false)
+	require.NoError(t, err)
+
+	data := make([]byte, blockSize)
+	for i := range data {
+		data[i] = 0xAB
+	}
+
+	_, err = cache.WriteAt(data, 3*blockSize)
+	require.NoError(t, err)
+
+	_, err = cache.WriteAt(data, 40*blockSize)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.mmap.Flush())
+
+	// The previous cache is not closed, as the closing removes the file.
+	opened, err := OpenCache(size, blockSize, path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = opened.Close()
+	})
+
+	assert.Equal(t, []int64{3 * blockSize, 40 * blockSize}, opened.dirtySortedKeys())
+
+	b := make([]byte, blockSize)
+	_, err = opened.ReadAt(b, 40*blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, data, b)
+
+	_, err = opened.ReadAt(b, 10*blockSize)
+	assert.ErrorIs(t, err, ErrBytesNotAvailable{})
+}
+
+func TestOpenCacheSizeMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rootfs.cow")
+
+	cache, err := NewCache(8*4096, 4096, path, false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = cache.Close()
+	})
+
+	_, err = OpenCache(16*4096, 4096, path)
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -16,6 +17,9 @@ import (
 const (
 	// Chunks must always be bigger or equal to the block size.
 	ChunkSize = 4 * 1024 * 1024 // 4 MB
+
+	// readAheadMaxChunks is the maximum number of chunks fetched ahead of the sequential reads.
+	readAheadMaxChunks = 8
 )
 
 type Chunker struct {
@@ -28,6 +32,11 @@ type Chunker struct {
 
 	// TODO: Optimize this so we don't need to keep the fetchers in memory.
 	fetchers *utils.WaitMap
+
+	// The read-ahead window grows while the reads continue to the next chunk and resets on a random read.
+	readAheadMu     sync.Mutex
+	lastChunk       int64
+	readAheadChunks int64
 }
 
 func NewChunker(
@@ -48,6 +57,8 @@ func NewChunker(
 		base:     base,
 		cache:    cache,
 		fetchers: utils.NewWaitMap(),
+
+		lastChunk: -1,
 	}
 
 	return chunker, nil
@@ -81,6 +92,8 @@ func (c *Chunker) WriteTo(w io.Writer) (int64, error) {
 }
 
 func (c *Chunker) Slice(off, length int64) ([]byte, error) {
+	c.readAhead(off + length - 1)
+
 	b, err := c.cache.Slice(off, length)
 	if err == nil {
 		return b, nil
@@ -103,6 +116,15 @@ func (c *Chunker) Slice(off, length int64) ([]byte, error) {
 	return b, nil
 }
 
+// CachedSlice returns the data only if they are already in the cache, the end of the slice is clamped to the size.
+func (c *Chunker) CachedSlice(off, length int64) ([]byte, error) {
+	if off < 0 || off >= c.size {
+		return nil, ErrBytesNotAvailable{}
+	}
+
+	return c.cache.Slice(off, min(length, c.size-off))
+}
+
 // fetchToCache ensures that the data at the given offset and length is available in the cache.
 func (c *Chunker) fetchToCache(off, length int64) error {
 	var eg errgroup.Group
@@ -116,37 +138,8 @@ func (c *Chunker) fetchToCache(off, length int64) error {
 		// Ensure the closure captures the correct block offset.
 		fetchOff := startingChunkOffset + chunkOff
 
-		eg.Go(func() (err error) {
-			defer func() {
-				if r := recover(); r != nil {
-					zap.L().Error("recovered from panic in the fetch handler", zap.Any("error", r))
-					err = fmt.Errorf("recovered from panic in the fetch handler: %v", r)
-				}
-			}()
-
-			err = c.fetchers.Wait(fetchOff, func() error {
-				select {
-				case <-c.ctx.Done():
-					return fmt.Errorf("error fetching range %d-%d: %w", fetchOff, fetchOff+ChunkSize, c.ctx.Err())
-				default:
-				}
-
-				b := make([]byte, ChunkSize)
-
-				_, err := c.base.ReadAt(b, fetchOff)
-				if err != nil && !errors.Is(err, io.EOF) {
-					return fmt.Errorf("failed to read chunk from base %d: %w", fetchOff, err)
-				}
-
-				_, cacheErr := c.cache.WriteAtWithoutLock(b, fetchOff)
-				if cacheErr != nil {
-					return fmt.Errorf("failed to write chunk %d to cache: %w", fetchOff, cacheErr)
-				}
-
-				return nil
-			})
-
-			return err
+		eg.Go(func() error {
+			return c.fetchChunk(fetchOff)
 		})
 	}
 
@@ -158,6 +151,75 @@ func (c *Chunker) fetchToCache(off, length int64) error {
 	return nil
 }
 
+// fetchChunk fetches the chunk at the offset to the cache, the chunk is fetched only once.
+func (c *Chunker) fetchChunk(fetchOff int64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("recovered from panic in the fetch handler", zap.Any("error", r))
+			err = fmt.Errorf("recovered from panic in the fetch handler: %v", r)
+		}
+	}()
+
+	return c.fetchers.Wait(fetchOff, func() error {
+		select {
+		case <-c.ctx.Done():
+			return fmt.Errorf("error fetching range %d-%d: %w", fetchOff, fetchOff+ChunkSize, c.ctx.Err())
+		default:
+		}
+
+		b := make([]byte, ChunkSize)
+
+		_, err := c.base.ReadAt(b, fetchOff)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read chunk from base %d: %w", fetchOff, err)
+		}
+
+		_, cacheErr := c.cache.WriteAtWithoutLock(b, fetchOff)
+		if cacheErr != nil {
+			return fmt.Errorf("failed to write chunk %d to cache: %w", fetchOff, cacheErr)
+		}
+
+		return nil
+	})
+}
+
+// readAhead fetches the chunks after the read in the background when the reads are sequential.
+// The window doubles with each read that continues to the next chunk, so the random reads don't fetch more data than needed.
+func (c *Chunker) readAhead(off int64) {
+	chunk := header.BlockIdx(off, ChunkSize)
+
+	c.readAheadMu.Lock()
+	if chunk == c.lastChunk {
+		c.readAheadMu.Unlock()
+
+		return
+	}
+
+	if chunk == c.lastChunk+1 {
+		c.readAheadChunks = min(max(2*c.readAheadChunks, 1), readAheadMaxChunks)
+	} else {
+		c.readAheadChunks = 0
+	}
+
+	c.lastChunk = chunk
+	window := c.readAheadChunks
+	c.readAheadMu.Unlock()
+
+	for i := int64(1); i <= window; i++ {
+		fetchOff := header.BlockOffset(chunk+i, ChunkSize)
+		if fetchOff >= c.size {
+			break
+		}
+
+		go func() {
+			err := c.fetchChunk(fetchOff)
+			if err != nil {
+				zap.L().Debug("failed to read ahead chunk", zap.Int64("offset", fetchOff), zap.Error(err))
+			}
+		}()
+	}
+}
+
 func (c *Chunker) Close() error {
 	return c.cache.Close()
 }
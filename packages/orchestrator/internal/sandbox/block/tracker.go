@@ -15,30 +15,81 @@ type TrackedSliceDevice struct {
 	blockSize int64
 
 	nilTracking atomic.Bool
+	frozen      atomic.Bool
 	dirty       *bitset.BitSet
 	dirtyMu     sync.Mutex
 	empty       []byte
+
+	// The released blocks are guarded by the accessedMu as they are always updated together with the accessed ones.
+	accessed   *bitset.BitSet
+	released   *bitset.BitSet
+	accessedMu sync.Mutex
 }
 
 func NewTrackedSliceDevice(blockSize int64, device ReadonlyDevice) (*TrackedSliceDevice, error) {
+	size, err := device.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device size: %w", err)
+	}
+
+	blocks := uint(header.TotalBlocks(size, blockSize))
+
 	return &TrackedSliceDevice{
 		data:      device,
 		empty:     make([]byte, blockSize),
 		blockSize: blockSize,
+		accessed:  bitset.New(blocks),
+		released:  bitset.New(blocks),
 	}, nil
 }
 
 func (t *TrackedSliceDevice) Disable() error {
+	err := t.resetDirty()
+	if err != nil {
+		return err
+	}
+
+	t.nilTracking.Store(true)
+
+	return nil
+}
+
+// Freeze starts the dirty tracking like Disable, but the blocks are still served from the source.
+// It is used for the snapshots of the VM that keeps running afterwards, the blocks requested while the VM is paused are read only by the snapshot.
+func (t *TrackedSliceDevice) Freeze() error {
+	err := t.resetDirty()
+	if err != nil {
+		return err
+	}
+
+	t.frozen.Store(true)
+
+	return nil
+}
+
+// Unfreeze stops the dirty tracking started by Freeze.
+func (t *TrackedSliceDevice) Unfreeze() {
+	t.frozen.Store(false)
+}
+
+func (t *TrackedSliceDevice) resetDirty() error {
 	size, err := t.data.Size()
 	if err != nil {
 		return fmt.Errorf("failed to get device size: %w", err)
 	}
 
-	t.dirty = bitset.New(uint(header.TotalBlocks(size, t.blockSize)))
+	dirty := bitset.New(uint(header.TotalBlocks(size, t.blockSize)))
 	// We are starting with all being dirty.
-	t.dirty.FlipRange(0, t.dirty.Len())
+	dirty.FlipRange(0, dirty.Len())
 
-	t.nilTracking.Store(true)
+	// The content of the released blocks is not used by the guest, so they don't have to be part of the diff.
+	t.accessedMu.Lock()
+	dirty.InPlaceDifference(t.released)
+	t.accessedMu.Unlock()
+
+	t.dirtyMu.Lock()
+	t.dirty = dirty
+	t.dirtyMu.Unlock()
 
 	return nil
 }
@@ -52,9 +103,63 @@ func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 		return t.empty, nil
 	}
 
+	idx := uint(header.BlockIdx(off, t.blockSize))
+
+	// The block was not served to the VM before the freeze, so its content in the snapshot is the same as in the source.
+	if t.frozen.Load() {
+		t.dirtyMu.Lock()
+		t.dirty.Clear(idx)
+		t.dirtyMu.Unlock()
+	}
+
+	t.accessedMu.Lock()
+	t.accessed.Set(idx)
+	released := t.released.Test(idx)
+	t.released.Clear(idx)
+	t.accessedMu.Unlock()
+
+	// The guest got the released block back, it expects no content, so it doesn't have to be read from the source.
+	if released {
+		return t.empty, nil
+	}
+
 	return t.data.Slice(off, length)
 }
 
+// Release marks the blocks in the range as freed by the guest, e.g. when the memory balloon inflates.
+// Only the blocks fully covered by the range are released, the rest of a partially covered block is still used by the guest.
+// The released blocks are served empty when they are accessed again and are skipped in the diff.
+func (t *TrackedSliceDevice) Release(off int64, length int64) {
+	start := uint(header.TotalBlocks(off, t.blockSize))
+	end := uint(header.BlockIdx(off+length, t.blockSize))
+
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	for i := start; i < end && i < t.released.Len(); i++ {
+		t.released.Set(i)
+		t.accessed.Clear(i)
+	}
+}
+
+// MarkAllAccessed marks all blocks as accessed, it is used when the blocks served before are not known.
+func (t *TrackedSliceDevice) MarkAllAccessed() {
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	t.accessed = bitset.New(t.accessed.Len())
+	t.accessed.FlipRange(0, t.accessed.Len())
+	t.released.ClearAll()
+}
+
+// Released returns the blocks that were released by the guest and were not accessed since.
+func (t *TrackedSliceDevice) Released() *bitset.BitSet {
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	return t.released.Clone()
+}
+
 // Return which bytes were not read since Disable.
 // This effectively returns the bytes that have been requested after paused vm and are not dirty.
 func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
@@ -63,3 +168,12 @@ func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
 
 	return t.dirty.Clone()
 }
+
+// Return which blocks were served to the VM before Disable.
+// Unlike Dirty, this can be used without disabling the device, so the VM can continue running after the snapshot.
+func (t *TrackedSliceDevice) Accessed() *bitset.BitSet {
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	return t.accessed.Clone()
+}
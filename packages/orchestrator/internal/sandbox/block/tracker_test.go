@@ -0,0 +1,180 @@
+package block
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// cacheDevice serves the content of the cache as a read-only device.
+type cacheDevice struct {
+	*Cache
+}
+
+func (c *cacheDevice) BlockSize() int64 {
+	return c.blockSize
+}
+
+func (c *cacheDevice) Header() *header.Header {
+	return nil
+}
+
+func newTestTrackedDevice(t *testing.T, blockSize, size int64) *TrackedSliceDevice {
+	t.Helper()
+
+	cache, err := NewCache(size, blockSize, filepath.Join(t.TempDir(), "memfile"), false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = cache.Close()
+	})
+
+	device, err := NewTrackedSliceDevice(blockSize, &cacheDevice{cache})
+	require.NoError(t, err)
+
+	return device
+}
+
+func TestTrackedSliceDevice_Release(t *testing.T) {
+	const (
+		pageSize  = 4 << 10
+		blockSize = 2 << 20
+		size      = 8 * blockSize
+	)
+
+	tests := []struct {
+		name     string
+		off      int64
+		length   int64
+		released []uint
+	}{
+		{
+			name:   "page inside a block",
+			off:    blockSize + pageSize,
+			length: pageSize,
+		},
+		{
+			name:   "page at the start of a block",
+			off:    blockSize,
+			length: pageSize,
+		},
+		{
+			name:     "whole block",
+			off:      blockSize,
+			length:   blockSize,
+			released: []uint{1},
+		},
+		{
+			name:     "unaligned range covering blocks",
+			off:      blockSize - pageSize,
+			length:   2*blockSize + 2*pageSize,
+			released: []uint{1, 2},
+		},
+		{
+			name:     "range past the end of the device",
+			off:      6 * blockSize,
+			length:   4 * blockSize,
+			released: []uint{6, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := newTestTrackedDevice(t, blockSize, size)
+
+			device.MarkAllAccessed()
+			device.Release(tt.off, tt.length)
+
+			released := device.Released()
+			assert.Equal(t, uint(len(tt.released)), released.Count())
+			for _, idx := range tt.released {
+				assert.True(t, released.Test(idx), "block %d should be released", idx)
+			}
+
+			accessed := device.Accessed()
+			assert.Equal(t, uint(8-len(tt.released)), accessed.Count())
+			for _, idx := range tt.released {
+				assert.False(t, accessed.Test(idx), "block %d should not be accessed", idx)
+			}
+		})
+	}
+}
+
+func TestTrackedSliceDevice_ReleasedBlockServedEmpty(t *testing.T) {
+	const (
+		blockSize = 4096
+		size      = 4 * blockSize
+	)
+
+	device := newTestTrackedDevice(t, blockSize, size)
+
+	data := make([]byte, blockSize)
+	for i := range data {
+		data[i] = 0xAB
+	}
+
+	_, err := device.data.(*cacheDevice).WriteAt(data, blockSize)
+	require.NoError(t, err)
+
+	device.Release(blockSize, blockSize)
+
+	slice, err := device.Slice(blockSize, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, blockSize), slice)
+
+	// The block is used by the guest again, it is neither released nor skipped in the diff.
+	assert.Zero(t, device.Released().Count())
+	assert.True(t, device.Accessed().Test(1))
+
+	require.NoError(t, device.Disable())
+	assert.True(t, device.Dirty().Test(1))
+}
+
+func TestTrackedSliceDevice_Freeze(t *testing.T) {
+	const (
+		blockSize = 4096
+		size      = 4 * blockSize
+	)
+
+	device := newTestTrackedDevice(t, blockSize, size)
+
+	data := make([]byte, blockSize)
+	for i := range data {
+		data[i] = 0xAB
+	}
+
+	for _, off := range []int64{0, 2 * blockSize} {
+		_, err := device.data.(*cacheDevice).WriteAt(data, off)
+		require.NoError(t, err)
+	}
+
+	// Block 0 is served to the VM and block 3 is released before the snapshot.
+	_, err := device.Slice(0, blockSize)
+	require.NoError(t, err)
+	device.Release(3*blockSize, blockSize)
+
+	require.NoError(t, device.Freeze())
+
+	// The snapshot reads block 2, the running VM has to get its content.
+	slice, err := device.Slice(2*blockSize, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, data, slice)
+
+	dirty := device.Dirty()
+	assert.True(t, dirty.Test(0))
+	assert.True(t, dirty.Test(1), "block 1 was not read by the snapshot, it could have been served before the tracking")
+	assert.False(t, dirty.Test(2), "block 2 was served from the source only for the snapshot")
+	assert.False(t, dirty.Test(3), "block 3 is released")
+
+	device.Unfreeze()
+
+	// The VM is running again, the blocks it gets are tracked as accessed and not served empty.
+	slice, err = device.Slice(2*blockSize, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, data, slice)
+	assert.True(t, device.Accessed().Test(2))
+	assert.False(t, device.Dirty().Test(2), "the dirty blocks of the snapshot changed after the unfreeze")
+}
@@ -16,6 +16,12 @@ type File struct {
 	store       *DiffStore
 	fileType    DiffType
 	persistence storage.StorageProvider
+	// peers are the addresses of the orchestrators that have the build cached.
+	peers []string
+	// source is the address of the orchestrator the snapshot is migrated from, its build diff is read from it until it is uploaded.
+	source string
+	// teamID is the team of the migrated snapshot, the source seals the data of the build diff with its data key.
+	teamID string
 }
 
 func NewFile(
@@ -23,15 +29,34 @@ func NewFile(
 	store *DiffStore,
 	fileType DiffType,
 	persistence storage.StorageProvider,
+	peers []string,
 ) *File {
 	return &File{
 		header:      header,
 		store:       store,
 		fileType:    fileType,
 		persistence: persistence,
+		peers:       peers,
 	}
 }
 
+// NewMigratedFile returns the file of the snapshot migrated from the source orchestrator, the upload of the snapshot could still be in progress.
+func NewMigratedFile(
+	header *header.Header,
+	store *DiffStore,
+	fileType DiffType,
+	persistence storage.StorageProvider,
+	peers []string,
+	source string,
+	teamID string,
+) *File {
+	f := NewFile(header, store, fileType, persistence, peers)
+	f.source = source
+	f.teamID = teamID
+
+	return f
+}
+
 func min(a, b int64) int64 {
 	if a < b {
 		return a
@@ -42,7 +67,7 @@ func min(a, b int64) int64 {
 
 func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 	for n < len(p) {
-		mappedOffset, mappedLength, buildID, err := b.header.GetShiftedMapping(off + int64(n))
+		mappedOffset, mappedLength, mapping, err := b.header.GetShiftedMapping(off + int64(n))
 		if err != nil {
 			return 0, fmt.Errorf("failed to get mapping: %w", err)
 		}
@@ -57,7 +82,7 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 				len(p)-n,
 				off,
 				readLength,
-				buildID,
+				mapping.BuildId,
 				b.fileType,
 				mappedOffset,
 				n,
@@ -73,13 +98,13 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 		// Skip reading when the uuid is nil.
 		// We will use this to handle base builds that are already diffs.
 		// The passed slice p must start as empty, otherwise we would need to copy the empty values there.
-		if *buildID == uuid.Nil {
+		if mapping.BuildId == uuid.Nil {
 			n += int(readLength)
 
 			continue
 		}
 
-		mappedBuild, err := b.getBuild(buildID)
+		mappedBuild, err := b.getBuild(mapping)
 		if err != nil {
 			return 0, fmt.Errorf("failed to get build: %w", err)
 		}
@@ -100,17 +125,17 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 
 // The slice access must be in the predefined blocksize of the build.
 func (b *File) Slice(off, length int64) ([]byte, error) {
-	mappedOffset, _, buildID, err := b.header.GetShiftedMapping(off)
+	mappedOffset, _, mapping, err := b.header.GetShiftedMapping(off)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get mapping: %w", err)
 	}
 
 	// Pass empty huge page when the build id is nil.
-	if *buildID == uuid.Nil {
+	if mapping.BuildId == uuid.Nil {
 		return header.EmptyHugePage, nil
 	}
 
-	build, err := b.getBuild(buildID)
+	build, err := b.getBuild(mapping)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build: %w", err)
 	}
@@ -118,14 +143,47 @@ func (b *File) Slice(off, length int64) ([]byte, error) {
 	return build.Slice(mappedOffset, int64(b.header.Metadata.BlockSize))
 }
 
-func (b *File) getBuild(buildID *uuid.UUID) (Diff, error) {
-	storageDiff := newStorageDiff(
-		b.store.cachePath,
-		buildID.String(),
-		b.fileType,
-		int64(b.header.Metadata.BlockSize),
-		b.persistence,
-	)
+// getBuild returns the diff with the data of the mapping.
+// The data are read from the content-addressed chunk if the mapping references it, otherwise from the build diff.
+func (b *File) getBuild(mapping *header.BuildMap) (Diff, error) {
+	if b.source != "" && mapping.BuildId == b.header.Metadata.BuildId && mapping.Hash.IsZero() {
+		source, err := b.store.Get(newMigratedDiff(b.store, b.header, b.fileType, b.persistence, b.source, b.teamID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get migrated build from store: %w", err)
+		}
+
+		return source, nil
+	}
+
+	var storageDiff *StorageDiff
+
+	if !mapping.Hash.IsZero() {
+		storageDiff = newContentStorageDiff(
+			b.store.cachePath,
+			mapping,
+			b.fileType,
+			int64(b.header.Metadata.BlockSize),
+			b.persistence,
+		)
+	} else {
+		storageDiff = newStorageDiff(
+			b.store.cachePath,
+			mapping.BuildId.String(),
+			b.fileType,
+			int64(b.header.Metadata.BlockSize),
+			mapping.Compression,
+			mapping.KeyId,
+			b.persistence,
+		)
+
+		// The header of the file is the header of its own build, so it isn't fetched again.
+		// The peers advertised only the build of the file, the builds it is based on are read from the storage.
+		if mapping.BuildId == b.header.Metadata.BuildId {
+			storageDiff.header = b.header
+			storageDiff.peers = b.peers
+			storageDiff.peerClient = b.store.peers
+		}
+	}
 
 	source, err := b.store.Get(storageDiff)
 	if err != nil {
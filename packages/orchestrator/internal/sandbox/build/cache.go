@@ -10,6 +10,8 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
 const (
@@ -35,6 +37,8 @@ type DiffStore struct {
 	pdSizes map[DiffStoreKey]*deleteDiff
 	pdMu    sync.RWMutex
 	pdDelay time.Duration
+
+	peers *PeerClient
 }
 
 func NewDiffStore(ctx context.Context, cachePath string, ttl, delay time.Duration, maxUsedPercentage float64) (*DiffStore, error) {
@@ -54,6 +58,7 @@ func NewDiffStore(ctx context.Context, cachePath string, ttl, delay time.Duratio
 		close:     make(chan struct{}),
 		pdSizes:   make(map[DiffStoreKey]*deleteDiff),
 		pdDelay:   delay,
+		peers:     NewPeerClient(PeerAuthToken),
 	}
 
 	cache.OnEviction(func(ctx context.Context, reason ttlcache.EvictionReason, item *ttlcache.Item[DiffStoreKey, Diff]) {
@@ -82,6 +87,11 @@ func GetDiffStoreKey(buildID string, diffType DiffType) DiffStoreKey {
 func (s *DiffStore) Close() {
 	close(s.close)
 	s.cache.Stop()
+
+	err := s.peers.Close()
+	if err != nil {
+		zap.L().Warn("failed to close peer connections", zap.Error(err))
+	}
 }
 
 func (s *DiffStore) Get(diff Diff) (Diff, error) {
@@ -112,10 +122,41 @@ func (s *DiffStore) Add(d Diff) {
 	s.cache.Set(d.CacheKey(), d, ttlcache.DefaultTTL)
 }
 
+// Peers returns the client reading from the other orchestrators.
+func (s *DiffStore) Peers() *PeerClient {
+	return s.peers
+}
+
 func (s *DiffStore) Has(d Diff) bool {
 	return s.cache.Has(d.CacheKey())
 }
 
+// peerSlicer is implemented by the diffs that can return their data to the peers without fetching them.
+type peerSlicer interface {
+	PeerSlice(ctx context.Context, persistence storage.StorageProvider, off, length int64) ([]byte, error)
+}
+
+// PeerSlice returns the data of the diff sealed for the peer only if they are already cached on the node.
+// The diffs being deleted are not served, their slices could be unmapped before they are used.
+func (s *DiffStore) PeerSlice(ctx context.Context, persistence storage.StorageProvider, key DiffStoreKey, off, length int64) ([]byte, error) {
+	item := s.cache.Get(key, ttlcache.WithDisableTouchOnHit[DiffStoreKey, Diff]())
+	if item == nil || s.isBeingDeleted(key) {
+		return nil, ErrChunkNotCached
+	}
+
+	diff, ok := item.Value().(peerSlicer)
+	if !ok {
+		return nil, ErrChunkNotCached
+	}
+
+	b, err := diff.PeerSlice(ctx, persistence, off, length)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrChunkNotCached, err)
+	}
+
+	return b, nil
+}
+
 func (s *DiffStore) startDiskSpaceEviction(threshold float64) {
 	getDelay := func(fast bool) time.Duration {
 		if fast {
@@ -16,7 +16,7 @@ const (
 )
 
 func newDiff(t *testing.T, cachePath, buildId string, diffType DiffType, blockSize int64) Diff {
-	localDiff, err := NewLocalDiffFile(cachePath, buildId, diffType)
+	localDiff, err := NewLocalDiffFile(cachePath, buildId, diffType, "")
 	assert.NoError(t, err)
 
 	// Write 100 bytes to the file
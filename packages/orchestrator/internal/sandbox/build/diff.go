@@ -18,6 +18,7 @@ func (ErrNoDiff) Error() string {
 const (
 	Memfile DiffType = storage.MemfileName
 	Rootfs  DiffType = storage.RootfsName
+	Volume  DiffType = storage.VolumeName
 )
 
 type Diff interface {
@@ -8,18 +8,25 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type LocalDiffFile struct {
 	*os.File
 	cachePath string
 	cacheKey  DiffStoreKey
+	buildID   string
+	diffType  DiffType
+	// teamID is the team the diff is uploaded for, the data served to the peers are sealed with its data key.
+	teamID string
 }
 
 func NewLocalDiffFile(
 	basePath string,
 	buildId string,
 	diffType DiffType,
+	teamID string,
 ) (*LocalDiffFile, error) {
 	cachePathSuffix := id.Generate()
 
@@ -35,6 +42,9 @@ func NewLocalDiffFile(
 		File:      f,
 		cachePath: cachePath,
 		cacheKey:  GetDiffStoreKey(buildId, diffType),
+		buildID:   buildId,
+		diffType:  diffType,
+		teamID:    teamID,
 	}, nil
 }
 
@@ -62,6 +72,8 @@ func (f *LocalDiffFile) CloseToDiff(
 		f.cachePath,
 		size.Size(),
 		blockSize,
+		header.FileScope(f.buildID, string(f.diffType)),
+		f.teamID,
 	)
 }
 
@@ -71,6 +83,9 @@ type localDiff struct {
 	cacheKey  DiffStoreKey
 	cachePath string
 	cache     *block.Cache
+
+	scope  header.EncryptionScope
+	teamID string
 }
 
 func newLocalDiff(
@@ -78,6 +93,8 @@ func newLocalDiff(
 	cachePath string,
 	size int64,
 	blockSize int64,
+	scope header.EncryptionScope,
+	teamID string,
 ) (*localDiff, error) {
 	cache, err := block.NewCache(size, blockSize, cachePath, true)
 	if err != nil {
@@ -90,6 +107,8 @@ func newLocalDiff(
 		cacheKey:  cacheKey,
 		cachePath: cachePath,
 		cache:     cache,
+		scope:     scope,
+		teamID:    teamID,
 	}, nil
 }
 
@@ -109,6 +128,26 @@ func (b *localDiff) Slice(off, length int64) ([]byte, error) {
 	return b.cache.Slice(off, length)
 }
 
+// PeerSlice returns the data of the diff written on this node sealed for the peer, the slice is cut at the end of the diff.
+// The diff isn't uploaded yet, so the data are sealed with the data key of the team the diff is uploaded for.
+func (b *localDiff) PeerSlice(ctx context.Context, persistence storage.StorageProvider, off, length int64) ([]byte, error) {
+	if off < 0 || off >= b.size {
+		return nil, fmt.Errorf("offset %d is out of the diff size %d", off, b.size)
+	}
+
+	data, err := b.cache.Slice(off, length)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := teamDataKey(ctx, persistence, b.teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	return sealPeerData(aead, b.scope, off, data)
+}
+
 func (b *localDiff) FileSize() (int64, error) {
 	return b.cache.FileSize()
 }
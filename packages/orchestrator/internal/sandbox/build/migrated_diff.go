@@ -0,0 +1,283 @@
+package build
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	// uploadWaitTimeout is how long the migrated diff waits for the upload when the source can't serve it.
+	uploadWaitTimeout  = 5 * time.Minute
+	uploadPollInterval = time.Second
+)
+
+// migratedDiff is the diff of the snapshot migrated from the source orchestrator, its upload to the storage could still be in progress.
+// The chunks are read from the source, the storage is used only after the snapshot is uploaded.
+type migratedDiff struct {
+	chunker   *utils.SetOnce[*block.Chunker]
+	cachePath string
+	cacheKey  DiffStoreKey
+	blockSize int64
+
+	header      *header.Header
+	diffType    DiffType
+	store       *DiffStore
+	persistence storage.StorageProvider
+	source      string
+	teamID      string
+}
+
+func newMigratedDiff(
+	store *DiffStore,
+	h *header.Header,
+	diffType DiffType,
+	persistence storage.StorageProvider,
+	source string,
+	teamID string,
+) *migratedDiff {
+	buildID := h.Metadata.BuildId.String()
+	cacheFile := fmt.Sprintf("%s-%s-migrated-%s", buildID, diffType, id.Generate())
+
+	return &migratedDiff{
+		chunker:   utils.NewSetOnce[*block.Chunker](),
+		cachePath: filepath.Join(store.cachePath, cacheFile),
+		// The key differs from the build diff, the uploaded diff is then read through the store under the build key.
+		cacheKey:    DiffStoreKey(fmt.Sprintf("%s/migrated", GetDiffStoreKey(buildID, diffType))),
+		blockSize:   int64(h.Metadata.BlockSize),
+		header:      h,
+		diffType:    diffType,
+		store:       store,
+		persistence: persistence,
+		source:      source,
+		teamID:      teamID,
+	}
+}
+
+func (d *migratedDiff) CacheKey() DiffStoreKey {
+	return d.cacheKey
+}
+
+func (d *migratedDiff) Init(ctx context.Context) error {
+	buildID := d.header.Metadata.BuildId
+
+	uploaded := newUploadedReader(ctx, d.header.Mapping, buildID, func(ctx context.Context) (io.ReaderAt, error) {
+		return openUploadedFile(ctx, d.store, buildID.String(), d.diffType, d.persistence)
+	})
+
+	// The source seals the data of the diff with the data key of the team the snapshot is uploaded for.
+	aead, err := teamDataKey(ctx, d.persistence, d.teamID)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to get data key: %w", err)
+		d.chunker.SetError(errMsg)
+
+		return errMsg
+	}
+
+	base := newPeerReader(ctx, d.store.peers, []string{d.source}, buildID.String(), d.diffType, uploaded.size, aead, uploaded)
+
+	chunker, err := block.NewChunker(ctx, uploaded.size, d.blockSize, base, d.cachePath)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to create chunker: %w", err)
+		d.chunker.SetError(errMsg)
+
+		return errMsg
+	}
+
+	return d.chunker.SetValue(chunker)
+}
+
+func (d *migratedDiff) Close() error {
+	c, err := d.chunker.Wait()
+	if err != nil {
+		return err
+	}
+
+	return c.Close()
+}
+
+func (d *migratedDiff) ReadAt(p []byte, off int64) (int, error) {
+	c, err := d.chunker.Wait()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.ReadAt(p, off)
+}
+
+func (d *migratedDiff) Slice(off, length int64) ([]byte, error) {
+	c, err := d.chunker.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Slice(off, length)
+}
+
+func (d *migratedDiff) CachePath() (string, error) {
+	return d.cachePath, nil
+}
+
+func (d *migratedDiff) FileSize() (int64, error) {
+	c, err := d.chunker.Wait()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.FileSize()
+}
+
+// openUploadedFile returns the file of the uploaded build, it returns the not exist error until the upload finishes.
+// The header and the build diff that is not packed are uploaded in parallel, so both have to exist.
+func openUploadedFile(ctx context.Context, store *DiffStore, buildID string, diffType DiffType, persistence storage.StorageProvider) (io.ReaderAt, error) {
+	headerObject, err := persistence.OpenObject(ctx, storagePath(buildID, diffType)+storage.HeaderSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := header.Deserialize(headerObject)
+	if err != nil {
+		return nil, err
+	}
+
+	referencesDiff := slices.ContainsFunc(h.Mapping, func(m *header.BuildMap) bool {
+		return m.BuildId == h.Metadata.BuildId && m.Hash.IsZero()
+	})
+
+	if referencesDiff {
+		diffObject, err := persistence.OpenObject(ctx, storagePath(buildID, diffType))
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = diffObject.Size()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewFile(h, store, diffType, persistence, nil), nil
+}
+
+// uploadedReader reads the migrated diff from the uploaded build.
+// The offsets in the diff are translated to the offsets in the file by the mappings the source created,
+// so the data are read however the uploaded diff is stored.
+type uploadedReader struct {
+	ctx      context.Context
+	mappings []*header.BuildMap
+	size     int64
+	open     func(ctx context.Context) (io.ReaderAt, error)
+
+	mu   sync.Mutex
+	file io.ReaderAt
+}
+
+func newUploadedReader(ctx context.Context, mappings []*header.BuildMap, buildID uuid.UUID, open func(ctx context.Context) (io.ReaderAt, error)) *uploadedReader {
+	var diffMappings []*header.BuildMap
+	var size int64
+
+	for _, m := range mappings {
+		if m.BuildId != buildID || !m.Hash.IsZero() {
+			continue
+		}
+
+		diffMappings = append(diffMappings, m)
+		size = max(size, int64(m.BuildStorageOffset+m.Length))
+	}
+
+	slices.SortFunc(diffMappings, func(a, b *header.BuildMap) int {
+		return cmp.Compare(a.BuildStorageOffset, b.BuildStorageOffset)
+	})
+
+	return &uploadedReader{
+		ctx:      ctx,
+		mappings: diffMappings,
+		size:     size,
+		open:     open,
+	}
+}
+
+// uploadedFile waits for the upload, the readers wait for the same upload.
+func (r *uploadedReader) uploadedFile() (io.ReaderAt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file != nil {
+		return r.file, nil
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, uploadWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(uploadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		file, err := r.open(ctx)
+		if err == nil {
+			r.file = file
+
+			return file, nil
+		}
+
+		if !errors.Is(err, storage.ErrorObjectNotExist) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("build is not uploaded: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *uploadedReader) ReadAt(p []byte, off int64) (int, error) {
+	file, err := r.uploadedFile()
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for n < len(p) {
+		diffOff := off + int64(n)
+		if diffOff >= r.size {
+			return n, io.EOF
+		}
+
+		i := sort.Search(len(r.mappings), func(i int) bool {
+			return int64(r.mappings[i].BuildStorageOffset+r.mappings[i].Length) > diffOff
+		})
+		if i == len(r.mappings) || int64(r.mappings[i].BuildStorageOffset) > diffOff {
+			return n, fmt.Errorf("offset %d of the diff is not mapped", diffOff)
+		}
+
+		m := r.mappings[i]
+		shift := diffOff - int64(m.BuildStorageOffset)
+		length := min(int64(len(p)-n), int64(m.Length)-shift)
+
+		read, err := file.ReadAt(p[n:int64(n)+length], int64(m.Offset)+shift)
+		n += read
+
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestUploadedReader(t *testing.T) {
+	const blockSize = 4
+
+	buildID := uuid.New()
+	parentID := uuid.New()
+
+	// The file has 6 blocks, the blocks 1, 2 and 4 are in the diff of the build.
+	file := []byte("aaaabbbbccccddddeeeeffff")
+	mappings := []*header.BuildMap{
+		{Offset: 0, Length: blockSize, BuildId: parentID},
+		{Offset: 4 * blockSize, Length: blockSize, BuildId: buildID, BuildStorageOffset: 2 * blockSize},
+		{Offset: 1 * blockSize, Length: 2 * blockSize, BuildId: buildID, BuildStorageOffset: 0},
+		{Offset: 3 * blockSize, Length: blockSize, BuildId: parentID, BuildStorageOffset: blockSize},
+	}
+
+	opened := 0
+	r := newUploadedReader(context.Background(), mappings, buildID, func(context.Context) (io.ReaderAt, error) {
+		opened++
+		if opened < 2 {
+			return nil, storage.ErrorObjectNotExist
+		}
+
+		return bytes.NewReader(file), nil
+	})
+	require.Equal(t, int64(3*blockSize), r.size)
+
+	p := make([]byte, 16)
+	n, err := r.ReadAt(p, 2)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, "bbcccceeee", string(p[:n]))
+	assert.Equal(t, 2, opened)
+
+	p = make([]byte, blockSize)
+	_, err = r.ReadAt(p, 2*blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, "eeee", string(p))
+	assert.Equal(t, 2, opened)
+}
@@ -0,0 +1,369 @@
+package build
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	peerReadTimeout = 10 * time.Second
+
+	// peerMaxMessageSize leaves the space for the message overhead around the chunk.
+	peerMaxMessageSize = block.ChunkSize + 1<<20
+
+	snapshotReadTimeout = 30 * time.Second
+	// snapshotMaxMessageSize is large enough for the headers of the fragmented rootfs.
+	snapshotMaxMessageSize = 512 << 20
+
+	// peerAuthHeader carries the token shared by the orchestrators of the cluster.
+	peerAuthHeader = "x-peer-auth-token"
+
+	// The failed peer is skipped for the backoff doubled with every consecutive failure.
+	peerBaseBackoff = 5 * time.Second
+	peerMaxBackoff  = 5 * time.Minute
+)
+
+var (
+	// ErrChunkNotCached is returned when the chunk can't be served to the peers without fetching it from the storage.
+	ErrChunkNotCached = errors.New("chunk is not cached")
+
+	// ErrPeerAuthNotConfigured is returned when the orchestrators don't share the token, the peers are not used then.
+	ErrPeerAuthNotConfigured = errors.New("peer auth token is not configured")
+)
+
+// PeerAuthToken is the token shared by the orchestrators of the cluster, the chunks and snapshots are served only to the peers presenting it.
+var PeerAuthToken = os.Getenv("PEER_AUTH_TOKEN")
+
+// AuthorizePeer checks that the request comes from the orchestrator presenting the token.
+// All requests are rejected when the token is not configured.
+func AuthorizePeer(ctx context.Context, token string) error {
+	if token == "" {
+		return status.Error(codes.Unavailable, ErrPeerAuthNotConfigured.Error())
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "error getting metadata from context")
+	}
+
+	tokens := md.Get(peerAuthHeader)
+	if len(tokens) != 1 {
+		return status.Error(codes.Unauthenticated, "peer auth token is missing")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(token)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid peer auth token")
+	}
+
+	return nil
+}
+
+// sealPeerData encrypts the data sent to the other orchestrator with the data key the data are stored with.
+// The token only lets the peer ask, the data are read only by the orchestrator that gets the key from the KMS itself.
+// The data of the unencrypted diffs are sent as they are stored.
+func sealPeerData(aead cipher.AEAD, scope header.EncryptionScope, off int64, data []byte) ([]byte, error) {
+	if aead == nil {
+		return data, nil
+	}
+
+	return header.SealFrame(aead, header.PeerScope(scope), uint64(off), data)
+}
+
+// openPeerData decrypts the data sealed by sealPeerData.
+func openPeerData(aead cipher.AEAD, scope header.EncryptionScope, off int64, data []byte) ([]byte, error) {
+	if aead == nil {
+		return data, nil
+	}
+
+	return header.OpenFrame(aead, header.PeerScope(scope), uint64(off), data)
+}
+
+// teamDataKey returns the cipher of the data key of the team, the snapshots of the team are uploaded encrypted with it.
+// It returns nil if the encryption is disabled.
+func teamDataKey(ctx context.Context, persistence storage.StorageProvider, teamID string) (cipher.AEAD, error) {
+	keys, err := storage.GetDataKeys()
+	if err != nil || keys == nil {
+		return nil, err
+	}
+
+	if teamID == "" {
+		return nil, errors.New("the team of the diff is unknown, its data can't be sealed")
+	}
+
+	_, aead, err := keys.TeamKey(ctx, persistence, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead, nil
+}
+
+// SealPeerSnapfile seals the snapfile of the snapshot migrated to the other orchestrator with the data key of the team.
+func SealPeerSnapfile(ctx context.Context, persistence storage.StorageProvider, teamID, buildID string, snapfile []byte) ([]byte, error) {
+	aead, err := teamDataKey(ctx, persistence, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	return sealPeerData(aead, storage.SnapfileScope(buildID), 0, snapfile)
+}
+
+// OpenPeerSnapfile opens the snapfile sealed by SealPeerSnapfile.
+func OpenPeerSnapfile(ctx context.Context, persistence storage.StorageProvider, teamID, buildID string, sealed []byte) ([]byte, error) {
+	aead, err := teamDataKey(ctx, persistence, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	return openPeerData(aead, storage.SnapfileScope(buildID), 0, sealed)
+}
+
+type peerCredentials struct {
+	token string
+}
+
+func (c peerCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{peerAuthHeader: c.token}, nil
+}
+
+func (c peerCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// PeerClient reads the chunks of the diffs cached by the other orchestrators.
+// The connections to the peers are kept for the lifetime of the client.
+type PeerClient struct {
+	token string
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewPeerClient(token string) *PeerClient {
+	return &PeerClient{
+		token: token,
+		conns: make(map[string]*grpc.ClientConn),
+	}
+}
+
+// Enabled reports whether the client can authenticate to the peers.
+func (p *PeerClient) Enabled() bool {
+	return p.token != ""
+}
+
+func (p *PeerClient) client(address string) (orchestrator.ChunkServiceClient, error) {
+	if !p.Enabled() {
+		return nil, ErrPeerAuthNotConfigured
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conn, ok := p.conns[address]
+	if !ok {
+		var err error
+
+		conn, err = grpc.NewClient(address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(peerCredentials{token: p.token}),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(peerMaxMessageSize)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to peer %s: %w", address, err)
+		}
+
+		p.conns[address] = conn
+	}
+
+	return orchestrator.NewChunkServiceClient(conn), nil
+}
+
+func (p *PeerClient) readChunk(ctx context.Context, address, name string, diffType DiffType, off, length int64) ([]byte, error) {
+	client, err := p.client(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, peerReadTimeout)
+	defer cancel()
+
+	res, err := client.Read(ctx, &orchestrator.ChunkReadRequest{
+		BuildId:  name,
+		DiffType: string(diffType),
+		Offset:   off,
+		Length:   length,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetData(), nil
+}
+
+// ReadSnapshot reads the headers and the snapfile of the snapshot paused on the peer.
+func (p *PeerClient) ReadSnapshot(ctx context.Context, address, templateID, buildID string) (*orchestrator.SnapshotReadResponse, error) {
+	client, err := p.client(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, snapshotReadTimeout)
+	defer cancel()
+
+	return client.ReadSnapshot(ctx, &orchestrator.SnapshotReadRequest{
+		TemplateId: templateID,
+		BuildId:    buildID,
+	}, grpc.MaxCallRecvMsgSize(snapshotMaxMessageSize))
+}
+
+func (p *PeerClient) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	for address, conn := range p.conns {
+		errs = append(errs, conn.Close())
+		delete(p.conns, address)
+	}
+
+	return errors.Join(errs...)
+}
+
+// peerReader reads the chunks from the peers that have the diff cached and falls back to the base reader.
+// The peers that fail with other than the not found error are skipped for a backoff growing with the consecutive failures.
+// The chunks of the encrypted diffs are sealed by the peer, they are opened with the data key of the diff.
+type peerReader struct {
+	ctx      context.Context
+	client   *PeerClient
+	peers    []string
+	name     string
+	diffType DiffType
+	size     int64
+	aead     cipher.AEAD
+	base     io.ReaderAt
+
+	failedMu sync.Mutex
+	failed   map[string]peerFailure
+}
+
+type peerFailure struct {
+	failures int
+	until    time.Time
+}
+
+func newPeerReader(ctx context.Context, client *PeerClient, peers []string, name string, diffType DiffType, size int64, aead cipher.AEAD, base io.ReaderAt) *peerReader {
+	return &peerReader{
+		ctx:      ctx,
+		client:   client,
+		peers:    peers,
+		name:     name,
+		diffType: diffType,
+		size:     size,
+		aead:     aead,
+		base:     base,
+		failed:   make(map[string]peerFailure),
+	}
+}
+
+func (r *peerReader) available(peer string) bool {
+	r.failedMu.Lock()
+	defer r.failedMu.Unlock()
+
+	failure, ok := r.failed[peer]
+
+	return !ok || time.Now().After(failure.until)
+}
+
+func (r *peerReader) markFailed(peer string) time.Duration {
+	r.failedMu.Lock()
+	defer r.failedMu.Unlock()
+
+	failure := r.failed[peer]
+	backoff := peerBaseBackoff
+	for i := 0; i < failure.failures && backoff < peerMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > peerMaxBackoff {
+		backoff = peerMaxBackoff
+	}
+
+	failure.failures++
+	failure.until = time.Now().Add(backoff)
+	r.failed[peer] = failure
+
+	return backoff
+}
+
+func (r *peerReader) markAvailable(peer string) {
+	r.failedMu.Lock()
+	defer r.failedMu.Unlock()
+
+	delete(r.failed, peer)
+}
+
+func (r *peerReader) ReadAt(p []byte, off int64) (int, error) {
+	if !r.client.Enabled() {
+		return r.base.ReadAt(p, off)
+	}
+
+	expected := min(int64(len(p)), r.size-off)
+
+	for _, peer := range r.peers {
+		if !r.available(peer) {
+			continue
+		}
+
+		data, err := r.client.readChunk(r.ctx, peer, r.name, r.diffType, off, int64(len(p)))
+		if status.Code(err) == codes.NotFound {
+			r.markAvailable(peer)
+
+			continue
+		}
+
+		if err == nil {
+			data, err = openPeerData(r.aead, header.FileScope(r.name, string(r.diffType)), off, data)
+		}
+
+		if err == nil && int64(len(data)) != expected {
+			err = fmt.Errorf("peer returned %d bytes instead of %d", len(data), expected)
+		}
+
+		if err != nil {
+			backoff := r.markFailed(peer)
+			zap.L().Warn("failed to read chunk from peer, skipping it", zap.String("peer", peer), zap.String("name", r.name), zap.String("diff_type", string(r.diffType)), zap.Duration("backoff", backoff), zap.Error(err))
+
+			continue
+		}
+
+		r.markAvailable(peer)
+
+		n := copy(p, data)
+		if n < len(p) {
+			return n, io.EOF
+		}
+
+		return n, nil
+	}
+
+	return r.base.ReadAt(p, off)
+}
@@ -0,0 +1,269 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testPeerToken = "peer-token"
+
+type fakeChunkServer struct {
+	orchestrator.UnimplementedChunkServiceServer
+
+	data []byte
+	code codes.Code
+	// aead seals the served data the same as the orchestrator serving the encrypted diff.
+	aead   cipher.AEAD
+	served atomic.Int64
+}
+
+func (f *fakeChunkServer) Read(ctx context.Context, req *orchestrator.ChunkReadRequest) (*orchestrator.ChunkReadResponse, error) {
+	err := AuthorizePeer(ctx, testPeerToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.code != codes.OK {
+		return nil, status.Error(f.code, "fake error")
+	}
+
+	f.served.Add(1)
+	end := min(req.GetOffset()+req.GetLength(), int64(len(f.data)))
+
+	data, err := sealPeerData(f.aead, header.FileScope(req.GetBuildId(), req.GetDiffType()), req.GetOffset(), f.data[req.GetOffset():end])
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &orchestrator.ChunkReadResponse{Data: data}, nil
+}
+
+func newTestAEAD(t *testing.T, key byte) cipher.AEAD {
+	t.Helper()
+
+	block, err := aes.NewCipher(bytes.Repeat([]byte{key}, 32))
+	require.NoError(t, err)
+
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	return aead
+}
+
+func startChunkServer(t *testing.T, chunks *fakeChunkServer) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	orchestrator.RegisterChunkServiceServer(srv, chunks)
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+type countingReader struct {
+	*bytes.Reader
+
+	reads atomic.Int64
+}
+
+func (c *countingReader) ReadAt(p []byte, off int64) (int, error) {
+	c.reads.Add(1)
+
+	return c.Reader.ReadAt(p, off)
+}
+
+func TestPeerReader(t *testing.T) {
+	data := bytes.Repeat([]byte("chunk"), 1000)
+
+	missing := &fakeChunkServer{code: codes.NotFound}
+	broken := &fakeChunkServer{code: codes.Internal}
+	cached := &fakeChunkServer{data: data}
+
+	client := NewPeerClient(testPeerToken)
+	t.Cleanup(func() { client.Close() })
+
+	peers := []string{
+		startChunkServer(t, missing),
+		startChunkServer(t, broken),
+		startChunkServer(t, cached),
+	}
+
+	base := &countingReader{Reader: bytes.NewReader(data)}
+	r := newPeerReader(context.Background(), client, peers, "build", Memfile, int64(len(data)), nil, base)
+
+	b := make([]byte, 1024)
+	n, err := r.ReadAt(b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, data[:1024], b[:n])
+
+	// The last chunk is shorter than the read.
+	n, err = r.ReadAt(b, int64(len(data))-100)
+	require.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, data[len(data)-100:], b[:n])
+
+	assert.Equal(t, int64(2), cached.served.Load())
+	assert.Equal(t, int64(0), base.reads.Load())
+
+	// The broken peer is skipped after the first failure.
+	assert.False(t, r.available(peers[1]))
+	assert.True(t, r.available(peers[2]))
+
+	// The read falls back to the base when no peer has the chunk.
+	r = newPeerReader(context.Background(), client, peers[:1], "build", Memfile, int64(len(data)), nil, base)
+
+	n, err = r.ReadAt(b, 1024)
+	require.NoError(t, err)
+	assert.Equal(t, data[1024:1024+n], b[:n])
+	assert.Equal(t, int64(1), base.reads.Load())
+}
+
+func TestPeerReader_Sealed(t *testing.T) {
+	data := bytes.Repeat([]byte("chunk"), 1000)
+	aead := newTestAEAD(t, 7)
+
+	client := NewPeerClient(testPeerToken)
+	t.Cleanup(func() { client.Close() })
+
+	sealed := &fakeChunkServer{data: data, aead: aead}
+	peers := []string{startChunkServer(t, sealed)}
+
+	t.Run("sealed chunk is opened with the data key", func(t *testing.T) {
+		base := &countingReader{Reader: bytes.NewReader(data)}
+		r := newPeerReader(context.Background(), client, peers, "build", Memfile, int64(len(data)), aead, base)
+
+		b := make([]byte, 1024)
+		n, err := r.ReadAt(b, 1024)
+		require.NoError(t, err)
+		assert.Equal(t, data[1024:1024+n], b[:n])
+		assert.Equal(t, int64(0), base.reads.Load())
+	})
+
+	t.Run("chunk sealed with another key falls back to the base", func(t *testing.T) {
+		base := &countingReader{Reader: bytes.NewReader(data)}
+		r := newPeerReader(context.Background(), client, peers, "build", Memfile, int64(len(data)), newTestAEAD(t, 8), base)
+
+		b := make([]byte, 1024)
+		n, err := r.ReadAt(b, 0)
+		require.NoError(t, err)
+		assert.Equal(t, data[:n], b[:n])
+		assert.Equal(t, int64(1), base.reads.Load())
+	})
+
+	t.Run("unsealed chunk of the encrypted diff falls back to the base", func(t *testing.T) {
+		plain := &fakeChunkServer{data: data}
+
+		base := &countingReader{Reader: bytes.NewReader(data)}
+		r := newPeerReader(context.Background(), client, []string{startChunkServer(t, plain)}, "build", Memfile, int64(len(data)), aead, base)
+
+		b := make([]byte, 1024)
+		n, err := r.ReadAt(b, 0)
+		require.NoError(t, err)
+		assert.Equal(t, data[:n], b[:n])
+		assert.Equal(t, int64(1), plain.served.Load())
+		assert.Equal(t, int64(1), base.reads.Load())
+	})
+}
+
+func TestPeerReader_Backoff(t *testing.T) {
+	data := bytes.Repeat([]byte("chunk"), 1000)
+
+	peer := &fakeChunkServer{code: codes.Internal}
+
+	client := NewPeerClient(testPeerToken)
+	t.Cleanup(func() { client.Close() })
+
+	peers := []string{startChunkServer(t, peer)}
+	base := &countingReader{Reader: bytes.NewReader(data)}
+	r := newPeerReader(context.Background(), client, peers, "build", Memfile, int64(len(data)), nil, base)
+
+	b := make([]byte, 1024)
+	_, err := r.ReadAt(b, 0)
+	require.NoError(t, err)
+
+	failure := r.failed[peers[0]]
+	assert.Equal(t, 1, failure.failures)
+	assert.WithinDuration(t, time.Now().Add(peerBaseBackoff), failure.until, time.Second)
+
+	// The peer recovers, it's asked again once the backoff passes.
+	peer.code = codes.OK
+	peer.data = data
+
+	_, err = r.ReadAt(b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), peer.served.Load())
+
+	failure.until = time.Now().Add(-time.Second)
+	r.failed[peers[0]] = failure
+
+	_, err = r.ReadAt(b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), peer.served.Load())
+	assert.True(t, r.available(peers[0]))
+	assert.Empty(t, r.failed)
+
+	// The backoff grows with the consecutive failures up to the limit.
+	for range 20 {
+		r.markFailed(peers[0])
+	}
+	assert.WithinDuration(t, time.Now().Add(peerMaxBackoff), r.failed[peers[0]].until, time.Second)
+}
+
+func TestPeerClient_Auth(t *testing.T) {
+	data := bytes.Repeat([]byte("chunk"), 1000)
+
+	cached := &fakeChunkServer{data: data}
+	peers := []string{startChunkServer(t, cached)}
+
+	t.Run("peer with another token is rejected", func(t *testing.T) {
+		client := NewPeerClient("other-token")
+		t.Cleanup(func() { client.Close() })
+
+		_, err := client.readChunk(context.Background(), peers[0], "build", Memfile, 0, 1024)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("client without the token doesn't use the peers", func(t *testing.T) {
+		client := NewPeerClient("")
+		t.Cleanup(func() { client.Close() })
+
+		_, err := client.readChunk(context.Background(), peers[0], "build", Memfile, 0, 1024)
+		require.ErrorIs(t, err, ErrPeerAuthNotConfigured)
+
+		base := &countingReader{Reader: bytes.NewReader(data)}
+		r := newPeerReader(context.Background(), client, peers, "build", Memfile, int64(len(data)), nil, base)
+
+		b := make([]byte, 1024)
+		_, err = r.ReadAt(b, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), base.reads.Load())
+		assert.Empty(t, r.failed)
+	})
+
+	t.Run("server without the token rejects all peers", func(t *testing.T) {
+		err := AuthorizePeer(context.Background(), "")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	assert.Equal(t, int64(0), cached.served.Load())
+}
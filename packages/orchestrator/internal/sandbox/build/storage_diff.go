@@ -2,13 +2,18 @@ package build
 
 import (
 	"context"
+	"crypto/cipher"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 
+	"github.com/google/uuid"
+
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	storage "github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
@@ -23,6 +28,23 @@ type StorageDiff struct {
 	storagePath string
 	blockSize   int64
 	persistence storage.StorageProvider
+
+	compression header.CompressionType
+	// headerPath is the path of the build header with the compression index of the diff.
+	// It is empty for the content-addressed chunks, they are compressed as one frame.
+	headerPath string
+	// header is the build header already loaded by the caller, the header isn't fetched again if it is set.
+	header *header.Header
+	// keyID is the data key the diff is encrypted with, it is nil for the unencrypted diff.
+	keyID uuid.UUID
+	// hash is the hash of the content-addressed chunk, the fetched chunk is verified against it.
+	hash header.ChunkHash
+
+	name     string
+	diffType DiffType
+	// peers are the addresses of the orchestrators the chunks are read from before the storage.
+	peers      []string
+	peerClient *PeerClient
 }
 
 func newStorageDiff(
@@ -30,12 +52,64 @@ func newStorageDiff(
 	buildId string,
 	diffType DiffType,
 	blockSize int64,
+	compression header.CompressionType,
+	keyID uuid.UUID,
+	persistence storage.StorageProvider,
+) *StorageDiff {
+	path := storagePath(buildId, diffType)
+
+	diff := newStorageDiffFromPath(
+		basePath,
+		buildId,
+		path,
+		diffType,
+		blockSize,
+		compression,
+		keyID,
+		persistence,
+	)
+	diff.headerPath = path + storage.HeaderSuffix
+
+	return diff
+}
+
+// newContentStorageDiff returns the diff reading the content-addressed chunk.
+// The chunk is cached under its name, so the builds sharing the chunk fetch it only once.
+func newContentStorageDiff(
+	basePath string,
+	mapping *header.BuildMap,
+	diffType DiffType,
+	blockSize int64,
+	persistence storage.StorageProvider,
+) *StorageDiff {
+	diff := newStorageDiffFromPath(
+		basePath,
+		mapping.ChunkName(),
+		storage.StorageContentChunkPath(mapping.ChunkName()),
+		diffType,
+		blockSize,
+		mapping.Compression,
+		mapping.KeyId,
+		persistence,
+	)
+	diff.hash = mapping.Hash
+
+	return diff
+}
+
+func newStorageDiffFromPath(
+	basePath string,
+	name string,
+	storagePath string,
+	diffType DiffType,
+	blockSize int64,
+	compression header.CompressionType,
+	keyID uuid.UUID,
 	persistence storage.StorageProvider,
 ) *StorageDiff {
 	cachePathSuffix := id.Generate()
 
-	storagePath := storagePath(buildId, diffType)
-	cacheFile := fmt.Sprintf("%s-%s-%s", buildId, diffType, cachePathSuffix)
+	cacheFile := fmt.Sprintf("%s-%s-%s", name, diffType, cachePathSuffix)
 	cachePath := filepath.Join(basePath, cacheFile)
 
 	return &StorageDiff{
@@ -43,8 +117,12 @@ func newStorageDiff(
 		cachePath:   cachePath,
 		chunker:     utils.NewSetOnce[*block.Chunker](),
 		blockSize:   blockSize,
+		compression: compression,
+		keyID:       keyID,
 		persistence: persistence,
-		cacheKey:    GetDiffStoreKey(buildId, diffType),
+		cacheKey:    GetDiffStoreKey(name, diffType),
+		name:        name,
+		diffType:    diffType,
 	}
 }
 
@@ -65,7 +143,53 @@ func (b *StorageDiff) Init(ctx context.Context) error {
 		return errMsg
 	}
 
-	chunker, err := block.NewChunker(ctx, size, b.blockSize, obj, b.cachePath)
+	var base io.ReaderAt = obj
+
+	// The build header has the compression index and the checksums of the build diff.
+	buildHeader := b.header
+
+	if buildHeader == nil && b.headerPath != "" {
+		buildHeader, err = b.buildHeader(ctx)
+		if err != nil {
+			errMsg := fmt.Errorf("failed to get build header: %w", err)
+			b.chunker.SetError(errMsg)
+			return errMsg
+		}
+	}
+
+	aead, err := b.dataKey(ctx)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to get data key: %w", err)
+		b.chunker.SetError(errMsg)
+		return errMsg
+	}
+
+	// The chunker fetches the plain data, so the frames are decrypted and decompressed on fetch.
+	if b.compression != header.CompressionNone || b.keyID != uuid.Nil {
+		index, err := b.compressionIndex(buildHeader, size)
+		if err != nil {
+			errMsg := fmt.Errorf("failed to get compression index: %w", err)
+			b.chunker.SetError(errMsg)
+			return errMsg
+		}
+
+		base = header.NewFrameReader(index, aead, b.encryptionScope(), obj)
+		size = int64(index.Size)
+	}
+
+	// The peers seal the data with the data key of the diff, the opened data are verified the same as the data from the storage.
+	if len(b.peers) > 0 && b.peerClient != nil {
+		base = newPeerReader(ctx, b.peerClient, b.peers, b.name, b.diffType, size, aead, base)
+	}
+
+	base, err = b.verifyingReader(buildHeader, base, size)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to verify diff: %w", err)
+		b.chunker.SetError(errMsg)
+		return errMsg
+	}
+
+	chunker, err := block.NewChunker(ctx, size, b.blockSize, base, b.cachePath)
 	if err != nil {
 		errMsg := fmt.Errorf("failed to create chunker: %w", err)
 		b.chunker.SetError(errMsg)
@@ -75,6 +199,88 @@ func (b *StorageDiff) Init(ctx context.Context) error {
 	return b.chunker.SetValue(chunker)
 }
 
+// compressionIndex returns the index of the compressed frames of the object.
+// The content-addressed chunk is one frame of at most the content chunk size.
+func (b *StorageDiff) compressionIndex(h *header.Header, size int64) (*header.CompressionIndex, error) {
+	if h == nil && b.headerPath != "" {
+		return nil, fmt.Errorf("%s is compressed or encrypted, but it has no header", b.storagePath)
+	}
+
+	if h == nil {
+		return &header.CompressionIndex{
+			Type:    b.compression,
+			Size:    header.ContentChunkSize,
+			Offsets: []uint64{0, uint64(size)},
+		}, nil
+	}
+
+	if h.Compression == nil || h.Compression.Type != b.compression {
+		return nil, fmt.Errorf("header of %s doesn't have the %s compression index", b.storagePath, b.compression)
+	}
+
+	return h.Compression, nil
+}
+
+// buildHeader fetches the header of the build diff, it returns nil for the builds uploaded without a header.
+func (b *StorageDiff) buildHeader(ctx context.Context) (*header.Header, error) {
+	obj, err := b.persistence.OpenObject(ctx, b.headerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := header.Deserialize(obj)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize header: %w", err)
+	}
+
+	return h, nil
+}
+
+// verifyingReader returns the reader verifying the fetched chunks against the checksums of the build diff or against the hash of the content-addressed chunk.
+// The diffs uploaded before the checksums were added are not verified.
+func (b *StorageDiff) verifyingReader(h *header.Header, base io.ReaderAt, size int64) (io.ReaderAt, error) {
+	if !b.hash.IsZero() {
+		return header.NewContentChunkReader(b.hash, base), nil
+	}
+
+	if h == nil || h.Checksums == nil {
+		return base, nil
+	}
+
+	return header.NewChecksumReader(h.Checksums, size, base)
+}
+
+// encryptionScope returns the scope the frames of the diff are encrypted for.
+func (b *StorageDiff) encryptionScope() header.EncryptionScope {
+	if !b.hash.IsZero() {
+		return header.ChunkScope(b.hash)
+	}
+
+	return header.FileScope(b.name, string(b.diffType))
+}
+
+// dataKey returns the cipher of the data key the diff is encrypted with, or nil if the diff is not encrypted.
+func (b *StorageDiff) dataKey(ctx context.Context) (cipher.AEAD, error) {
+	if b.keyID == uuid.Nil {
+		return nil, nil
+	}
+
+	keys, err := storage.GetDataKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	if keys == nil {
+		return nil, fmt.Errorf("%s is encrypted with data key %s, but no KMS is configured", b.storagePath, b.keyID)
+	}
+
+	return keys.Key(ctx, b.persistence, b.keyID)
+}
+
 func (b *StorageDiff) Close() error {
 	c, err := b.chunker.Wait()
 	if err != nil {
@@ -102,6 +308,27 @@ func (b *StorageDiff) Slice(off, length int64) ([]byte, error) {
 	return c.Slice(off, length)
 }
 
+// PeerSlice returns the data sealed for the peer only if they were already fetched, it never reads from the storage.
+// The data are sealed with the data key the diff is stored with, the data of the unencrypted diff are returned as stored.
+func (b *StorageDiff) PeerSlice(ctx context.Context, _ storage.StorageProvider, off, length int64) ([]byte, error) {
+	c, err := b.chunker.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.CachedSlice(off, length)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := b.dataKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	return sealPeerData(aead, header.FileScope(b.name, string(b.diffType)), off, data)
+}
+
 func (b *StorageDiff) WriteTo(w io.Writer) (int64, error) {
 	c, err := b.chunker.Wait()
 	if err != nil {
@@ -0,0 +1,127 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// countingStorage records the objects opened through the storage.
+type countingStorage struct {
+	storage.StorageProvider
+
+	mu     sync.Mutex
+	opened []string
+}
+
+func (c *countingStorage) OpenObject(ctx context.Context, path string) (storage.StorageObjectProvider, error) {
+	c.mu.Lock()
+	c.opened = append(c.opened, path)
+	c.mu.Unlock()
+
+	return c.StorageProvider.OpenObject(ctx, path)
+}
+
+func newTestStorage(t *testing.T) *countingStorage {
+	t.Helper()
+
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	return &countingStorage{StorageProvider: persistence}
+}
+
+func writeTestObject(t *testing.T, persistence storage.StorageProvider, path string, data []byte) {
+	t.Helper()
+
+	object, err := persistence.OpenObject(context.Background(), path)
+	require.NoError(t, err)
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	require.NoError(t, err)
+}
+
+func readTestDiff(t *testing.T, diff *StorageDiff, size int) ([]byte, error) {
+	t.Helper()
+
+	require.NoError(t, diff.Init(context.Background()))
+	t.Cleanup(func() { diff.Close() })
+
+	data := make([]byte, size)
+	_, err := diff.ReadAt(data, 0)
+
+	return data, err
+}
+
+func TestStorageDiff_Init(t *testing.T) {
+	buildID := uuid.New()
+	data := bytes.Repeat([]byte("diff"), int(blockSize))
+
+	testHeader := func(checksums []uint32) *header.Header {
+		h := header.NewHeader(header.NewTemplateMetadata(buildID, uint64(blockSize), uint64(len(data))), nil)
+		h.SetChecksums(checksums)
+
+		return h
+	}
+
+	t.Run("build without a header is read unverified", func(t *testing.T) {
+		persistence := newTestStorage(t)
+		writeTestObject(t, persistence, storagePath(buildID.String(), Rootfs), data)
+
+		diff := newStorageDiff(t.TempDir(), buildID.String(), Rootfs, blockSize, header.CompressionNone, uuid.Nil, persistence)
+
+		read, err := readTestDiff(t, diff, len(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, read)
+	})
+
+	t.Run("fetched header verifies the diff", func(t *testing.T) {
+		persistence := newTestStorage(t)
+		writeTestObject(t, persistence, storagePath(buildID.String(), Rootfs), data)
+
+		serialized, err := header.SerializeHeader(testHeader([]uint32{header.Checksum(data) + 1}))
+		require.NoError(t, err)
+
+		var headerData bytes.Buffer
+		_, err = headerData.ReadFrom(serialized)
+		require.NoError(t, err)
+
+		writeTestObject(t, persistence, storagePath(buildID.String(), Rootfs)+storage.HeaderSuffix, headerData.Bytes())
+
+		diff := newStorageDiff(t.TempDir(), buildID.String(), Rootfs, blockSize, header.CompressionNone, uuid.Nil, persistence)
+
+		_, err = readTestDiff(t, diff, len(data))
+		assert.Error(t, err, "the diff doesn't match the checksums of the header")
+	})
+
+	t.Run("loaded header isn't fetched again", func(t *testing.T) {
+		persistence := newTestStorage(t)
+		writeTestObject(t, persistence, storagePath(buildID.String(), Rootfs), data)
+
+		diff := newStorageDiff(t.TempDir(), buildID.String(), Rootfs, blockSize, header.CompressionNone, uuid.Nil, persistence)
+		diff.header = testHeader([]uint32{header.Checksum(data)})
+
+		read, err := readTestDiff(t, diff, len(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, read)
+
+		assert.NotContains(t, persistence.opened, storagePath(buildID.String(), Rootfs)+storage.HeaderSuffix)
+	})
+
+	t.Run("compressed build without a header fails", func(t *testing.T) {
+		persistence := newTestStorage(t)
+		writeTestObject(t, persistence, storagePath(buildID.String(), Rootfs), data)
+
+		diff := newStorageDiff(t.TempDir(), buildID.String(), Rootfs, blockSize, header.CompressionZstd, uuid.Nil, persistence)
+
+		assert.Error(t, diff.Init(context.Background()))
+	})
+}
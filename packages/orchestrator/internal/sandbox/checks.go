@@ -15,15 +15,22 @@ import (
 const (
 	healthCheckInterval = 20 * time.Second
 	healthCheckTimeout  = 100 * time.Millisecond
+	oomBaselineTimeout  = time.Second
 )
 
 type Checks struct {
 	sandbox *Sandbox
+	tracer  trace.Tracer
 
 	ctx       context.Context
 	cancelCtx context.CancelCauseFunc
 
 	healthy atomic.Bool
+	// oomKills is updated with every metrics read, the older envd versions don't report it.
+	oomKills atomic.Int64
+	// oomKillsBaseline is the count read at the sandbox start, -1 until the first read.
+	// The guest kernel of the resumed sandbox counts the kills since its original boot.
+	oomKillsBaseline atomic.Int64
 
 	UseClickhouseMetrics bool
 }
@@ -38,6 +45,7 @@ func NewChecks(ctx context.Context, tracer trace.Tracer, sandbox *Sandbox, useCl
 	ctx, cancel := context.WithCancelCause(context.Background())
 	h := &Checks{
 		sandbox:              sandbox,
+		tracer:               tracer,
 		ctx:                  ctx,
 		cancelCtx:            cancel,
 		healthy:              atomic.Bool{}, // defaults to `false`
@@ -45,6 +53,7 @@ func NewChecks(ctx context.Context, tracer trace.Tracer, sandbox *Sandbox, useCl
 	}
 	// By default, the sandbox should be healthy, if the status change we report it.
 	h.healthy.Store(true)
+	h.oomKillsBaseline.Store(-1)
 	return h, nil
 }
 
@@ -64,17 +73,28 @@ func (c *Checks) logHealth() {
 
 	// Get metrics and health status on sandbox startup
 	go c.Healthcheck(false)
+	go c.recordOOMBaseline()
 
 	for {
 		select {
 		case <-healthTicker.C:
 			c.Healthcheck(false)
+			c.observeBalloon()
 		case <-c.ctx.Done():
 			return
 		}
 	}
 }
 
+// recordOOMBaseline reads the OOM kills at the sandbox start, so only the kills after the start are reported.
+// If the read fails, the first successful metrics read is used as the baseline.
+func (c *Checks) recordOOMBaseline() {
+	_, err := c.GetMetrics(oomBaselineTimeout)
+	if err != nil && context.Cause(c.ctx) == nil {
+		sbxlogger.I(c.sandbox).Debug("failed to read the OOM kills baseline", zap.Error(err))
+	}
+}
+
 func (c *Checks) Healthcheck(alwaysReport bool) {
 	ok, err := c.GetHealth(healthCheckTimeout)
 	// Sandbox stopped
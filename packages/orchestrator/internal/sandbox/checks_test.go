@@ -0,0 +1,29 @@
+package sandbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestChecks_OOMKills(t *testing.T) {
+	checks, err := NewChecks(context.Background(), noop.NewTracerProvider().Tracer(""), nil, false)
+	require.NoError(t, err)
+	t.Cleanup(checks.Stop)
+
+	assert.Equal(t, int64(0), checks.OOMKills(), "no count was reported yet")
+
+	// The resumed sandbox reports the kills from before the snapshot.
+	checks.observeOOMKills(3)
+	assert.Equal(t, int64(0), checks.OOMKills())
+
+	checks.observeOOMKills(5)
+	assert.Equal(t, int64(2), checks.OOMKills())
+
+	// The baseline is kept from the first read.
+	checks.observeOOMKills(4)
+	assert.Equal(t, int64(1), checks.OOMKills())
+}
@@ -88,6 +88,8 @@ func cleanupFiles(files *storage.SandboxFiles) error {
 
 	for _, p := range []string{
 		files.SandboxFirecrackerSocketPath(),
+		files.SandboxFirecrackerStdoutPath(),
+		files.SandboxFirecrackerStderrPath(),
 		files.SandboxUffdSocketPath(),
 		files.SandboxCacheRootfsLinkPath(),
 	} {
@@ -0,0 +1,87 @@
+package dataset
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// GuestDevicePath returns the dataset drive in the guest, the dataset drives follow the rootfs and the volume drives.
+func GuestDevicePath(index int) string {
+	return fmt.Sprintf("/dev/vd%c", 'c'+index)
+}
+
+// Dataset serves the rootfs of a template build to the sandbox as a read-only NBD device.
+// The device reads the blocks through the build store, so the sandboxes on the node share one cache of the dataset.
+type Dataset struct {
+	config *orchestrator.SandboxDataset
+
+	mnt   *nbd.DirectPathMount
+	ready *utils.SetOnce[string]
+
+	tracer trace.Tracer
+}
+
+func New(
+	ctx context.Context,
+	tracer trace.Tracer,
+	templateCache *template.Cache,
+	devicePool *nbd.DevicePool,
+	config *orchestrator.SandboxDataset,
+) (*Dataset, error) {
+	device, err := templateCache.GetDataset(ctx, config.GetBuildId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dataset build '%s': %w", config.GetBuildId(), err)
+	}
+
+	return &Dataset{
+		config: config,
+		mnt:    nbd.NewReadonlyPathMount(tracer, device, devicePool),
+		ready:  utils.NewSetOnce[string](),
+		tracer: tracer,
+	}, nil
+}
+
+func (d *Dataset) Start(ctx context.Context) error {
+	deviceIndex, err := d.mnt.Open(ctx)
+	if err != nil {
+		return d.ready.SetError(fmt.Errorf("error opening dataset device: %w", err))
+	}
+
+	return d.ready.SetValue(nbd.GetDevicePath(deviceIndex))
+}
+
+func (d *Dataset) Path() (string, error) {
+	return d.ready.Wait()
+}
+
+func (d *Dataset) MountPath() string {
+	return d.config.GetMountPath()
+}
+
+func (d *Dataset) Close(ctx context.Context) error {
+	ctx, span := d.tracer.Start(ctx, "dataset-close", trace.WithAttributes(
+		attribute.String("dataset.build_id", d.config.GetBuildId()),
+	))
+	defer span.End()
+
+	_, err := d.Path()
+	if err != nil {
+		// The device was never opened.
+		return err
+	}
+
+	err = d.mnt.Close(ctx)
+	if err != nil {
+		return fmt.Errorf("error closing dataset mount: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package dataset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
+)
+
+func TestGuestDevicePath(t *testing.T) {
+	// The rootfs is /dev/vda and the volume is the next drive.
+	assert.Equal(t, "/dev/vdb", volume.GuestDevicePath)
+
+	assert.Equal(t, "/dev/vdc", GuestDevicePath(0))
+	assert.Equal(t, "/dev/vdf", GuestDevicePath(3))
+}
@@ -28,12 +28,23 @@ func (r *RootfsDiffCreator) process(ctx context.Context, out io.Writer) (*header
 	return r.rootfs.ExportDiff(ctx, out, r.stopHook)
 }
 
+// LiveRootfsDiffCreator exports the rootfs diff without stopping the sandbox.
+type LiveRootfsDiffCreator struct {
+	rootfs rootfs.Provider
+}
+
+func (r *LiveRootfsDiffCreator) process(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	return r.rootfs.ExportLiveDiff(ctx, out)
+}
+
 type MemoryDiffCreator struct {
 	tracer     trace.Tracer
 	memfile    *storage.TemporaryMemfile
 	dirtyPages *bitset.BitSet
-	blockSize  int64
-	doneHook   func(context.Context) error
+	// releasedPages are the pages released by the guest, they are stored as empty instead of copying them from the memfile.
+	releasedPages *bitset.BitSet
+	blockSize     int64
+	doneHook      func(context.Context) error
 }
 
 func (r *MemoryDiffCreator) process(ctx context.Context, out io.Writer) (h *header.DiffMetadata, e error) {
@@ -50,7 +61,7 @@ func (r *MemoryDiffCreator) process(ctx context.Context, out io.Writer) (h *head
 	}
 	defer memfileSource.Close()
 
-	return header.WriteDiffWithTrace(
+	m, err := header.WriteDiffWithTrace(
 		ctx,
 		r.tracer,
 		memfileSource,
@@ -58,4 +69,13 @@ func (r *MemoryDiffCreator) process(ctx context.Context, out io.Writer) (h *head
 		r.dirtyPages,
 		out,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.releasedPages != nil {
+		m.Empty.InPlaceUnion(r.releasedPages)
+	}
+
+	return m, nil
 }
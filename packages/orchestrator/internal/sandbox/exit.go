@@ -0,0 +1,44 @@
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// ClassifyExit explains why the sandbox exited on its own, it is called after the sandbox Wait returns.
+// The kernel panic written to the serial console takes precedence over the OOM kills reported by envd,
+// the sandbox without either is reported as exited with the exit code of the FC process.
+func (s *Sandbox) ClassifyExit(waitErr error) *orchestrator.SandboxExit {
+	exit := &orchestrator.SandboxExit{
+		Reason:  orchestrator.SandboxExitReason_ExitProcessExited,
+		Message: "sandbox process exited",
+	}
+
+	if waitErr != nil {
+		exit.Message = waitErr.Error()
+	}
+
+	if code, ok := s.process.ExitCode(); ok {
+		exit.ExitCode = &code
+	}
+
+	if message, ok := s.process.KernelPanic(); ok {
+		exit.Reason = orchestrator.SandboxExitReason_ExitKernelPanic
+		exit.Message = message
+
+		if strings.Contains(strings.ToLower(message), "out of memory") {
+			exit.Reason = orchestrator.SandboxExitReason_ExitOutOfMemory
+		}
+
+		return exit
+	}
+
+	if oomKills := s.Checks.OOMKills(); oomKills > 0 {
+		exit.Reason = orchestrator.SandboxExitReason_ExitOutOfMemory
+		exit.Message = fmt.Sprintf("%d processes were killed by the guest OOM killer, %s", oomKills, exit.Message)
+	}
+
+	return exit
+}
@@ -0,0 +1,231 @@
+//go:build linux
+// +build linux
+
+package fc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	describeTimeout = 5 * time.Second
+
+	userfaultfdLink = "anon_inode:[userfaultfd]"
+)
+
+// AdoptProcess takes over the FC process started by the previous orchestrator run.
+// The pid is of the unshare process that wraps the FC, killing it kills the FC too.
+// The output FIFOs of the process are reopened, so the console is still read. The exit code of the process is lost,
+// the process is not a child of this orchestrator.
+func AdoptProcess(
+	ctx context.Context,
+	slot *network.Slot,
+	files *storage.SandboxFiles,
+	rootfsPath string,
+	templateID string,
+	teamID string,
+	pid int,
+) (*Process, error) {
+	socketPath := files.SandboxFirecrackerSocketPath()
+
+	// The pid can be reused by another process after the FC exited.
+	err := VerifyProcess(pid, socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pidfd, err := unix.PidfdOpen(pid, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening pidfd of fc process: %w", err)
+	}
+
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		unix.Close(pidfd)
+
+		return nil, fmt.Errorf("error finding fc process: %w", err)
+	}
+
+	p := &Process{
+		Exit:                  make(chan error, 1),
+		exited:                make(chan struct{}),
+		console:               &panicDetector{},
+		cmd:                   &exec.Cmd{Process: proc},
+		firecrackerSocketPath: socketPath,
+		client:                newApiClient(socketPath),
+		rootfsPath:            rootfsPath,
+		files:                 files,
+		slot:                  slot,
+	}
+
+	sbxMetadata := sbxlogger.SandboxMetadata{
+		SandboxID:  files.SandboxID,
+		TemplateID: templateID,
+		TeamID:     teamID,
+	}
+
+	// The process started before the output went through the FIFOs writes to the pipes of the previous run.
+	err = p.readOutputs(sbxMetadata, nil, nil)
+	if err != nil {
+		sbxlogger.I(sbxMetadata).Warn("failed to read output of adopted fc process, the kernel panic won't be detected", zap.Error(err))
+	}
+
+	// The process is not a child of this orchestrator, so it cannot be waited on.
+	go func() {
+		defer close(p.exited)
+		defer unix.Close(pidfd)
+
+		err := waitPidfd(pidfd)
+		p.waitOutput()
+
+		if err != nil {
+			zap.L().Error("error waiting for adopted fc process", zap.Int("pid", pid), zap.Error(err))
+
+			p.Exit <- fmt.Errorf("error waiting for fc process: %w", err)
+
+			return
+		}
+
+		p.Exit <- nil
+	}()
+
+	describeCtx, cancel := context.WithTimeout(ctx, describeTimeout)
+	defer cancel()
+
+	info, err := p.client.describeInstance(describeCtx)
+	if err != nil {
+		return nil, errors.Join(err, p.Stop())
+	}
+
+	if info.State == nil || *info.State != models.InstanceInfoStateRunning {
+		return nil, errors.Join(fmt.Errorf("fc instance is not running: %s", swag.StringValue(info.State)), p.Stop())
+	}
+
+	return p, nil
+}
+
+// VerifyProcess checks the pid belongs to the process started for the FC socket.
+func VerifyProcess(pid int, socketPath string) error {
+	cmdline, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "cmdline"))
+	if err != nil {
+		return fmt.Errorf("error reading cmdline of process %d: %w", pid, err)
+	}
+
+	if !bytes.Contains(cmdline, []byte(socketPath)) {
+		return fmt.Errorf("process %d is not the fc process", pid)
+	}
+
+	return nil
+}
+
+func waitPidfd(pidfd int) error {
+	fds := []unix.PollFd{{Fd: int32(pidfd), Events: unix.POLLIN}}
+
+	for {
+		_, err := unix.Poll(fds, -1)
+		if errors.Is(err, unix.EINTR) {
+			continue
+		}
+
+		return err
+	}
+}
+
+// Userfaultfd duplicates the userfaultfd the FC registered its memory with.
+// The fd is needed to continue serving the page faults of an adopted process.
+func (p *Process) Userfaultfd() (int, error) {
+	fcPid, err := findFirecrackerPid(p.files.FirecrackerPath(), p.firecrackerSocketPath)
+	if err != nil {
+		return 0, err
+	}
+
+	fdDir := filepath.Join("/proc", strconv.Itoa(fcPid), "fd")
+
+	entries, err := os.ReadDir(fdDir)
+	if err != nil {
+		return 0, fmt.Errorf("error listing fds of fc process: %w", err)
+	}
+
+	targetFd := -1
+	for _, entry := range entries {
+		link, err := os.Readlink(filepath.Join(fdDir, entry.Name()))
+		if err != nil || link != userfaultfdLink {
+			continue
+		}
+
+		targetFd, err = strconv.Atoi(entry.Name())
+		if err != nil {
+			return 0, fmt.Errorf("error parsing fd '%s': %w", entry.Name(), err)
+		}
+
+		break
+	}
+
+	if targetFd < 0 {
+		return 0, fmt.Errorf("fc process %d has no userfaultfd", fcPid)
+	}
+
+	pidfd, err := unix.PidfdOpen(fcPid, 0)
+	if err != nil {
+		return 0, fmt.Errorf("error opening pidfd of fc process: %w", err)
+	}
+	defer unix.Close(pidfd)
+
+	fd, err := unix.PidfdGetfd(pidfd, targetFd, 0)
+	if err != nil {
+		return 0, fmt.Errorf("error getting userfaultfd of fc process: %w", err)
+	}
+
+	return fd, nil
+}
+
+// findFirecrackerPid finds the FC process inside the unshare namespace by its API socket.
+func findFirecrackerPid(firecrackerPath, socketPath string) (int, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0, fmt.Errorf("error listing processes: %w", err)
+	}
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		cmdline, err := os.ReadFile(filepath.Join("/proc", entry.Name(), "cmdline"))
+		if err != nil {
+			continue
+		}
+
+		args := strings.Split(strings.TrimRight(string(cmdline), "\x00"), "\x00")
+		if args[0] != firecrackerPath {
+			continue
+		}
+
+		for i := 1; i < len(args)-1; i++ {
+			if args[i] == "--api-sock" && args[i+1] == socketPath {
+				return pid, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("fc process with socket '%s' not found", socketPath)
+}
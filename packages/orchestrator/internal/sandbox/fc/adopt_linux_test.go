@@ -0,0 +1,108 @@
+//go:build linux
+// +build linux
+
+package fc
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// outputScript writes to the console before the restart, while no orchestrator reads the output and after the adoption.
+// Its arguments are the FC socket, so the process is recognized as the FC, and the directory with the test markers.
+const outputScript = `echo "booted"
+while [ ! -e "$2/restarted" ]; do sleep 0.01; done
+echo "written during the restart" || exit 1
+while [ ! -e "$2/adopted" ]; do sleep 0.01; done
+echo "Kernel panic - not syncing: after the adoption" || exit 1`
+
+// serveRunningInstance serves the FC API that describes the running VM.
+func serveRunningInstance(t *testing.T, socketPath string) {
+	t.Helper()
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"app_name":"Firecracker","id":"test","state":"Running","vmm_version":"1.10.1"}`))
+	})}
+
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+}
+
+func TestAdoptProcess_Output(t *testing.T) {
+	files := storage.NewTemplateFiles("template", "build", "kernel", "firecracker").OpenSandboxFiles("i"+id.Generate(), id.Generate())
+	t.Cleanup(func() {
+		os.Remove(files.SandboxFirecrackerSocketPath())
+		os.Remove(files.SandboxFirecrackerStdoutPath())
+		os.Remove(files.SandboxFirecrackerStderrPath())
+	})
+
+	serveRunningInstance(t, files.SandboxFirecrackerSocketPath())
+
+	markers := t.TempDir()
+
+	// The previous orchestrator run starts the process with the output FIFOs.
+	stdout, err := createOutput(files.SandboxFirecrackerStdoutPath())
+	require.NoError(t, err)
+	defer stdout.Close()
+
+	stderr, err := createOutput(files.SandboxFirecrackerStderrPath())
+	require.NoError(t, err)
+	defer stderr.Close()
+
+	previous, err := os.OpenFile(files.SandboxFirecrackerStdoutPath(), os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	require.NoError(t, err)
+
+	cmd := exec.Command("sh", "-c", outputScript, "sh", files.SandboxFirecrackerSocketPath(), markers)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	stdout.Close()
+	stderr.Close()
+
+	line, err := bufio.NewReader(previous).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "booted\n", line)
+
+	// The previous orchestrator run exits, the process keeps writing its output.
+	require.NoError(t, previous.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(markers, "restarted"), nil, 0o600))
+
+	p, err := AdoptProcess(context.Background(), nil, files, "", "template", "team", cmd.Process.Pid)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(markers, "adopted"), nil, 0o600))
+
+	select {
+	case <-p.Exited():
+	case <-time.After(10 * time.Second):
+		t.Fatal("adopted process didn't exit")
+	}
+
+	// The panic is written after the output that was buffered during the restart, so the write of the process didn't fail.
+	message, ok := p.KernelPanic()
+	require.True(t, ok)
+	assert.Equal(t, "Kernel panic - not syncing: after the adoption", message)
+}
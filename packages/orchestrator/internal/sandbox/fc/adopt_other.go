@@ -0,0 +1,24 @@
+//go:build !linux
+// +build !linux
+
+package fc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func AdoptProcess(ctx context.Context, slot *network.Slot, files *storage.SandboxFiles, rootfsPath string, templateID string, teamID string, pid int) (*Process, error) {
+	return nil, errors.New("platform does not support adopting fc process")
+}
+
+func VerifyProcess(pid int, socketPath string) error {
+	return errors.New("platform does not support adopting fc process")
+}
+
+func (p *Process) Userfaultfd() (int, error) {
+	return 0, errors.New("platform does not support adopting fc process")
+}
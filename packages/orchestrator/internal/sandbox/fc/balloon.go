@@ -0,0 +1,28 @@
+package fc
+
+const (
+	// balloonStatsIntervalS is how often the guest reports the memory statistics to the balloon device.
+	// The statistics can't be enabled after the VM starts, so they are enabled for all VMs.
+	balloonStatsIntervalS = 1
+)
+
+// hasBalloon reports whether the VM gets the balloon device.
+// Firecracker doesn't support the balloon device for the memory backed by hugepages.
+func hasBalloon(hugePages bool) bool {
+	return !hugePages
+}
+
+// BalloonStats are the balloon size and the guest memory statistics reported through the balloon device.
+type BalloonStats struct {
+	// TargetMiB is the requested size of the balloon.
+	TargetMiB int64
+	// ActualMiB is the size of the balloon the guest already inflated to.
+	ActualMiB int64
+
+	// FreeMemory is the guest memory in bytes that is not used at all.
+	FreeMemory int64
+	// AvailableMemory is the guest memory in bytes that can be used without swapping, including the reclaimable caches.
+	AvailableMemory int64
+	// TotalMemory is the guest memory in bytes that is not held by the balloon.
+	TotalMemory int64
+}
@@ -163,7 +163,7 @@ func (c *apiClient) setBootSource(ctx context.Context, kernelArgs string, kernel
 	return nil
 }
 
-func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error {
+func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string, rateLimiter *models.RateLimiter) error {
 	rootfs := "rootfs"
 	ioEngine := "Async"
 	isRootDevice := true
@@ -176,6 +176,7 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 			IsRootDevice: &isRootDevice,
 			IsReadOnly:   false,
 			IoEngine:     &ioEngine,
+			RateLimiter:  rateLimiter,
 		},
 	}
 
@@ -187,14 +188,60 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 	return nil
 }
 
-func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string) error {
+// setDrive adds the drive the team volume or a dataset is attached to.
+// The drives are added to all templates, because they can't be added to the snapshot later.
+func (c *apiClient) setDrive(ctx context.Context, driveID string, path string, isReadOnly bool) error {
+	ioEngine := "Async"
+	isRootDevice := false
+	driveConfig := operations.PutGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.Drive{
+			DriveID:      &driveID,
+			PathOnHost:   path,
+			IsRootDevice: &isRootDevice,
+			IsReadOnly:   isReadOnly,
+			IoEngine:     &ioEngine,
+		},
+	}
+
+	_, err := c.client.Operations.PutGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc drive '%s' config: %w", driveID, err)
+	}
+
+	return nil
+}
+
+// updateDrivePath reopens the drive, the guest is notified about the new size of the drive.
+func (c *apiClient) updateDrivePath(ctx context.Context, driveID string, path string) error {
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.PartialDrive{
+			DriveID:    &driveID,
+			PathOnHost: path,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive '%s': %w", driveID, err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string, rateLimiter *models.RateLimiter) error {
 	networkConfig := operations.PutGuestNetworkInterfaceByIDParams{
 		Context: ctx,
 		IfaceID: ifaceID,
 		Body: &models.NetworkInterface{
-			IfaceID:     &ifaceID,
-			GuestMac:    tapMac,
-			HostDevName: &tapName,
+			IfaceID:       &ifaceID,
+			GuestMac:      tapMac,
+			HostDevName:   &tapName,
+			RxRateLimiter: rateLimiter,
+			TxRateLimiter: rateLimiter,
 		},
 	}
 
@@ -220,6 +267,122 @@ func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tap
 	return nil
 }
 
+func (c *apiClient) updateRootfsDriveRateLimiter(ctx context.Context, rateLimiter *models.RateLimiter) error {
+	rootfs := "rootfs"
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: rootfs,
+		Body: &models.PartialDrive{
+			DriveID:     &rootfs,
+			RateLimiter: rateLimiter,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateNetworkInterfaceRateLimiter(ctx context.Context, ifaceID string, rateLimiter *models.RateLimiter) error {
+	networkConfig := operations.PatchGuestNetworkInterfaceByIDParams{
+		Context: ctx,
+		IfaceID: ifaceID,
+		Body: &models.PartialNetworkInterface{
+			IfaceID:       &ifaceID,
+			RxRateLimiter: rateLimiter,
+			TxRateLimiter: rateLimiter,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestNetworkInterfaceByID(&networkConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc network rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) setBalloon(ctx context.Context, amountMiB int64, deflateOnOOM bool, statsIntervalS int64) error {
+	balloonConfig := operations.PutBalloonParams{
+		Context: ctx,
+		Body: &models.Balloon{
+			AmountMib:             &amountMiB,
+			DeflateOnOom:          &deflateOnOOM,
+			StatsPollingIntervals: statsIntervalS,
+		},
+	}
+
+	_, err := c.client.Operations.PutBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc balloon config: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMiB int64) error {
+	balloonConfig := operations.PatchBalloonParams{
+		Context: ctx,
+		Body: &models.BalloonUpdate{
+			AmountMib: &amountMiB,
+		},
+	}
+
+	_, err := c.client.Operations.PatchBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc balloon: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	statsParams := operations.DescribeBalloonStatsParams{
+		Context: ctx,
+	}
+
+	res, err := c.client.Operations.DescribeBalloonStats(&statsParams)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fc balloon stats: %w", err)
+	}
+
+	return res.Payload, nil
+}
+
+func (c *apiClient) describeInstance(ctx context.Context) (*models.InstanceInfo, error) {
+	describeParams := operations.DescribeInstanceParams{
+		Context: ctx,
+	}
+
+	res, err := c.client.Operations.DescribeInstance(&describeParams)
+	if err != nil {
+		return nil, fmt.Errorf("error describing fc instance: %w", err)
+	}
+
+	return res.Payload, nil
+}
+
+// setMemory configures the vCPUs and the memory of the VM together with the balloon device, if the VM can have one.
+// The balloon device can only be added before the VM starts, the sandboxes resumed from the snapshot inherit it.
+func (c *apiClient) setMemory(
+	ctx context.Context,
+	vCPUCount int64,
+	memoryMB int64,
+	hugePages bool,
+) error {
+	if hasBalloon(hugePages) {
+		err := c.setBalloon(ctx, 0, true, balloonStatsIntervalS)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.setMachineConfig(ctx, vCPUCount, memoryMB, hugePages)
+}
+
 func (c *apiClient) setMachineConfig(
 	ctx context.Context,
 	vCPUCount int64,
@@ -0,0 +1,142 @@
+//go:build linux
+// +build linux
+
+package fc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFirecrackerAPI struct {
+	mu       sync.Mutex
+	requests []string
+	bodies   map[string]map[string]any
+}
+
+// newFakeFirecrackerAPI serves the Firecracker API on a unix socket and records the configuration requests.
+func newFakeFirecrackerAPI(t *testing.T) (*fakeFirecrackerAPI, *apiClient) {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "fc.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	api := &fakeFirecrackerAPI{bodies: map[string]map[string]any{}}
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		api.mu.Lock()
+		api.requests = append(api.requests, r.Method+" "+r.URL.Path)
+		api.bodies[r.URL.Path] = body
+		api.mu.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	})}
+
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	return api, newApiClient(socketPath)
+}
+
+func TestApiClient_SetMemory(t *testing.T) {
+	t.Run("balloon is added without hugepages", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		err := client.setMemory(context.Background(), 2, 512, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"PUT /balloon", "PUT /machine-config"}, api.requests)
+		assert.Equal(t, map[string]any{"amount_mib": float64(0), "deflate_on_oom": true, "stats_polling_interval_s": float64(balloonStatsIntervalS)}, api.bodies["/balloon"])
+		assert.NotContains(t, api.bodies["/machine-config"], "huge_pages")
+	})
+
+	t.Run("balloon is skipped with hugepages", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		err := client.setMemory(context.Background(), 2, 512, true)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"PUT /machine-config"}, api.requests)
+		assert.Equal(t, "2M", api.bodies["/machine-config"]["huge_pages"])
+	})
+}
+
+func TestApiClient_SetDrive(t *testing.T) {
+	t.Run("dataset drive is read-only", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		require.NoError(t, client.setDrive(context.Background(), datasetDriveID(0), "/dataset", true))
+
+		assert.Equal(t, []string{"PUT /drives/dataset-0"}, api.requests)
+		assert.Equal(t, "dataset-0", api.bodies["/drives/dataset-0"]["drive_id"])
+		assert.Equal(t, "/dataset", api.bodies["/drives/dataset-0"]["path_on_host"])
+		assert.Equal(t, true, api.bodies["/drives/dataset-0"]["is_read_only"])
+		assert.Equal(t, false, api.bodies["/drives/dataset-0"]["is_root_device"])
+	})
+
+	t.Run("volume drive is writable", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		require.NoError(t, client.setDrive(context.Background(), volumeDriveID, "/volume", false))
+
+		assert.Equal(t, []string{"PUT /drives/volume"}, api.requests)
+		assert.NotContains(t, api.bodies["/drives/volume"], "is_read_only")
+	})
+
+	t.Run("resumed sandbox reopens the dataset drive", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		require.NoError(t, client.updateDrivePath(context.Background(), datasetDriveID(1), "/dev/nbd3"))
+
+		assert.Equal(t, []string{"PATCH /drives/dataset-1"}, api.requests)
+		assert.Equal(t, map[string]any{"drive_id": "dataset-1", "path_on_host": "/dev/nbd3"}, api.bodies["/drives/dataset-1"])
+	})
+}
+
+func TestApiClient_RateLimiters(t *testing.T) {
+	limits := RateLimits{DiskBandwidth: 1 << 20, DiskIOPS: 100, NetworkBandwidth: 2 << 20}
+
+	bucket := func(size int64) map[string]any {
+		return map[string]any{"size": float64(size), "refill_time": float64(rateLimiterRefillTimeMs)}
+	}
+
+	t.Run("limits are set with the devices", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		require.NoError(t, client.setRootfsDrive(context.Background(), "/rootfs", limits.driveRateLimiter()))
+		require.NoError(t, client.setNetworkInterface(context.Background(), "eth0", "tap0", "02:00:00:00:00:01", limits.networkRateLimiter()))
+
+		assert.Equal(t, []string{"PUT /drives/rootfs", "PUT /network-interfaces/eth0", "PUT /mmds/config"}, api.requests)
+		assert.Equal(t, map[string]any{"bandwidth": bucket(1 << 20), "ops": bucket(100)}, api.bodies["/drives/rootfs"]["rate_limiter"])
+
+		network := api.bodies["/network-interfaces/eth0"]
+		assert.Equal(t, map[string]any{"bandwidth": bucket(2 << 20)}, network["rx_rate_limiter"])
+		assert.Equal(t, map[string]any{"bandwidth": bucket(2 << 20)}, network["tx_rate_limiter"])
+	})
+
+	t.Run("limits of the running VM are replaced", func(t *testing.T) {
+		api, client := newFakeFirecrackerAPI(t)
+
+		require.NoError(t, client.updateRootfsDriveRateLimiter(context.Background(), RateLimits{}.driveRateLimiter()))
+		require.NoError(t, client.updateNetworkInterfaceRateLimiter(context.Background(), "eth0", limits.networkRateLimiter()))
+
+		assert.Equal(t, []string{"PATCH /drives/rootfs", "PATCH /network-interfaces/eth0"}, api.requests)
+		// The removed limits are sent as the empty buckets.
+		assert.Equal(t, map[string]any{"bandwidth": bucket(0), "ops": bucket(0)}, api.bodies["/drives/rootfs"]["rate_limiter"])
+		assert.Equal(t, map[string]any{"bandwidth": bucket(2 << 20)}, api.bodies["/network-interfaces/eth0"]["rx_rate_limiter"])
+		assert.Equal(t, map[string]any{"bandwidth": bucket(2 << 20)}, api.bodies["/network-interfaces/eth0"]["tx_rate_limiter"])
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
 )
 
 type apiClient struct{}
@@ -34,3 +35,31 @@ func (c *apiClient) pauseVM(ctx context.Context) error {
 func (c *apiClient) createSnapshot(ctx context.Context, snapfilePath string, memfilePath string) error {
 	return nil
 }
+
+func (c *apiClient) updateRootfsDriveRateLimiter(ctx context.Context, rateLimiter *models.RateLimiter) error {
+	return nil
+}
+
+func (c *apiClient) updateDrivePath(ctx context.Context, driveID string, path string) error {
+	return nil
+}
+
+func (c *apiClient) updateNetworkInterfaceRateLimiter(ctx context.Context, ifaceID string, rateLimiter *models.RateLimiter) error {
+	return nil
+}
+
+func (c *apiClient) setMemory(ctx context.Context, vCPUCount int64, memoryMB int64, hugePages bool) error {
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMiB int64) error {
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	return &models.BalloonStats{}, nil
+}
+
+func (c *apiClient) describeInstance(ctx context.Context) (*models.InstanceInfo, error) {
+	return &models.InstanceInfo{}, nil
+}
@@ -0,0 +1,72 @@
+package fc
+
+import (
+	"bytes"
+	"sync"
+)
+
+const (
+	kernelPanicPrefix = "Kernel panic - not syncing"
+
+	// maxConsoleLineLength limits the buffered line, the longer lines are not checked for the panic.
+	maxConsoleLineLength = 4096
+)
+
+// panicDetector scans the guest serial console written to the FC process stdout for the kernel panic message.
+type panicDetector struct {
+	mu       sync.Mutex
+	line     []byte
+	overflow bool
+	message  string
+}
+
+func (d *panicDetector) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data := p
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			if d.overflow || len(d.line)+len(data) > maxConsoleLineLength {
+				d.overflow = true
+				d.line = d.line[:0]
+			} else {
+				d.line = append(d.line, data...)
+			}
+
+			break
+		}
+
+		if !d.overflow && len(d.line)+i <= maxConsoleLineLength {
+			d.check(append(d.line, data[:i]...))
+		}
+
+		d.line = d.line[:0]
+		d.overflow = false
+		data = data[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (d *panicDetector) check(line []byte) {
+	if d.message != "" {
+		return
+	}
+
+	i := bytes.Index(line, []byte(kernelPanicPrefix))
+	if i < 0 {
+		return
+	}
+
+	d.message = string(bytes.TrimSpace(line[i:]))
+}
+
+// Panic returns the kernel panic message, if the guest kernel panicked.
+func (d *panicDetector) Panic() (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.message, d.message != ""
+}
@@ -0,0 +1,57 @@
+package fc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPanicDetector(t *testing.T) {
+	d := &panicDetector{}
+
+	_, ok := d.Panic()
+	assert.False(t, ok)
+
+	// The console output is written in arbitrary chunks.
+	_, err := d.Write([]byte("[    1.234] systemd[1]: started\n[   12.345] Kernel pa"))
+	require.NoError(t, err)
+
+	_, ok = d.Panic()
+	assert.False(t, ok)
+
+	_, err = d.Write([]byte("nic - not syncing: Out of memory and no killable processes...\r\n[   12.346] CPU: 0 PID: 1\n"))
+	require.NoError(t, err)
+
+	message, ok := d.Panic()
+	require.True(t, ok)
+	assert.Equal(t, "Kernel panic - not syncing: Out of memory and no killable processes...", message)
+
+	// Only the first panic is kept.
+	_, err = d.Write([]byte("Kernel panic - not syncing: Attempted to kill init!\n"))
+	require.NoError(t, err)
+
+	message, _ = d.Panic()
+	assert.Contains(t, message, "Out of memory")
+}
+
+func TestPanicDetectorLongLine(t *testing.T) {
+	d := &panicDetector{}
+
+	_, err := d.Write([]byte(strings.Repeat("x", maxConsoleLineLength)))
+	require.NoError(t, err)
+
+	_, err = d.Write([]byte("Kernel panic - not syncing: truncated\n"))
+	require.NoError(t, err)
+
+	_, ok := d.Panic()
+	assert.False(t, ok)
+
+	_, err = d.Write([]byte("Kernel panic - not syncing: VFS: Unable to mount root fs\n"))
+	require.NoError(t, err)
+
+	message, ok := d.Panic()
+	require.True(t, ok)
+	assert.Equal(t, "Kernel panic - not syncing: VFS: Unable to mount root fs", message)
+}
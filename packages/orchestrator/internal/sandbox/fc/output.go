@@ -0,0 +1,130 @@
+package fc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapio"
+
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+const (
+	// outputPipeSize is the buffer of the output FIFOs. While no orchestrator reads the output, e.g. during its restart,
+	// the FC writes to the buffer and blocks only after it is full.
+	outputPipeSize = 1 << 20
+
+	// outputDrainTimeout bounds the wait for the rest of the output after the FC process exited,
+	// a leftover process holding the FIFO open must not block the exit.
+	outputDrainTimeout = 5 * time.Second
+)
+
+// createOutput creates the FIFO the FC process writes its stdout or stderr to and opens its end for the process.
+// The end is opened for reading and writing, so the FIFO always has a reader and the writes of the process don't fail
+// with EPIPE after the orchestrator process exits. The next orchestrator process reopens the FIFO by its path.
+func createOutput(path string) (*os.File, error) {
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error removing fc output: %w", err)
+	}
+
+	err = syscall.Mkfifo(path, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error creating fc output: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening fc output: %w", err)
+	}
+
+	err = setPipeSize(f, outputPipeSize)
+	if err != nil {
+		zap.L().Warn("failed to set fc output size", zap.String("path", path), zap.Error(err))
+	}
+
+	return f, nil
+}
+
+// readOutput copies the output written to the FIFO until all the writers of the FIFO are closed.
+// The returned channel is closed after the copy ends.
+func readOutput(path string, w io.Writer) (<-chan struct{}, error) {
+	// The FIFO is opened without waiting for a writer, the end of the FC process keeps it open for writing.
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening fc output: %w", err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer f.Close()
+
+		_, err := io.Copy(w, f)
+		if err != nil {
+			zap.L().Warn("error reading fc output", zap.String("path", path), zap.Error(err))
+		}
+	}()
+
+	return done, nil
+}
+
+// readOutputs starts reading the stdout and stderr of the FC process from their FIFOs.
+// The stdout carries the guest serial console, so it is scanned for the kernel panic.
+func (p *Process) readOutputs(sbxMetadata sbxlogger.SandboxMetadata, stdoutExternal io.Writer, stderrExternal io.Writer) error {
+	stdoutWriter := &zapio.Writer{Log: sbxlogger.I(sbxMetadata).Logger, Level: zap.InfoLevel}
+	stdoutWriters := []io.Writer{stdoutWriter, p.console}
+	if stdoutExternal != nil {
+		stdoutWriters = append(stdoutWriters, stdoutExternal)
+	}
+
+	stdoutDone, err := readOutput(p.files.SandboxFirecrackerStdoutPath(), io.MultiWriter(stdoutWriters...))
+	if err != nil {
+		return err
+	}
+
+	stderrWriter := &zapio.Writer{Log: sbxlogger.I(sbxMetadata).Logger, Level: zap.ErrorLevel}
+	stderrWriters := []io.Writer{stderrWriter}
+	if stderrExternal != nil {
+		stderrWriters = append(stderrWriters, stderrExternal)
+	}
+
+	stderrDone, err := readOutput(p.files.SandboxFirecrackerStderrPath(), io.MultiWriter(stderrWriters...))
+	if err != nil {
+		return err
+	}
+
+	outputDone := make(chan struct{})
+	p.outputDone = outputDone
+
+	go func() {
+		defer close(outputDone)
+
+		<-stdoutDone
+		stdoutWriter.Close()
+
+		<-stderrDone
+		stderrWriter.Close()
+	}()
+
+	return nil
+}
+
+// waitOutput waits until the output the FC process wrote before it exited is read.
+func (p *Process) waitOutput() {
+	if p.outputDone == nil {
+		return
+	}
+
+	select {
+	case <-p.outputDone:
+	case <-time.After(outputDrainTimeout):
+		zap.L().Warn("fc output is still open after the process exited", zap.String("sandbox_id", p.files.SandboxID))
+	}
+}
@@ -0,0 +1,16 @@
+//go:build linux
+// +build linux
+
+package fc
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func setPipeSize(f *os.File, size int) error {
+	_, err := unix.FcntlInt(f.Fd(), unix.F_SETPIPE_SZ, size)
+
+	return err
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package fc
+
+import (
+	"os"
+)
+
+// setPipeSize keeps the default size, the pipe size can be changed only on Linux.
+func setPipeSize(f *os.File, size int) error {
+	return nil
+}
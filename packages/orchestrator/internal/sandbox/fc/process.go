@@ -11,10 +11,10 @@ import (
 	"syscall"
 	txtTemplate "text/template"
 
+	"github.com/go-openapi/swag"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapio"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/socket"
@@ -29,11 +29,30 @@ const startScript = `mount --make-rprivate / &&
 mount -t tmpfs tmpfs {{ .buildDir }} -o X-mount.mkdir &&
 mount -t tmpfs tmpfs {{ .buildKernelDir }} -o X-mount.mkdir &&
 ln -s {{ .rootfsPath }} {{ .buildRootfsPath }} &&
-ln -s {{ .kernelPath }} {{ .buildKernelPath }} &&
+ln -s {{ .volumePath }} {{ .buildVolumePath }} &&
+{{ range .datasets }}ln -s {{ .Link }} {{ .BuildPath }} &&
+{{ end }}ln -s {{ .kernelPath }} {{ .buildKernelPath }} &&
 ip netns exec {{ .namespaceID }} {{ .firecrackerPath }} --api-sock {{ .firecrackerSocket }}`
 
 var startScriptTemplate = txtTemplate.Must(txtTemplate.New("fc-start").Parse(startScript))
 
+// volumeDriveID is the drive the team volume is attached to.
+// Sandboxes without a volume have the drive pointing to /dev/null, so the guest sees an empty disk.
+const volumeDriveID = "volume"
+
+// MaxDatasets is the number of the read-only drives the datasets are attached to.
+// The drives of the unused datasets point to /dev/null.
+const MaxDatasets = 4
+
+func datasetDriveID(index int) string {
+	return fmt.Sprintf("dataset-%d", index)
+}
+
+type datasetLink struct {
+	Link      string
+	BuildPath string
+}
+
 type ProcessOptions struct {
 	// InitScriptPath is the path to the init script that will be executed inside the VM on kernel start.
 	InitScriptPath string
@@ -48,6 +67,9 @@ type ProcessOptions struct {
 	Stdout io.Writer
 	// Stderr is the writer to which the process stderr will be written.
 	Stderr io.Writer
+
+	// RateLimits are the disk and network limits applied to the VM devices.
+	RateLimits RateLimits
 }
 
 type Process struct {
@@ -60,10 +82,20 @@ type Process struct {
 	files      *storage.SandboxFiles
 
 	Exit chan error
+	// exited is closed after the FC process exits, unlike Exit it can be waited on by multiple callers.
+	exited chan struct{}
+	// exitCode is set before exited is closed, it is nil when the process was killed by a signal.
+	exitCode *int32
+
+	console *panicDetector
+	// outputDone is closed after the output of the process is read to the end.
+	outputDone <-chan struct{}
 
 	client *apiClient
 
-	buildRootfsPath string
+	buildRootfsPath   string
+	buildVolumePath   string
+	buildDatasetPaths []string
 }
 
 func NewProcess(
@@ -90,11 +122,26 @@ func NewProcess(
 	)
 
 	buildRootfsPath := baseBuild.SandboxRootfsPath()
+	buildVolumePath := baseBuild.SandboxVolumePath()
+
+	buildDatasetPaths := make([]string, MaxDatasets)
+	datasets := make([]datasetLink, MaxDatasets)
+	for i := range MaxDatasets {
+		buildDatasetPaths[i] = baseBuild.SandboxDatasetPath(i)
+		datasets[i] = datasetLink{
+			Link:      files.SandboxCacheDatasetLinkPath(i),
+			BuildPath: buildDatasetPaths[i],
+		}
+	}
+
 	err := startScriptTemplate.Execute(&fcStartScript, map[string]interface{}{
 		"rootfsPath":        files.SandboxCacheRootfsLinkPath(),
 		"kernelPath":        files.CacheKernelPath(),
 		"buildDir":          baseBuild.SandboxBuildDir(),
 		"buildRootfsPath":   buildRootfsPath,
+		"volumePath":        files.SandboxCacheVolumeLinkPath(),
+		"buildVolumePath":   buildVolumePath,
+		"datasets":          datasets,
 		"buildKernelPath":   files.BuildKernelPath(),
 		"buildKernelDir":    files.BuildKernelDir(),
 		"namespaceID":       slot.NamespaceID(),
@@ -135,6 +182,8 @@ func NewProcess(
 
 	return &Process{
 		Exit:                  make(chan error, 1),
+		exited:                make(chan struct{}),
+		console:               &panicDetector{},
 		cmd:                   cmd,
 		firecrackerSocketPath: files.SandboxFirecrackerSocketPath(),
 		client:                newApiClient(files.SandboxFirecrackerSocketPath()),
@@ -142,7 +191,9 @@ func NewProcess(
 		files:                 files,
 		slot:                  slot,
 
-		buildRootfsPath: buildRootfsPath,
+		buildRootfsPath:   buildRootfsPath,
+		buildVolumePath:   buildVolumePath,
+		buildDatasetPaths: buildDatasetPaths,
 	}, nil
 }
 
@@ -164,25 +215,45 @@ func (p *Process) configure(
 		TeamID:     teamID,
 	}
 
-	stdoutWriter := &zapio.Writer{Log: sbxlogger.I(sbxMetadata).Logger, Level: zap.InfoLevel}
-	stdoutWriters := []io.Writer{stdoutWriter}
-	if stdoutExternal != nil {
-		stdoutWriters = append(stdoutWriters, stdoutExternal)
+	// The output goes through the FIFOs, so the orchestrator that adopts the process after a restart keeps reading it.
+	stdout, err := createOutput(p.files.SandboxFirecrackerStdoutPath())
+	if err != nil {
+		return err
+	}
+	// The process has its own copy of the FIFO end.
+	defer stdout.Close()
+
+	stderr, err := createOutput(p.files.SandboxFirecrackerStderrPath())
+	if err != nil {
+		return err
 	}
-	p.cmd.Stdout = io.MultiWriter(stdoutWriters...)
+	defer stderr.Close()
 
-	stderrWriter := &zapio.Writer{Log: sbxlogger.I(sbxMetadata).Logger, Level: zap.ErrorLevel}
-	stderrWriters := []io.Writer{stderrWriter}
-	if stderrExternal != nil {
-		stderrWriters = append(stderrWriters, stderrExternal)
+	err = p.readOutputs(sbxMetadata, stdoutExternal, stderrExternal)
+	if err != nil {
+		return err
 	}
-	p.cmd.Stderr = io.MultiWriter(stderrWriters...)
 
-	err := utils.SymlinkForce("/dev/null", p.files.SandboxCacheRootfsLinkPath())
+	p.cmd.Stdout = stdout
+	p.cmd.Stderr = stderr
+
+	err = utils.SymlinkForce("/dev/null", p.files.SandboxCacheRootfsLinkPath())
 	if err != nil {
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
+	err = utils.SymlinkForce("/dev/null", p.files.SandboxCacheVolumeLinkPath())
+	if err != nil {
+		return fmt.Errorf("error symlinking volume: %w", err)
+	}
+
+	for i := range MaxDatasets {
+		err = utils.SymlinkForce("/dev/null", p.files.SandboxCacheDatasetLinkPath(i))
+		if err != nil {
+			return fmt.Errorf("error symlinking dataset: %w", err)
+		}
+	}
+
 	err = p.cmd.Start()
 	if err != nil {
 		return fmt.Errorf("error starting fc process: %w", err)
@@ -192,10 +263,17 @@ func (p *Process) configure(
 	defer cancelStart(fmt.Errorf("fc finished starting"))
 
 	go func() {
-		defer stderrWriter.Close()
-		defer stdoutWriter.Close()
+		defer close(p.exited)
 
 		waitErr := p.cmd.Wait()
+		// The exit is classified by the output, e.g. the kernel panic, so it has to be read first.
+		p.waitOutput()
+
+		if p.cmd.ProcessState != nil && p.cmd.ProcessState.Exited() {
+			exitCode := int32(p.cmd.ProcessState.ExitCode())
+			p.exitCode = &exitCode
+		}
+
 		if waitErr != nil {
 			var exitErr *exec.ExitError
 			if errors.As(waitErr, &exitErr) {
@@ -267,7 +345,8 @@ func (p *Process) Create(
 	args := KernelArgs{
 		// Disable kernel logs for production to speed the FC operations
 		// https://github.com/firecracker-microvm/firecracker/blob/main/docs/prod-host-setup.md#logging-and-performance
-		"quiet":    "",
+		// Only the emergency messages like the kernel panic are written to the console, so the sandbox exit can be classified.
+		"console":  "ttyS0",
 		"loglevel": "1",
 
 		// Define kernel init path
@@ -292,8 +371,6 @@ func (p *Process) Create(
 	}
 	if options.KernelLogs || options.SystemdToKernelLogs {
 		// Forward kernel logs to the ttyS0, which will be picked up by the stdout of FC process
-		delete(args, "quiet")
-		args["console"] = "ttyS0"
 		args["loglevel"] = "5" // KERN_NOTICE
 	}
 
@@ -312,7 +389,7 @@ func (p *Process) Create(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
-	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath)
+	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath, options.RateLimits.driveRateLimiter())
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -320,8 +397,26 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(childCtx, "set fc drivers config")
 
+	err = p.client.setDrive(childCtx, volumeDriveID, p.buildVolumePath, false)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting fc volume drive config: %w", err), fcStopErr)
+	}
+	telemetry.ReportEvent(childCtx, "set fc volume drive config")
+
+	for i, datasetPath := range p.buildDatasetPaths {
+		err = p.client.setDrive(childCtx, datasetDriveID(i), datasetPath, true)
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			return errors.Join(fmt.Errorf("error setting fc dataset drive config: %w", err), fcStopErr)
+		}
+	}
+	telemetry.ReportEvent(childCtx, "set fc dataset drives config")
+
 	// Network
-	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC())
+	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC(), options.RateLimits.networkRateLimiter())
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -329,13 +424,13 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(childCtx, "set fc network config")
 
-	err = p.client.setMachineConfig(childCtx, vCPUCount, memoryMB, hugePages)
+	err = p.client.setMemory(childCtx, vCPUCount, memoryMB, hugePages)
 	if err != nil {
 		fcStopErr := p.Stop()
 
 		return errors.Join(fmt.Errorf("error setting fc machine config: %w", err), fcStopErr)
 	}
-	telemetry.ReportEvent(childCtx, "set fc machine config")
+	telemetry.ReportEvent(childCtx, "set fc machine config", attribute.Bool("balloon", hasBalloon(hugePages)))
 
 	err = p.client.startVM(childCtx)
 	if err != nil {
@@ -355,6 +450,9 @@ func (p *Process) Resume(
 	uffdSocketPath string,
 	snapfile template.File,
 	uffdReady chan struct{},
+	rateLimits RateLimits,
+	volumePath string,
+	datasetPaths []string,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "resume-fc")
 	defer childSpan.End()
@@ -379,6 +477,20 @@ func (p *Process) Resume(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
+	if volumePath != "" {
+		err = utils.SymlinkForce(volumePath, p.files.SandboxCacheVolumeLinkPath())
+		if err != nil {
+			return fmt.Errorf("error symlinking volume: %w", err)
+		}
+	}
+
+	for i, datasetPath := range datasetPaths {
+		err = utils.SymlinkForce(datasetPath, p.files.SandboxCacheDatasetLinkPath(i))
+		if err != nil {
+			return fmt.Errorf("error symlinking dataset: %w", err)
+		}
+	}
+
 	err = p.client.loadSnapshot(
 		childCtx,
 		uffdSocketPath,
@@ -391,6 +503,35 @@ func (p *Process) Resume(
 		return errors.Join(fmt.Errorf("error loading snapshot: %w", err), fcStopErr)
 	}
 
+	// The snapshot contains the limits of the sandbox it was taken from, they have to be replaced before the VM runs.
+	err = p.setRateLimits(childCtx, rateLimits)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting rate limits: %w", err), fcStopErr)
+	}
+
+	// The drive in the snapshot can have a different size than the attached volume, reopening it notifies the guest about the change.
+	if volumePath != "" {
+		err = p.client.updateDrivePath(childCtx, volumeDriveID, p.buildVolumePath)
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			// The templates built before the volumes were added don't have the drive.
+			return errors.Join(fmt.Errorf("error attaching volume, the template may need to be rebuilt: %w", err), fcStopErr)
+		}
+	}
+
+	for i := range datasetPaths {
+		err = p.client.updateDrivePath(childCtx, datasetDriveID(i), p.buildDatasetPaths[i])
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			// The templates built before the datasets were added don't have the drives.
+			return errors.Join(fmt.Errorf("error attaching dataset, the template may need to be rebuilt: %w", err), fcStopErr)
+		}
+	}
+
 	err = p.client.resumeVM(childCtx)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -435,6 +576,32 @@ func (p *Process) Stop() error {
 	return nil
 }
 
+// Exited returns a channel that is closed after the FC process exits.
+func (p *Process) Exited() <-chan struct{} {
+	return p.exited
+}
+
+// ExitCode returns the exit code of the FC process, it is valid after the process exits.
+// The process killed by a signal has no exit code.
+func (p *Process) ExitCode() (int32, bool) {
+	select {
+	case <-p.exited:
+	default:
+		return 0, false
+	}
+
+	if p.exitCode == nil {
+		return 0, false
+	}
+
+	return *p.exitCode, true
+}
+
+// KernelPanic returns the guest kernel panic message written to the serial console.
+func (p *Process) KernelPanic() (string, bool) {
+	return p.console.Panic()
+}
+
 func (p *Process) Pause(ctx context.Context, tracer trace.Tracer) error {
 	ctx, childSpan := tracer.Start(ctx, "pause-fc")
 	defer childSpan.End()
@@ -442,6 +609,56 @@ func (p *Process) Pause(ctx context.Context, tracer trace.Tracer) error {
 	return p.client.pauseVM(ctx)
 }
 
+// Unpause resumes the VM after it was paused, e.g. after a live snapshot.
+func (p *Process) Unpause(ctx context.Context, tracer trace.Tracer) error {
+	ctx, childSpan := tracer.Start(ctx, "unpause-fc")
+	defer childSpan.End()
+
+	return p.client.resumeVM(ctx)
+}
+
+// UpdateRateLimits replaces the disk and network limits of the running VM.
+func (p *Process) UpdateRateLimits(ctx context.Context, tracer trace.Tracer, rateLimits RateLimits) error {
+	ctx, childSpan := tracer.Start(ctx, "update-rate-limits-fc")
+	defer childSpan.End()
+
+	return p.setRateLimits(ctx, rateLimits)
+}
+
+func (p *Process) setRateLimits(ctx context.Context, rateLimits RateLimits) error {
+	err := p.client.updateRootfsDriveRateLimiter(ctx, rateLimits.driveRateLimiter())
+	if err != nil {
+		return err
+	}
+
+	return p.client.updateNetworkInterfaceRateLimiter(ctx, p.slot.VpeerName(), rateLimits.networkRateLimiter())
+}
+
+// UpdateBalloon sets the target size of the memory balloon, the guest inflates or deflates the balloon asynchronously.
+func (p *Process) UpdateBalloon(ctx context.Context, tracer trace.Tracer, amountMiB int64) error {
+	ctx, childSpan := tracer.Start(ctx, "update-balloon-fc")
+	defer childSpan.End()
+
+	return p.client.updateBalloon(ctx, amountMiB)
+}
+
+// BalloonStats returns the balloon size and the guest memory statistics.
+// It fails if the VM was created without the balloon device.
+func (p *Process) BalloonStats(ctx context.Context) (*BalloonStats, error) {
+	stats, err := p.client.balloonStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BalloonStats{
+		TargetMiB:       swag.Int64Value(stats.TargetMib),
+		ActualMiB:       swag.Int64Value(stats.ActualMib),
+		FreeMemory:      stats.FreeMemory,
+		AvailableMemory: stats.AvailableMemory,
+		TotalMemory:     stats.TotalMemory,
+	}, nil
+}
+
 // CreateSnapshot VM needs to be paused before creating a snapshot.
 func (p *Process) CreateSnapshot(ctx context.Context, tracer trace.Tracer, snapfilePath string, memfilePath string) error {
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
@@ -0,0 +1,43 @@
+package fc
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+)
+
+// rateLimiterRefillTimeMs is the refill period of the token buckets, the limits are configured per second.
+const rateLimiterRefillTimeMs = 1000
+
+// RateLimits are the per-second limits of the VM devices, the zero value means unlimited.
+type RateLimits struct {
+	// DiskBandwidth is the rootfs drive bandwidth in bytes per second.
+	DiskBandwidth int64
+	// DiskIOPS is the rootfs drive operations per second.
+	DiskIOPS int64
+	// NetworkBandwidth is the network interface bandwidth in bytes per second, applied to each direction separately.
+	NetworkBandwidth int64
+}
+
+func (l RateLimits) driveRateLimiter() *models.RateLimiter {
+	return &models.RateLimiter{
+		Bandwidth: tokenBucket(l.DiskBandwidth),
+		Ops:       tokenBucket(l.DiskIOPS),
+	}
+}
+
+func (l RateLimits) networkRateLimiter() *models.RateLimiter {
+	return &models.RateLimiter{
+		Bandwidth: tokenBucket(l.NetworkBandwidth),
+	}
+}
+
+// tokenBucket returns a bucket refilled with the given amount every second.
+// Firecracker disables the bucket when its size is zero, which is also used to remove a previously set limit.
+func tokenBucket(perSecond int64) *models.TokenBucket {
+	size := max(perSecond, 0)
+	refillTime := int64(rateLimiterRefillTimeMs)
+
+	return &models.TokenBucket{
+		Size:       &size,
+		RefillTime: &refillTime,
+	}
+}
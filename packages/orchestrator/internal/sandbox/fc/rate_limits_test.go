@@ -0,0 +1,49 @@
+package fc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+)
+
+func requireTokenBucket(t *testing.T, bucket *models.TokenBucket, size int64) {
+	t.Helper()
+
+	require.NotNil(t, bucket)
+	require.NotNil(t, bucket.Size)
+	require.NotNil(t, bucket.RefillTime)
+	assert.Equal(t, size, *bucket.Size)
+	assert.Equal(t, int64(rateLimiterRefillTimeMs), *bucket.RefillTime)
+}
+
+func TestRateLimits(t *testing.T) {
+	t.Run("limits are per second", func(t *testing.T) {
+		limits := RateLimits{DiskBandwidth: 100 << 20, DiskIOPS: 1000, NetworkBandwidth: 10 << 20}
+
+		drive := limits.driveRateLimiter()
+		requireTokenBucket(t, drive.Bandwidth, 100<<20)
+		requireTokenBucket(t, drive.Ops, 1000)
+
+		network := limits.networkRateLimiter()
+		requireTokenBucket(t, network.Bandwidth, 10<<20)
+		assert.Nil(t, network.Ops)
+	})
+
+	t.Run("zero value disables the buckets", func(t *testing.T) {
+		// The empty buckets are sent too, so the update removes the previously set limits.
+		drive := RateLimits{}.driveRateLimiter()
+		requireTokenBucket(t, drive.Bandwidth, 0)
+		requireTokenBucket(t, drive.Ops, 0)
+
+		requireTokenBucket(t, RateLimits{}.networkRateLimiter().Bandwidth, 0)
+	})
+
+	t.Run("negative limits are unlimited", func(t *testing.T) {
+		drive := RateLimits{DiskBandwidth: -1, DiskIOPS: -1}.driveRateLimiter()
+		requireTokenBucket(t, drive.Bandwidth, 0)
+		requireTokenBucket(t, drive.Ops, 0)
+	})
+}
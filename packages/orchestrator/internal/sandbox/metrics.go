@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 )
 
@@ -16,6 +18,7 @@ type Metrics struct {
 	CPUUsedPercent float64 `json:"cpu_used_pct"`  // Percent rounded to 2 decimal places
 	MemTotalMiB    int64   `json:"mem_total_mib"` // Total virtual memory in MiB
 	MemUsedMiB     int64   `json:"mem_used_mib"`  // Used virtual memory in MiB
+	MemOOMKills    int64   `json:"mem_oom_kills"` // Processes killed by the OOM killer since the boot
 }
 
 func (c *Checks) GetMetrics(timeout time.Duration) (*Metrics, error) {
@@ -50,5 +53,46 @@ func (c *Checks) GetMetrics(timeout time.Duration) (*Metrics, error) {
 		return nil, err
 	}
 
+	c.observeOOMKills(m.MemOOMKills)
+
 	return &m, nil
 }
+
+// observeOOMKills stores the OOM kills reported by envd, the first reported count is the baseline.
+func (c *Checks) observeOOMKills(count int64) {
+	c.oomKillsBaseline.CompareAndSwap(-1, count)
+	c.oomKills.Store(count)
+}
+
+// OOMKills returns the number of the guest processes killed by the OOM killer since the sandbox start, as last reported by envd.
+func (c *Checks) OOMKills() int64 {
+	baseline := c.oomKillsBaseline.Load()
+	if baseline < 0 {
+		return 0
+	}
+
+	return max(c.oomKills.Load()-baseline, 0)
+}
+
+// GetNetworkCounters returns the traffic of the sandbox counted by the slot firewall.
+func (c *Checks) GetNetworkCounters() (network.Counters, error) {
+	if err := context.Cause(c.ctx); err != nil {
+		return network.Counters{}, err
+	}
+
+	firewall := c.sandbox.Slot.Firewall
+	if firewall == nil {
+		return network.Counters{}, fmt.Errorf("slot firewall is not initialized")
+	}
+
+	return firewall.Counters()
+}
+
+// GetBalloonStats returns the memory balloon size and the guest memory statistics reported through the balloon device.
+func (c *Checks) GetBalloonStats() (*fc.BalloonStats, error) {
+	if err := context.Cause(c.ctx); err != nil {
+		return nil, err
+	}
+
+	return c.sandbox.BalloonStats(c.ctx)
+}
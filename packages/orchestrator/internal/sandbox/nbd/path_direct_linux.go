@@ -27,6 +27,9 @@ import (
 const (
 	connections    = 4
 	connectTimeout = 30 * time.Second
+	// deadconnTimeout is how long the kernel keeps the requests when all connections are dead.
+	// It covers the orchestrator restart, the devices of the running sandboxes are reconnected by the new process.
+	deadconnTimeout = 2 * time.Minute
 
 	// disconnectTimeout should not be necessary if the disconnect is reliable
 	disconnectTimeout = 30 * time.Second
@@ -42,6 +45,7 @@ type DirectPathMount struct {
 	Backend     block.Device
 	deviceIndex uint32
 	blockSize   uint64
+	readOnly    bool
 
 	dispatchers []*Dispatch
 	socksClient []*os.File
@@ -66,6 +70,14 @@ func NewDirectPathMount(tracer trace.Tracer, b block.Device, devicePool *DeviceP
 	}
 }
 
+// NewReadonlyPathMount exports the device as read-only, the kernel rejects the writes to it.
+func NewReadonlyPathMount(tracer trace.Tracer, b block.ReadonlyDevice, devicePool *DevicePool) *DirectPathMount {
+	d := NewDirectPathMount(tracer, &readonlyBackend{b}, devicePool)
+	d.readOnly = true
+
+	return d
+}
+
 func (d *DirectPathMount) Open(ctx context.Context) (retDeviceIndex uint32, err error) {
 	defer func() {
 		// Set the device index to the one returned, correctly capture error values
@@ -86,53 +98,17 @@ func (d *DirectPathMount) Open(ctx context.Context) (retDeviceIndex uint32, err
 			return math.MaxUint32, err
 		}
 
-		d.socksClient = make([]*os.File, 0)
-		d.socksServer = make([]io.Closer, 0)
-		d.dispatchers = make([]*Dispatch, 0)
-
-		for i := 0; i < connections; i++ {
-			// Create the socket pairs
-			sockPair, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
-			if err != nil {
-				return math.MaxUint32, err
-			}
-
-			client := os.NewFile(uintptr(sockPair[0]), "client")
-			server := os.NewFile(uintptr(sockPair[1]), "server")
-			serverc, err := net.FileConn(server)
-			if err != nil {
-				return math.MaxUint32, err
-			}
-			server.Close()
-
-			dispatch := NewDispatch(d.ctx, serverc, d.Backend)
-			// Start reading commands on the socket and dispatching them to our provider
-			d.handlersWg.Add(1)
-			go func() {
-				defer d.handlersWg.Done()
-
-				handleErr := dispatch.Handle()
-				// The error is expected to happen if the nbd (socket connection) is closed
-				zap.L().Info("closing handler for NBD commands",
-					zap.Error(handleErr),
-					zap.Uint32("device_index", deviceIndex),
-					zap.Int("socket_index", i),
-				)
-			}()
-
-			d.socksServer = append(d.socksServer, serverc)
-			d.socksClient = append(d.socksClient, client)
-			d.dispatchers = append(d.dispatchers, dispatch)
+		err = d.openSockets(deviceIndex)
+		if err != nil {
+			return math.MaxUint32, err
 		}
 
 		var opts []nbdnl.ConnectOption
 		opts = append(opts, nbdnl.WithBlockSize(d.blockSize))
 		opts = append(opts, nbdnl.WithTimeout(connectTimeout))
-		opts = append(opts, nbdnl.WithDeadconnTimeout(connectTimeout))
-
-		serverFlags := nbdnl.FlagHasFlags | nbdnl.FlagCanMulticonn
+		opts = append(opts, nbdnl.WithDeadconnTimeout(deadconnTimeout))
 
-		idx, err := nbdnl.Connect(deviceIndex, d.socksClient, uint64(size), 0, serverFlags, opts...)
+		idx, err := nbdnl.Connect(deviceIndex, d.socksClient, uint64(size), 0, d.serverFlags(), opts...)
 		if err == nil {
 			// The idx should be the same as deviceIndex, because we are connecting to it,
 			// but we will use the one returned by nbdnl
@@ -187,6 +163,104 @@ func (d *DirectPathMount) Open(ctx context.Context) (retDeviceIndex uint32, err
 	return deviceIndex, nil
 }
 
+// Adopt serves the device connected by the previous orchestrator process from the backend.
+// The connections of the previous process are dead, the kernel resends their pending requests to the new ones.
+// The device is disconnected on Close even if the adoption fails.
+func (d *DirectPathMount) Adopt(deviceIndex uint32) error {
+	err := d.devicePool.AdoptDevice(deviceIndex)
+	if err != nil {
+		return fmt.Errorf("error adopting device: %w", err)
+	}
+
+	d.deviceIndex = deviceIndex
+
+	err = d.openSockets(deviceIndex)
+	if err != nil {
+		return fmt.Errorf("error opening sockets: %w", err)
+	}
+
+	err = nbdnl.Reconfigure(
+		deviceIndex,
+		d.socksClient,
+		0,
+		d.serverFlags(),
+		nbdnl.WithTimeout(connectTimeout),
+		nbdnl.WithDeadconnTimeout(deadconnTimeout),
+	)
+	if err != nil {
+		return fmt.Errorf("error reconfiguring NBD: %w", err)
+	}
+
+	s, err := nbdnl.Status(deviceIndex)
+	if err != nil {
+		return fmt.Errorf("error getting NBD status: %w", err)
+	}
+
+	if !s.Connected {
+		return fmt.Errorf("device %d is not connected", deviceIndex)
+	}
+
+	return nil
+}
+
+// DeviceIndex returns the index of the opened device.
+func (d *DirectPathMount) DeviceIndex() uint32 {
+	return d.deviceIndex
+}
+
+func (d *DirectPathMount) serverFlags() nbdnl.ServerFlags {
+	serverFlags := nbdnl.FlagHasFlags | nbdnl.FlagCanMulticonn
+	if d.readOnly {
+		serverFlags |= nbdnl.FlagReadOnly
+	}
+
+	return serverFlags
+}
+
+// openSockets creates the connections for the device and starts dispatching their commands to the backend.
+func (d *DirectPathMount) openSockets(deviceIndex uint32) error {
+	d.socksClient = make([]*os.File, 0)
+	d.socksServer = make([]io.Closer, 0)
+	d.dispatchers = make([]*Dispatch, 0)
+
+	for i := 0; i < connections; i++ {
+		// Create the socket pairs
+		sockPair, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+		if err != nil {
+			return err
+		}
+
+		client := os.NewFile(uintptr(sockPair[0]), "client")
+		server := os.NewFile(uintptr(sockPair[1]), "server")
+		serverc, err := net.FileConn(server)
+		if err != nil {
+			return err
+		}
+		server.Close()
+
+		dispatch := NewDispatch(d.ctx, serverc, d.Backend)
+		// Start reading commands on the socket and dispatching them to our provider
+		d.handlersWg.Add(1)
+		go func() {
+			defer d.handlersWg.Done()
+
+			handleErr := dispatch.Handle()
+			// The error is expected to happen if the nbd (socket connection) is closed
+			zap.L().Info("closing handler for NBD commands",
+				zap.Error(handleErr),
+				zap.Uint32("device_index", deviceIndex),
+				zap.Int("socket_index", i),
+			)
+		}()
+
+		d.socksServer = append(d.socksServer, serverc)
+		d.socksClient = append(d.socksClient, client)
+		d.dispatchers = append(d.dispatchers, dispatch)
+	}
+
+	return nil
+}
+
 func (d *DirectPathMount) Close(ctx context.Context) error {
 	childCtx, childSpan := d.tracer.Start(ctx, "direct-path-mount-close")
 	defer childSpan.End()
@@ -247,6 +321,16 @@ func (d *DirectPathMount) Close(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// DisconnectDevice disconnects the device left by the previous orchestrator process that can't be adopted.
+func DisconnectDevice(ctx context.Context, devicePool *DevicePool, deviceIndex uint32) error {
+	err := disconnectNBDWithTimeout(ctx, deviceIndex, disconnectTimeout)
+	if err != nil {
+		return fmt.Errorf("error disconnecting NBD: %w", err)
+	}
+
+	return devicePool.ReleaseDeviceWithRetry(deviceIndex)
+}
+
 func disconnectNBDWithTimeout(ctx context.Context, deviceIndex uint32, timeout time.Duration) error {
 	// Now ask to disconnect
 	telemetry.ReportEvent(ctx, "disconnecting NBD")
@@ -20,10 +20,26 @@ func NewDirectPathMount(tracer trace.Tracer, b block.Device, devicePool *DeviceP
 	return nil
 }
 
+func NewReadonlyPathMount(tracer trace.Tracer, b block.ReadonlyDevice, devicePool *DevicePool) *DirectPathMount {
+	return nil
+}
+
 func (d *DirectPathMount) Open(ctx context.Context) (uint32, error) {
 	return 0, errors.New("platform does not support direct path mount")
 }
 
+func (d *DirectPathMount) Adopt(deviceIndex uint32) error {
+	return errors.New("platform does not support direct path mount")
+}
+
+func (d *DirectPathMount) DeviceIndex() uint32 {
+	return 0
+}
+
 func (d *DirectPathMount) Close(ctx context.Context) error {
 	return errors.New("platform does not support direct path mount")
 }
+
+func DisconnectDevice(ctx context.Context, devicePool *DevicePool, deviceIndex uint32) error {
+	return errors.New("platform does not support direct path mount")
+}
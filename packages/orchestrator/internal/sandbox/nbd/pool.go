@@ -252,6 +252,24 @@ func (d *DevicePool) GetDevice(ctx context.Context) (DeviceSlot, error) {
 	return slot, nil
 }
 
+// AdoptDevice marks the device connected by the previous orchestrator process as used.
+func (d *DevicePool) AdoptDevice(idx DeviceSlot) error {
+	free, err := d.isDeviceFree(idx)
+	if err != nil {
+		return fmt.Errorf("failed to check if device is free: %w", err)
+	}
+
+	if free {
+		return fmt.Errorf("device %d is not connected", idx)
+	}
+
+	d.mu.Lock()
+	d.usedSlots.Set(uint(idx))
+	d.mu.Unlock()
+
+	return nil
+}
+
 // ReleaseDevice will return an error if the device is not free and not release the slot — you can retry.
 func (d *DevicePool) ReleaseDevice(idx DeviceSlot) error {
 	free, err := d.isDeviceFree(idx)
@@ -308,7 +326,12 @@ func (d *DevicePool) Close(_ context.Context) error {
 	var errs error
 	for slotIdx, e := d.usedSlots.NextSet(0); e; slotIdx, e = d.usedSlots.NextSet(slotIdx + 1) {
 		slot := DeviceSlot(slotIdx)
-		err := d.ReleaseDeviceWithRetry(slot)
+		err := d.ReleaseDevice(slot)
+		if errors.Is(err, ErrDeviceInUse{}) {
+			// The device is used by a running sandbox, it is adopted with the sandbox after the restart.
+			continue
+		}
+
 		if err != nil {
 			errs = errors.Join(errs, fmt.Errorf("failed to release device %d: %w", slot, err))
 		}
@@ -0,0 +1,18 @@
+package nbd
+
+import (
+	"errors"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+)
+
+var errReadonlyDevice = errors.New("device is read-only")
+
+// readonlyBackend serves the read-only device through the NBD dispatch that expects a writable one.
+type readonlyBackend struct {
+	block.ReadonlyDevice
+}
+
+func (r *readonlyBackend) WriteAt([]byte, int64) (int, error) {
+	return 0, errReadonlyDevice
+}
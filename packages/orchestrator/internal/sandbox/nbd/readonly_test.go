@@ -0,0 +1,15 @@
+package nbd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadonlyBackend_WriteAt(t *testing.T) {
+	backend := &readonlyBackend{}
+
+	n, err := backend.WriteAt([]byte("data"), 0)
+	assert.ErrorIs(t, err, errReadonlyDevice)
+	assert.Zero(t, n)
+}
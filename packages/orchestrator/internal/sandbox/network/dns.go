@@ -0,0 +1,464 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const (
+	dnsPort = 53
+
+	// minResolvedTTL prevents the resolved IPs from expiring before the guest manages to connect to them.
+	minResolvedTTL = 60 * time.Second
+	maxResolvedTTL = 1 * time.Hour
+
+	resolvedExpireInterval = 10 * time.Second
+	upstreamTimeout        = 5 * time.Second
+)
+
+var dnsUpstream = env.GetEnv("SANDBOX_DNS_UPSTREAM", "8.8.8.8:53")
+
+// DNSFilter resolves the DNS queries of the sandbox and allows the egress traffic to the IPs of the allowed domains.
+// The guest DNS traffic is redirected to the filter listening on the tap IP in the slot namespace,
+// the lookups of the domains that are not allowed are refused.
+// The guest connections to the HTTP and HTTPS ports are redirected to the proxy of the filter, they are forwarded only
+// if their HTTP host or TLS server name is an allowed domain, so the other domains served from the same IPs can't be reached.
+// The traffic to the other ports is filtered on the IP level only, QUIC is dropped so the HTTPS clients fall back to TCP.
+type DNSFilter struct {
+	slot *Slot
+
+	mu      sync.RWMutex
+	domains []string
+	logger  *zap.Logger
+
+	client    *dns.Client
+	udpServer *dns.Server
+	tcpServer *dns.Server
+
+	httpListener  net.Listener
+	httpsListener net.Listener
+
+	// conns are the proxied guest connections, they are closed when the filter stops.
+	connsMu sync.Mutex
+	conns   map[net.Conn]struct{}
+
+	done chan struct{}
+}
+
+func newDNSFilter(slot *Slot, domains []string, logger *zap.Logger) *DNSFilter {
+	return &DNSFilter{
+		slot:    slot,
+		domains: normalizeDomains(domains),
+		logger:  logger,
+		client:  &dns.Client{Timeout: upstreamTimeout},
+		conns:   make(map[net.Conn]struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// start opens the listeners in the slot namespace and redirects the guest DNS traffic to them.
+func (f *DNSFilter) start() error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, f.slot.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", f.slot.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	listenAddr := net.JoinHostPort(f.slot.TapIPString(), fmt.Sprint(dnsPort))
+
+	var packetConn net.PacketConn
+	var listener net.Listener
+	// The sockets stay in the slot namespace after leaving it, the upstream queries and connections are made from the host namespace.
+	err = n.Do(func(_ ns.NetNS) (err error) {
+		var opened []io.Closer
+		defer func() {
+			if err != nil {
+				for _, c := range opened {
+					c.Close()
+				}
+			}
+		}()
+
+		packetConn, err = net.ListenPacket("udp4", listenAddr)
+		if err != nil {
+			return fmt.Errorf("error listening on udp '%s': %w", listenAddr, err)
+		}
+		opened = append(opened, packetConn)
+
+		listener, err = net.Listen("tcp4", listenAddr)
+		if err != nil {
+			return fmt.Errorf("error listening on tcp '%s': %w", listenAddr, err)
+		}
+		opened = append(opened, listener)
+
+		httpAddr := net.JoinHostPort(f.slot.TapIPString(), fmt.Sprint(httpProxyPort))
+		f.httpListener, err = net.Listen("tcp4", httpAddr)
+		if err != nil {
+			return fmt.Errorf("error listening on tcp '%s': %w", httpAddr, err)
+		}
+		opened = append(opened, f.httpListener)
+
+		httpsAddr := net.JoinHostPort(f.slot.TapIPString(), fmt.Sprint(httpsProxyPort))
+		f.httpsListener, err = net.Listen("tcp4", httpsAddr)
+		if err != nil {
+			return fmt.Errorf("error listening on tcp '%s': %w", httpsAddr, err)
+		}
+		opened = append(opened, f.httpsListener)
+
+		return f.redirect(true)
+	})
+	if err != nil {
+		return fmt.Errorf("failed execution in network namespace '%s': %w", f.slot.NamespaceID(), err)
+	}
+
+	f.udpServer = &dns.Server{PacketConn: packetConn, Handler: f}
+	f.tcpServer = &dns.Server{Listener: listener, Handler: f}
+
+	for _, server := range []*dns.Server{f.udpServer, f.tcpServer} {
+		go func() {
+			serveErr := server.ActivateAndServe()
+			if serveErr != nil {
+				zap.L().Error("DNS filter stopped serving", zap.String("namespace_id", f.slot.NamespaceID()), zap.Error(serveErr))
+			}
+		}()
+	}
+
+	go f.serveProxy(f.httpListener, forwardHTTP)
+	go f.serveProxy(f.httpsListener, forwardTLS)
+
+	go f.expireResolved()
+
+	return nil
+}
+
+// stop closes the listeners and removes the DNS redirect from the slot namespace.
+func (f *DNSFilter) stop() error {
+	close(f.done)
+
+	var errs []error
+	for _, server := range []*dns.Server{f.udpServer, f.tcpServer} {
+		if err := server.Shutdown(); err != nil {
+			errs = append(errs, fmt.Errorf("error shutting down DNS server: %w", err))
+		}
+	}
+
+	for _, listener := range []net.Listener{f.httpListener, f.httpsListener} {
+		if err := listener.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("error closing proxy listener: %w", err))
+		}
+	}
+
+	// The connections allowed for the previous sandbox of the slot must not stay open.
+	f.connsMu.Lock()
+	for conn := range f.conns {
+		conn.Close()
+	}
+	f.connsMu.Unlock()
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, f.slot.NamespaceID()))
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to get slot network namespace '%s': %w", f.slot.NamespaceID(), err))...)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		return f.redirect(false)
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed execution in network namespace '%s': %w", f.slot.NamespaceID(), err))
+	}
+
+	err = f.slot.Firewall.ClearResolvedIPs()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error clearing resolved IPs: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// update replaces the allowed domains, the IPs resolved for the previous domains are removed.
+func (f *DNSFilter) update(domains []string, logger *zap.Logger) error {
+	domains = normalizeDomains(domains)
+
+	f.mu.Lock()
+	changed := !slices.Equal(f.domains, domains)
+	f.domains = domains
+	f.logger = logger
+	f.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+
+	return f.slot.Firewall.ClearResolvedIPs()
+}
+
+// redirect adds or removes the NAT rules redirecting the guest DNS traffic to the filter and the HTTP and HTTPS connections
+// to its proxy, it must run in the slot namespace.
+func (f *DNSFilter) redirect(add bool) error {
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	redirects := []struct {
+		protocol string
+		port     int
+		to       int
+	}{
+		{protocol: "udp", port: dnsPort, to: dnsPort},
+		{protocol: "tcp", port: dnsPort, to: dnsPort},
+		{protocol: "tcp", port: httpPort, to: httpProxyPort},
+		{protocol: "tcp", port: httpsPort, to: httpsProxyPort},
+	}
+
+	var errs []error
+	for _, r := range redirects {
+		destination := net.JoinHostPort(f.slot.TapIPString(), fmt.Sprint(r.to))
+		rule := []string{"-i", f.slot.TapName(), "-p", r.protocol, "--dport", fmt.Sprint(r.port), "-j", "DNAT", "--to-destination", destination}
+
+		err = updateRule(tables, add, "nat", "PREROUTING", rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error updating %s %d redirect rule: %w", r.protocol, r.port, err))
+		}
+	}
+
+	// The server name of QUIC isn't inspected, the HTTPS clients fall back to the TCP connections.
+	quic := []string{"-i", f.slot.TapName(), "-p", "udp", "--dport", fmt.Sprint(httpsPort), "-j", "DROP"}
+
+	err = updateRule(tables, add, "filter", "FORWARD", quic)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error updating QUIC drop rule: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func updateRule(tables *iptables.IPTables, add bool, table, chain string, rule []string) error {
+	if add {
+		// The rule can be left in the namespace by the previous orchestrator process when the slot is adopted.
+		return tables.AppendUnique(table, chain, rule...)
+	}
+
+	return tables.DeleteIfExists(table, chain, rule...)
+}
+
+// serveProxy accepts the guest connections redirected to the listener until it is closed.
+func (f *DNSFilter) serveProxy(listener net.Listener, forward forwardFunc) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				zap.L().Error("egress proxy stopped accepting", zap.String("namespace_id", f.slot.NamespaceID()), zap.Error(err))
+			}
+
+			return
+		}
+
+		go f.proxy(conn, forward)
+	}
+}
+
+// proxy forwards the guest connection to its original destination.
+// The connections to the allowed CIDRs are forwarded as they are, the connections to the IPs of the allowed domains
+// are forwarded only if they are made to an allowed domain.
+func (f *DNSFilter) proxy(conn net.Conn, forward forwardFunc) {
+	f.connsMu.Lock()
+	select {
+	case <-f.done:
+		// The filter stopped after the connection was accepted.
+		f.connsMu.Unlock()
+		conn.Close()
+
+		return
+	default:
+		f.conns[conn] = struct{}{}
+	}
+	f.connsMu.Unlock()
+
+	defer func() {
+		f.connsMu.Lock()
+		delete(f.conns, conn)
+		f.connsMu.Unlock()
+
+		conn.Close()
+	}()
+
+	f.mu.RLock()
+	domains := f.domains
+	logger := f.logger
+	f.mu.RUnlock()
+
+	destination, err := originalDestination(conn)
+	if err != nil {
+		zap.L().Debug("failed to get original destination of the guest connection", zap.String("namespace_id", f.slot.NamespaceID()), zap.Error(err))
+
+		return
+	}
+
+	// The connections made to the proxy port directly are not redirected.
+	if destination.Addr().String() == f.slot.TapIPString() {
+		return
+	}
+
+	custom, resolved := f.slot.Firewall.allowedDestination(destination.Addr())
+	if custom {
+		err = forwardRaw(conn, dialUpstream(destination))
+	} else if resolved {
+		err = forward(conn, dialUpstream(destination), func(host string) bool {
+			if domainAllowed(domains, host) {
+				return true
+			}
+
+			logger.Info("Blocked connection", zap.String("domain", host), zap.String("ip", destination.Addr().String()))
+
+			return false
+		})
+	} else {
+		logger.Info("Blocked connection", zap.String("ip", destination.Addr().String()))
+
+		return
+	}
+
+	if err != nil && !errors.Is(err, errHostBlocked) && !errors.Is(err, net.ErrClosed) {
+		zap.L().Debug("egress proxy connection failed", zap.String("namespace_id", f.slot.NamespaceID()), zap.String("destination", destination.String()), zap.Error(err))
+	}
+}
+
+func (f *DNSFilter) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if len(req.Question) == 0 {
+		dns.HandleFailed(w, req)
+
+		return
+	}
+
+	name := req.Question[0].Name
+
+	f.mu.RLock()
+	allowed := domainAllowed(f.domains, name)
+	logger := f.logger
+	f.mu.RUnlock()
+
+	if !allowed {
+		logger.Info("Blocked DNS lookup", zap.String("domain", strings.TrimSuffix(name, ".")))
+
+		resp := new(dns.Msg)
+		resp.SetRcode(req, dns.RcodeRefused)
+		_ = w.WriteMsg(resp)
+
+		return
+	}
+
+	network := "udp"
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		network = "tcp"
+	}
+
+	client := *f.client
+	client.Net = network
+
+	resp, _, err := client.Exchange(req, dnsUpstream)
+	if err != nil {
+		zap.L().Warn("DNS filter upstream query failed", zap.String("namespace_id", f.slot.NamespaceID()), zap.String("domain", name), zap.Error(err))
+		dns.HandleFailed(w, req)
+
+		return
+	}
+
+	// The IPs have to be allowed before the guest receives the answer and starts connecting to them.
+	ips, ttl := resolvedIPs(resp)
+	if len(ips) > 0 {
+		err = f.slot.Firewall.AddResolvedIPs(ips, ttl)
+		if err != nil {
+			zap.L().Error("error allowing resolved IPs", zap.String("namespace_id", f.slot.NamespaceID()), zap.String("domain", name), zap.Error(err))
+		}
+	}
+
+	_ = w.WriteMsg(resp)
+}
+
+func (f *DNSFilter) expireResolved() {
+	ticker := time.NewTicker(resolvedExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			err := f.slot.Firewall.ExpireResolvedIPs()
+			if err != nil {
+				zap.L().Error("error expiring resolved IPs", zap.String("namespace_id", f.slot.NamespaceID()), zap.Error(err))
+			}
+		}
+	}
+}
+
+// resolvedIPs returns the IPv4 addresses from the answer with the lowest TTL of their records.
+func resolvedIPs(resp *dns.Msg) ([]netip.Addr, time.Duration) {
+	ips := make([]netip.Addr, 0, len(resp.Answer))
+	ttl := maxResolvedTTL
+
+	for _, rr := range resp.Answer {
+		a, ok := rr.(*dns.A)
+		if !ok {
+			continue
+		}
+
+		ip, ok := netip.AddrFromSlice(a.A.To4())
+		if !ok {
+			continue
+		}
+
+		ips = append(ips, ip)
+		ttl = min(ttl, time.Duration(a.Hdr.Ttl)*time.Second)
+	}
+
+	return ips, max(ttl, minResolvedTTL)
+}
+
+// domainAllowed checks the queried name against the allowed domains.
+// The "*.example.com" pattern matches all subdomains of example.com, other patterns match the exact domain.
+func domainAllowed(domains []string, name string) bool {
+	name = strings.ToLower(dns.Fqdn(name))
+
+	for _, domain := range domains {
+		if suffix, ok := strings.CutPrefix(domain, "*."); ok {
+			if strings.HasSuffix(name, "."+suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if name == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		normalized = append(normalized, strings.ToLower(dns.Fqdn(strings.TrimSpace(domain))))
+	}
+
+	return normalized
+}
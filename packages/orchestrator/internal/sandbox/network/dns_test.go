@@ -0,0 +1,30 @@
+package network
+
+import "testing"
+
+func Test_domainAllowed(t *testing.T) {
+	domains := normalizeDomains([]string{"pypi.org", "*.amazonaws.com", "GitHub.com."})
+
+	tests := []struct {
+		name   string
+		domain string
+		want   bool
+	}{
+		{name: "exact domain", domain: "pypi.org.", want: true},
+		{name: "exact domain case insensitive", domain: "PyPI.org.", want: true},
+		{name: "subdomain of exact domain", domain: "files.pypi.org.", want: false},
+		{name: "wildcard subdomain", domain: "s3.amazonaws.com.", want: true},
+		{name: "wildcard nested subdomain", domain: "bucket.s3.amazonaws.com.", want: true},
+		{name: "wildcard apex", domain: "amazonaws.com.", want: false},
+		{name: "wildcard suffix only", domain: "evilamazonaws.com.", want: false},
+		{name: "normalized allowed domain", domain: "github.com", want: true},
+		{name: "other domain", domain: "example.com.", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domainAllowed(domains, tt.domain); got != tt.want {
+				t.Errorf("domainAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"net/netip"
 	"os"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/nftables"
 	"github.com/google/nftables/expr"
@@ -15,6 +18,12 @@ import (
 
 const (
 	tableName = "slot-firewall"
+
+	// allTrafficCIDR is used to block all traffic that is not explicitly allowed.
+	allTrafficCIDR = "0.0.0.0/0"
+
+	egressCounterName  = "egress"
+	ingressCounterName = "ingress"
 )
 
 var blockedRanges = []string{
@@ -24,13 +33,35 @@ var blockedRanges = []string{
 	"172.16.0.0/12",
 }
 
+// Counters contain the traffic forwarded from and to the sandbox since the slot was assigned to it.
+// The egress counters include the packets dropped by the firewall.
+// The traffic of the sandbox with the DNS filter and the egress proxy in the slot namespace is counted too.
+type Counters struct {
+	EgressBytes    uint64
+	EgressPackets  uint64
+	IngressBytes   uint64
+	IngressPackets uint64
+}
+
 type Firewall struct {
 	conn         *nftables.Conn
 	table        *nftables.Table
 	chain        *nftables.Chain
+	inputChain   *nftables.Chain
+	outputChain  *nftables.Chain
 	blockSet     set.Set
 	allowSet     set.Set
 	tapInterface string
+
+	// allowMu guards the allow set, it is updated both by the egress configuration and by the DNS filter.
+	allowMu sync.Mutex
+	// customAllowed are the CIDRs explicitly allowed by the egress configuration.
+	customAllowed []string
+	// resolved are the IPs of the allowed domains with the time their DNS records expire.
+	resolved map[netip.Addr]time.Time
+
+	// stale are the tables of the previous orchestrator process the adopted firewall replaces.
+	stale []string
 }
 
 func NewFirewall(tapIf string) (*Firewall, error) {
@@ -39,8 +70,63 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		return nil, fmt.Errorf("new nftables conn: %w", err)
 	}
 
+	return newFirewall(conn, tapIf, tableName)
+}
+
+// AdoptFirewall creates the firewall in the namespace that already has the tables of the previous orchestrator process.
+// Their sets can't be reused, so the new table is created next to them and they keep filtering the traffic until
+// they are removed with RemoveStale, after the sandbox rules are configured in the new table.
+func AdoptFirewall(tapIf string) (*Firewall, error) {
+	conn, err := nftables.New(nftables.AsLasting())
+	if err != nil {
+		return nil, fmt.Errorf("new nftables conn: %w", err)
+	}
+
+	tables, err := conn.ListTablesOfFamily(nftables.TableFamilyINet)
+	if err != nil {
+		return nil, fmt.Errorf("list nftables tables: %w", err)
+	}
+
+	var stale []string
+	for _, t := range tables {
+		if t.Name == tableName || strings.HasPrefix(t.Name, tableName+"-") {
+			stale = append(stale, t.Name)
+		}
+	}
+
+	name := tableName
+	if slices.Contains(stale, tableName) {
+		name = tableName + "-adopted"
+	}
+
+	fw, err := newFirewall(conn, tapIf, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fw.stale = stale
+
+	return fw, nil
+}
+
+// RemoveStale deletes the tables left by the previous orchestrator process.
+func (fw *Firewall) RemoveStale() error {
+	for _, name := range fw.stale {
+		fw.conn.DelTable(&nftables.Table{Name: name, Family: nftables.TableFamilyINet})
+	}
+
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("flush stale tables removal: %w", err)
+	}
+
+	fw.stale = nil
+
+	return nil
+}
+
+func newFirewall(conn *nftables.Conn, tapIf string, name string) (*Firewall, error) {
 	table := conn.AddTable(&nftables.Table{
-		Name:   tableName,
+		Name:   name,
 		Family: nftables.TableFamilyINet,
 	})
 	acceptPolicy := nftables.ChainPolicyAccept
@@ -53,6 +139,24 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		Policy:   &acceptPolicy,
 	})
 
+	// The connections redirected to the DNS filter and the egress proxy end in the slot namespace, they are only counted.
+	inputChain := conn.AddChain(&nftables.Chain{
+		Name:     "INPUT",
+		Table:    table,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookInput,
+		Priority: nftables.ChainPriorityFilter,
+		Policy:   &acceptPolicy,
+	})
+	outputChain := conn.AddChain(&nftables.Chain{
+		Name:     "OUTPUT",
+		Table:    table,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookOutput,
+		Priority: nftables.ChainPriorityFilter,
+		Policy:   &acceptPolicy,
+	})
+
 	// Create block-set and allow-set
 	blockSet, err := set.New(conn, table, "filtered_blocklist", nftables.TypeIPAddr)
 	if err != nil {
@@ -67,9 +171,12 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		conn:         conn,
 		table:        table,
 		chain:        chain,
+		inputChain:   inputChain,
+		outputChain:  outputChain,
 		blockSet:     blockSet,
 		allowSet:     allowSet,
 		tapInterface: tapIf,
+		resolved:     make(map[netip.Addr]time.Time),
 	}
 
 	// Add firewall rules to the chain
@@ -102,6 +209,43 @@ func (fw *Firewall) installRules() error {
 		},
 	}
 
+	// Count all traffic from and to the tap interface before any verdict is applied
+	fw.conn.AddObj(&nftables.CounterObj{Table: fw.table, Name: egressCounterName})
+	fw.conn.AddObj(&nftables.CounterObj{Table: fw.table, Name: ingressCounterName})
+
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+			&expr.Cmp{
+				Register: 1,
+				Op:       expr.CmpOpEq,
+				Data:     append([]byte(m), 0), // null-terminated
+			},
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: ingressCounterName},
+		},
+	})
+
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.inputChain,
+		Exprs: append(slices.Clone(ifaceMatch),
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: egressCounterName},
+		),
+	})
+
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.outputChain,
+		Exprs: []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+			&expr.Cmp{
+				Register: 1,
+				Op:       expr.CmpOpEq,
+				Data:     append([]byte(m), 0), // null-terminated
+			},
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: ingressCounterName},
+		},
+	})
+
 	// Allow ESTABLISHED,RELATED
 	exprs, err := rule.Build(
 		expr.VerdictAccept,
@@ -129,6 +273,14 @@ func (fw *Firewall) installRules() error {
 		),
 	})
 
+	// Inserted last, so it is the first rule in the chain
+	fw.conn.InsertRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(slices.Clone(ifaceMatch),
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: egressCounterName},
+		),
+	})
+
 	// Drop anything in blockSet
 	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
@@ -146,21 +298,51 @@ func (fw *Firewall) installRules() error {
 	return nil
 }
 
+// Counters returns the traffic counters of the slot.
+func (fw *Firewall) Counters() (Counters, error) {
+	egress, err := fw.conn.GetObject(&nftables.CounterObj{Table: fw.table, Name: egressCounterName})
+	if err != nil {
+		return Counters{}, fmt.Errorf("get egress counter: %w", err)
+	}
+
+	ingress, err := fw.conn.GetObject(&nftables.CounterObj{Table: fw.table, Name: ingressCounterName})
+	if err != nil {
+		return Counters{}, fmt.Errorf("get ingress counter: %w", err)
+	}
+
+	egressCounter, egressOk := egress.(*nftables.CounterObj)
+	ingressCounter, ingressOk := ingress.(*nftables.CounterObj)
+	if !egressOk || !ingressOk {
+		return Counters{}, fmt.Errorf("unexpected counter object types %T and %T", egress, ingress)
+	}
+
+	return Counters{
+		EgressBytes:    egressCounter.Bytes,
+		EgressPackets:  egressCounter.Packets,
+		IngressBytes:   ingressCounter.Bytes,
+		IngressPackets: ingressCounter.Packets,
+	}, nil
+}
+
+// ResetCounters sets the traffic counters of the slot to zero.
+func (fw *Firewall) ResetCounters() error {
+	for _, name := range []string{egressCounterName, ingressCounterName} {
+		_, err := fw.conn.ResetObject(&nftables.CounterObj{Table: fw.table, Name: name})
+		if err != nil {
+			return fmt.Errorf("reset %s counter: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 // AddBlockedIP adds a single CIDR to the block set at runtime.
 func (fw *Firewall) AddBlockedIP(cidr string) error {
 	// 0.0.0.0/0 is not valid IP per GoLang, so we handle it as a special case
-	if cidr == "0.0.0.0/0" {
+	if cidr == allTrafficCIDR {
 		fw.conn.FlushSet(fw.blockSet.Set())
 
-		toAppend := []nftables.SetElement{
-			{Key: netip.MustParseAddr("0.0.0.0").AsSlice()},
-			{
-				Key:         netip.MustParseAddr("255.255.255.255").AsSlice(),
-				IntervalEnd: true,
-			},
-		}
-
-		if err := fw.conn.SetAddElements(fw.blockSet.Set(), toAppend); err != nil {
+		if err := fw.conn.SetAddElements(fw.blockSet.Set(), allTrafficElements()); err != nil {
 			return fmt.Errorf("add elements to block set: %w", err)
 		}
 	} else {
@@ -234,6 +416,195 @@ func (fw *Firewall) ResetBlockedCustom() error {
 
 // ResetAllowedCustom resets allow set back to original ranges.
 func (fw *Firewall) ResetAllowedCustom() error {
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	fw.customAllowed = nil
+	clear(fw.resolved)
+
+	initData, err := set.AddressStringsToSetData(defaultAllowedRanges())
+	if err != nil {
+		return fmt.Errorf("parse initial allow CIDRs: %w", err)
+	}
+	if err := fw.allowSet.ClearAndAddElements(fw.conn, initData); err != nil {
+		return err
+	}
+	return fw.conn.Flush()
+}
+
+// ReplaceCustom replaces the custom allowed and blocked CIDRs, the original ranges are always kept.
+// The allowed CIDRs cannot overlap with the original blocked ranges, so the sandbox can't reach the internal network.
+func (fw *Firewall) ReplaceCustom(allowed []string, blocked []string) error {
+	for _, cidr := range allowed {
+		if err := validateAllowedCIDR(cidr); err != nil {
+			return err
+		}
+	}
+
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	fw.customAllowed = slices.Clone(allowed)
+
+	allowData, err := fw.allowSetData()
+	if err != nil {
+		return fmt.Errorf("parse allow CIDRs: %w", err)
+	}
+
+	if slices.Contains(blocked, allTrafficCIDR) {
+		fw.conn.FlushSet(fw.blockSet.Set())
+
+		if err := fw.conn.SetAddElements(fw.blockSet.Set(), allTrafficElements()); err != nil {
+			return fmt.Errorf("add elements to block set: %w", err)
+		}
+	} else {
+		blockData, err := set.AddressStringsToSetData(append(slices.Clone(blockedRanges), blocked...))
+		if err != nil {
+			return fmt.Errorf("parse block CIDRs: %w", err)
+		}
+
+		if err := fw.blockSet.ClearAndAddElements(fw.conn, blockData); err != nil {
+			return err
+		}
+	}
+
+	if err := fw.allowSet.ClearAndAddElements(fw.conn, allowData); err != nil {
+		return err
+	}
+
+	err = fw.conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush replace custom changes: %w", err)
+	}
+	return nil
+}
+
+// AddResolvedIPs allows the IPs resolved for an allowed domain until the TTL of the DNS record expires.
+// IPs from the internal ranges are never allowed, so a domain can't be used to reach the internal network.
+func (fw *Firewall) AddResolvedIPs(ips []netip.Addr, ttl time.Duration) error {
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+
+	changed := false
+	for _, ip := range ips {
+		if !ip.Is4() || isBlockedRange(ip) {
+			continue
+		}
+
+		if _, ok := fw.resolved[ip]; !ok {
+			changed = true
+		}
+
+		if fw.resolved[ip].Before(expiresAt) {
+			fw.resolved[ip] = expiresAt
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return fw.syncAllowSet()
+}
+
+// ExpireResolvedIPs removes the resolved IPs whose DNS records expired from the allow set.
+func (fw *Firewall) ExpireResolvedIPs() error {
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	now := time.Now()
+
+	changed := false
+	for ip, expiresAt := range fw.resolved {
+		if expiresAt.Before(now) {
+			delete(fw.resolved, ip)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return fw.syncAllowSet()
+}
+
+// ClearResolvedIPs removes all the resolved IPs from the allow set.
+func (fw *Firewall) ClearResolvedIPs() error {
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	if len(fw.resolved) == 0 {
+		return nil
+	}
+
+	clear(fw.resolved)
+
+	return fw.syncAllowSet()
+}
+
+// allowedDestination reports whether the IP is allowed by the default and custom CIDRs,
+// or whether it is allowed only as the resolved IP of an allowed domain.
+func (fw *Firewall) allowedDestination(ip netip.Addr) (custom bool, resolved bool) {
+	fw.allowMu.Lock()
+	defer fw.allowMu.Unlock()
+
+	for _, cidr := range append(defaultAllowedRanges(), fw.customAllowed...) {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err == nil && prefix.Contains(ip) {
+			return true, false
+		}
+	}
+
+	expiresAt, ok := fw.resolved[ip]
+
+	return false, ok && time.Now().Before(expiresAt)
+}
+
+// syncAllowSet replaces the allow set content, allowMu must be held.
+func (fw *Firewall) syncAllowSet() error {
+	allowData, err := fw.allowSetData()
+	if err != nil {
+		return fmt.Errorf("parse allow CIDRs: %w", err)
+	}
+
+	if err := fw.allowSet.ClearAndAddElements(fw.conn, allowData); err != nil {
+		return err
+	}
+
+	err = fw.conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush allow set changes: %w", err)
+	}
+	return nil
+}
+
+// allowSetData returns the default, custom and resolved allowed ranges, allowMu must be held.
+// The resolved IPs already covered by the other ranges are skipped, as the interval set can't contain overlapping elements.
+func (fw *Firewall) allowSetData() ([]set.SetData, error) {
+	ranges := append(defaultAllowedRanges(), fw.customAllowed...)
+
+	data, err := set.AddressStringsToSetData(ranges)
+	if err != nil {
+		return nil, err
+	}
+
+	for ip := range fw.resolved {
+		covered := slices.ContainsFunc(data, func(d set.SetData) bool {
+			return d.Prefix.IsValid() && d.Prefix.Contains(ip)
+		})
+
+		if !covered {
+			data = append(data, set.SetData{Address: ip})
+		}
+	}
+
+	return data, nil
+}
+
+func defaultAllowedRanges() []string {
 	initIps := make([]string, 0)
 
 	// Allow Logs Collector IP for logs
@@ -242,12 +613,40 @@ func (fw *Firewall) ResetAllowedCustom() error {
 		initIps = append(initIps, ip)
 	}
 
-	initData, err := set.AddressStringsToSetData(initIps)
+	return initIps
+}
+
+func allTrafficElements() []nftables.SetElement {
+	return []nftables.SetElement{
+		{Key: netip.MustParseAddr("0.0.0.0").AsSlice()},
+		{
+			Key:         netip.MustParseAddr("255.255.255.255").AsSlice(),
+			IntervalEnd: true,
+		},
+	}
+}
+
+func isBlockedRange(ip netip.Addr) bool {
+	for _, blockedRange := range blockedRanges {
+		if netip.MustParsePrefix(blockedRange).Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAllowedCIDR(cidr string) error {
+	prefix, err := netip.ParsePrefix(cidr)
 	if err != nil {
-		return fmt.Errorf("parse initial allow CIDRs: %w", err)
+		return fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
 	}
-	if err := fw.allowSet.ClearAndAddElements(fw.conn, initData); err != nil {
-		return err
+
+	for _, blockedRange := range blockedRanges {
+		if netip.MustParsePrefix(blockedRange).Overlaps(prefix) {
+			return fmt.Errorf("CIDR '%s' overlaps with the internal range '%s'", cidr, blockedRange)
+		}
 	}
-	return fw.conn.Flush()
+
+	return nil
 }
@@ -133,7 +133,13 @@ func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool)
 		}
 	}
 
-	err := slot.ConfigureInternet(ctx, tracer, allowInternet)
+	// The counters could contain the traffic of the previous sandbox that used the slot
+	err := slot.Firewall.ResetCounters()
+	if err != nil {
+		return nil, fmt.Errorf("error resetting slot traffic counters: %w", err)
+	}
+
+	err = slot.ConfigureInternet(ctx, tracer, allowInternet)
 	if err != nil {
 		return nil, fmt.Errorf("error setting slot internet access: %w", err)
 	}
@@ -141,6 +147,27 @@ func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool)
 	return slot, nil
 }
 
+// Adopt registers the slot of the sandbox started by the previous orchestrator process, its network already exists.
+// The slot firewall is replaced, the previous one has to be removed with RemoveStaleFirewall after the egress is configured.
+// The adopted slot is returned to the pool as any other slot.
+func (p *Pool) Adopt(key string, idx int) (*Slot, error) {
+	slot, err := p.slotStorage.Adopt(key, idx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adopt slot '%d': %w", idx, err)
+	}
+
+	err = slot.AdoptFirewall()
+	if err != nil {
+		if cerr := p.cleanup(slot); cerr != nil {
+			return nil, fmt.Errorf("adopt firewall: %v; cleanup: %w", err, cerr)
+		}
+
+		return nil, fmt.Errorf("error adopting slot firewall: %w", err)
+	}
+
+	return slot, nil
+}
+
 func (p *Pool) Return(ctx context.Context, tracer trace.Tracer, slot *Slot) error {
 	err := slot.ResetInternet(ctx, tracer)
 	if err != nil {
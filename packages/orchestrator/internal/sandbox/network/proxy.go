@@ -0,0 +1,250 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+const (
+	httpPort  = 80
+	httpsPort = 443
+
+	// The guest connections to the HTTP and HTTPS ports are redirected to the proxy listening on the tap IP.
+	httpProxyPort  = 50080
+	httpsProxyPort = 50443
+
+	// serverNameTimeout bounds the wait for the TLS client hello or the first HTTP request of the connection.
+	serverNameTimeout   = 10 * time.Second
+	upstreamDialTimeout = 10 * time.Second
+)
+
+var (
+	errHelloRead    = errors.New("client hello read")
+	errHostBlocked  = errors.New("host is not allowed")
+	errNoServerName = errors.New("client hello has no server name")
+)
+
+type dialFunc func() (net.Conn, error)
+
+// forwardFunc forwards the guest connection to the upstream if the hosts it connects to are allowed.
+type forwardFunc func(guest net.Conn, dial dialFunc, allowed func(host string) bool) error
+
+// forwardRaw copies the connection to the upstream without inspecting it.
+func forwardRaw(guest net.Conn, dial dialFunc) error {
+	upstream, err := dial()
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+
+	responses := copyAsync(guest, upstream)
+
+	_, err = io.Copy(upstream, guest)
+	closeWrite(upstream)
+
+	return errors.Join(err, <-responses)
+}
+
+// forwardTLS forwards the HTTPS connection if the server name of its TLS client hello is allowed.
+// The HTTP requests sent inside the TLS connection can't be inspected, their host isn't checked.
+func forwardTLS(guest net.Conn, dial dialFunc, allowed func(host string) bool) error {
+	var hello bytes.Buffer
+
+	err := guest.SetReadDeadline(time.Now().Add(serverNameTimeout))
+	if err != nil {
+		return err
+	}
+
+	name, err := readServerName(guest, &hello)
+	if err != nil {
+		return err
+	}
+
+	err = guest.SetReadDeadline(time.Time{})
+	if err != nil {
+		return err
+	}
+
+	if !allowed(name) {
+		return fmt.Errorf("%w: %s", errHostBlocked, name)
+	}
+
+	upstream, err := dial()
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+
+	_, err = upstream.Write(hello.Bytes())
+	if err != nil {
+		return fmt.Errorf("error writing client hello: %w", err)
+	}
+
+	responses := copyAsync(guest, upstream)
+
+	_, err = io.Copy(upstream, guest)
+	closeWrite(upstream)
+
+	return errors.Join(err, <-responses)
+}
+
+// readServerName reads the TLS client hello from the guest and returns its server name, the read data are written to the hello buffer.
+// The handshake is stopped after the hello is parsed, nothing is sent to the guest.
+func readServerName(guest net.Conn, hello io.Writer) (string, error) {
+	var name string
+
+	err := tls.Server(&helloConn{Conn: guest, r: io.TeeReader(guest, hello)}, &tls.Config{
+		GetConfigForClient: func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+			name = info.ServerName
+
+			return nil, errHelloRead
+		},
+	}).Handshake()
+	if !errors.Is(err, errHelloRead) {
+		return "", fmt.Errorf("error reading client hello: %w", err)
+	}
+
+	if name == "" {
+		return "", errNoServerName
+	}
+
+	return name, nil
+}
+
+// helloConn is the guest connection the TLS client hello is read from, the writes of the handshake are discarded.
+type helloConn struct {
+	net.Conn
+
+	r io.Reader
+}
+
+func (c *helloConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func (c *helloConn) Write(p []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+// forwardHTTP forwards the HTTP requests of the connection whose host is allowed.
+// Every request is checked, so the connection kept alive can't be reused for another host served from the same IP.
+func forwardHTTP(guest net.Conn, dial dialFunc, allowed func(host string) bool) error {
+	reader := bufio.NewReader(guest)
+
+	var upstream net.Conn
+	var responses <-chan error
+
+	defer func() {
+		if upstream != nil {
+			upstream.Close()
+		}
+	}()
+
+	for {
+		// Only the first request has to arrive in time, the connection kept alive can be idle.
+		if upstream == nil {
+			err := guest.SetReadDeadline(time.Now().Add(serverNameTimeout))
+			if err != nil {
+				return err
+			}
+		}
+
+		req, err := http.ReadRequest(reader)
+		if upstream != nil && errors.Is(err, io.EOF) {
+			closeWrite(upstream)
+
+			return <-responses
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading request: %w", err)
+		}
+
+		host := req.Host
+		if h, _, splitErr := net.SplitHostPort(host); splitErr == nil {
+			host = h
+		}
+
+		if !allowed(host) {
+			return fmt.Errorf("%w: %s", errHostBlocked, host)
+		}
+
+		if upstream == nil {
+			err = guest.SetReadDeadline(time.Time{})
+			if err != nil {
+				return err
+			}
+
+			upstream, err = dial()
+			if err != nil {
+				return err
+			}
+
+			responses = copyAsync(guest, upstream)
+		}
+
+		// The request is written as it was received, the default user agent isn't added to it.
+		if _, ok := req.Header["User-Agent"]; !ok {
+			req.Header["User-Agent"] = []string{""}
+		}
+
+		err = req.Write(upstream)
+		if err != nil {
+			return fmt.Errorf("error writing request: %w", err)
+		}
+
+		// The upgraded connection doesn't carry the HTTP requests anymore.
+		if req.Header.Get("Upgrade") != "" {
+			_, err = io.Copy(upstream, reader)
+			closeWrite(upstream)
+
+			return errors.Join(err, <-responses)
+		}
+	}
+}
+
+// copyAsync copies the responses of the upstream to the guest, the returned channel receives the result after the upstream closes.
+func copyAsync(guest, upstream net.Conn) <-chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := io.Copy(guest, upstream)
+		closeWrite(guest)
+
+		done <- err
+	}()
+
+	return done
+}
+
+// closeWrite closes the write side of the connection, so the other side reads the end of the data.
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		_ = c.CloseWrite()
+
+		return
+	}
+
+	_ = conn.Close()
+}
+
+// dialUpstream returns the function connecting to the original destination of the guest connection.
+// The connection is made from the host namespace, the same as the upstream DNS queries.
+func dialUpstream(destination netip.AddrPort) dialFunc {
+	return func() (net.Conn, error) {
+		conn, err := net.DialTimeout("tcp4", destination.String(), upstreamDialTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to '%s': %w", destination, err)
+		}
+
+		return conn, nil
+	}
+}
@@ -0,0 +1,47 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"golang.org/x/sys/unix"
+)
+
+// originalDestination returns the destination of the guest connection before it was redirected to the proxy.
+func originalDestination(conn net.Conn) (netip.AddrPort, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return netip.AddrPort{}, errors.New("connection is not a TCP connection")
+	}
+
+	raw, err := tcpConn.SyscallConn()
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("error getting raw connection: %w", err)
+	}
+
+	var addr *unix.IPv6Mreq
+	var sockErr error
+
+	err = raw.Control(func(fd uintptr) {
+		// The address is returned as the sockaddr_in structure, it fits the size of the IPv6 mreq.
+		addr, sockErr = unix.GetsockoptIPv6Mreq(int(fd), unix.SOL_IP, unix.SO_ORIGINAL_DST)
+	})
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("error accessing connection: %w", err)
+	}
+
+	if sockErr != nil {
+		return netip.AddrPort{}, fmt.Errorf("error getting original destination: %w", sockErr)
+	}
+
+	port := binary.BigEndian.Uint16(addr.Multiaddr[2:4])
+	ip := netip.AddrFrom4([4]byte(addr.Multiaddr[4:8]))
+
+	return netip.AddrPortFrom(ip, port), nil
+}
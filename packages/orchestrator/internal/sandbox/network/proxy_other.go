@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+)
+
+func originalDestination(conn net.Conn) (netip.AddrPort, error) {
+	return netip.AddrPort{}, errors.New("platform does not support redirected connections")
+}
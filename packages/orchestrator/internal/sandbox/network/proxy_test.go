@@ -0,0 +1,133 @@
+package network
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// proxyTestConn returns the guest end of the connection and forwards its proxy end in the background.
+func proxyTestConn(t *testing.T, forward forwardFunc, upstream string, domains []string) (net.Conn, <-chan error) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	guest, err := net.Dial("tcp4", listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { guest.Close() })
+
+	proxied, err := listener.Accept()
+	require.NoError(t, err)
+	t.Cleanup(func() { proxied.Close() })
+
+	dial := func() (net.Conn, error) {
+		return net.Dial("tcp4", upstream)
+	}
+
+	allowed := func(host string) bool {
+		return domainAllowed(normalizeDomains(domains), host)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- forward(proxied, dial, allowed)
+		proxied.Close()
+	}()
+
+	return guest, done
+}
+
+func TestForwardTLS(t *testing.T) {
+	var served atomic.Int64
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		fmt.Fprint(w, r.TLS.ServerName)
+	}))
+	t.Cleanup(upstream.Close)
+
+	t.Run("allowed server name is forwarded", func(t *testing.T) {
+		guest, done := proxyTestConn(t, forwardTLS, upstream.Listener.Addr().String(), []string{"pypi.org"})
+
+		conn := tls.Client(guest, &tls.Config{ServerName: "pypi.org", InsecureSkipVerify: true})
+
+		req, err := http.NewRequest(http.MethodGet, "https://pypi.org/", nil)
+		require.NoError(t, err)
+		require.NoError(t, req.Write(conn))
+
+		resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "pypi.org", string(body))
+
+		conn.Close()
+		<-done
+	})
+
+	t.Run("other server name is blocked", func(t *testing.T) {
+		before := served.Load()
+
+		guest, done := proxyTestConn(t, forwardTLS, upstream.Listener.Addr().String(), []string{"pypi.org"})
+
+		conn := tls.Client(guest, &tls.Config{ServerName: "example.com", InsecureSkipVerify: true})
+		require.Error(t, conn.Handshake())
+
+		require.ErrorIs(t, <-done, errHostBlocked)
+		assert.Equal(t, before, served.Load())
+	})
+
+	t.Run("client hello without server name is blocked", func(t *testing.T) {
+		guest, done := proxyTestConn(t, forwardTLS, upstream.Listener.Addr().String(), []string{"pypi.org"})
+
+		conn := tls.Client(guest, &tls.Config{InsecureSkipVerify: true})
+		require.Error(t, conn.Handshake())
+
+		require.ErrorIs(t, <-done, errNoServerName)
+	})
+}
+
+func TestForwardHTTP(t *testing.T) {
+	var served atomic.Int64
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		fmt.Fprint(w, r.Host)
+	}))
+	t.Cleanup(upstream.Close)
+
+	guest, done := proxyTestConn(t, forwardHTTP, upstream.Listener.Addr().String(), []string{"*.pythonhosted.org"})
+	reader := bufio.NewReader(guest)
+
+	request := func(host string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, "http://"+host+"/", nil)
+		require.NoError(t, err)
+		require.NoError(t, req.Write(guest))
+
+		return http.ReadResponse(reader, req)
+	}
+
+	resp, err := request("files.pythonhosted.org:80")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "files.pythonhosted.org:80", string(body))
+
+	// The connection kept alive can't be reused for another host.
+	_, err = request("example.com")
+	require.Error(t, err)
+
+	require.ErrorIs(t, <-done, errHostBlocked)
+	assert.Equal(t, int64(1), served.Load())
+}
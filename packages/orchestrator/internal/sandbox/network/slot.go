@@ -6,11 +6,13 @@ import (
 	"log"
 	"net"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 
 	"github.com/containernetworking/plugins/pkg/ns"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	netutils "k8s.io/utils/net"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -59,6 +61,10 @@ type Slot struct {
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
 
+	// dnsFilter is running when the egress is limited to the allowed domains.
+	dnsFilter   *DNSFilter
+	dnsFilterMu sync.Mutex
+
 	vPeerIp net.IP
 	vEthIp  net.IP
 	vrtMask net.IPMask
@@ -221,6 +227,36 @@ func (s *Slot) InitializeFirewall() error {
 	return nil
 }
 
+// AdoptFirewall replaces the firewall the previous orchestrator process created in the slot namespace.
+// The previous firewall keeps filtering the traffic until RemoveStaleFirewall is called.
+func (s *Slot) AdoptFirewall() error {
+	if s.Firewall != nil {
+		return fmt.Errorf("firewall is already initialized for slot %s", s.Key)
+	}
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	return n.Do(func(_ ns.NetNS) error {
+		fw, err := AdoptFirewall(s.TapName())
+		if err != nil {
+			return fmt.Errorf("error adopting firewall: %w", err)
+		}
+
+		s.Firewall = fw
+
+		return nil
+	})
+}
+
+// RemoveStaleFirewall removes the firewall of the previous orchestrator process, call it after the slot egress is configured.
+func (s *Slot) RemoveStaleFirewall() error {
+	return s.Firewall.RemoveStale()
+}
+
 func (s *Slot) CloseFirewall() error {
 	if s.Firewall == nil {
 		return nil
@@ -255,7 +291,7 @@ func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allow
 	defer n.Close()
 
 	err = n.Do(func(_ ns.NetNS) error {
-		err = s.Firewall.AddBlockedIP("0.0.0.0/0")
+		err = s.Firewall.AddBlockedIP(allTrafficCIDR)
 		if err != nil {
 			return fmt.Errorf("error setting firewall rules: %w", err)
 		}
@@ -269,12 +305,58 @@ func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allow
 	return nil
 }
 
+// ConfigureEgress replaces the custom egress rules of the slot firewall.
+// Denying "0.0.0.0/0" blocks all traffic that is not explicitly allowed.
+// When domains are set, the guest DNS queries go through the DNS filter, which allows the IPs resolved for these domains
+// and logs the blocked lookups to the logger.
+func (s *Slot) ConfigureEgress(ctx context.Context, tracer trace.Tracer, allowed []string, denied []string, domains []string, logger *zap.Logger) error {
+	_, span := tracer.Start(ctx, "slot-egress-configure", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.StringSlice("allowed_cidrs", allowed),
+		attribute.StringSlice("denied_cidrs", denied),
+		attribute.StringSlice("allowed_domains", domains),
+	))
+	defer span.End()
+
+	s.firewallCustomRules.Store(true)
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		err := s.Firewall.ReplaceCustom(allowed, denied)
+		if err != nil {
+			return fmt.Errorf("error setting firewall rules: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+	}
+
+	err = s.configureDNSFilter(domains, logger)
+	if err != nil {
+		return fmt.Errorf("error configuring DNS filter: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 	))
 	defer span.End()
 
+	err := s.configureDNSFilter(nil, nil)
+	if err != nil {
+		return fmt.Errorf("error stopping DNS filter: %w", err)
+	}
+
 	if !s.firewallCustomRules.CompareAndSwap(true, false) {
 		return nil
 	}
@@ -300,6 +382,38 @@ func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 	return nil
 }
 
+// configureDNSFilter starts, updates or stops the DNS filter of the slot, no domains stop the filter.
+func (s *Slot) configureDNSFilter(domains []string, logger *zap.Logger) error {
+	s.dnsFilterMu.Lock()
+	defer s.dnsFilterMu.Unlock()
+
+	if len(domains) == 0 {
+		if s.dnsFilter == nil {
+			return nil
+		}
+
+		err := s.dnsFilter.stop()
+		s.dnsFilter = nil
+
+		return err
+	}
+
+	if s.dnsFilter != nil {
+		return s.dnsFilter.update(domains, logger)
+	}
+
+	filter := newDNSFilter(s, domains, logger)
+
+	err := filter.start()
+	if err != nil {
+		return err
+	}
+
+	s.dnsFilter = filter
+
+	return nil
+}
+
 func getHostNetworkCIDR() *net.IPNet {
 	cidr := env.GetEnv("SANDBOXES_HOST_NETWORK_CIDR", defaultHostNetworkCIDR)
 
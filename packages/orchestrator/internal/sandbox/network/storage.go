@@ -13,6 +13,8 @@ var localNamespaceStorageSwitch = os.Getenv("USE_LOCAL_NAMESPACE_STORAGE")
 
 type Storage interface {
 	Acquire(ctx context.Context) (*Slot, error)
+	// Adopt reserves the slot acquired by the previous orchestrator process.
+	Adopt(key string, idx int) (*Slot, error)
 	Release(*Slot) error
 }
 
@@ -121,6 +121,36 @@ func (s *StorageKV) Acquire(_ context.Context) (*Slot, error) {
 	return slot, nil
 }
 
+// Adopt keeps the reservation made by the previous orchestrator process, the slot is reserved again if it was released meanwhile.
+func (s *StorageKV) Adopt(key string, idx int) (*Slot, error) {
+	if key != s.getKVKey(idx) {
+		return nil, fmt.Errorf("slot key '%s' doesn't belong to slot %d of this node", key, idx)
+	}
+
+	kv := s.consulClient.KV()
+
+	pair, _, err := kv.Get(key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Consul KV: %w", err)
+	}
+
+	if pair == nil {
+		status, _, err := kv.CAS(&consulApi.KVPair{
+			Key:         key,
+			ModifyIndex: 0,
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write to Consul KV: %w", err)
+		}
+
+		if !status {
+			return nil, fmt.Errorf("IP slot %d was acquired by someone else", idx)
+		}
+	}
+
+	return NewSlot(key, idx)
+}
+
 func (s *StorageKV) Release(ips *Slot) error {
 	kv := s.consulClient.KV()
 
@@ -100,6 +100,22 @@ func (s *StorageLocal) Acquire(ctx context.Context) (*Slot, error) {
 	}
 }
 
+func (s *StorageLocal) Adopt(key string, idx int) (*Slot, error) {
+	s.acquiredNsMu.Lock()
+	defer s.acquiredNsMu.Unlock()
+
+	slotName := getSlotName(idx)
+	if _, found := s.acquiredNs[slotName]; found {
+		return nil, fmt.Errorf("slot %d is already acquired", idx)
+	}
+
+	// The namespace existed on start, so it was marked as foreign.
+	delete(s.foreignNs, slotName)
+	s.acquiredNs[slotName] = struct{}{}
+
+	return NewSlot(key, idx)
+}
+
 func (s *StorageLocal) Release(ips *Slot) error {
 	s.acquiredNsMu.Lock()
 	defer s.acquiredNsMu.Unlock()
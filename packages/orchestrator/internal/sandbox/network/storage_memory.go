@@ -38,6 +38,23 @@ func (s *StorageMemory) Acquire(_ context.Context) (*Slot, error) {
 	return nil, fmt.Errorf("failed to acquire IP slot: no empty slots found")
 }
 
+func (s *StorageMemory) Adopt(key string, idx int) (*Slot, error) {
+	s.freeSlotsMu.Lock()
+	defer s.freeSlotsMu.Unlock()
+
+	if idx < 1 || idx >= s.slotsSize {
+		return nil, fmt.Errorf("slot index %d is out of range", idx)
+	}
+
+	if s.freeSlots[idx] {
+		return nil, fmt.Errorf("slot %d is already acquired", idx)
+	}
+
+	s.freeSlots[idx] = true
+
+	return NewSlot(key, idx)
+}
+
 func (s *StorageMemory) Release(ips *Slot) error {
 	s.freeSlotsMu.Lock()
 	defer s.freeSlotsMu.Unlock()
@@ -0,0 +1,189 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bits-and-blooms/bitset"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// memoryPrefetchWindow is how long after the resume the memfile blocks accessed by the guest are observed.
+	memoryPrefetchWindow = 10 * time.Second
+
+	// rootfsPrefetchWorkers is the number of the rootfs reads running in parallel while warming the rootfs.
+	rootfsPrefetchWorkers = 8
+)
+
+// observeMemoryPrefetch checks the memfile blocks accessed by the guest at the end of the prefetch window.
+// If the sandbox was resumed with the prefetch profile, the hit and miss rates of the profile are reported.
+// If the build doesn't have the profile and the sandbox was started from the template, the accessed blocks are uploaded as the profile.
+func (s *Sandbox) observeMemoryPrefetch(
+	tracer trace.Tracer,
+	persistence storage.StorageProvider,
+	memfile block.ReadonlyDevice,
+	prefetch *template.MemfilePrefetch,
+	profile *header.PrefetchProfile,
+) {
+	record := profile == nil && !s.Config.GetSnapshot() && prefetch.StartRecording()
+	if profile == nil && !record {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cleanup.Add(func(context.Context) error {
+		cancel()
+
+		return nil
+	})
+
+	go func() {
+		select {
+		case <-time.After(memoryPrefetchWindow):
+		case <-ctx.Done():
+			if record {
+				prefetch.FinishRecording(nil)
+			}
+
+			return
+		}
+
+		accessed := s.memory.Accessed()
+
+		if !record {
+			s.reportMemoryPrefetch(ctx, tracer, profile, accessed)
+
+			return
+		}
+
+		recorded, err := s.recordMemoryPrefetch(ctx, tracer, persistence, memfile, accessed)
+		if err != nil {
+			sbxlogger.I(s).Warn("failed to record memory prefetch profile", zap.Error(err))
+		}
+
+		prefetch.FinishRecording(recorded)
+	}()
+}
+
+// reportMemoryPrefetch reports how many of the blocks accessed by the guest were in the prefetch profile (hits) and how many weren't (misses).
+func (s *Sandbox) reportMemoryPrefetch(ctx context.Context, tracer trace.Tracer, profile *header.PrefetchProfile, accessed *bitset.BitSet) {
+	_, span := tracer.Start(ctx, "report-memory-prefetch")
+	defer span.End()
+
+	hits, misses := profile.Compare(accessed)
+
+	hitRate := 0.0
+	if hits+misses > 0 {
+		hitRate = float64(hits) / float64(hits+misses)
+	}
+
+	span.SetAttributes(
+		telemetry.WithSandboxID(s.Config.SandboxId),
+		attribute.Int("prefetch.hits", int(hits)),
+		attribute.Int("prefetch.misses", int(misses)),
+		attribute.Float64("prefetch.hit_rate", hitRate),
+	)
+
+	sbxlogger.I(s).Info("memory prefetch",
+		zap.Uint("prefetch_hits", hits),
+		zap.Uint("prefetch_misses", misses),
+		zap.Float64("prefetch_hit_rate", hitRate),
+		zap.Int("prefetch_ranges", len(profile.Ranges)),
+	)
+}
+
+// recordMemoryPrefetch uploads the blocks accessed by the guest as the prefetch profile of the build.
+func (s *Sandbox) recordMemoryPrefetch(
+	ctx context.Context,
+	tracer trace.Tracer,
+	persistence storage.StorageProvider,
+	memfile block.ReadonlyDevice,
+	accessed *bitset.BitSet,
+) (*header.PrefetchProfile, error) {
+	ctx, span := tracer.Start(ctx, "record-memory-prefetch")
+	defer span.End()
+
+	size, err := memfile.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memfile size: %w", err)
+	}
+
+	profile := header.NewPrefetchProfile(uint64(memfile.BlockSize()), uint64(size), accessed)
+
+	span.SetAttributes(
+		telemetry.WithSandboxID(s.Config.SandboxId),
+		telemetry.WithBuildID(s.Config.BuildId),
+		attribute.Int("prefetch.blocks", int(accessed.Count())),
+		attribute.Int("prefetch.ranges", len(profile.Ranges)),
+	)
+
+	err = storage.NewTemplateBuild(nil, nil, persistence, s.template.Files().TemplateFiles).UploadMemfilePrefetch(ctx, profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload prefetch profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// warmRootfs reads the rootfs ranges from the prefetch profile of the build in the background right after the resume,
+// so the chunks with the commonly used files are already cached when the guest reads them.
+func (s *Sandbox) warmRootfs(tracer trace.Tracer, rootfs block.ReadonlyDevice, profile *header.PrefetchProfile) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cleanup.Add(func(context.Context) error {
+		cancel()
+
+		return nil
+	})
+
+	go func() {
+		ctx, span := tracer.Start(ctx, "warm-rootfs")
+		defer span.End()
+
+		span.SetAttributes(
+			telemetry.WithSandboxID(s.Config.SandboxId),
+			attribute.Int("prefetch.ranges", len(profile.Ranges)),
+		)
+
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.SetLimit(rootfsPrefetchWorkers)
+
+	outerLoop:
+		for _, r := range profile.Ranges {
+			end := int64(r.Offset + r.Length)
+
+			for off := int64(r.Offset); off < end; off += block.ChunkSize {
+				if ctx.Err() != nil {
+					break outerLoop
+				}
+
+				eg.Go(func() error {
+					b := make([]byte, min(block.ChunkSize, end-off))
+
+					_, err := rootfs.ReadAt(b, off)
+					if err != nil {
+						return fmt.Errorf("failed to read rootfs at %d: %w", off, err)
+					}
+
+					return nil
+				})
+			}
+		}
+
+		err := eg.Wait()
+		if err != nil {
+			sbxlogger.I(s).Warn("failed to warm rootfs", zap.Error(err))
+		}
+	}()
+}
@@ -94,6 +94,21 @@ func (o *DirectProvider) ExportDiff(
 	return m, nil
 }
 
+func (o *DirectProvider) ExportLiveDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	ctx, childSpan := o.tracer.Start(ctx, "direct-provider-live-export")
+	defer childSpan.End()
+
+	o.cache.MarkAllAsDirty()
+	m, err := o.cache.ExportToDiff(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "cache exported")
+
+	return m, nil
+}
+
 func (o *DirectProvider) Close(_ context.Context) error {
 	o.finishedOperations <- struct{}{}
 
@@ -61,6 +61,46 @@ func NewNBDProvider(tracer trace.Tracer, rootfs block.ReadonlyDevice, cachePath
 	}, nil
 }
 
+// AdoptNBDProvider serves the rootfs device of the sandbox started by the previous orchestrator process.
+// The cache file is reused, so the sandbox keeps its writes.
+func AdoptNBDProvider(tracer trace.Tracer, rootfs block.ReadonlyDevice, cachePath string, devicePool *nbd.DevicePool, deviceIndex uint32) (*NBDProvider, error) {
+	size, err := rootfs.Size()
+	if err != nil {
+		return nil, fmt.Errorf("error getting device size: %w", err)
+	}
+
+	blockSize := rootfs.BlockSize()
+
+	cache, err := block.OpenCache(size, blockSize, cachePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache: %w", err)
+	}
+
+	overlay := block.NewOverlay(rootfs, cache, blockSize)
+
+	mnt := nbd.NewDirectPathMount(tracer, overlay, devicePool)
+
+	provider := &NBDProvider{
+		tracer:             tracer,
+		mnt:                mnt,
+		overlay:            overlay,
+		ready:              utils.NewSetOnce[string](),
+		finishedOperations: make(chan struct{}, 1),
+		blockSize:          blockSize,
+		devicePool:         devicePool,
+	}
+
+	// The provider is returned even if the adoption fails, closing it disconnects the device.
+	err = mnt.Adopt(deviceIndex)
+	if err != nil {
+		adoptErr := fmt.Errorf("error adopting overlay device: %w", err)
+
+		return provider, errors.Join(adoptErr, provider.ready.SetError(adoptErr))
+	}
+
+	return provider, provider.ready.SetValue(nbd.GetDevicePath(deviceIndex))
+}
+
 func (o *NBDProvider) Start(ctx context.Context) error {
 	deviceIndex, err := o.mnt.Open(ctx)
 	if err != nil {
@@ -114,6 +154,25 @@ func (o *NBDProvider) ExportDiff(
 	return m, nil
 }
 
+func (o *NBDProvider) ExportLiveDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "cow-live-export")
+	defer childSpan.End()
+
+	err := o.flush(childCtx)
+	if err != nil {
+		return nil, fmt.Errorf("error flushing cow device: %w", err)
+	}
+
+	m, err := o.overlay.ExportToDiff(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	telemetry.ReportEvent(childCtx, "cache exported")
+
+	return m, nil
+}
+
 func (o *NBDProvider) Close(ctx context.Context) error {
 	childCtx, childSpan := o.tracer.Start(ctx, "cow-close")
 	defer childSpan.End()
@@ -146,6 +205,16 @@ func (o *NBDProvider) Path() (string, error) {
 	return o.ready.Wait()
 }
 
+// DeviceIndex returns the index of the NBD device the rootfs is served on.
+func (o *NBDProvider) DeviceIndex() (uint32, error) {
+	_, err := o.Path()
+	if err != nil {
+		return 0, err
+	}
+
+	return o.mnt.DeviceIndex(), nil
+}
+
 // flush flushes the data to the operating system's buffer.
 func (o *NBDProvider) flush(ctx context.Context) error {
 	telemetry.ReportEvent(ctx, "flushing cow device")
@@ -12,4 +12,6 @@ type Provider interface {
 	Close(ctx context.Context) error
 	Path() (string, error)
 	ExportDiff(ctx context.Context, out io.Writer, stopSandbox func(context.Context) error) (*header.DiffMetadata, error)
+	// ExportLiveDiff exports the diff without stopping the sandbox, the sandbox must be paused during the export.
+	ExportLiveDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error)
 }
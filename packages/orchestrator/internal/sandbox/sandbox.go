@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,14 +20,17 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/dataset"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -44,6 +49,11 @@ type Resources struct {
 	rootfs   rootfs.Provider
 	memory   uffd.MemoryBackend
 	uffdExit chan error
+	// volume is nil if the sandbox has no volume attached.
+	volume   *volume.Volume
+	datasets []*dataset.Dataset
+	// keepSlot prevents the cleanup from returning the slot to the network pool when it is handed over to another sandbox.
+	keepSlot *atomic.Bool
 }
 
 type Metadata struct {
@@ -60,8 +70,18 @@ type Sandbox struct {
 	cleanup *Cleanup
 
 	process *fc.Process
+	// balloon is nil if the sandbox doesn't have the balloon device.
+	balloon *memoryBalloon
 
 	template template.Template
+	// buildPeers are persisted with the state, so the adopted sandbox can fetch the template from them.
+	buildPeers []string
+	// migrationSource is the orchestrator the sandbox was migrated from, the template is read from it until it is uploaded.
+	migrationSource string
+
+	// stateMu guards the persisted state, it must not be saved again after the sandbox is stopped.
+	stateMu      sync.Mutex
+	stateRemoved bool
 
 	Checks *Checks
 }
@@ -96,7 +116,8 @@ func CreateSandbox(
 
 	cleanup := NewCleanup()
 
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	keepSlot := &atomic.Bool{}
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, nil, keepSlot, cleanup, allowInternet)
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -192,6 +213,7 @@ func CreateSandbox(
 		rootfs:   rootfsProvider,
 		memory:   uffd.NewNoopMemory(memfileSize, memfile.BlockSize()),
 		uffdExit: make(chan error, 1),
+		keepSlot: keepSlot,
 	}
 
 	metadata := &Metadata{
@@ -226,12 +248,14 @@ func CreateSandbox(
 }
 
 // ResumeSandbox resumes the sandbox from already saved template or snapshot.
+// If the slot is not nil, it is used instead of getting a new one from the network pool and the sandbox takes the ownership of it.
 // IMPORTANT: You have to run cleanup functions for the already initialized resources even if there is any error,
 // or after you are done with the started sandbox.
 func ResumeSandbox(
 	ctx context.Context,
 	tracer trace.Tracer,
 	networkPool *network.Pool,
+	slot *network.Slot,
 	templateCache *template.Cache,
 	config *orchestrator.SandboxConfig,
 	traceID string,
@@ -239,6 +263,9 @@ func ResumeSandbox(
 	endAt time.Time,
 	baseTemplateID string,
 	devicePool *nbd.DevicePool,
+	persistence storage.StorageProvider,
+	buildPeers []string,
+	migrationSource string,
 	allowInternet,
 	useClickhouseMetrics bool,
 ) (*Sandbox, *Cleanup, error) {
@@ -247,22 +274,19 @@ func ResumeSandbox(
 
 	cleanup := NewCleanup()
 
-	t, err := templateCache.GetTemplate(
-		config.TemplateId,
-		config.BuildId,
-		config.KernelVersion,
-		config.FirecrackerVersion,
-	)
-	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
-	}
-
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	// The slot is requested first so a handed over slot is always registered in the cleanup
+	keepSlot := &atomic.Bool{}
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, slot, keepSlot, cleanup, allowInternet)
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
 	}()
 
+	t, err := getTemplate(templateCache, config, buildPeers, migrationSource)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
+	}
+
 	sandboxFiles := t.Files().NewSandboxFiles(config.SandboxId)
 	cleanup.Add(func(ctx context.Context) error {
 		filesErr := cleanupFiles(sandboxFiles)
@@ -297,11 +321,70 @@ func ResumeSandbox(
 		}
 	}()
 
+	// The snapshot has only one volume drive.
+	if len(config.GetVolumes()) > 1 {
+		return nil, cleanup, fmt.Errorf("only one volume can be attached to the sandbox, got %d", len(config.GetVolumes()))
+	}
+
+	var sandboxVolume *volume.Volume
+	if len(config.GetVolumes()) == 1 {
+		sandboxVolume, err = createVolume(
+			childCtx,
+			tracer,
+			templateCache,
+			persistence,
+			devicePool,
+			cleanup,
+			config.GetVolumes()[0],
+			sandboxFiles.SandboxCacheVolumePath(),
+		)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to create volume: %w", err)
+		}
+
+		go func() {
+			runErr := sandboxVolume.Start(childCtx)
+			if runErr != nil {
+				zap.L().Error("volume error", zap.Error(runErr))
+			}
+		}()
+	}
+
+	if len(config.GetDatasets()) > fc.MaxDatasets {
+		return nil, cleanup, fmt.Errorf("at most %d datasets can be attached to the sandbox, got %d", fc.MaxDatasets, len(config.GetDatasets()))
+	}
+
+	datasets := make([]*dataset.Dataset, 0, len(config.GetDatasets()))
+	for _, datasetConfig := range config.GetDatasets() {
+		d, datasetErr := createDataset(childCtx, tracer, templateCache, devicePool, cleanup, datasetConfig)
+		if datasetErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to create dataset: %w", datasetErr)
+		}
+
+		go func() {
+			runErr := d.Start(childCtx)
+			if runErr != nil {
+				zap.L().Error("dataset error", zap.Error(runErr))
+			}
+		}()
+
+		datasets = append(datasets, d)
+	}
+
 	memfile, err := t.Memfile()
 	if err != nil {
 		return nil, cleanup, fmt.Errorf("failed to get memfile: %w", err)
 	}
 
+	// The sandbox can run without the prefetch profile, it only speeds up the resume.
+	var prefetchProfile *header.PrefetchProfile
+	prefetch, err := t.MemfilePrefetch()
+	if err != nil {
+		zap.L().Warn("failed to get memfile prefetch profile", logger.WithSandboxID(config.SandboxId), zap.Error(err))
+	} else {
+		prefetchProfile = prefetch.Profile()
+	}
+
 	fcUffdPath := sandboxFiles.SandboxUffdSocketPath()
 
 	fcUffd, err := serveMemory(
@@ -311,6 +394,7 @@ func ResumeSandbox(
 		memfile,
 		fcUffdPath,
 		config.SandboxId,
+		prefetchProfile,
 	)
 	if err != nil {
 		return nil, cleanup, fmt.Errorf("failed to serve memory: %w", err)
@@ -332,10 +416,39 @@ func ResumeSandbox(
 	if err != nil {
 		return nil, cleanup, fmt.Errorf("failed to get rootfs path: %w", err)
 	}
+	var volumePath string
+	if sandboxVolume != nil {
+		volumePath, err = sandboxVolume.Path()
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to get volume path: %w", err)
+		}
+	}
+	datasetPaths := make([]string, 0, len(datasets))
+	for _, d := range datasets {
+		datasetPath, pathErr := d.Path()
+		if pathErr != nil {
+			return nil, cleanup, fmt.Errorf("failed to get dataset path: %w", pathErr)
+		}
+
+		datasetPaths = append(datasetPaths, datasetPath)
+	}
 	ips := <-ipsCh
 	if ips.err != nil {
 		return nil, cleanup, fmt.Errorf("failed to get network slot: %w", err)
 	}
+	if config.Network != nil {
+		allowed, denied := egressRules(allowInternet, config.Network)
+		logger := sbxlogger.E(sbxlogger.SandboxMetadata{
+			SandboxID:  config.SandboxId,
+			TemplateID: config.TemplateId,
+			TeamID:     config.TeamId,
+		}).Logger
+
+		err = ips.slot.ConfigureEgress(childCtx, tracer, allowed, denied, config.Network.GetAllowedDomains(), logger)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to configure sandbox egress: %w", err)
+		}
+	}
 	fcHandle, fcErr := fc.NewProcess(
 		uffdStartCtx,
 		tracer,
@@ -367,6 +480,9 @@ func ResumeSandbox(
 		fcUffdPath,
 		snapfile,
 		fcUffd.Ready(),
+		rateLimits(config.RateLimits),
+		volumePath,
+		datasetPaths,
 	)
 	if fcStartErr != nil {
 		return nil, cleanup, fmt.Errorf("failed to start FC: %w", fcStartErr)
@@ -379,6 +495,9 @@ func ResumeSandbox(
 		rootfs:   rootfsOverlay,
 		memory:   fcUffd,
 		uffdExit: uffdExit,
+		volume:   sandboxVolume,
+		datasets: datasets,
+		keepSlot: keepSlot,
 	}
 
 	metadata := &Metadata{
@@ -392,13 +511,25 @@ func ResumeSandbox(
 		Resources: resources,
 		Metadata:  metadata,
 
-		template: t,
-		files:    sandboxFiles,
-		process:  fcHandle,
+		template:   t,
+		files:      sandboxFiles,
+		process:    fcHandle,
+		balloon:    newMemoryBalloon(childCtx, fcHandle, config.RamMb),
+		buildPeers: buildPeers,
+
+		migrationSource: migrationSource,
 
 		cleanup: cleanup,
 	}
 
+	// The balloon could be inflated when the snapshot was taken, the resumed sandbox is not idle anymore.
+	if sbx.balloon != nil {
+		err = sbx.DeflateBalloon(childCtx, tracer)
+		if err != nil {
+			sbxlogger.I(sbx).Warn("failed to deflate memory balloon", zap.Error(err))
+		}
+	}
+
 	// Part of the sandbox as we need to stop Checks before pausing the sandbox
 	// This is to prevent race condition of reporting unhealthy sandbox
 	checks, err := NewChecks(ctx, tracer, sbx, useClickhouseMetrics)
@@ -421,6 +552,44 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to wait for sandbox start: %w", err)
 	}
 
+	// The memory of a paused sandbox already has the volume and the datasets mounted.
+	if !config.GetSnapshot() {
+		if sandboxVolume != nil {
+			err = sbx.mountVolume(ctx, tracer, sandboxVolume, config.GetVolumes()[0].GetGenerationId() == "")
+			if err != nil {
+				return nil, cleanup, fmt.Errorf("failed to mount volume: %w", err)
+			}
+		}
+
+		for i, d := range datasets {
+			err = sbx.mountDataset(ctx, tracer, d, i)
+			if err != nil {
+				return nil, cleanup, fmt.Errorf("failed to mount dataset: %w", err)
+			}
+		}
+	}
+
+	if prefetch != nil {
+		sbx.observeMemoryPrefetch(tracer, persistence, memfile, prefetch, prefetchProfile)
+	}
+
+	rootfsPrefetch, err := t.RootfsPrefetch()
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to get rootfs prefetch profile", zap.Error(err))
+	} else if rootfsPrefetch != nil {
+		sbx.warmRootfs(tracer, readonlyRootfs, rootfsPrefetch)
+	}
+
+	// The state is removed first, so the sandbox being stopped is not adopted after a restart.
+	cleanup.AddPriority(func(ctx context.Context) error {
+		return sbx.removeState()
+	})
+
+	err = sbx.SaveState()
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to save sandbox state, it won't be adopted after a restart", zap.Error(err))
+	}
+
 	go sbx.Checks.Start()
 
 	return sbx, cleanup, nil
@@ -451,6 +620,74 @@ func (s *Sandbox) Stop(ctx context.Context) error {
 	return nil
 }
 
+// StopKeepingSlot stops the sandbox without returning its network slot to the pool and waits for the FC process to exit.
+// The returned slot should be passed to ResumeSandbox, the new sandbox then takes the ownership of it.
+func (s *Sandbox) StopKeepingSlot(ctx context.Context) (*network.Slot, error) {
+	if s.cleanup.hasRun.Load() {
+		return nil, fmt.Errorf("sandbox is already stopped")
+	}
+
+	s.keepSlot.Store(true)
+
+	err := s.Stop(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The slot can be reused only after the FC process releases the tap device
+	select {
+	case <-s.process.Exited():
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for FC to exit: %w", context.Cause(ctx))
+	}
+
+	return s.Slot, nil
+}
+
+// BaseBuildID returns the ID of the template build the sandbox's diff chain starts from.
+func (s *Sandbox) BaseBuildID() (string, error) {
+	rootfs, err := s.template.Rootfs()
+	if err != nil {
+		return "", fmt.Errorf("failed to get rootfs: %w", err)
+	}
+
+	return rootfs.Header().Metadata.BaseBuildId.String(), nil
+}
+
+// UpdateRateLimits replaces the disk and network limits of the running sandbox.
+func (s *Sandbox) UpdateRateLimits(ctx context.Context, tracer trace.Tracer, limits *orchestrator.SandboxRateLimits) error {
+	err := s.process.UpdateRateLimits(ctx, tracer, rateLimits(limits))
+	if err != nil {
+		return fmt.Errorf("failed to update sandbox rate limits: %w", err)
+	}
+
+	s.Config.RateLimits = limits
+
+	return nil
+}
+
+func rateLimits(limits *orchestrator.SandboxRateLimits) fc.RateLimits {
+	return fc.RateLimits{
+		DiskBandwidth:    limits.GetDiskBandwidthBytes(),
+		DiskIOPS:         limits.GetDiskIops(),
+		NetworkBandwidth: limits.GetNetworkBandwidthBytes(),
+	}
+}
+
+// UpdateNetwork replaces the egress rules of the running sandbox.
+func (s *Sandbox) UpdateNetwork(ctx context.Context, tracer trace.Tracer, allowInternet bool, rules *orchestrator.SandboxNetworkConfig) error {
+	allowed, denied := egressRules(allowInternet, rules)
+
+	err := s.Slot.ConfigureEgress(ctx, tracer, allowed, denied, rules.GetAllowedDomains(), sbxlogger.E(s).Logger)
+	if err != nil {
+		return fmt.Errorf("failed to configure sandbox egress: %w", err)
+	}
+
+	s.Config.Network = rules
+
+	return nil
+}
+
 // Close cleans up the sandbox and stops all resources.
 func (s *Sandbox) Close(ctx context.Context, tracer trace.Tracer) error {
 	_, span := tracer.Start(ctx, "sandbox-close")
@@ -540,12 +777,14 @@ func (s *Sandbox) Pause(
 		childCtx,
 		tracer,
 		buildID,
+		s.Config.TeamId,
 		originalMemfile.Header(),
 		&MemoryDiffCreator{
-			tracer:     tracer,
-			memfile:    memfile,
-			dirtyPages: s.memory.Dirty(),
-			blockSize:  originalMemfile.BlockSize(),
+			tracer:        tracer,
+			memfile:       memfile,
+			dirtyPages:    s.memory.Dirty(),
+			releasedPages: s.memory.Released(),
+			blockSize:     originalMemfile.BlockSize(),
 			doneHook: func(ctx context.Context) error {
 				return memfile.Close()
 			},
@@ -559,6 +798,7 @@ func (s *Sandbox) Pause(
 		childCtx,
 		tracer,
 		buildID,
+		s.Config.TeamId,
 		originalRootfs.Header(),
 		&RootfsDiffCreator{
 			rootfs:   s.rootfs,
@@ -569,6 +809,139 @@ func (s *Sandbox) Pause(
 		return nil, fmt.Errorf("error while post processing: %w", err)
 	}
 
+	// The rootfs export stopped the sandbox, the volume is written back in the cleanup and it has to be stored before the pause is reported as done.
+	if s.volume != nil {
+		err = s.Stop(childCtx)
+		if errors.Is(err, volume.ErrWriteBack) {
+			return nil, fmt.Errorf("error writing back volume: %w", err)
+		}
+	}
+
+	return &Snapshot{
+		Snapfile:          snapfile,
+		MemfileDiff:       memfileDiff,
+		MemfileDiffHeader: memfileDiffHeader,
+		RootfsDiff:        rootfsDiff,
+		RootfsDiffHeader:  rootfsDiffHeader,
+	}, nil
+}
+
+// LiveSnapshot creates a snapshot of the sandbox without stopping it.
+// The VM is only paused for the duration of the memory dump and the rootfs export.
+func (s *Sandbox) LiveSnapshot(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	childCtx, childSpan := tracer.Start(ctx, "sandbox-live-snapshot")
+	defer childSpan.End()
+
+	buildID, err := uuid.Parse(snapshotTemplateFiles.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	if err := s.process.Pause(childCtx, tracer); err != nil {
+		return nil, fmt.Errorf("failed to pause VM: %w", err)
+	}
+
+	unpause := sync.OnceValue(func() error {
+		// The memory is unfrozen before the VM runs again, so the pages it touches are tracked.
+		s.memory.Unfreeze()
+
+		return s.process.Unpause(childCtx, tracer)
+	})
+	// Make sure the VM is not left paused if any of the steps fail
+	defer func() {
+		err := unpause()
+		if err != nil {
+			sbxlogger.I(s).Error("failed to unpause VM after live snapshot", zap.Error(err))
+		}
+	}()
+
+	// The memory can't be disabled as the VM keeps running, the pages touched while creating the snapshot are served from the original memfile
+	// and are not part of the diff.
+	if err := s.memory.Freeze(); err != nil {
+		return nil, fmt.Errorf("failed to freeze uffd: %w", err)
+	}
+	// The released pages are taken before the snapshot, the pages it touches are no longer released for the running VM.
+	releasedPages := s.memory.Released()
+
+	snapfile := template.NewLocalFileLink(snapshotTemplateFiles.CacheSnapfilePath())
+
+	memfile, err := storage.AcquireTmpMemfile(childCtx, buildID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire memfile snapshot: %w", err)
+	}
+	// Close the file even if an error occurs
+	defer memfile.Close()
+
+	err = s.process.CreateSnapshot(
+		childCtx,
+		tracer,
+		snapfile.Path(),
+		memfile.Path(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	dirtyPages := s.memory.Dirty()
+
+	originalMemfile, err := s.template.Memfile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original memfile: %w", err)
+	}
+	originalRootfs, err := s.template.Rootfs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+	}
+
+	// The rootfs has to be exported while the VM is still paused to be consistent with the memory snapshot.
+	rootfsDiff, rootfsDiffHeader, err := pauseProcessRootfs(
+		childCtx,
+		tracer,
+		buildID,
+		s.Config.TeamId,
+		originalRootfs.Header(),
+		&LiveRootfsDiffCreator{
+			rootfs: s.rootfs,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
+	err = unpause()
+	if err != nil {
+		rootfsDiff.Close()
+
+		return nil, fmt.Errorf("failed to unpause VM: %w", err)
+	}
+
+	memfileDiff, memfileDiffHeader, err := pauseProcessMemory(
+		childCtx,
+		tracer,
+		buildID,
+		s.Config.TeamId,
+		originalMemfile.Header(),
+		&MemoryDiffCreator{
+			tracer:        tracer,
+			memfile:       memfile,
+			dirtyPages:    dirtyPages,
+			releasedPages: releasedPages,
+			blockSize:     originalMemfile.BlockSize(),
+			doneHook: func(ctx context.Context) error {
+				return memfile.Close()
+			},
+		},
+	)
+	if err != nil {
+		rootfsDiff.Close()
+
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
 	return &Snapshot{
 		Snapfile:          snapfile,
 		MemfileDiff:       memfileDiff,
@@ -608,6 +981,7 @@ func pauseProcessMemory(
 	ctx context.Context,
 	tracer trace.Tracer,
 	buildId uuid.UUID,
+	teamID string,
 	originalHeader *header.Header,
 	diffCreator DiffCreator,
 ) (build.Diff, *header.Header, error) {
@@ -618,6 +992,7 @@ func pauseProcessMemory(
 		build.DefaultCachePath,
 		buildId.String(),
 		build.Memfile,
+		teamID,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create memfile diff file: %w", err)
@@ -662,20 +1037,24 @@ func pauseProcessMemory(
 		attribute.String("snapshot.metadata.base_build_id", memfileMetadata.BaseBuildId.String()),
 	)
 
-	return memfileDiff, header.NewHeader(memfileMetadata, memfileMappings), nil
+	memfileHeader := header.NewHeader(memfileMetadata, memfileMappings)
+	memfileHeader.SetChecksums(m.Checksums)
+
+	return memfileDiff, memfileHeader, nil
 }
 
 func pauseProcessRootfs(
 	ctx context.Context,
 	tracer trace.Tracer,
 	buildId uuid.UUID,
+	teamID string,
 	originalHeader *header.Header,
 	diffCreator DiffCreator,
 ) (build.Diff, *header.Header, error) {
 	ctx, childSpan := tracer.Start(ctx, "process-rootfs")
 	defer childSpan.End()
 
-	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, buildId.String(), build.Rootfs)
+	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, buildId.String(), build.Rootfs, teamID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create rootfs diff: %w", err)
 	}
@@ -714,13 +1093,52 @@ func pauseProcessRootfs(
 		attribute.Int64("snapshot.rootfs.block_size", int64(rootfsMetadata.BlockSize)),
 	)
 
-	return rootfsDiff, header.NewHeader(rootfsMetadata, rootfsMappings), nil
+	rootfsHeader := header.NewHeader(rootfsMetadata, rootfsMappings)
+	rootfsHeader.SetChecksums(rootfsDiffMetadata.Checksums)
+
+	return rootfsDiff, rootfsHeader, nil
+}
+
+// egressRules returns the allowed and denied CIDRs for the slot firewall.
+// When the internet is disabled globally, only the explicitly allowed CIDRs can be reached.
+// The allowed domains only add the IPs they resolve to, so they deny all other traffic, including the connections made directly to an IP.
+func egressRules(allowInternet bool, rules *orchestrator.SandboxNetworkConfig) ([]string, []string) {
+	if !allowInternet || rules.GetDenyAll() || len(rules.GetAllowedDomains()) > 0 {
+		return rules.GetAllowedCidrs(), []string{"0.0.0.0/0"}
+	}
+
+	return rules.GetAllowedCidrs(), rules.GetDeniedCidrs()
+}
+
+// getTemplate returns the template of the sandbox, the template of the migrated sandbox is read from the source orchestrator.
+func getTemplate(templateCache *template.Cache, config *orchestrator.SandboxConfig, buildPeers []string, migrationSource string) (template.Template, error) {
+	if migrationSource != "" {
+		return templateCache.GetMigratedTemplate(
+			config.TemplateId,
+			config.BuildId,
+			config.KernelVersion,
+			config.FirecrackerVersion,
+			buildPeers,
+			migrationSource,
+			config.TeamId,
+		)
+	}
+
+	return templateCache.GetTemplate(
+		config.TemplateId,
+		config.BuildId,
+		config.KernelVersion,
+		config.FirecrackerVersion,
+		buildPeers,
+	)
 }
 
 func getNetworkSlotAsync(
 	ctx context.Context,
 	tracer trace.Tracer,
 	networkPool *network.Pool,
+	slot *network.Slot,
+	keepSlot *atomic.Bool,
 	cleanup *Cleanup,
 	allowInternet bool,
 ) chan networkSlotRes {
@@ -732,16 +1150,25 @@ func getNetworkSlotAsync(
 	go func() {
 		defer close(r)
 
-		ips, err := networkPool.Get(networkCtx, tracer, allowInternet)
-		if err != nil {
-			r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
-			return
+		ips := slot
+		if ips == nil {
+			var err error
+			ips, err = networkPool.Get(networkCtx, tracer, allowInternet)
+			if err != nil {
+				r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
+				return
+			}
 		}
 
 		cleanup.Add(func(ctx context.Context) error {
 			_, span := tracer.Start(ctx, "network-slot-clean")
 			defer span.End()
 
+			// The slot was handed over to another sandbox
+			if keepSlot.Load() {
+				return nil
+			}
+
 			// We can run this cleanup asynchronously, as it is not important for the sandbox lifecycle
 			go func() {
 				returnErr := networkPool.Return(context.Background(), tracer, ips)
@@ -794,6 +1221,55 @@ func createRootfsOverlay(
 	return rootfsOverlay, nil
 }
 
+func createVolume(
+	ctx context.Context,
+	tracer trace.Tracer,
+	templateCache *template.Cache,
+	persistence storage.StorageProvider,
+	devicePool *nbd.DevicePool,
+	cleanup *Cleanup,
+	config *orchestrator.SandboxVolume,
+	cachePath string,
+) (*volume.Volume, error) {
+	ctx, span := tracer.Start(ctx, "create-volume")
+	defer span.End()
+
+	v, err := volume.New(ctx, tracer, templateCache, persistence, devicePool, config, cachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cleanup runs after the FC process is stopped, so the volume is written back with all the sandbox writes.
+	cleanup.Add(func(ctx context.Context) error {
+		return v.Close(ctx)
+	})
+
+	return v, nil
+}
+
+func createDataset(
+	ctx context.Context,
+	tracer trace.Tracer,
+	templateCache *template.Cache,
+	devicePool *nbd.DevicePool,
+	cleanup *Cleanup,
+	config *orchestrator.SandboxDataset,
+) (*dataset.Dataset, error) {
+	ctx, span := tracer.Start(ctx, "create-dataset")
+	defer span.End()
+
+	d, err := dataset.New(ctx, tracer, templateCache, devicePool, config)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanup.Add(func(ctx context.Context) error {
+		return d.Close(ctx)
+	})
+
+	return d, nil
+}
+
 func serveMemory(
 	ctx context.Context,
 	tracer trace.Tracer,
@@ -801,8 +1277,9 @@ func serveMemory(
 	memfile block.ReadonlyDevice,
 	socketPath string,
 	sandboxID string,
+	prefetch *header.PrefetchProfile,
 ) (uffd.MemoryBackend, error) {
-	fcUffd, uffdErr := uffd.New(memfile, socketPath, memfile.BlockSize())
+	fcUffd, uffdErr := uffd.New(memfile, socketPath, memfile.BlockSize(), prefetch)
 	if uffdErr != nil {
 		return nil, fmt.Errorf("failed to create uffd: %w", uffdErr)
 	}
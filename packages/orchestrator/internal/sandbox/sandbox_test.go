@@ -0,0 +1,66 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func Test_egressRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		allowInternet bool
+		rules         *orchestrator.SandboxNetworkConfig
+		wantAllowed   []string
+		wantDenied    []string
+	}{
+		{
+			name:          "no rules",
+			allowInternet: true,
+		},
+		{
+			name:          "internet disabled",
+			allowInternet: false,
+			rules:         &orchestrator.SandboxNetworkConfig{AllowedCidrs: []string{"1.1.1.1/32"}, DeniedCidrs: []string{"8.8.8.8/32"}},
+			wantAllowed:   []string{"1.1.1.1/32"},
+			wantDenied:    []string{"0.0.0.0/0"},
+		},
+		{
+			name:          "denied CIDRs",
+			allowInternet: true,
+			rules:         &orchestrator.SandboxNetworkConfig{AllowedCidrs: []string{"1.1.1.1/32"}, DeniedCidrs: []string{"8.8.8.8/32"}},
+			wantAllowed:   []string{"1.1.1.1/32"},
+			wantDenied:    []string{"8.8.8.8/32"},
+		},
+		{
+			name:          "deny all",
+			allowInternet: true,
+			rules:         &orchestrator.SandboxNetworkConfig{DenyAll: true},
+			wantDenied:    []string{"0.0.0.0/0"},
+		},
+		{
+			name:          "allowed domains deny the direct IP traffic",
+			allowInternet: true,
+			rules:         &orchestrator.SandboxNetworkConfig{AllowedDomains: []string{"pypi.org"}, DeniedCidrs: []string{"8.8.8.8/32"}},
+			wantDenied:    []string{"0.0.0.0/0"},
+		},
+		{
+			name:          "allowed domains with allowed CIDRs",
+			allowInternet: true,
+			rules:         &orchestrator.SandboxNetworkConfig{AllowedDomains: []string{"*.amazonaws.com"}, AllowedCidrs: []string{"1.1.1.1/32"}},
+			wantAllowed:   []string{"1.1.1.1/32"},
+			wantDenied:    []string{"0.0.0.0/0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, denied := egressRules(tt.allowInternet, tt.rules)
+
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, tt.wantDenied, denied)
+		})
+	}
+}
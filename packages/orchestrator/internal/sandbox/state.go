@@ -0,0 +1,211 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// stateDir keeps the runtime state of the running sandboxes, so they can be adopted after the orchestrator restart.
+const stateDir = "/orchestrator/state"
+
+const stateFileSuffix = ".json"
+
+// State is the runtime state of the running sandbox that lives outside the orchestrator process.
+type State struct {
+	path string
+
+	Config    *orchestrator.SandboxConfig `json:"-"`
+	RawConfig json.RawMessage             `json:"config"`
+
+	StartedAt  time.Time `json:"started_at"`
+	EndAt      time.Time `json:"end_at"`
+	BuildPeers []string  `json:"build_peers"`
+	// MigrationSource is the address of the orchestrator the sandbox was migrated from, it serves the snapshot until it is uploaded.
+	MigrationSource string `json:"migration_source,omitempty"`
+
+	// FilesID is the random ID of the sandbox files, the sockets and the rootfs cache are named by it.
+	FilesID string `json:"files_id"`
+	// Pid is the pid of the unshare process the FC runs in.
+	Pid int `json:"pid"`
+
+	SlotKey string `json:"slot_key"`
+	SlotIdx int    `json:"slot_idx"`
+
+	RootfsDevice   uint32                        `json:"rootfs_device"`
+	MemoryMappings []uffd.GuestRegionUffdMapping `json:"memory_mappings"`
+}
+
+func (s *Sandbox) statePath() string {
+	return filepath.Join(stateDir, s.Config.SandboxId+"-"+s.files.RandomID()+stateFileSuffix)
+}
+
+// SaveState persists the current runtime state of the sandbox, it is a no-op after the sandbox is stopped.
+func (s *Sandbox) SaveState() error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	if s.stateRemoved {
+		return nil
+	}
+
+	nbdRootfs, ok := s.rootfs.(*rootfs.NBDProvider)
+	if !ok {
+		return fmt.Errorf("rootfs of type %T can't be adopted", s.rootfs)
+	}
+
+	rootfsDevice, err := nbdRootfs.DeviceIndex()
+	if err != nil {
+		return fmt.Errorf("failed to get rootfs device: %w", err)
+	}
+
+	memory, ok := s.memory.(*uffd.Uffd)
+	if !ok {
+		return fmt.Errorf("memory of type %T can't be adopted", s.memory)
+	}
+
+	pid, err := s.process.Pid()
+	if err != nil {
+		return fmt.Errorf("failed to get fc pid: %w", err)
+	}
+
+	rawConfig, err := protojson.Marshal(s.Config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sandbox config: %w", err)
+	}
+
+	data, err := json.Marshal(State{
+		RawConfig:      rawConfig,
+		StartedAt:      s.StartedAt,
+		EndAt:          s.EndAt,
+		BuildPeers:     s.buildPeers,
+		FilesID:        s.files.RandomID(),
+		Pid:            pid,
+		SlotKey:        s.Slot.Key,
+		SlotIdx:        s.Slot.Idx,
+		RootfsDevice:   rootfsDevice,
+		MemoryMappings: memory.Mappings(),
+
+		MigrationSource: s.migrationSource,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal sandbox state: %w", err)
+	}
+
+	err = os.MkdirAll(stateDir, 0o700)
+	if err != nil {
+		return fmt.Errorf("failed to create state dir: %w", err)
+	}
+
+	// The state is replaced atomically, so a crash during the write doesn't leave a corrupted file.
+	tmpPath := s.statePath() + ".tmp"
+
+	err = os.WriteFile(tmpPath, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write sandbox state: %w", err)
+	}
+
+	err = os.Rename(tmpPath, s.statePath())
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to replace sandbox state: %w", err), os.Remove(tmpPath))
+	}
+
+	return nil
+}
+
+// removeState removes the persisted state, the stopped sandbox must not be adopted.
+func (s *Sandbox) removeState() error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	s.stateRemoved = true
+
+	err := os.Remove(s.statePath())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove sandbox state: %w", err)
+	}
+
+	return nil
+}
+
+// LoadStates reads the states persisted by the previous orchestrator process.
+// The state files are kept until the sandbox is adopted or cleaned up, the unreadable ones are removed.
+func LoadStates() ([]*State, error) {
+	entries, err := os.ReadDir(stateDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sandbox states: %w", err)
+	}
+
+	var states []*State
+	var errs []error
+
+	for _, entry := range entries {
+		path := filepath.Join(stateDir, entry.Name())
+
+		if strings.HasSuffix(entry.Name(), stateFileSuffix) {
+			state, readErr := readState(path)
+			if readErr == nil {
+				states = append(states, state)
+
+				continue
+			}
+
+			errs = append(errs, readErr)
+		}
+
+		removeErr := os.Remove(path)
+		if removeErr != nil {
+			errs = append(errs, fmt.Errorf("failed to remove sandbox state '%s': %w", entry.Name(), removeErr))
+		}
+	}
+
+	return states, errors.Join(errs...)
+}
+
+func readState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sandbox state '%s': %w", path, err)
+	}
+
+	var state State
+
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sandbox state '%s': %w", path, err)
+	}
+
+	state.path = path
+	state.Config = &orchestrator.SandboxConfig{}
+
+	err = protojson.Unmarshal(state.RawConfig, state.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sandbox config '%s': %w", path, err)
+	}
+
+	return &state, nil
+}
+
+// Remove removes the state file, it is called when the sandbox can't be adopted.
+func (s *State) Remove() error {
+	err := os.Remove(s.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove sandbox state: %w", err)
+	}
+
+	return nil
+}
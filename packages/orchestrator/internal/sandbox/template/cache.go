@@ -2,7 +2,9 @@ package template
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
@@ -26,6 +28,9 @@ const (
 	buildCacheMaxUsedPercentage = 75.0
 )
 
+// ErrSnapshotNotCached is returned when the snapshot paused on the node is not in the cache.
+var ErrSnapshotNotCached = errors.New("snapshot is not cached")
+
 type Cache struct {
 	cache       *ttlcache.Cache[string, Template]
 	persistence storage.StorageProvider
@@ -82,6 +87,34 @@ func (c *Cache) GetTemplate(
 	buildId,
 	kernelVersion,
 	firecrackerVersion string,
+	peers []string,
+) (Template, error) {
+	return c.getTemplate(templateId, buildId, kernelVersion, firecrackerVersion, peers, "", "")
+}
+
+// GetMigratedTemplate returns the template of the snapshot migrated from the source orchestrator.
+// The snapshot is read from the source, so it can be resumed before its upload to the storage finishes.
+// The source seals the snapshot data with the data key of the team, so the team is needed to read them.
+func (c *Cache) GetMigratedTemplate(
+	templateId,
+	buildId,
+	kernelVersion,
+	firecrackerVersion string,
+	peers []string,
+	source string,
+	teamID string,
+) (Template, error) {
+	return c.getTemplate(templateId, buildId, kernelVersion, firecrackerVersion, peers, source, teamID)
+}
+
+func (c *Cache) getTemplate(
+	templateId,
+	buildId,
+	kernelVersion,
+	firecrackerVersion string,
+	peers []string,
+	source string,
+	teamID string,
 ) (Template, error) {
 	storageTemplate, err := newTemplateFromStorage(
 		templateId,
@@ -92,11 +125,15 @@ func (c *Cache) GetTemplate(
 		nil,
 		c.persistence,
 		nil,
+		peers,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create template cache from storage: %w", err)
 	}
 
+	storageTemplate.source = source
+	storageTemplate.teamID = teamID
+
 	t, found := c.cache.GetOrSet(
 		storageTemplate.Files().CacheKey(),
 		storageTemplate,
@@ -110,6 +147,68 @@ func (c *Cache) GetTemplate(
 	return t.Value(), nil
 }
 
+// GetVolume returns the volume generation, the header is read from the storage if it is nil.
+func (c *Cache) GetVolume(ctx context.Context, generationID string, h *header.Header) (*Storage, error) {
+	return NewStorage(ctx, c.buildStore, generationID, build.Volume, h, c.persistence, nil)
+}
+
+// GetDataset returns the rootfs of the build attached as a read-only dataset.
+// The diffs are shared through the build store with all the sandboxes on the node that use the same build.
+func (c *Cache) GetDataset(ctx context.Context, buildID string) (*Storage, error) {
+	return c.GetBuild(ctx, buildID, build.Rootfs)
+}
+
+// GetBuild returns the file of the build read through its whole diff chain.
+// The diffs are shared through the build store with the sandboxes on the node.
+func (c *Cache) GetBuild(ctx context.Context, buildID string, fileType build.DiffType) (*Storage, error) {
+	return NewStorage(ctx, c.buildStore, buildID, fileType, nil, c.persistence, nil)
+}
+
+// ReadCachedChunk returns the data of the diff cached on the node, so the other orchestrators don't have to fetch them from the storage.
+// The data are sealed with the data key of the diff, only the orchestrator with the access to the key can read them.
+func (c *Cache) ReadCachedChunk(ctx context.Context, name string, diffType build.DiffType, off, length int64) ([]byte, error) {
+	return c.buildStore.PeerSlice(ctx, c.persistence, build.GetDiffStoreKey(name, diffType), off, length)
+}
+
+// ReadSnapshot returns the headers and the snapfile of the snapshot paused on the node, so it can be migrated before its upload finishes.
+// The snapfile is sealed with the data key of the team of the snapshot.
+func (c *Cache) ReadSnapshot(ctx context.Context, templateId, buildId string) (memfileHeader, rootfsHeader *header.Header, snapfile []byte, err error) {
+	key := storage.NewTemplateFiles(templateId, buildId, "", "").CacheKey()
+
+	item := c.cache.Get(key, ttlcache.WithDisableTouchOnHit[string, Template]())
+	if item == nil {
+		return nil, nil, nil, ErrSnapshotNotCached
+	}
+
+	// Only the snapshots added on this node have the headers and the snapfile that aren't in the storage.
+	t, ok := item.Value().(*storageTemplate)
+	if !ok || t.source != "" || t.localSnapfile == nil || t.memfileHeader == nil || t.rootfsHeader == nil {
+		return nil, nil, nil, ErrSnapshotNotCached
+	}
+
+	snapfile, err = os.ReadFile(t.localSnapfile.Path())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read snapfile: %w", err)
+	}
+
+	snapfile, err = build.SealPeerSnapfile(ctx, c.persistence, t.teamID, buildId, snapfile)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to seal snapfile: %w", err)
+	}
+
+	return t.memfileHeader, t.rootfsHeader, snapfile, nil
+}
+
+// AddVolumeDiff caches the diff of the volume generation written on this node, so it doesn't have to be fetched from the storage.
+func (c *Cache) AddVolumeDiff(diff build.Diff) {
+	switch diff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		c.buildStore.Add(diff)
+	}
+}
+
 func (c *Cache) AddSnapshot(
 	templateId,
 	buildId,
@@ -120,6 +219,7 @@ func (c *Cache) AddSnapshot(
 	localSnapfile *LocalFileLink,
 	memfileDiff build.Diff,
 	rootfsDiff build.Diff,
+	teamID string,
 ) error {
 	switch memfileDiff.(type) {
 	case *build.NoDiff:
@@ -144,11 +244,14 @@ func (c *Cache) AddSnapshot(
 		rootfsHeader,
 		c.persistence,
 		localSnapfile,
+		nil,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create template cache from storage: %w", err)
 	}
 
+	storageTemplate.teamID = teamID
+
 	_, found := c.cache.GetOrSet(
 		storageTemplate.Files().CacheKey(),
 		storageTemplate,
@@ -3,6 +3,7 @@ package template
 import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type LocalTemplate struct {
@@ -44,6 +45,15 @@ func (t *LocalTemplate) Snapfile() (File, error) {
 	return &NoopSnapfile{}, nil
 }
 
+// MemfilePrefetch returns an empty profile, the local template is booted without the memory snapshot.
+func (t *LocalTemplate) MemfilePrefetch() (*MemfilePrefetch, error) {
+	return &MemfilePrefetch{}, nil
+}
+
+func (t *LocalTemplate) RootfsPrefetch() (*header.PrefetchProfile, error) {
+	return nil, nil
+}
+
 type NoopSnapfile struct{}
 
 func (n *NoopSnapfile) Close() error {
@@ -0,0 +1,78 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// MemfilePrefetch holds the prefetch profile of the build memfile.
+// If the build doesn't have the profile yet, only one sandbox on the node records it at a time.
+type MemfilePrefetch struct {
+	mu        sync.Mutex
+	profile   *header.PrefetchProfile
+	recording bool
+}
+
+func newMemfilePrefetch(ctx context.Context, persistence storage.StorageProvider, files *storage.TemplateFiles) (*MemfilePrefetch, error) {
+	profile, err := fetchPrefetchProfile(ctx, persistence, files.StorageMemfilePrefetchPath())
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemfilePrefetch{profile: profile}, nil
+}
+
+// fetchPrefetchProfile returns nil if the build doesn't have the prefetch profile.
+func fetchPrefetchProfile(ctx context.Context, persistence storage.StorageProvider, path string) (*header.PrefetchProfile, error) {
+	object, err := persistence.OpenObject(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := header.DeserializePrefetchProfile(object)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize prefetch profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// Profile returns nil if the build doesn't have the prefetch profile.
+func (p *MemfilePrefetch) Profile() *header.PrefetchProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.profile
+}
+
+// StartRecording returns true if the build doesn't have the profile and no other sandbox is recording it.
+func (p *MemfilePrefetch) StartRecording() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.profile != nil || p.recording {
+		return false
+	}
+
+	p.recording = true
+
+	return true
+}
+
+// FinishRecording stores the recorded profile. If the profile is nil, another sandbox can try to record it again.
+func (p *MemfilePrefetch) FinishRecording(profile *header.PrefetchProfile) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.profile = profile
+	p.recording = false
+}
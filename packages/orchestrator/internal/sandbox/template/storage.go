@@ -29,6 +29,7 @@ func NewStorage(
 	fileType build.DiffType,
 	h *header.Header,
 	persistence storage.StorageProvider,
+	peers []string,
 ) (*Storage, error) {
 	if h == nil {
 		headerObjectPath := buildId + "/" + string(fileType) + storage.HeaderSuffix
@@ -89,7 +90,7 @@ func NewStorage(
 		}, nil)
 	}
 
-	b := build.NewFile(h, store, fileType, persistence)
+	b := build.NewFile(h, store, fileType, persistence, peers)
 
 	return &Storage{
 		source: b,
@@ -97,6 +98,23 @@ func NewStorage(
 	}, nil
 }
 
+// NewMigratedStorage returns the file of the snapshot migrated from the source orchestrator.
+// Its build diff is read from the source, as the upload of the snapshot could still be in progress.
+func NewMigratedStorage(
+	store *build.DiffStore,
+	fileType build.DiffType,
+	h *header.Header,
+	persistence storage.StorageProvider,
+	peers []string,
+	source string,
+	teamID string,
+) *Storage {
+	return &Storage{
+		source: build.NewMigratedFile(h, store, fileType, persistence, peers, source, teamID),
+		header: h,
+	}
+}
+
 func (d *Storage) ReadAt(p []byte, off int64) (int, error) {
 	return d.source.ReadAt(p, off)
 }
@@ -1,37 +1,51 @@
 package template
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type storageFile struct {
 	path string
 }
 
+// newStorageFile downloads the small object to the path, the object is decrypted for the scope if it's sealed.
 func newStorageFile(
 	ctx context.Context,
 	persistence storage.StorageProvider,
 	objectPath string,
 	path string,
+	scope header.EncryptionScope,
 ) (*storageFile, error) {
-	f, err := os.Create(path)
+	object, err := persistence.OpenObject(ctx, objectPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create file: %w", err)
+		return nil, err
 	}
 
-	defer f.Close()
+	var buf bytes.Buffer
 
-	object, err := persistence.OpenObject(ctx, objectPath)
+	_, err = object.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	keys, err := storage.GetDataKeys()
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = object.WriteTo(f)
+	data, err := storage.UnsealObject(ctx, keys, persistence, scope, buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt file: %w", err)
+	}
+
+	err = os.WriteFile(path, data, 0o644)
 	if err != nil {
 		cleanupErr := os.Remove(path)
 		return nil, fmt.Errorf("NEW STORAGE failed to write to file: %w", errors.Join(err, cleanupErr))
@@ -1,8 +1,10 @@
 package template
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"sync"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
@@ -18,12 +20,20 @@ type storageTemplate struct {
 	memfile  *utils.SetOnce[block.ReadonlyDevice]
 	rootfs   *utils.SetOnce[block.ReadonlyDevice]
 	snapfile *utils.SetOnce[File]
+	prefetch *utils.SetOnce[*MemfilePrefetch]
+
+	rootfsPrefetch *utils.SetOnce[*header.PrefetchProfile]
 
 	memfileHeader *header.Header
 	rootfsHeader  *header.Header
 	localSnapfile *LocalFileLink
 
 	persistence storage.StorageProvider
+	peers       []string
+	// source is the address of the orchestrator the snapshot is migrated from, the headers and the snapfile are read from it.
+	source string
+	// teamID is the team of the snapshot, the data exchanged with the other orchestrator during the migration are sealed with its data key.
+	teamID string
 }
 
 func newTemplateFromStorage(
@@ -35,6 +45,7 @@ func newTemplateFromStorage(
 	rootfsHeader *header.Header,
 	persistence storage.StorageProvider,
 	localSnapfile *LocalFileLink,
+	peers []string,
 ) (*storageTemplate, error) {
 	files, err := storage.NewTemplateFiles(
 		templateId,
@@ -52,13 +63,32 @@ func newTemplateFromStorage(
 		memfileHeader: memfileHeader,
 		rootfsHeader:  rootfsHeader,
 		persistence:   persistence,
+		peers:         peers,
 		memfile:       utils.NewSetOnce[block.ReadonlyDevice](),
 		rootfs:        utils.NewSetOnce[block.ReadonlyDevice](),
 		snapfile:      utils.NewSetOnce[File](),
+		prefetch:      utils.NewSetOnce[*MemfilePrefetch](),
+
+		rootfsPrefetch: utils.NewSetOnce[*header.PrefetchProfile](),
 	}, nil
 }
 
 func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore) {
+	if t.source != "" {
+		err := t.fetchSnapshot(ctx, buildStore)
+		if err != nil {
+			errMsg := fmt.Errorf("failed to read snapshot from %s: %w", t.source, err)
+
+			t.snapfile.SetError(errMsg)
+			t.prefetch.SetError(errMsg)
+			t.rootfsPrefetch.SetError(errMsg)
+			t.memfile.SetError(errMsg)
+			t.rootfs.SetError(errMsg)
+
+			return
+		}
+	}
+
 	var wg sync.WaitGroup
 
 	wg.Add(1)
@@ -73,6 +103,7 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 			t.persistence,
 			t.files.StorageSnapfilePath(),
 			t.files.CacheSnapfilePath(),
+			storage.SnapfileScope(t.files.BuildId),
 		)
 		if snapfileErr != nil {
 			errMsg := fmt.Errorf("failed to fetch snapfile: %w", snapfileErr)
@@ -87,14 +118,35 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 	go func() error {
 		defer wg.Done()
 
-		memfileStorage, memfileErr := NewStorage(
-			ctx,
-			buildStore,
-			t.files.BuildId,
-			build.Memfile,
-			t.memfileHeader,
-			t.persistence,
-		)
+		prefetch, prefetchErr := newMemfilePrefetch(ctx, t.persistence, t.files.TemplateFiles)
+		if prefetchErr != nil {
+			errMsg := fmt.Errorf("failed to fetch memfile prefetch profile: %w", prefetchErr)
+
+			return t.prefetch.SetError(errMsg)
+		}
+
+		return t.prefetch.SetValue(prefetch)
+	}()
+
+	wg.Add(1)
+	go func() error {
+		defer wg.Done()
+
+		rootfsPrefetch, prefetchErr := fetchPrefetchProfile(ctx, t.persistence, t.files.StorageRootfsPrefetchPath())
+		if prefetchErr != nil {
+			errMsg := fmt.Errorf("failed to fetch rootfs prefetch profile: %w", prefetchErr)
+
+			return t.rootfsPrefetch.SetError(errMsg)
+		}
+
+		return t.rootfsPrefetch.SetValue(rootfsPrefetch)
+	}()
+
+	wg.Add(1)
+	go func() error {
+		defer wg.Done()
+
+		memfileStorage, memfileErr := t.newStorage(ctx, buildStore, build.Memfile, t.memfileHeader)
 
 		if memfileErr != nil {
 			errMsg := fmt.Errorf("failed to create memfile storage: %w", memfileErr)
@@ -109,14 +161,7 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 	go func() error {
 		defer wg.Done()
 
-		rootfsStorage, rootfsErr := NewStorage(
-			ctx,
-			buildStore,
-			t.files.BuildId,
-			build.Rootfs,
-			t.rootfsHeader,
-			t.persistence,
-		)
+		rootfsStorage, rootfsErr := t.newStorage(ctx, buildStore, build.Rootfs, t.rootfsHeader)
 		if rootfsErr != nil {
 			errMsg := fmt.Errorf("failed to create rootfs storage: %w", rootfsErr)
 
@@ -129,6 +174,47 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 	wg.Wait()
 }
 
+// fetchSnapshot reads the headers and the snapfile of the migrated snapshot from the source orchestrator.
+func (t *storageTemplate) fetchSnapshot(ctx context.Context, buildStore *build.DiffStore) error {
+	res, err := buildStore.Peers().ReadSnapshot(ctx, t.source, t.files.TemplateId, t.files.BuildId)
+	if err != nil {
+		return err
+	}
+
+	t.memfileHeader, err = header.Deserialize(bytes.NewReader(res.GetMemfileHeader()))
+	if err != nil {
+		return fmt.Errorf("failed to deserialize memfile header: %w", err)
+	}
+
+	t.rootfsHeader, err = header.Deserialize(bytes.NewReader(res.GetRootfsHeader()))
+	if err != nil {
+		return fmt.Errorf("failed to deserialize rootfs header: %w", err)
+	}
+
+	snapfile, err := build.OpenPeerSnapfile(ctx, t.persistence, t.teamID, t.files.BuildId, res.GetSnapfile())
+	if err != nil {
+		return fmt.Errorf("failed to open snapfile: %w", err)
+	}
+
+	err = os.WriteFile(t.files.CacheSnapfilePath(), snapfile, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write snapfile: %w", err)
+	}
+
+	t.localSnapfile = NewLocalFileLink(t.files.CacheSnapfilePath())
+
+	return nil
+}
+
+// newStorage returns the file of the template, the build diff of the migrated snapshot is read from the source until it is uploaded.
+func (t *storageTemplate) newStorage(ctx context.Context, buildStore *build.DiffStore, fileType build.DiffType, h *header.Header) (*Storage, error) {
+	if t.source != "" {
+		return NewMigratedStorage(buildStore, fileType, h, t.persistence, t.peers, t.source, t.teamID), nil
+	}
+
+	return NewStorage(ctx, buildStore, t.files.BuildId, fileType, h, t.persistence, t.peers)
+}
+
 func (t *storageTemplate) Close() error {
 	return closeTemplate(t)
 }
@@ -148,3 +234,11 @@ func (t *storageTemplate) Rootfs() (block.ReadonlyDevice, error) {
 func (t *storageTemplate) Snapfile() (File, error) {
 	return t.snapfile.Wait()
 }
+
+func (t *storageTemplate) MemfilePrefetch() (*MemfilePrefetch, error) {
+	return t.prefetch.Wait()
+}
+
+func (t *storageTemplate) RootfsPrefetch() (*header.PrefetchProfile, error) {
+	return t.rootfsPrefetch.Wait()
+}
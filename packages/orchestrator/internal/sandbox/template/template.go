@@ -7,6 +7,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type Template interface {
@@ -14,6 +15,8 @@ type Template interface {
 	Memfile() (block.ReadonlyDevice, error)
 	Rootfs() (block.ReadonlyDevice, error)
 	Snapfile() (File, error)
+	MemfilePrefetch() (*MemfilePrefetch, error)
+	RootfsPrefetch() (*header.PrefetchProfile, error)
 	Close() error
 }
 
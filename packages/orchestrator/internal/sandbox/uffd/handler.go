@@ -1,6 +1,7 @@
 package uffd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 const (
@@ -45,17 +47,44 @@ type Uffd struct {
 
 	memfile    *block.TrackedSliceDevice
 	socketPath string
+
+	// prefetch is fetched from the source ahead of the page faults, it is nil if the build doesn't have the profile.
+	prefetch *header.PrefetchProfile
+	source   block.ReadonlyDevice
+	stopCtx  context.Context
+
+	// adopted is the setup taken from the FC process started by the previous orchestrator process.
+	adopted *UffdSetup
+
+	mappingsMu sync.Mutex
+	mappings   []GuestRegionUffdMapping
 }
 
 func (u *Uffd) Disable() error {
 	return u.memfile.Disable()
 }
 
+func (u *Uffd) Freeze() error {
+	return u.memfile.Freeze()
+}
+
+func (u *Uffd) Unfreeze() {
+	u.memfile.Unfreeze()
+}
+
 func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
 
-func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uffd, error) {
+func (u *Uffd) Accessed() *bitset.BitSet {
+	return u.memfile.Accessed()
+}
+
+func (u *Uffd) Released() *bitset.BitSet {
+	return u.memfile.Released()
+}
+
+func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64, prefetch *header.PrefetchProfile) (*Uffd, error) {
 	pRead, pWrite, err := os.Pipe()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create exit fd: %w", err)
@@ -66,6 +95,8 @@ func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uff
 		return nil, fmt.Errorf("failed to create tracked slice device: %w", err)
 	}
 
+	stopCtx, cancelStop := context.WithCancel(context.Background())
+
 	return &Uffd{
 		exitCh:     make(chan error, 1),
 		readyCh:    make(chan struct{}, 1),
@@ -73,7 +104,12 @@ func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uff
 		exitWriter: pWrite,
 		memfile:    trackedMemfile,
 		socketPath: socketPath,
+		prefetch:   prefetch,
+		source:     memfile,
+		stopCtx:    stopCtx,
 		stopFn: sync.OnceValue(func() error {
+			cancelStop()
+
 			_, writeErr := pWrite.Write([]byte{0})
 			if writeErr != nil {
 				return fmt.Errorf("failed write to exit writer: %w", writeErr)
@@ -84,7 +120,35 @@ func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uff
 	}, nil
 }
 
+// Adopt serves the memory of the FC process started by the previous orchestrator process, the uffd is taken from the process.
+// The pages served before are not known, so all of them are tracked as accessed.
+func Adopt(memfile block.ReadonlyDevice, blockSize int64, setup *UffdSetup) (*Uffd, error) {
+	u, err := New(memfile, "", blockSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	u.memfile.MarkAllAccessed()
+	u.adopted = setup
+
+	return u, nil
+}
+
+// Mappings returns the guest memory regions received from FC, they are empty until the memory is ready.
+func (u *Uffd) Mappings() []GuestRegionUffdMapping {
+	u.mappingsMu.Lock()
+	defer u.mappingsMu.Unlock()
+
+	return u.mappings
+}
+
 func (u *Uffd) Start(sandboxId string) error {
+	if u.adopted != nil {
+		go u.run(sandboxId)
+
+		return nil
+	}
+
 	lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: u.socketPath, Net: "unix"})
 	if err != nil {
 		return fmt.Errorf("failed listening on socket: %w", err)
@@ -97,19 +161,26 @@ func (u *Uffd) Start(sandboxId string) error {
 		return fmt.Errorf("failed setting socket permissions: %w", err)
 	}
 
-	go func() {
-		// TODO: If the handle function fails, we should kill the sandbox
-		handleErr := u.handle(sandboxId)
-		closeErr := u.lis.Close()
-		writerErr := u.exitWriter.Close()
+	go u.run(sandboxId)
+
+	return nil
+}
 
-		u.exitCh <- errors.Join(handleErr, closeErr, writerErr)
+func (u *Uffd) run(sandboxId string) {
+	// TODO: If the handle function fails, we should kill the sandbox
+	handleErr := u.handle(sandboxId)
 
-		close(u.readyCh)
-		close(u.exitCh)
-	}()
+	var closeErr error
+	if u.lis != nil {
+		closeErr = u.lis.Close()
+	}
 
-	return nil
+	writerErr := u.exitWriter.Close()
+
+	u.exitCh <- errors.Join(handleErr, closeErr, writerErr)
+
+	close(u.readyCh)
+	close(u.exitCh)
 }
 
 func (u *Uffd) receiveSetup() (*UffdSetup, error) {
@@ -167,9 +238,12 @@ func (u *Uffd) receiveSetup() (*UffdSetup, error) {
 }
 
 func (u *Uffd) handle(sandboxId string) (err error) {
-	setup, err := u.receiveSetup()
-	if err != nil {
-		return fmt.Errorf("failed to receive setup message from firecracker: %w", err)
+	setup := u.adopted
+	if setup == nil {
+		setup, err = u.receiveSetup()
+		if err != nil {
+			return fmt.Errorf("failed to receive setup message from firecracker: %w", err)
+		}
 	}
 
 	uffd := setup.Fd
@@ -180,8 +254,23 @@ func (u *Uffd) handle(sandboxId string) (err error) {
 		}
 	}()
 
+	u.mappingsMu.Lock()
+	u.mappings = setup.Mappings
+	u.mappingsMu.Unlock()
+
 	u.readyCh <- struct{}{}
 
+	if u.adopted != nil {
+		err = Wake(int(uffd), setup.Mappings)
+		if err != nil {
+			return fmt.Errorf("failed to wake the pending page faults: %w", err)
+		}
+	}
+
+	if u.prefetch != nil {
+		go u.prefetchMemory(sandboxId)
+	}
+
 	err = Serve(
 		int(uffd),
 		setup.Mappings,
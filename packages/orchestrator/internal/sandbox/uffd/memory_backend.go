@@ -4,7 +4,11 @@ import "github.com/bits-and-blooms/bitset"
 
 type MemoryBackend interface {
 	Disable() error
+	Freeze() error
+	Unfreeze()
 	Dirty() *bitset.BitSet
+	Accessed() *bitset.BitSet
+	Released() *bitset.BitSet
 
 	Start(sandboxId string) error
 	Stop() error
@@ -30,10 +30,24 @@ func (m *NoopMemory) Disable() error {
 	return nil
 }
 
+func (m *NoopMemory) Freeze() error {
+	return nil
+}
+
+func (m *NoopMemory) Unfreeze() {}
+
 func (m *NoopMemory) Dirty() *bitset.BitSet {
 	return m.dirty
 }
 
+func (m *NoopMemory) Accessed() *bitset.BitSet {
+	return m.dirty
+}
+
+func (m *NoopMemory) Released() *bitset.BitSet {
+	return bitset.New(0)
+}
+
 func (m *NoopMemory) Start(sandboxId string) error {
 	return nil
 }
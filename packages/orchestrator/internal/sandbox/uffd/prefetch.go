@@ -0,0 +1,55 @@
+package uffd
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// prefetchWorkers is the number of the memfile blocks fetched in parallel.
+const prefetchWorkers = 16
+
+// prefetchMemory fetches the blocks from the prefetch profile to the chunk cache before the guest faults on them.
+// The blocks are read from the source directly, so they are not marked as accessed by the guest.
+// The faults on the blocks that are being prefetched wait for the same chunk fetch instead of starting a new one.
+func (u *Uffd) prefetchMemory(sandboxId string) {
+	blockSize := u.source.BlockSize()
+	if int64(u.prefetch.Metadata.BlockSize) != blockSize {
+		zap.L().Warn("uffd: prefetch profile block size doesn't match the memfile",
+			logger.WithSandboxID(sandboxId),
+			zap.Uint64("profile_block_size", u.prefetch.Metadata.BlockSize),
+			zap.Int64("memfile_block_size", blockSize),
+		)
+
+		return
+	}
+
+	eg, ctx := errgroup.WithContext(u.stopCtx)
+	eg.SetLimit(prefetchWorkers)
+
+outerLoop:
+	for _, r := range u.prefetch.Ranges {
+		for off := int64(r.Offset); off < int64(r.Offset+r.Length); off += blockSize {
+			if ctx.Err() != nil {
+				break outerLoop
+			}
+
+			eg.Go(func() error {
+				_, err := u.source.Slice(off, blockSize)
+				if err != nil {
+					return fmt.Errorf("failed to prefetch block at %d: %w", off, err)
+				}
+
+				return nil
+			})
+		}
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		zap.L().Warn("uffd: failed to prefetch memory", logger.WithSandboxID(sandboxId), zap.Error(err))
+	}
+}
@@ -20,6 +20,24 @@ import (
 
 var ErrUnexpectedEventType = errors.New("unexpected event type")
 
+// uffdEventRemove is sent when the guest memory range is released with madvise, e.g. by the balloon device.
+// It is not defined in the userfaultfd constants package.
+const uffdEventRemove = 0x15
+
+type uffdRemove struct {
+	start uint64
+	end   uint64
+}
+
+// uffdioWake wakes up the threads waiting for the page faults in the range.
+// It is not defined in the userfaultfd constants package.
+const uffdioWake = 0x8010AA02
+
+type uffdioRange struct {
+	start uint64
+	len   uint64
+}
+
 type GuestRegionUffdMapping struct {
 	BaseHostVirtAddr uintptr `json:"base_host_virt_addr"`
 	Size             uintptr `json:"size"`
@@ -40,6 +58,55 @@ func getMapping(addr uintptr, mappings []GuestRegionUffdMapping) (*GuestRegionUf
 	return nil, fmt.Errorf("address %d not found in any mapping", addr)
 }
 
+// memoryRange is a range of the memory file.
+type memoryRange struct {
+	offset int64
+	length int64
+}
+
+// removedRanges returns the ranges of the memory file backing the guest memory range [start, end).
+// The range can span multiple mappings, the parts outside of all mappings are skipped.
+func removedRanges(start, end uintptr, mappings []GuestRegionUffdMapping) []memoryRange {
+	var ranges []memoryRange
+
+	for _, m := range mappings {
+		from := max(start, m.BaseHostVirtAddr)
+		to := min(end, m.BaseHostVirtAddr+m.Size)
+
+		if from >= to {
+			continue
+		}
+
+		ranges = append(ranges, memoryRange{
+			offset: int64(m.Offset + from - m.BaseHostVirtAddr),
+			length: int64(to - from),
+		})
+	}
+
+	return ranges
+}
+
+// Wake retries the page faults in the mappings, the faults read but not served by the previous handler are sent again.
+func Wake(uffd int, mappings []GuestRegionUffdMapping) error {
+	for _, m := range mappings {
+		r := uffdioRange{
+			start: uint64(m.BaseHostVirtAddr),
+			len:   uint64(m.Size),
+		}
+
+		if _, _, errno := syscall.Syscall(
+			syscall.SYS_IOCTL,
+			uintptr(uffd),
+			uffdioWake,
+			uintptr(unsafe.Pointer(&r)),
+		); errno != 0 {
+			return fmt.Errorf("failed uffdio wake: %w", errno)
+		}
+	}
+
+	return nil
+}
+
 func Serve(
 	uffd int,
 	mappings []GuestRegionUffdMapping,
@@ -134,6 +201,24 @@ outerLoop:
 		}
 
 		msg := (*(*constants.UffdMsg)(unsafe.Pointer(&buf[0])))
+
+		if constants.GetMsgEvent(&msg) == uffdEventRemove {
+			// The pages that are being copied could be mapped again after the removal, so the copies have to finish first.
+			err := eg.Wait()
+			if err != nil {
+				return fmt.Errorf("failed to handle uffd: %w", err)
+			}
+
+			arg := constants.GetMsgArg(&msg)
+			remove := (*(*uffdRemove)(unsafe.Pointer(&arg[0])))
+
+			for _, r := range removedRanges(uintptr(remove.start), uintptr(remove.end), mappings) {
+				src.Release(r.offset, r.length)
+			}
+
+			continue
+		}
+
 		if constants.GetMsgEvent(&msg) != constants.UFFD_EVENT_PAGEFAULT {
 			zap.L().Error("UFFD serve unexpected event type", logger.WithSandboxID(sandboxId), zap.Any("event_type", constants.GetMsgEvent(&msg)))
 
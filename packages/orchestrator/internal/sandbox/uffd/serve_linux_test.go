@@ -0,0 +1,55 @@
+package uffd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemovedRanges(t *testing.T) {
+	const pageSize = 4 << 10
+
+	mappings := []GuestRegionUffdMapping{
+		{BaseHostVirtAddr: 0x10000000, Size: 0x100000, Offset: 0},
+		{BaseHostVirtAddr: 0x20000000, Size: 0x100000, Offset: 0x100000},
+	}
+
+	tests := []struct {
+		name       string
+		start, end uintptr
+		want       []memoryRange
+	}{
+		{
+			name:  "single page",
+			start: 0x10000000 + pageSize,
+			end:   0x10000000 + 2*pageSize,
+			want:  []memoryRange{{offset: pageSize, length: pageSize}},
+		},
+		{
+			name:  "range in the second mapping",
+			start: 0x20000000,
+			end:   0x20080000,
+			want:  []memoryRange{{offset: 0x100000, length: 0x80000}},
+		},
+		{
+			name:  "range spanning both mappings",
+			start: 0x100f0000,
+			end:   0x20010000,
+			want: []memoryRange{
+				{offset: 0xf0000, length: 0x10000},
+				{offset: 0x100000, length: 0x10000},
+			},
+		},
+		{
+			name:  "range outside of the mappings",
+			start: 0x30000000,
+			end:   0x30001000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, removedRanges(tt.start, tt.end, mappings))
+		})
+	}
+}
@@ -21,3 +21,7 @@ type GuestRegionUffdMapping struct {
 func Serve(uffd int, mappings []GuestRegionUffdMapping, src *block.TrackedSliceDevice, fd uintptr, stop func() error, sandboxId string) error {
 	return errors.New("platform does not support UFFD")
 }
+
+func Wake(uffd int, mappings []GuestRegionUffdMapping) error {
+	return errors.New("platform does not support UFFD")
+}
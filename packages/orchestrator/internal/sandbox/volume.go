@@ -0,0 +1,124 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/dataset"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process/processconnect"
+)
+
+const (
+	volumeMountTimeout = 30 * time.Second
+	volumeSyncTimeout  = 10 * time.Second
+
+	// volumeMountScript mounts the device unless the volume is already mounted, e.g. in the resumed paused sandbox.
+	// The root directory of the new volume is handed over to the default user.
+	volumeMountScript = `mountpoint -q "$1" || { mkdir -p "$1" && mount "$2" "$1" && if [ "$3" = "true" ]; then chown user:user "$1"; fi; }`
+
+	// datasetMountScript mounts the dataset read-only, the journal of the rootfs the dataset is made from can't be replayed on the read-only device.
+	datasetMountScript = `mountpoint -q "$1" || { mkdir -p "$1" && mount -o ro,noload "$2" "$1"; }`
+)
+
+// mountVolume mounts the attached volume in the guest through envd.
+func (s *Sandbox) mountVolume(ctx context.Context, tracer trace.Tracer, v *volume.Volume, isNew bool) error {
+	ctx, span := tracer.Start(ctx, "mount-volume", trace.WithAttributes(
+		attribute.String("volume.mount_path", v.MountPath()),
+	))
+	defer span.End()
+
+	err := s.runGuestScript(ctx, volumeMountScript, v.MountPath(), volume.GuestDevicePath, strconv.FormatBool(isNew))
+	if err != nil {
+		return fmt.Errorf("volume mount failed: %w", err)
+	}
+
+	return nil
+}
+
+// mountDataset mounts the attached dataset in the guest through envd.
+func (s *Sandbox) mountDataset(ctx context.Context, tracer trace.Tracer, d *dataset.Dataset, index int) error {
+	ctx, span := tracer.Start(ctx, "mount-dataset", trace.WithAttributes(
+		attribute.String("dataset.mount_path", d.MountPath()),
+	))
+	defer span.End()
+
+	err := s.runGuestScript(ctx, datasetMountScript, d.MountPath(), dataset.GuestDevicePath(index))
+	if err != nil {
+		return fmt.Errorf("dataset mount failed: %w", err)
+	}
+
+	return nil
+}
+
+// SyncVolume flushes the guest page cache of the volume, so the writes that weren't synced yet are not lost when the sandbox is killed.
+func (s *Sandbox) SyncVolume(ctx context.Context, tracer trace.Tracer) error {
+	if s.volume == nil {
+		return nil
+	}
+
+	ctx, span := tracer.Start(ctx, "sync-volume")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, volumeSyncTimeout)
+	defer cancel()
+
+	return s.runGuestScript(ctx, `sync -f "$1"`, s.volume.MountPath())
+}
+
+// runGuestScript runs the bash script as root in the guest through envd.
+func (s *Sandbox) runGuestScript(ctx context.Context, script string, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, volumeMountTimeout)
+	defer cancel()
+
+	req := connect.NewRequest(&process.StartRequest{
+		Process: &process.ProcessConfig{
+			Cmd:  "/bin/bash",
+			Args: append([]string{"-c", script, "--"}, args...),
+		},
+	})
+	grpc.SetUserHeader(req.Header(), "root")
+	if s.Config.EnvdAccessToken != nil {
+		req.Header().Set("X-Access-Token", *s.Config.EnvdAccessToken)
+	}
+
+	address := fmt.Sprintf("http://%s:%d", s.Slot.HostIPString(), consts.DefaultEnvdServerPort)
+	client := processconnect.NewProcessClient(&http.Client{Timeout: volumeMountTimeout}, address)
+
+	stream, err := client.Start(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error starting process: %w", err)
+	}
+	defer stream.Close()
+
+	var stderr []byte
+	for stream.Receive() {
+		event := stream.Msg().GetEvent()
+
+		stderr = append(stderr, event.GetData().GetStderr()...)
+
+		if end := event.GetEnd(); end != nil {
+			if end.GetExitCode() != 0 {
+				return fmt.Errorf("process exited with %s: %s", end.GetStatus(), stderr)
+			}
+
+			return nil
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("error waiting for process: %w", err)
+	}
+
+	return fmt.Errorf("process ended without exit status")
+}
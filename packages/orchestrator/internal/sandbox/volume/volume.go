@@ -0,0 +1,281 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/ext4"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	DefaultMountPath = "/data"
+
+	// GuestDevicePath is the volume drive in the guest, it is the second drive after the rootfs.
+	GuestDevicePath = "/dev/vdb"
+)
+
+// ErrWriteBack is returned when the changed blocks of the volume couldn't be stored, the next generation is then incomplete.
+var ErrWriteBack = errors.New("failed to write back volume")
+
+// Volume serves the team volume to the sandbox as an NBD device.
+// The blocks written by the sandbox are kept in the local cache and stored as the next generation of the volume when the volume is closed.
+type Volume struct {
+	config *orchestrator.SandboxVolume
+
+	header  *header.Header
+	overlay *block.Overlay
+	mnt     *nbd.DirectPathMount
+
+	ready *utils.SetOnce[string]
+
+	templateCache *template.Cache
+	persistence   storage.StorageProvider
+
+	tracer trace.Tracer
+}
+
+func New(
+	ctx context.Context,
+	tracer trace.Tracer,
+	templateCache *template.Cache,
+	persistence storage.StorageProvider,
+	devicePool *nbd.DevicePool,
+	config *orchestrator.SandboxVolume,
+	cachePath string,
+) (*Volume, error) {
+	generationID := config.GetGenerationId()
+
+	var h *header.Header
+	// The new volume has no data yet, all of its blocks are empty.
+	if generationID == "" {
+		volumeID, err := uuid.Parse(config.GetVolumeId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse volume id: %w", err)
+		}
+
+		size := uint64(config.GetSizeMb()) << 20
+
+		h = header.NewHeader(
+			header.NewTemplateMetadata(volumeID, header.RootfsBlockSize, size),
+			[]*header.BuildMap{{
+				Offset:  0,
+				Length:  size,
+				BuildId: uuid.Nil,
+			}},
+		)
+		generationID = volumeID.String()
+	}
+
+	device, err := templateCache.GetVolume(ctx, generationID, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume generation '%s': %w", generationID, err)
+	}
+
+	size, err := device.Size()
+	if err != nil {
+		return nil, fmt.Errorf("error getting volume size: %w", err)
+	}
+
+	cache, err := block.NewCache(size, device.BlockSize(), cachePath, false)
+	if err != nil {
+		return nil, fmt.Errorf("error creating volume cache: %w", err)
+	}
+
+	overlay := block.NewOverlay(device, cache, device.BlockSize())
+
+	return &Volume{
+		config:        config,
+		header:        device.Header(),
+		overlay:       overlay,
+		mnt:           nbd.NewDirectPathMount(tracer, overlay, devicePool),
+		ready:         utils.NewSetOnce[string](),
+		templateCache: templateCache,
+		persistence:   persistence,
+		tracer:        tracer,
+	}, nil
+}
+
+// Start opens the NBD device, the new volume is formatted before it is passed to the sandbox.
+func (v *Volume) Start(ctx context.Context) error {
+	deviceIndex, err := v.mnt.Open(ctx)
+	if err != nil {
+		return v.ready.SetError(fmt.Errorf("error opening volume device: %w", err))
+	}
+
+	devicePath := nbd.GetDevicePath(deviceIndex)
+
+	if v.config.GetGenerationId() == "" {
+		err = ext4.MakeVolume(ctx, v.tracer, devicePath)
+		if err != nil {
+			return v.ready.SetError(fmt.Errorf("error formatting volume: %w", err))
+		}
+	}
+
+	return v.ready.SetValue(devicePath)
+}
+
+func (v *Volume) Path() (string, error) {
+	return v.ready.Wait()
+}
+
+func (v *Volume) MountPath() string {
+	if v.config.GetMountPath() == "" {
+		return DefaultMountPath
+	}
+
+	return v.config.GetMountPath()
+}
+
+// Close writes the changed blocks back as the next generation of the volume and releases the device.
+// It must be called only after the sandbox stopped writing to the volume.
+func (v *Volume) Close(ctx context.Context) error {
+	ctx, span := v.tracer.Start(ctx, "volume-close", trace.WithAttributes(
+		attribute.String("volume.id", v.config.GetVolumeId()),
+		attribute.String("volume.next_generation_id", v.config.GetNextGenerationId()),
+	))
+	defer span.End()
+
+	devicePath, err := v.Path()
+	if err != nil {
+		// The device was never opened, so there is nothing to write back.
+		return errors.Join(err, v.overlay.Close())
+	}
+
+	var errs []error
+
+	err = flush(ctx, devicePath)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error flushing volume device: %w", err))
+	}
+
+	err = v.mnt.Close(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error closing volume mount: %w", err))
+	}
+
+	// The generation is not written if the device wasn't flushed, it could miss some of the sandbox writes.
+	if len(errs) == 0 {
+		err = v.writeBack(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: %w", ErrWriteBack, err))
+		}
+	} else {
+		errs = append(errs, ErrWriteBack)
+	}
+
+	err = v.overlay.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error closing volume cache: %w", err))
+	}
+
+	zap.L().Info("volume device released", zap.String("volume_id", v.config.GetVolumeId()))
+
+	return errors.Join(errs...)
+}
+
+func (v *Volume) writeBack(ctx context.Context) error {
+	generationID, err := uuid.Parse(v.config.GetNextGenerationId())
+	if err != nil {
+		return fmt.Errorf("failed to parse next generation id: %w", err)
+	}
+
+	// The volume has no team, its diff is served to the peers only if the encryption is disabled.
+	diffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, generationID.String(), build.Volume, "")
+	if err != nil {
+		return fmt.Errorf("failed to create volume diff: %w", err)
+	}
+
+	diffMetadata, err := v.overlay.ExportToDiff(diffFile)
+	if err != nil {
+		diffFile.Close()
+
+		return fmt.Errorf("error exporting volume cache: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "exported volume")
+
+	mapping, err := diffMetadata.CreateMapping(ctx, generationID)
+	if err != nil {
+		diffFile.Close()
+
+		return fmt.Errorf("failed to create volume mapping: %w", err)
+	}
+
+	mappings := header.NormalizeMappings(header.MergeMappings(v.header.Mapping, mapping))
+
+	diff, err := diffFile.CloseToDiff(int64(v.header.Metadata.BlockSize))
+	if err != nil {
+		return fmt.Errorf("failed to convert volume diff file to local diff: %w", err)
+	}
+
+	var diffPath *string
+	switch d := diff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		path, err := d.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting volume diff path: %w", err)
+		}
+
+		diffPath = &path
+	}
+
+	metadata := v.header.Metadata.NextGeneration(generationID)
+
+	telemetry.SetAttributes(ctx,
+		attribute.Int64("volume.header.mappings.length", int64(len(mappings))),
+		attribute.Int64("volume.diff.size", int64(diffMetadata.Dirty.Count()*uint(metadata.BlockSize))),
+		attribute.Int64("volume.metadata.generation", int64(metadata.Generation)),
+	)
+
+	h := header.NewHeader(metadata, mappings)
+	h.SetChecksums(diffMetadata.Checksums)
+
+	err = storage.NewVolumeGeneration(generationID.String(), h, v.persistence).Upload(ctx, diffPath)
+	if err != nil {
+		diff.Close()
+
+		return fmt.Errorf("error uploading volume generation: %w", err)
+	}
+
+	v.templateCache.AddVolumeDiff(diff)
+
+	return nil
+}
+
+// flush writes the data buffered by the host for the device.
+func flush(ctx context.Context, devicePath string) error {
+	telemetry.ReportEvent(ctx, "flushing volume device")
+	defer telemetry.ReportEvent(ctx, "flushing volume done")
+
+	file, err := os.Open(devicePath)
+	if err != nil {
+		return fmt.Errorf("failed to open volume device: %w", err)
+	}
+	defer file.Close()
+
+	if err := unix.IoctlSetInt(int(file.Fd()), unix.BLKFLSBUF, 0); err != nil {
+		return fmt.Errorf("ioctl BLKFLSBUF failed: %w", err)
+	}
+
+	return syscall.Fsync(int(file.Fd()))
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+// adoptSandboxes takes over the sandboxes left running by the previous orchestrator process.
+// The sandboxes that can't be adopted are stopped and their resources are released.
+// It has to run before the server starts serving, so the adopted resources are not taken by new sandboxes.
+func (s *server) adoptSandboxes(ctx context.Context) {
+	states, err := sandbox.LoadStates()
+	if err != nil {
+		zap.L().Error("failed to load some sandbox states", zap.Error(err))
+	}
+
+	for _, state := range states {
+		err := s.adoptSandbox(ctx, state)
+		if err != nil {
+			zap.L().Error("failed to adopt sandbox, it was cleaned up", logger.WithSandboxID(state.Config.SandboxId), zap.Error(err))
+		}
+	}
+
+	zap.L().Info("Adopted running sandboxes", zap.Int("adopted", s.sandboxes.Count()), zap.Int("found", len(states)))
+}
+
+func (s *server) adoptSandbox(ctx context.Context, state *sandbox.State) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, requestTimeout, fmt.Errorf("adoption timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-adopt")
+	defer childSpan.End()
+
+	sbx, cleanup, err := sandbox.AdoptSandbox(
+		ctx,
+		s.tracer,
+		s.networkPool,
+		s.templateCache,
+		s.devicePool,
+		state,
+		config.AllowSandboxInternet,
+		s.metricsWriteFlag(state.Config.SandboxId),
+	)
+	if err != nil {
+		// The cleanup must finish even if the adoption timed out, the resources would be left behind otherwise.
+		cleanupErr := cleanup.Run(context.WithoutCancel(ctx))
+
+		return errors.Join(err, cleanupErr)
+	}
+
+	s.sandboxes.Insert(state.Config.SandboxId, sbx)
+
+	go s.waitSandbox(sbx, cleanup)
+
+	sbxlogger.I(sbx).Info("Sandbox adopted")
+
+	return nil
+}
+
+// saveState persists the changed sandbox, the sandbox is still running with the change, so the failure is only logged.
+func (s *server) saveState(sbx *sandbox.Sandbox) {
+	err := sbx.SaveState()
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to save sandbox state", zap.Error(err))
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Checkpoint(ctxConn context.Context, in *orchestrator.SandboxCheckpointRequest) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-checkpoint")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	// The sandbox can't be paused, deleted or reset while it's being snapshotted.
+	unlock := s.sandboxLocks.Lock(in.SandboxId)
+	defer unlock()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := s.liveSnapshot(ctx, sbx, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// liveSnapshot snapshots the running sandbox under the template and build ID, adds the snapshot to the template cache and uploads it.
+// The returned error is already a grpc status error.
+func (s *server) liveSnapshot(ctx context.Context, sbx *sandbox.Sandbox, templateID, buildID string) error {
+	// The volume is written back only when the sandbox is stopped, the snapshot would reference the volume data that doesn't exist yet.
+	if len(sbx.Config.GetVolumes()) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "sandbox '%s' with a volume attached can't be snapshotted while running", sbx.Config.SandboxId)
+	}
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		templateID,
+		buildID,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating template files", err)
+
+		return status.Errorf(codes.Internal, "error creating template files: %s", err)
+	}
+
+	snapshot, err := sbx.LiveSnapshot(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(sbx.Config.SandboxId))
+
+		return status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", sbx.Config.SandboxId, err)
+	}
+
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+		sbx.Config.TeamId,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
+
+		return status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+	}
+
+	telemetry.ReportEvent(ctx, "added snapshot to template cache")
+
+	go s.uploadSnapshot(sbx, snapshotTemplateFiles, snapshot)
+
+	return nil
+}
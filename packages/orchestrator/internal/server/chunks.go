@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+type chunkServer struct {
+	orchestrator.UnimplementedChunkServiceServer
+
+	server *server
+	// token is shared by the orchestrators of the cluster, the requests without it are rejected.
+	token string
+}
+
+// Read serves the chunk of the diff cached on the node to the other orchestrator.
+// The chunks are never fetched from the storage for the peers, the peer falls back to the storage itself.
+func (c *chunkServer) Read(ctx context.Context, req *orchestrator.ChunkReadRequest) (*orchestrator.ChunkReadResponse, error) {
+	_, childSpan := c.server.tracer.Start(ctx, "read-chunk")
+	defer childSpan.End()
+
+	err := build.AuthorizePeer(ctx, c.token)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetOffset() < 0 || req.GetLength() <= 0 || req.GetLength() > block.ChunkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chunk range %d+%d", req.GetOffset(), req.GetLength())
+	}
+
+	data, err := c.server.templateCache.ReadCachedChunk(ctx, req.GetBuildId(), build.DiffType(req.GetDiffType()), req.GetOffset(), req.GetLength())
+	if errors.Is(err, build.ErrChunkNotCached) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read chunk: %s", err)
+	}
+
+	return &orchestrator.ChunkReadResponse{Data: data}, nil
+}
+
+// ReadSnapshot serves the snapshot paused on the node to the orchestrator the sandbox is migrated to.
+func (c *chunkServer) ReadSnapshot(ctx context.Context, req *orchestrator.SnapshotReadRequest) (*orchestrator.SnapshotReadResponse, error) {
+	_, childSpan := c.server.tracer.Start(ctx, "read-snapshot")
+	defer childSpan.End()
+
+	err := build.AuthorizePeer(ctx, c.token)
+	if err != nil {
+		return nil, err
+	}
+
+	memfileHeader, rootfsHeader, snapfile, err := c.server.templateCache.ReadSnapshot(ctx, req.GetTemplateId(), req.GetBuildId())
+	if errors.Is(err, template.ErrSnapshotNotCached) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read snapshot: %s", err)
+	}
+
+	memfile, err := serializeHeader(memfileHeader)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to serialize memfile header: %s", err)
+	}
+
+	rootfs, err := serializeHeader(rootfsHeader)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to serialize rootfs header: %s", err)
+	}
+
+	return &orchestrator.SnapshotReadResponse{
+		MemfileHeader: memfile,
+		RootfsHeader:  rootfs,
+		Snapfile:      snapfile,
+	}, nil
+}
+
+func serializeHeader(h *header.Header) ([]byte, error) {
+	serialized, err := header.SerializeHeader(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(serialized)
+}
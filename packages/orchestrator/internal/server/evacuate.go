@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/service"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// maxConcurrentEvacuations limits the sandboxes paused and uploaded at once, so the evacuation doesn't saturate the disk and the network.
+const maxConcurrentEvacuations = 4
+
+func (s *Service) Evacuate(ctx context.Context, evacuation *service.Evacuation) {
+	s.server.evacuate(ctx, evacuation)
+}
+
+// evacuate pauses all the running sandboxes and uploads their snapshots, so the API can resume them on the other nodes.
+// The sandboxes that can't be evacuated are stopped. No more sandboxes are paused once the context is canceled.
+func (s *server) evacuate(ctx context.Context, evacuation *service.Evacuation) {
+	sandboxes := s.sandboxes.Items()
+	evacuation.SetTotal(int64(len(sandboxes)))
+
+	zap.L().Info("Evacuating sandboxes", zap.Int("count", len(sandboxes)))
+
+	var eg errgroup.Group
+	eg.SetLimit(maxConcurrentEvacuations)
+
+	for _, sbx := range sandboxes {
+		eg.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			s.evacuateSandbox(ctx, sbx, evacuation)
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	progress := evacuation.Proto()
+	zap.L().Info("Finished evacuating sandboxes",
+		zap.Int("paused", len(progress.GetSandboxesPaused())),
+		zap.Int64("failed", progress.GetSandboxesFailed()),
+		zap.Error(ctx.Err()),
+	)
+}
+
+func (s *server) evacuateSandbox(ctx context.Context, sbx *sandbox.Sandbox, evacuation *service.Evacuation) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-evacuate", trace.WithAttributes(
+		telemetry.WithSandboxID(sbx.Config.SandboxId),
+	))
+	defer childSpan.End()
+
+	unlock := s.sandboxLocks.Lock(sbx.Config.SandboxId)
+	defer unlock()
+
+	// The sandbox could have been paused or deleted since the listing.
+	current, ok := s.sandboxes.Get(sbx.Config.SandboxId)
+	if !ok || current != sbx {
+		return
+	}
+
+	s.sandboxes.Remove(sbx.Config.SandboxId)
+
+	// The API records the snapshot under its own template, the template is used only for the local cache.
+	buildID := uuid.NewString()
+
+	snapshotTemplateFiles, snapshot, err := s.snapshotSandbox(ctx, sbx, sbx.Config.TemplateId, buildID, orchestrator.SandboxExitReason_ExitNodeDrain)
+	if err != nil {
+		evacuation.AddFailed()
+		sbxlogger.I(sbx).Error("error pausing evacuated sandbox", zap.Error(err))
+
+		return
+	}
+
+	// The upload works only with the snapshot, the sandbox is already stopped.
+	unlock()
+
+	// The sandbox is reported as paused only after the upload, so it can be resumed on any node right away.
+	err = s.persistSnapshot(sbx, snapshotTemplateFiles, snapshot)
+	if err != nil {
+		evacuation.AddFailed()
+		sbxlogger.I(sbx).Error("error uploading evacuated sandbox snapshot", zap.Error(err))
+
+		s.publishEvent(sbx, orchestrator.SandboxEventType_SandboxStopped, &orchestrator.SandboxExit{
+			Reason:  orchestrator.SandboxExitReason_ExitNodeDrain,
+			Message: "sandbox snapshot upload failed during the node evacuation",
+		})
+
+		return
+	}
+
+	evacuation.AddPaused(sbx.Config.SandboxId, sbx.Config.ExecutionId, buildID)
+	s.publishPaused(sbx, buildID)
+
+	telemetry.ReportEvent(ctx, "evacuated sandbox")
+}
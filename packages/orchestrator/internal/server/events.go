@@ -0,0 +1,127 @@
+package server
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// watcherBufferSize is the number of the events buffered for the watcher.
+// The watcher that doesn't keep up is disconnected, it reconciles the missed events by listing the sandboxes.
+const watcherBufferSize = 1024
+
+// eventsBroker fans out the sandbox lifecycle events to the watchers.
+type eventsBroker struct {
+	mu       sync.Mutex
+	watchers map[chan *orchestrator.SandboxEvent]struct{}
+}
+
+func newEventsBroker() *eventsBroker {
+	return &eventsBroker{
+		watchers: make(map[chan *orchestrator.SandboxEvent]struct{}),
+	}
+}
+
+func (b *eventsBroker) subscribe() (<-chan *orchestrator.SandboxEvent, func()) {
+	ch := make(chan *orchestrator.SandboxEvent, watcherBufferSize)
+
+	b.mu.Lock()
+	b.watchers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.watchers[ch]; ok {
+			delete(b.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (b *eventsBroker) publish(event *orchestrator.SandboxEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.watchers {
+		select {
+		case ch <- event:
+		default:
+			zap.L().Warn("sandbox events watcher is too slow, disconnecting it")
+
+			delete(b.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// publishStarted sends the created or resumed event with the sandbox, so the watcher doesn't have to list it.
+func (s *server) publishStarted(sbx *sandbox.Sandbox) {
+	eventType := orchestrator.SandboxEventType_SandboxCreated
+	if sbx.Config.Snapshot {
+		eventType = orchestrator.SandboxEventType_SandboxResumed
+	}
+
+	s.events.publish(&orchestrator.SandboxEvent{
+		Type:        eventType,
+		SandboxId:   sbx.Config.SandboxId,
+		ExecutionId: sbx.Config.ExecutionId,
+		Timestamp:   timestamppb.Now(),
+		Sandbox: &orchestrator.RunningSandbox{
+			Config:    sbx.Config,
+			ClientId:  s.info.ClientId,
+			StartTime: timestamppb.New(sbx.StartedAt),
+			EndTime:   timestamppb.New(sbx.EndAt),
+		},
+	})
+}
+
+func (s *server) publishEvent(sbx *sandbox.Sandbox, eventType orchestrator.SandboxEventType, exit *orchestrator.SandboxExit) {
+	s.events.publish(&orchestrator.SandboxEvent{
+		Type:        eventType,
+		SandboxId:   sbx.Config.SandboxId,
+		ExecutionId: sbx.Config.ExecutionId,
+		Timestamp:   timestamppb.Now(),
+		Exit:        exit,
+	})
+}
+
+// publishPaused sends the paused event with the snapshot build the sandbox was paused to.
+func (s *server) publishPaused(sbx *sandbox.Sandbox, buildID string) {
+	s.events.publish(&orchestrator.SandboxEvent{
+		Type:        orchestrator.SandboxEventType_SandboxPaused,
+		SandboxId:   sbx.Config.SandboxId,
+		ExecutionId: sbx.Config.ExecutionId,
+		Timestamp:   timestamppb.Now(),
+		BuildId:     buildID,
+	})
+}
+
+func (s *server) WatchEvents(_ *emptypb.Empty, stream orchestrator.SandboxService_WatchEventsServer) error {
+	events, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "sandbox events watcher didn't keep up with the events")
+			}
+
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestEventsBroker(t *testing.T) {
+	b := newEventsBroker()
+
+	events, unsubscribe := b.subscribe()
+	slow, _ := b.subscribe()
+
+	// Fill the buffer of the slow watcher, the fast one reads the events as they come.
+	for range watcherBufferSize {
+		b.publish(&orchestrator.SandboxEvent{SandboxId: "sandbox"})
+
+		event := <-events
+		assert.Equal(t, "sandbox", event.SandboxId)
+	}
+
+	b.publish(&orchestrator.SandboxEvent{SandboxId: "overflow"})
+
+	event := <-events
+	assert.Equal(t, "overflow", event.SandboxId)
+
+	// The slow watcher is disconnected after its buffered events are read.
+	for range watcherBufferSize {
+		_, ok := <-slow
+		require.True(t, ok)
+	}
+
+	_, ok := <-slow
+	assert.False(t, ok)
+
+	unsubscribe()
+
+	_, ok = <-events
+	assert.False(t, ok)
+
+	// The unsubscribed watchers don't get the events.
+	b.publish(&orchestrator.SandboxEvent{SandboxId: "sandbox"})
+	assert.Empty(t, b.watchers)
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Fork(ctxConn context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.Int("fork.children", len(in.Children)),
+	)
+
+	// The sandbox can't be paused, deleted or reset while it's being snapshotted.
+	unlock := s.sandboxLocks.Lock(in.SandboxId)
+	defer unlock()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := s.liveSnapshot(ctx, sbx, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The children are started only from the snapshot, the parent is free for other operations.
+	unlock()
+
+	var startedMu sync.Mutex
+	started := make([]string, 0, len(in.Children))
+
+	var eg errgroup.Group
+	for _, child := range in.Children {
+		// The children are always started from the fork snapshot, regardless of what the caller sent.
+		child.Sandbox.TemplateId = in.TemplateId
+		child.Sandbox.BuildId = in.BuildId
+		child.Sandbox.Snapshot = true
+		// The children inherit the egress rules of the parent unless they set their own.
+		// The rate limits are set by the caller from the team tier, the same as for any new sandbox.
+		if child.Sandbox.Network == nil {
+			child.Sandbox.Network = sbx.Config.Network
+		}
+
+		eg.Go(func() error {
+			err := s.startSandbox(ctx, child, nil)
+			if err != nil {
+				zap.L().Error("failed to start fork child", logger.WithSandboxID(child.Sandbox.SandboxId), zap.String("parent_sandbox_id", in.SandboxId), zap.Error(err))
+
+				return nil
+			}
+
+			startedMu.Lock()
+			started = append(started, child.Sandbox.SandboxId)
+			startedMu.Unlock()
+
+			return nil
+		})
+	}
+
+	// Errors are handled per child, some children can fail to start while the others are running.
+	_ = eg.Wait()
+
+	if len(in.Children) > 0 && len(started) == 0 {
+		telemetry.ReportCriticalError(ctx, "failed to start any fork child", nil, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "failed to start any child of sandbox '%s'", in.SandboxId)
+	}
+
+	return &orchestrator.SandboxForkResponse{
+		ClientId:   s.info.ClientId,
+		SandboxIds: started,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import "sync"
+
+// sandboxLocks serializes the operations that stop or snapshot a sandbox (pause, delete, reset, fork, checkpoint).
+// The locks are keyed by the sandbox ID, so the reset sandbox is guarded by the same lock as the sandbox it replaced.
+// The zero value is ready to use.
+type sandboxLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sandboxLock
+}
+
+type sandboxLock struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+// Lock blocks until no other operation holds the lock of the sandbox and returns the function releasing it.
+func (l *sandboxLocks) Lock(sandboxID string) (unlock func()) {
+	l.mu.Lock()
+
+	if l.locks == nil {
+		l.locks = make(map[string]*sandboxLock)
+	}
+
+	lock, ok := l.locks[sandboxID]
+	if !ok {
+		lock = &sandboxLock{}
+		l.locks[sandboxID] = lock
+	}
+
+	lock.waiters++
+
+	l.mu.Unlock()
+
+	lock.mu.Lock()
+
+	return sync.OnceFunc(func() {
+		lock.mu.Unlock()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		lock.waiters--
+		// The lock is dropped with the last holder, so the map doesn't grow with every sandbox started on the node.
+		if lock.waiters == 0 {
+			delete(l.locks, sandboxID)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxLocks(t *testing.T) {
+	t.Run("operations on the same sandbox are serialized", func(t *testing.T) {
+		var locks sandboxLocks
+
+		unlock := locks.Lock("sbx")
+
+		acquired := make(chan struct{})
+		go func() {
+			defer close(acquired)
+
+			locks.Lock("sbx")()
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("lock acquired while held by another operation")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("lock not acquired after release")
+		}
+	})
+
+	t.Run("operations on different sandboxes don't block each other", func(t *testing.T) {
+		var locks sandboxLocks
+
+		unlock := locks.Lock("sbx-1")
+		defer unlock()
+
+		acquired := make(chan struct{})
+		go func() {
+			defer close(acquired)
+
+			locks.Lock("sbx-2")()
+		}()
+
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("lock of another sandbox blocked")
+		}
+	})
+
+	t.Run("released locks are removed", func(t *testing.T) {
+		var locks sandboxLocks
+
+		unlock := locks.Lock("sbx")
+		require.Len(t, locks.locks, 1)
+
+		unlock()
+		// The repeated release is a no-op.
+		unlock()
+
+		assert.Empty(t, locks.locks)
+	})
+}
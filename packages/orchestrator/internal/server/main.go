@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/grpcserver"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
@@ -32,10 +33,14 @@ type server struct {
 	tracer        trace.Tracer
 	networkPool   *network.Pool
 	templateCache *template.Cache
-	pauseMu       sync.Mutex
-	devicePool    *nbd.DevicePool
-	persistence   storage.StorageProvider
-	featureFlags  *featureflags.Client
+	sandboxLocks  sandboxLocks
+	// handovers are the stopped sandboxes replaced by a new sandbox with the same ID and execution ID (reset).
+	// Their wait neither removes them from the cache nor reports them as stopped, the replacing sandbox keeps running.
+	handovers    sync.Map
+	devicePool   *nbd.DevicePool
+	persistence  storage.StorageProvider
+	featureFlags *featureflags.Client
+	events       *eventsBroker
 }
 
 type Service struct {
@@ -61,15 +66,11 @@ func New(
 	info *service.ServiceInfo,
 	proxy *proxy.SandboxProxy,
 	sandboxes *smap.Map[*sandbox.Sandbox],
+	templateCache *template.Cache,
 	featureFlags *featureflags.Client,
 ) (*Service, error) {
 	srv := &Service{info: info}
 
-	templateCache, err := template.NewCache(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create template cache: %w", err)
-	}
-
 	srv.proxy = proxy
 
 	persistence, err := storage.GetTemplateStorageProvider(ctx)
@@ -89,8 +90,11 @@ func New(
 		devicePool:    devicePool,
 		persistence:   persistence,
 		featureFlags:  featureFlags,
+		events:        newEventsBroker(),
 	}
 
+	srv.server.adoptSandboxes(ctx)
+
 	meter := tel.MeterProvider.Meter("orchestrator.sandbox")
 	_, err = telemetry.GetObservableUpDownCounter(meter, telemetry.OrchestratorSandboxCountMeterName, func(ctx context.Context, observer metric.Int64Observer) error {
 		observer.Observe(int64(srv.server.sandboxes.Count()))
@@ -102,6 +106,7 @@ func New(
 	}
 
 	orchestrator.RegisterSandboxServiceServer(grpc.GRPCServer(), srv.server)
+	orchestrator.RegisterChunkServiceServer(grpc.GRPCServer(), &chunkServer{server: srv.server, token: build.PeerAuthToken})
 
 	return srv, nil
 }
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// Reset restarts the sandbox from the template build it was originally started from.
+// The sandbox keeps its ID, execution ID, network slot and the remaining timeout, so the routing to it doesn't change.
+func (s *server) Reset(ctxConn context.Context, in *orchestrator.SandboxResetRequest) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-reset")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	// The sandbox can't be paused, deleted or snapshotted between the stop and the restart.
+	unlock := s.sandboxLocks.Lock(in.SandboxId)
+	defer unlock()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	// The volume would be written back and attached again in the previous generation.
+	if len(sbx.Config.GetVolumes()) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "sandbox '%s' with a volume attached can't be reset", in.SandboxId)
+	}
+
+	baseBuildID, err := sbx.BaseBuildID()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error getting base build", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error getting base build of sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	config := resetConfig(sbx.Config, baseBuildID)
+
+	// The stopped sandbox keeps the cache entry until the reset sandbox replaces it, its exit isn't reported as the sandbox stop.
+	s.handovers.Store(sbx, struct{}{})
+
+	slot, err := sbx.StopKeepingSlot(ctx)
+	if err != nil {
+		s.abortHandover(sbx, err)
+
+		telemetry.ReportCriticalError(ctx, "error stopping sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error stopping sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	telemetry.ReportEvent(ctx, "stopped sandbox")
+
+	// The connections in the pool lead to the stopped instance
+	s.proxy.RemoveFromPool(config.ExecutionId)
+
+	err = s.startSandbox(ctx, &orchestrator.SandboxCreateRequest{
+		Sandbox:   config,
+		StartTime: timestamppb.New(sbx.StartedAt),
+		EndTime:   timestamppb.New(sbx.EndAt),
+	}, slot)
+	if err != nil {
+		s.abortHandover(sbx, err)
+
+		telemetry.ReportCriticalError(ctx, "error starting reset sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error starting reset sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// abortHandover reports the stop of the sandbox that wasn't replaced.
+// If the wait of the sandbox already finished, it skipped the removal, so the sandbox is removed here.
+func (s *server) abortHandover(sbx *sandbox.Sandbox, err error) {
+	if _, ok := s.handovers.LoadAndDelete(sbx); ok {
+		return
+	}
+
+	s.sandboxExited(sbx, err)
+}
+
+// resetConfig returns the config of the sandbox started from the base build instead of the snapshot it's running from.
+func resetConfig(current *orchestrator.SandboxConfig, baseBuildID string) *orchestrator.SandboxConfig {
+	config := proto.Clone(current).(*orchestrator.SandboxConfig)
+	config.TemplateId = config.BaseTemplateId
+	config.BuildId = baseBuildID
+	config.Snapshot = false
+
+	return config
+}
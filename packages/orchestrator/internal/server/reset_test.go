@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/service"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+func newResetTestServer(sandboxes ...*sandbox.Sandbox) *server {
+	s := &server{
+		sandboxes: smap.New[*sandbox.Sandbox](),
+		tracer:    noop.NewTracerProvider().Tracer(""),
+		info:      &service.ServiceInfo{},
+		events:    newEventsBroker(),
+	}
+
+	for _, sbx := range sandboxes {
+		s.sandboxes.Insert(sbx.Config.SandboxId, sbx)
+	}
+
+	return s
+}
+
+func TestServer_Reset(t *testing.T) {
+	t.Run("missing sandbox", func(t *testing.T) {
+		s := newResetTestServer()
+
+		_, err := s.Reset(context.Background(), &orchestrator.SandboxResetRequest{SandboxId: "sbx"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("sandbox with a volume is rejected", func(t *testing.T) {
+		sbx := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: &orchestrator.SandboxConfig{
+			SandboxId: "sbx",
+			Volumes:   []*orchestrator.SandboxVolume{{VolumeId: "vol", MountPath: "/data"}},
+		}}}
+		s := newResetTestServer(sbx)
+
+		_, err := s.Reset(context.Background(), &orchestrator.SandboxResetRequest{SandboxId: "sbx"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		current, ok := s.sandboxes.Get("sbx")
+		require.True(t, ok)
+		assert.Same(t, sbx, current)
+	})
+
+	t.Run("reset waits for the running operation", func(t *testing.T) {
+		s := newResetTestServer()
+
+		unlock := s.sandboxLocks.Lock("sbx")
+
+		done := make(chan error)
+		go func() {
+			_, err := s.Reset(context.Background(), &orchestrator.SandboxResetRequest{SandboxId: "sbx"})
+			done <- err
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("reset finished while another operation held the sandbox")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+
+		select {
+		case err := <-done:
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		case <-time.After(time.Second):
+			t.Fatal("reset didn't finish after the operation released the sandbox")
+		}
+	})
+}
+
+func TestServer_ResetHandover(t *testing.T) {
+	config := func() *orchestrator.SandboxConfig {
+		return &orchestrator.SandboxConfig{SandboxId: "sbx", ExecutionId: "execution"}
+	}
+
+	t.Run("stopped sandbox exits before the reset sandbox starts", func(t *testing.T) {
+		stopped := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config()}}
+		s := newResetTestServer(stopped)
+
+		events, unsubscribe := s.events.subscribe()
+		defer unsubscribe()
+
+		s.handovers.Store(stopped, struct{}{})
+
+		// The server has no proxy, so the removal of the connections would panic.
+		s.sandboxExited(stopped, nil)
+
+		current, ok := s.sandboxes.Get("sbx")
+		require.True(t, ok)
+		assert.Same(t, stopped, current, "the entry is kept for the reset sandbox")
+
+		reset := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config()}}
+		s.sandboxes.Insert("sbx", reset)
+
+		current, ok = s.sandboxes.Get("sbx")
+		require.True(t, ok)
+		assert.Same(t, reset, current)
+
+		select {
+		case event := <-events:
+			t.Fatalf("handed over sandbox published the %s event", event.GetType())
+		default:
+		}
+
+		_, handedOver := s.handovers.Load(stopped)
+		assert.False(t, handedOver)
+	})
+
+	t.Run("stopped sandbox exits after the reset sandbox starts", func(t *testing.T) {
+		stopped := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config()}}
+		reset := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config()}}
+		s := newResetTestServer(reset)
+
+		events, unsubscribe := s.events.subscribe()
+		defer unsubscribe()
+
+		s.handovers.Store(stopped, struct{}{})
+		s.sandboxExited(stopped, nil)
+
+		current, ok := s.sandboxes.Get("sbx")
+		require.True(t, ok)
+		assert.Same(t, reset, current)
+
+		select {
+		case event := <-events:
+			t.Fatalf("handed over sandbox published the %s event", event.GetType())
+		default:
+		}
+	})
+
+	t.Run("failed reset leaves the report to the wait", func(t *testing.T) {
+		stopped := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config()}}
+		s := newResetTestServer(stopped)
+
+		s.handovers.Store(stopped, struct{}{})
+		s.abortHandover(stopped, assert.AnError)
+
+		// The wait of the sandbox didn't finish yet, it removes the sandbox and reports the exit.
+		_, handedOver := s.handovers.Load(stopped)
+		assert.False(t, handedOver)
+
+		current, ok := s.sandboxes.Get("sbx")
+		require.True(t, ok)
+		assert.Same(t, stopped, current)
+	})
+}
+
+func TestResetConfig(t *testing.T) {
+	current := &orchestrator.SandboxConfig{
+		TemplateId:     "snapshot-template",
+		BuildId:        "snapshot-build",
+		BaseTemplateId: "base-template",
+		Snapshot:       true,
+		SandboxId:      "sbx",
+		ExecutionId:    "execution",
+		Network:        &orchestrator.SandboxNetworkConfig{DeniedCidrs: []string{"0.0.0.0/0"}},
+		RateLimits:     &orchestrator.SandboxRateLimits{DiskIops: 100},
+	}
+	original := proto.Clone(current)
+
+	config := resetConfig(current, "base-build")
+
+	assert.Equal(t, "base-template", config.GetTemplateId())
+	assert.Equal(t, "base-build", config.GetBuildId())
+	assert.False(t, config.GetSnapshot())
+
+	// The routing, egress rules and limits of the sandbox don't change.
+	assert.Equal(t, "sbx", config.GetSandboxId())
+	assert.Equal(t, "execution", config.GetExecutionId())
+	assert.True(t, proto.Equal(current.GetNetwork(), config.GetNetwork()))
+	assert.True(t, proto.Equal(current.GetRateLimits(), config.GetRateLimits()))
+
+	assert.True(t, proto.Equal(original, current), "config of the running sandbox changed")
+}
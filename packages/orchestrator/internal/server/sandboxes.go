@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,6 +19,8 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -45,30 +48,41 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		attribute.String("envd.version", req.Sandbox.EnvdVersion),
 	)
 
+	err := s.startSandbox(childCtx, req, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cleanup sandbox: %s", err)
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId: s.info.ClientId,
+	}, nil
+}
+
+// startSandbox resumes the sandbox from the requested template and registers it on this node.
+// If the slot is not nil, the sandbox uses it instead of getting a new one from the network pool.
+func (s *server) startSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest, slot *network.Slot) error {
 	// TODO: Temporary workaround, remove API changes deployed
 	if req.Sandbox.GetExecutionId() == "" {
 		req.Sandbox.ExecutionId = uuid.New().String()
 	}
 
-	flagCtx := ldcontext.NewBuilder(featureflags.MetricsWriteFlagName).SetString("sandbox_id", req.Sandbox.SandboxId).Build()
-	metricsWriteFlag, flagErr := s.featureFlags.Ld.BoolVariation(featureflags.MetricsWriteFlagName, flagCtx, featureflags.MetricsWriteDefault)
-	if flagErr != nil {
-		zap.L().Error("soft failing during metrics write feature flag receive", zap.Error(flagErr))
-	}
-
 	sbx, cleanup, err := sandbox.ResumeSandbox(
-		childCtx,
+		ctx,
 		s.tracer,
 		s.networkPool,
+		slot,
 		s.templateCache,
 		req.Sandbox,
-		childSpan.SpanContext().TraceID().String(),
+		trace.SpanFromContext(ctx).SpanContext().TraceID().String(),
 		req.StartTime.AsTime(),
 		req.EndTime.AsTime(),
 		req.Sandbox.BaseTemplateId,
 		s.devicePool,
+		s.persistence,
+		req.BuildPeers,
+		req.GetMigrationSource(),
 		config.AllowSandboxInternet,
-		metricsWriteFlag,
+		s.metricsWriteFlag(req.Sandbox.SandboxId),
 	)
 	if err != nil {
 		zap.L().Error("failed to create sandbox, cleaning up", zap.Error(err))
@@ -77,48 +91,88 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		err := errors.Join(err, context.Cause(ctx), cleanupErr)
 		telemetry.ReportCriticalError(ctx, "failed to cleanup sandbox", err)
 
-		return nil, status.Errorf(codes.Internal, "failed to cleanup sandbox: %s", err)
+		return err
 	}
 
 	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
-	go func() {
-		ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-create-stop")
-		defer childSpan.End()
+	s.publishStarted(sbx)
 
-		waitErr := sbx.Wait(ctx)
-		if waitErr != nil {
-			sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
-		}
+	go s.waitSandbox(sbx, cleanup)
 
-		cleanupErr := cleanup.Run(ctx)
-		if cleanupErr != nil {
-			sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
-		}
+	return nil
+}
 
-		// Remove the sandbox from cache only if the cleanup IDs match.
-		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
-		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
-		s.sandboxes.RemoveCb(req.Sandbox.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
-			if !exists {
-				return false
-			}
+func (s *server) metricsWriteFlag(sandboxID string) bool {
+	flagCtx := ldcontext.NewBuilder(featureflags.MetricsWriteFlagName).SetString("sandbox_id", sandboxID).Build()
+	metricsWriteFlag, flagErr := s.featureFlags.Ld.BoolVariation(featureflags.MetricsWriteFlagName, flagCtx, featureflags.MetricsWriteDefault)
+	if flagErr != nil {
+		zap.L().Error("soft failing during metrics write feature flag receive", zap.Error(flagErr))
+	}
 
-			if v == nil {
-				return false
-			}
+	return metricsWriteFlag
+}
 
-			return sbx.Config.ExecutionId == v.Config.ExecutionId
-		})
+// waitSandbox cleans up the sandbox after it stops and removes it from the cache.
+func (s *server) waitSandbox(sbx *sandbox.Sandbox, cleanup *sandbox.Cleanup) {
+	ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-create-stop")
+	defer childSpan.End()
 
-		// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
-		s.proxy.RemoveFromPool(sbx.Config.ExecutionId)
+	waitErr := sbx.Wait(ctx)
+	if waitErr != nil {
+		sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
+	}
 
-		sbxlogger.E(sbx).Info("Sandbox killed")
-	}()
+	cleanupErr := cleanup.Run(ctx)
+	if cleanupErr != nil {
+		sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
+	}
 
-	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.info.ClientId,
-	}, nil
+	s.sandboxExited(sbx, waitErr)
+
+	sbxlogger.E(sbx).Info("Sandbox killed")
+}
+
+// sandboxExited removes the exited sandbox from the cache and reports the exit, unless it was handed over to the sandbox replacing it.
+func (s *server) sandboxExited(sbx *sandbox.Sandbox, waitErr error) {
+	if _, handedOver := s.handovers.LoadAndDelete(sbx); handedOver {
+		sbxlogger.I(sbx).Debug("sandbox was handed over, the replacing sandbox keeps the cache entry and the connections")
+
+		return
+	}
+
+	// Remove the sandbox from cache only if the cleanup IDs match.
+	// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
+	// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
+	removed := s.sandboxes.RemoveCb(sbx.Config.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		if !exists {
+			return false
+		}
+
+		if v == nil {
+			return false
+		}
+
+		// The reset sandbox keeps the execution ID, so the instance has to be compared too.
+		return sbx.Config.ExecutionId == v.Config.ExecutionId && sbx == v
+	})
+
+	// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
+	s.proxy.RemoveFromPool(sbx.Config.ExecutionId)
+
+	// The sandbox still in the cache exited on its own, the deleted and paused sandboxes are reported by their requests.
+	if removed {
+		exit := sbx.ClassifyExit(waitErr)
+		sbxlogger.I(sbx).Warn("sandbox exited on its own",
+			zap.String("exit_reason", exit.GetReason().String()),
+			zap.String("exit_message", exit.GetMessage()),
+		)
+
+		if exit.GetReason() == orchestrator.SandboxExitReason_ExitProcessExited && waitErr == nil {
+			s.publishEvent(sbx, orchestrator.SandboxEventType_SandboxStopped, exit)
+		} else {
+			s.publishEvent(sbx, orchestrator.SandboxEventType_SandboxCrashed, exit)
+		}
+	}
 }
 
 func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
@@ -137,7 +191,57 @@ func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	item.EndAt = req.EndTime.AsTime()
+	if req.EndTime != nil {
+		item.EndAt = req.EndTime.AsTime()
+	}
+
+	if req.RateLimits != nil {
+		err := item.UpdateRateLimits(ctx, s.tracer, req.RateLimits)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error updating sandbox rate limits", err, telemetry.WithSandboxID(req.SandboxId))
+
+			return nil, status.Errorf(codes.Internal, "error updating rate limits of sandbox '%s': %s", req.SandboxId, err)
+		}
+	}
+
+	if req.DeflateMemoryBalloon {
+		err := item.DeflateBalloon(ctx, s.tracer)
+		if err != nil && !errors.Is(err, sandbox.ErrBalloonNotSupported) {
+			telemetry.ReportCriticalError(ctx, "error deflating sandbox memory balloon", err, telemetry.WithSandboxID(req.SandboxId))
+
+			return nil, status.Errorf(codes.Internal, "error deflating memory balloon of sandbox '%s': %s", req.SandboxId, err)
+		}
+	}
+
+	s.saveState(item)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) UpdateNetwork(ctx context.Context, req *orchestrator.SandboxUpdateNetworkRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-update-network")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(req.SandboxId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	item, ok := s.sandboxes.Get(req.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := item.UpdateNetwork(ctx, s.tracer, config.AllowSandboxInternet, req.Network)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error updating sandbox network", err, telemetry.WithSandboxID(req.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error updating network of sandbox '%s': %s", req.SandboxId, err)
+	}
+
+	s.saveState(item)
 
 	return &emptypb.Empty{}, nil
 }
@@ -184,6 +288,9 @@ func (s *server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteR
 		attribute.String("client.id", s.info.ClientId),
 	)
 
+	unlock := s.sandboxLocks.Lock(in.SandboxId)
+	defer unlock()
+
 	sbx, ok := s.sandboxes.Get(in.SandboxId)
 	if !ok {
 		telemetry.ReportCriticalError(ctx, "sandbox not found", nil, telemetry.WithSandboxID(in.SandboxId))
@@ -201,11 +308,32 @@ func (s *server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteR
 	// Check health metrics before stopping the sandbox
 	sbx.Checks.Healthcheck(true)
 
-	err := sbx.Stop(ctx)
+	// The volume is written back after the sandbox is stopped, so the guest has to flush its writes first.
+	err := sbx.SyncVolume(ctx, s.tracer)
+	if err != nil {
+		sbxlogger.I(sbx).Warn("error syncing volume", logger.WithSandboxID(in.SandboxId), zap.Error(err))
+	}
+
+	err = sbx.Stop(ctx)
 	if err != nil {
 		sbxlogger.I(sbx).Error("error stopping sandbox", logger.WithSandboxID(in.SandboxId), zap.Error(err))
 	}
 
+	reason := in.GetReason()
+	if reason == orchestrator.SandboxExitReason_ExitUnknown {
+		reason = orchestrator.SandboxExitReason_ExitDeleted
+	}
+
+	s.publishEvent(sbx, orchestrator.SandboxEventType_SandboxStopped, &orchestrator.SandboxExit{
+		Reason:  reason,
+		Message: "sandbox deleted",
+	})
+
+	// The API keeps the previous generation of the volume if the changes couldn't be stored.
+	if errors.Is(err, volume.ErrWriteBack) {
+		return nil, status.Errorf(codes.Internal, "error writing back volume of sandbox '%s': %s", in.SandboxId, err)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -213,12 +341,11 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 	ctx, childSpan := s.tracer.Start(ctx, "sandbox-pause")
 	defer childSpan.End()
 
-	s.pauseMu.Lock()
+	unlock := s.sandboxLocks.Lock(in.SandboxId)
+	defer unlock()
 
 	sbx, ok := s.sandboxes.Get(in.SandboxId)
 	if !ok {
-		s.pauseMu.Unlock()
-
 		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
 
 		return nil, status.Error(codes.NotFound, "sandbox not found")
@@ -226,18 +353,37 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	s.sandboxes.Remove(in.SandboxId)
 
-	s.pauseMu.Unlock()
+	snapshotTemplateFiles, snapshot, err := s.snapshotSandbox(ctx, sbx, in.TemplateId, in.BuildId, orchestrator.SandboxExitReason_ExitUnknown)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishPaused(sbx, in.BuildId)
 
+	go s.uploadSnapshot(sbx, snapshotTemplateFiles, snapshot)
+
+	return &emptypb.Empty{}, nil
+}
+
+// snapshotSandbox pauses the sandbox removed from the running sandboxes and adds its snapshot to the template cache.
+// The sandbox is stopped afterwards, the stopped event with the reason is sent if the pause fails.
+func (s *server) snapshotSandbox(
+	ctx context.Context,
+	sbx *sandbox.Sandbox,
+	templateID,
+	buildID string,
+	pauseFailureReason orchestrator.SandboxExitReason,
+) (*storage.TemplateCacheFiles, *sandbox.Snapshot, error) {
 	snapshotTemplateFiles, err := storage.NewTemplateFiles(
-		in.TemplateId,
-		in.BuildId,
+		templateID,
+		buildID,
 		sbx.Config.KernelVersion,
 		sbx.Config.FirecrackerVersion,
 	).NewTemplateCacheFiles()
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error creating template files", err)
 
-		return nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
+		return nil, nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
 	}
 
 	defer func() {
@@ -249,16 +395,21 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 			err := sbx.Stop(ctx)
 			if err != nil {
-				sbxlogger.I(sbx).Error("error stopping sandbox after snapshot", logger.WithSandboxID(in.SandboxId), zap.Error(err))
+				sbxlogger.I(sbx).Error("error stopping sandbox after snapshot", logger.WithSandboxID(sbx.Config.SandboxId), zap.Error(err))
 			}
 		}()
 	}()
 
 	snapshot, err := sbx.Pause(ctx, s.tracer, snapshotTemplateFiles)
 	if err != nil {
-		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+		s.publishEvent(sbx, orchestrator.SandboxEventType_SandboxStopped, &orchestrator.SandboxExit{
+			Reason:  pauseFailureReason,
+			Message: fmt.Sprintf("sandbox pause failed: %s", err),
+		})
+
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(sbx.Config.SandboxId))
 
-		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.SandboxId, err)
+		return nil, nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", sbx.Config.SandboxId, err)
 	}
 
 	err = s.templateCache.AddSnapshot(
@@ -271,67 +422,73 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		snapshot.Snapfile,
 		snapshot.MemfileDiff,
 		snapshot.RootfsDiff,
+		sbx.Config.TeamId,
 	)
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
 
-		return nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+		return nil, nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
 	}
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
 
-	go func() {
-		var memfilePath *string
+	return snapshotTemplateFiles, snapshot, nil
+}
 
-		switch r := snapshot.MemfileDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			memfileLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting memfile diff path", zap.Error(err))
+// uploadSnapshot uploads the snapshot files to the persistent storage, so the snapshot can be resumed on any node.
+func (s *server) uploadSnapshot(sbx *sandbox.Sandbox, snapshotTemplateFiles *storage.TemplateCacheFiles, snapshot *sandbox.Snapshot) {
+	err := s.persistSnapshot(sbx, snapshotTemplateFiles, snapshot)
+	if err != nil {
+		sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+	}
+}
 
-				return
-			}
+// persistSnapshot uploads the snapshot files and waits for the upload to finish.
+func (s *server) persistSnapshot(sbx *sandbox.Sandbox, snapshotTemplateFiles *storage.TemplateCacheFiles, snapshot *sandbox.Snapshot) error {
+	var memfilePath *string
 
-			memfilePath = &memfileLocalPath
+	switch r := snapshot.MemfileDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		memfileLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("failed to get memfile diff path: %w", err)
 		}
 
-		var rootfsPath *string
+		memfilePath = &memfileLocalPath
+	}
 
-		switch r := snapshot.RootfsDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			rootfsLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting rootfs diff path", zap.Error(err))
+	var rootfsPath *string
 
-				return
-			}
-
-			rootfsPath = &rootfsLocalPath
+	switch r := snapshot.RootfsDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		rootfsLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("failed to get rootfs diff path: %w", err)
 		}
 
-		b := storage.NewTemplateBuild(
-			snapshot.MemfileDiffHeader,
-			snapshot.RootfsDiffHeader,
-			s.persistence,
-			snapshotTemplateFiles.TemplateFiles,
-		)
-
-		err = <-b.Upload(
-			context.Background(),
-			snapshot.Snapfile.Path(),
-			memfilePath,
-			rootfsPath,
-		)
-		if err != nil {
-			sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+		rootfsPath = &rootfsLocalPath
+	}
 
-			return
-		}
-	}()
+	b := storage.NewTemplateBuild(
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		s.persistence,
+		snapshotTemplateFiles.TemplateFiles,
+	).WithTeamID(sbx.Config.TeamId)
+
+	err := <-b.Upload(
+		context.Background(),
+		snapshot.Snapfile.Path(),
+		memfilePath,
+		rootfsPath,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload snapshot: %w", err)
+	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
@@ -15,9 +15,9 @@ func (s *server) ListCachedBuilds(ctx context.Context, _ *emptypb.Empty) (*orche
 
 	var builds []*orchestrator.CachedBuildInfo
 
-	for key, item := range s.templateCache.Items() {
+	for _, item := range s.templateCache.Items() {
 		builds = append(builds, &orchestrator.CachedBuildInfo{
-			BuildId:        key,
+			BuildId:        item.Value().Files().BuildId,
 			ExpirationTime: timestamppb.New(item.ExpiresAt()),
 		})
 	}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	orchestratorinfo "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
+)
+
+// Evacuator pauses all the sandboxes running on the node and uploads their snapshots.
+type Evacuator interface {
+	Evacuate(ctx context.Context, evacuation *Evacuation)
+}
+
+// Evacuation is the progress of pausing the sandboxes of the node drained in the evacuate mode.
+// It is kept after it finishes, so the paused sandboxes can be still read from the service info.
+type Evacuation struct {
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	total    int64
+	failed   int64
+	paused   []*orchestratorinfo.EvacuatedSandbox
+	finished bool
+}
+
+func (e *Evacuation) SetTotal(total int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.total = total
+}
+
+func (e *Evacuation) AddFailed() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failed++
+}
+
+// AddPaused records the sandbox whose snapshot is uploaded.
+func (e *Evacuation) AddPaused(sandboxID, executionID, buildID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.paused = append(e.paused, &orchestratorinfo.EvacuatedSandbox{
+		SandboxId:   sandboxID,
+		ExecutionId: executionID,
+		BuildId:     buildID,
+	})
+}
+
+func (e *Evacuation) Finish() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.finished = true
+}
+
+func (e *Evacuation) Finished() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.finished
+}
+
+func (e *Evacuation) Proto() *orchestratorinfo.ServiceEvacuation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return &orchestratorinfo.ServiceEvacuation{
+		SandboxesTotal:  e.total,
+		SandboxesFailed: e.failed,
+		SandboxesPaused: append([]*orchestratorinfo.EvacuatedSandbox(nil), e.paused...),
+		Finished:        e.finished,
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -20,8 +21,9 @@ type ServiceInfo struct {
 	Startup time.Time
 	Roles   []orchestratorinfo.ServiceInfoRole
 
-	status   orchestratorinfo.ServiceInfoStatus
-	statusMu sync.RWMutex
+	status     orchestratorinfo.ServiceInfoStatus
+	evacuation *Evacuation
+	statusMu   sync.RWMutex
 }
 
 var serviceRolesMapper = map[ServiceType]orchestratorinfo.ServiceInfoRole{
@@ -46,6 +48,45 @@ func (s *ServiceInfo) SetStatus(status orchestratorinfo.ServiceInfoStatus) {
 	}
 }
 
+func (s *ServiceInfo) GetEvacuation() *Evacuation {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	return s.evacuation
+}
+
+// StartEvacuation creates the evacuation with the context canceled when it is stopped, it returns false if the evacuation is already running.
+// The sandboxes paused by the previous evacuation are kept in the progress.
+func (s *ServiceInfo) StartEvacuation() (context.Context, *Evacuation, bool) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	if s.evacuation != nil && !s.evacuation.Finished() {
+		return nil, nil, false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	evacuation := &Evacuation{cancel: cancel}
+
+	if s.evacuation != nil {
+		evacuation.paused = s.evacuation.Proto().GetSandboxesPaused()
+	}
+
+	s.evacuation = evacuation
+
+	return ctx, evacuation, true
+}
+
+// StopEvacuation stops pausing the sandboxes, the node is not drained anymore.
+func (s *ServiceInfo) StopEvacuation() {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	if s.evacuation != nil {
+		s.evacuation.cancel()
+	}
+}
+
 func NewInfoContainer(clientId string, sourceVersion string, sourceCommit string) *ServiceInfo {
 	services := GetServices()
 	serviceRoles := make([]orchestratorinfo.ServiceInfoRole, 0)
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartEvacuation(t *testing.T) {
+	info := &ServiceInfo{}
+
+	ctx, evacuation, started := info.StartEvacuation()
+	require.True(t, started)
+
+	_, _, started = info.StartEvacuation()
+	assert.False(t, started, "the running evacuation must not be replaced")
+
+	evacuation.SetTotal(2)
+	evacuation.AddPaused("sandbox", "execution", "build")
+	evacuation.AddFailed()
+
+	info.StopEvacuation()
+	require.Error(t, ctx.Err())
+
+	evacuation.Finish()
+
+	_, next, started := info.StartEvacuation()
+	require.True(t, started)
+
+	progress := next.Proto()
+	assert.False(t, progress.GetFinished())
+	assert.Zero(t, progress.GetSandboxesFailed())
+	require.Len(t, progress.GetSandboxesPaused(), 1, "the sandboxes paused by the previous evacuation are kept")
+	assert.Equal(t, "sandbox", progress.GetSandboxesPaused()[0].GetSandboxId())
+}
@@ -18,12 +18,14 @@ type Server struct {
 
 	info      *ServiceInfo
 	sandboxes *smap.Map[*sandbox.Sandbox]
+	evacuator Evacuator
 }
 
-func NewInfoService(_ context.Context, grpc *grpc.Server, info *ServiceInfo, sandboxes *smap.Map[*sandbox.Sandbox]) *Server {
+func NewInfoService(_ context.Context, grpc *grpc.Server, info *ServiceInfo, sandboxes *smap.Map[*sandbox.Sandbox], evacuator Evacuator) *Server {
 	s := &Server{
 		info:      info,
 		sandboxes: sandboxes,
+		evacuator: evacuator,
 	}
 
 	orchestratorinfo.RegisterInfoServiceServer(grpc, s)
@@ -43,6 +45,11 @@ func (s *Server) ServiceInfo(_ context.Context, _ *emptypb.Empty) (*orchestrator
 		metricDiskMb += item.Config.TotalDiskSizeMb
 	}
 
+	var evacuation *orchestratorinfo.ServiceEvacuation
+	if e := info.GetEvacuation(); e != nil {
+		evacuation = e.Proto()
+	}
+
 	return &orchestratorinfo.ServiceInfoResponse{
 		NodeId:        info.ClientId,
 		ServiceId:     info.ServiceId,
@@ -58,11 +65,35 @@ func (s *Server) ServiceInfo(_ context.Context, _ *emptypb.Empty) (*orchestrator
 		MetricMemoryUsedMb:     metricMemoryUsedMb,
 		MetricDiskMb:           metricDiskMb,
 		MetricSandboxesRunning: int64(s.sandboxes.Count()),
+
+		Evacuation: evacuation,
 	}, nil
 }
 
 func (s *Server) ServiceStatusOverride(_ context.Context, req *orchestratorinfo.ServiceStatusChangeRequest) (*emptypb.Empty, error) {
-	zap.L().Info("service status override request received", zap.String("status", req.ServiceStatus.String()))
+	zap.L().Info("service status override request received", zap.String("status", req.ServiceStatus.String()), zap.String("drain_mode", req.DrainMode.String()))
 	s.info.SetStatus(req.ServiceStatus)
+
+	if req.ServiceStatus != orchestratorinfo.ServiceInfoStatus_OrchestratorDraining {
+		s.info.StopEvacuation()
+
+		return &emptypb.Empty{}, nil
+	}
+
+	if req.DrainMode == orchestratorinfo.ServiceDrainMode_DrainEvacuate {
+		ctx, evacuation, started := s.info.StartEvacuation()
+		if !started {
+			zap.L().Info("sandbox evacuation is already running")
+
+			return &emptypb.Empty{}, nil
+		}
+
+		go func() {
+			defer evacuation.Finish()
+
+			s.evacuator.Evacuate(ctx, evacuation)
+		}()
+	}
+
 	return &emptypb.Empty{}, nil
 }
@@ -3,6 +3,7 @@ package build
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -52,6 +53,33 @@ func (b *TemplateBuilder) runCommandWithConfirmation(
 	cwd *string,
 	envVars map[string]string,
 	confirmCh chan<- struct{},
+) error {
+	return b.runCommandWithOutput(
+		ctx,
+		postProcessor,
+		id,
+		sandboxID,
+		command,
+		runAsUser,
+		cwd,
+		envVars,
+		confirmCh,
+		nil,
+	)
+}
+
+// runCommandWithOutput writes the stdout of the command to the stdout writer instead of the build logs if the writer is not nil.
+func (b *TemplateBuilder) runCommandWithOutput(
+	ctx context.Context,
+	postProcessor *writer.PostProcessor,
+	id string,
+	sandboxID string,
+	command string,
+	runAsUser string,
+	cwd *string,
+	envVars map[string]string,
+	confirmCh chan<- struct{},
+	stdout io.Writer,
 ) error {
 	runCmdReq := connect.NewRequest(&process.StartRequest{
 		Process: &process.ProcessConfig{
@@ -109,7 +137,14 @@ func (b *TemplateBuilder) runCommandWithConfirmation(
 			switch {
 			case e.GetData() != nil:
 				data := e.GetData()
-				b.logStream(postProcessor, id, "stdout", string(data.GetStdout()))
+				if stdout != nil {
+					_, err := stdout.Write(data.GetStdout())
+					if err != nil {
+						return fmt.Errorf("error writing command output: %w", err)
+					}
+				} else {
+					b.logStream(postProcessor, id, "stdout", string(data.GetStdout()))
+				}
 				b.logStream(postProcessor, id, "stderr", string(data.GetStderr()))
 
 			case e.GetEnd() != nil:
@@ -56,6 +56,34 @@ func Make(ctx context.Context, tracer trace.Tracer, rootfsPath string, sizeMb in
 	return cmd.Run()
 }
 
+// MakeVolume formats the whole device, unlike the rootfs the volume keeps the journal as it is written by the running sandboxes.
+func MakeVolume(ctx context.Context, tracer trace.Tracer, devicePath string) error {
+	ctx, makeSpan := tracer.Start(ctx, "make-ext4-volume")
+	defer makeSpan.End()
+
+	cmd := exec.CommandContext(ctx,
+		"mkfs.ext4",
+		"-q",
+		"-F",
+		// The inode tables and the journal are initialized lazily by the guest, so only the written blocks are stored
+		"-E", "lazy_itable_init=1,lazy_journal_init=1,nodiscard",
+		"-m", strconv.FormatInt(reservedBlocksPercentage, 10),
+		devicePath,
+	)
+
+	makeStdoutWriter := telemetry.NewEventWriter(ctx, "stdout")
+	cmd.Stdout = makeStdoutWriter
+
+	makeStderrWriter := telemetry.NewEventWriter(ctx, "stderr")
+	cmd.Stderr = makeStderrWriter
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error making ext4 volume: %w", err)
+	}
+
+	return nil
+}
+
 func Mount(ctx context.Context, tracer trace.Tracer, rootfsPath string, mountPoint string) error {
 	ctx, mountSpan := tracer.Start(ctx, "mount-ext4")
 	defer mountSpan.End()
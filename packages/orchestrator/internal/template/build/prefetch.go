@@ -0,0 +1,119 @@
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+//go:embed prefetch.sh
+var prefetchScriptFile string
+
+var (
+	filefragBlockSizeRegex = regexp.MustCompile(`blocks of (\d+) bytes`)
+	// The extent line is "ext: logical_start.. logical_end: physical_start.. physical_end: length: expected: flags".
+	filefragExtentRegex = regexp.MustCompile(`^\s*\d+:\s*\d+\.\.\s*\d+:\s*(\d+)\.\.\s*(\d+):\s*\d+:`)
+)
+
+// recordRootfsPrefetch lists the rootfs blocks of the executables and the shared libraries used by the processes running after the ready command.
+// The blocks are fetched in the background when the sandbox is resumed from the template, so the first reads of the files don't wait for the storage.
+func (b *TemplateBuilder) recordRootfsPrefetch(
+	ctx context.Context,
+	postProcessor *writer.PostProcessor,
+	sandboxID string,
+	rootfsSize int64,
+	blockSize int64,
+) (*header.PrefetchProfile, error) {
+	ctx, span := b.tracer.Start(ctx, "record-rootfs-prefetch")
+	defer span.End()
+
+	var out bytes.Buffer
+	err := b.runCommandWithOutput(
+		ctx,
+		postProcessor,
+		"prefetch",
+		sandboxID,
+		prefetchScriptFile,
+		"root",
+		nil,
+		map[string]string{},
+		make(chan struct{}),
+		&out,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the used files: %w", err)
+	}
+
+	blocks, err := parseFilefragExtents(&out, rootfsSize, blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.NewPrefetchProfile(uint64(blockSize), uint64(rootfsSize), blocks), nil
+}
+
+// parseFilefragExtents returns the rootfs blocks covered by the physical extents in the filefrag output.
+// The extents without a physical location, e.g. with delayed allocation, are skipped.
+func parseFilefragExtents(out *bytes.Buffer, rootfsSize, blockSize int64) (*bitset.BitSet, error) {
+	blocks := bitset.New(uint(header.TotalBlocks(rootfsSize, blockSize)))
+
+	var fsBlockSize int64
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := filefragBlockSizeRegex.FindStringSubmatch(line); m != nil {
+			size, err := strconv.ParseInt(m[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing filesystem block size: %w", err)
+			}
+
+			fsBlockSize = size
+
+			continue
+		}
+
+		m := filefragExtentRegex.FindStringSubmatch(line)
+		if m == nil || fsBlockSize == 0 || strings.Contains(line, "unknown") || strings.Contains(line, "delalloc") {
+			continue
+		}
+
+		start, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing extent start: %w", err)
+		}
+
+		end, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing extent end: %w", err)
+		}
+
+		if start == 0 || end < start {
+			continue
+		}
+
+		startOff := start * fsBlockSize
+		endOff := min((end+1)*fsBlockSize, rootfsSize)
+
+		for off := startOff; off < endOff; off += blockSize {
+			blocks.Set(uint(header.BlockIdx(off, blockSize)))
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("error reading filefrag output: %w", err)
+	}
+
+	return blocks, nil
+}
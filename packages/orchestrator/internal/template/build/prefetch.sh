@@ -0,0 +1,16 @@
+#!/bin/bash
+# Prints the physical extents of the executables and the shared libraries mapped by the running processes.
+# Only the files on the root filesystem are listed, the extents of other files don't map to the template rootfs.
+
+root_dev=$(stat -c %d /)
+
+for pid in /proc/[0-9]*; do
+    readlink -f "$pid/exe" 2>/dev/null
+    awk '$6 ~ /^\// { print $6 }' "$pid/maps" 2>/dev/null
+done | sort -u | while read -r file; do
+    if [ -f "$file" ] && [ "$(stat -c %d "$file" 2>/dev/null)" = "$root_dev" ]; then
+        filefrag -e "$file" 2>/dev/null
+    fi
+done
+
+exit 0
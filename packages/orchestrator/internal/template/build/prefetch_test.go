@@ -0,0 +1,30 @@
+package build
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const filefragOutput = `Filesystem type is: ef53
+File size of /usr/bin/bash is 1183448 (289 blocks of 4096 bytes)
+ ext:     logical_offset:        physical_offset: length:   expected: flags:
+   0:        0..       1:         10..        11:      2:
+   1:        2..       2:         20..        20:      1:         12: last,eof
+/usr/bin/bash: 2 extents found
+File size of /tmp/new is 4096 (1 block of 4096 bytes)
+ ext:     logical_offset:        physical_offset: length:   expected: flags:
+   0:        0..       0:          0..         0:      1:             last,unknown_loc,delalloc,eof
+/tmp/new: 1 extent found
+`
+
+func TestParseFilefragExtents(t *testing.T) {
+	blocks, err := parseFilefragExtents(bytes.NewBufferString(filefragOutput), 64*4096, 4096)
+	require.NoError(t, err)
+
+	require.Equal(t, uint(3), blocks.Count())
+	require.True(t, blocks.Test(10))
+	require.True(t, blocks.Test(11))
+	require.True(t, blocks.Test(20))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -321,6 +322,23 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 		return nil, fmt.Errorf("error running start command: %w", err)
 	}
 
+	// The prefetch profile only speeds up the resume, the template works without it.
+	rootfsSize, err := rootfs.Size()
+	if err != nil {
+		return nil, fmt.Errorf("error getting rootfs size: %w", err)
+	}
+
+	rootfsPrefetch, err := b.recordRootfsPrefetch(
+		ctx,
+		postProcessor,
+		sbx.Metadata.Config.SandboxId,
+		rootfsSize,
+		template.RootfsBlockSize(),
+	)
+	if err != nil {
+		b.logger.Warn("Error recording rootfs prefetch profile", zap.Error(err))
+	}
+
 	// Pause sandbox
 	postProcessor.WriteMsg("Pausing sandbox template")
 	snapshot, err := sbx.Pause(
@@ -337,7 +355,9 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	uploadErrCh := b.uploadTemplate(
 		ctx,
 		template.TemplateFiles,
+		template.TeamID,
 		snapshot,
+		rootfsPrefetch,
 	)
 
 	uploadErr := <-uploadErrCh
@@ -354,7 +374,9 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 func (b *TemplateBuilder) uploadTemplate(
 	ctx context.Context,
 	templateFiles *storage.TemplateFiles,
+	teamID string,
 	snapshot *sandbox.Snapshot,
+	rootfsPrefetch *header.PrefetchProfile,
 ) chan error {
 	errCh := make(chan error, 1)
 
@@ -385,7 +407,7 @@ func (b *TemplateBuilder) uploadTemplate(
 			snapshot.RootfsDiffHeader,
 			b.storage,
 			templateFiles,
-		)
+		).WithTeamID(teamID)
 
 		memfileDiffPath, err := snapshot.MemfileDiff.CachePath()
 		if err != nil {
@@ -415,6 +437,13 @@ func (b *TemplateBuilder) uploadTemplate(
 			return
 		}
 
+		if rootfsPrefetch != nil {
+			prefetchErr := templateBuild.UploadRootfsPrefetch(ctx, rootfsPrefetch)
+			if prefetchErr != nil {
+				b.logger.Warn("Error uploading rootfs prefetch profile", zap.Error(prefetchErr), logger.WithBuildID(templateFiles.BuildId))
+			}
+		}
+
 		errCh <- nil
 	}()
 
@@ -39,6 +39,9 @@ type TemplateConfig struct {
 
 	// Command to run to check if the template is ready.
 	ReadyCmd string
+
+	// TeamID is the team owning the template, its data key encrypts the template data.
+	TeamID string
 }
 
 // Real size in MB of rootfs after building the template
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *ServerStore) TemplateBuildCompact(ctx context.Context, in *templatemanager.TemplateBuildCompactRequest) (*emptypb.Empty, error) {
+	childCtx, childSpan := s.tracer.Start(ctx, "template-compact-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.TemplateID),
+		telemetry.WithBuildID(in.BuildID),
+		attribute.String("source.build.id", in.SourceBuildID),
+		attribute.Int("fold.builds", len(in.FoldBuildIDs)),
+	))
+	defer childSpan.End()
+
+	s.wg.Add(1)
+	defer s.wg.Done()
+
+	if in.TemplateID == "" || in.BuildID == "" || in.SourceBuildID == "" {
+		return nil, errors.New("template id, build id and source build id are required fields")
+	}
+
+	err := s.templateStorage.Compact(childCtx, s.templateCache, in.TemplateID, in.SourceBuildID, in.BuildID, in.FoldBuildIDs, in.TeamID)
+	if err != nil {
+		telemetry.ReportCriticalError(childCtx, "error compacting template build", err)
+
+		return nil, fmt.Errorf("error compacting build '%s' into '%s': %w", in.SourceBuildID, in.BuildID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "compacted template build")
+
+	return &emptypb.Empty{}, nil
+}
@@ -60,6 +60,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		DiskSizeMB:      int64(config.DiskSizeMB),
 		BuildLogsWriter: logsWriter,
 		HugePages:       config.HugePages,
+		TeamID:          config.TeamID,
 	}
 
 	buildInfo, err := s.buildCache.Create(config.BuildID)
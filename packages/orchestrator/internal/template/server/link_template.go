@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *ServerStore) TemplateBuildLink(ctx context.Context, in *templatemanager.TemplateBuildLinkRequest) (*emptypb.Empty, error) {
+	childCtx, childSpan := s.tracer.Start(ctx, "template-link-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.TemplateID),
+		telemetry.WithBuildID(in.BuildID),
+		attribute.String("source.build.id", in.SourceBuildID),
+	))
+	defer childSpan.End()
+
+	s.wg.Add(1)
+	defer s.wg.Done()
+
+	if in.TemplateID == "" || in.BuildID == "" || in.SourceBuildID == "" {
+		return nil, errors.New("template id, build id and source build id are required fields")
+	}
+
+	err := s.templateStorage.Link(childCtx, in.SourceBuildID, in.BuildID)
+	if err != nil {
+		telemetry.ReportCriticalError(childCtx, "error linking template build", err)
+
+		return nil, fmt.Errorf("error linking build '%s' to '%s': %w", in.BuildID, in.SourceBuildID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "linked template build")
+
+	return &emptypb.Empty{}, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	sbxtemplate "github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/cache"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/template"
@@ -34,6 +35,7 @@ type ServerStore struct {
 	buildCache        *cache.BuildCache
 	buildLogger       *zap.Logger
 	templateStorage   *template.Storage
+	templateCache     *sbxtemplate.Cache
 	artifactsregistry artifactsregistry.ArtifactsRegistry
 	healthStatus      templatemanager.HealthState
 	wg                *sync.WaitGroup // wait group for running builds
@@ -50,6 +52,7 @@ func New(
 	devicePool *nbd.DevicePool,
 	proxy *proxy.SandboxProxy,
 	sandboxes *smap.Map[*sandbox.Sandbox],
+	templateCache *sbxtemplate.Cache,
 ) (*ServerStore, error) {
 	logger.Info("Initializing template manager")
 
@@ -86,6 +89,7 @@ func New(
 		buildLogger:       buildLogger,
 		artifactsregistry: artifactsregistry,
 		templateStorage:   templateStorage,
+		templateCache:     templateCache,
 		healthStatus:      templatemanager.HealthState_Healthy,
 		wg:                &sync.WaitGroup{},
 	}
@@ -0,0 +1,241 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	sbxtemplate "github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// Compact creates the build with the data of the fold builds from the source build chain copied into its own diffs.
+// The mappings of the other builds are kept, so the data shared with the base template are not copied again.
+// If no fold builds are passed, all the data are copied and the new build doesn't depend on any other build.
+func (t *Storage) Compact(
+	ctx context.Context,
+	templateCache *sbxtemplate.Cache,
+	templateId string,
+	sourceBuildId string,
+	buildId string,
+	foldBuildIds []string,
+	teamId string,
+) error {
+	id, err := uuid.Parse(buildId)
+	if err != nil {
+		return fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	var fold map[uuid.UUID]struct{}
+	if len(foldBuildIds) > 0 {
+		fold = make(map[uuid.UUID]struct{}, len(foldBuildIds))
+
+		for _, foldBuildId := range foldBuildIds {
+			foldId, err := uuid.Parse(foldBuildId)
+			if err != nil {
+				return fmt.Errorf("failed to parse fold build id: %w", err)
+			}
+
+			fold[foldId] = struct{}{}
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "compact-"+buildId)
+	if err != nil {
+		return fmt.Errorf("error when creating compaction directory: %w", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	memfilePath := filepath.Join(dir, storage.MemfileName)
+
+	memfileHeader, err := compactFile(ctx, templateCache, sourceBuildId, id, build.Memfile, fold, memfilePath)
+	if err != nil {
+		return fmt.Errorf("error when compacting memfile: %w", err)
+	}
+
+	rootfsPath := filepath.Join(dir, storage.RootfsName)
+
+	rootfsHeader, err := compactFile(ctx, templateCache, sourceBuildId, id, build.Rootfs, fold, rootfsPath)
+	if err != nil {
+		return fmt.Errorf("error when compacting rootfs: %w", err)
+	}
+
+	snapfilePath := filepath.Join(dir, storage.SnapfileName)
+
+	err = t.downloadSnapfile(ctx, sourceBuildId, snapfilePath)
+	if err != nil {
+		return fmt.Errorf("error when downloading source snapfile: %w", err)
+	}
+
+	files := storage.NewTemplateFiles(templateId, buildId, "", "")
+
+	err = <-storage.NewTemplateBuild(memfileHeader, rootfsHeader, t.persistence, files).
+		WithTeamID(teamId).
+		Upload(ctx, snapfilePath, &memfilePath, &rootfsPath)
+	if err != nil {
+		return fmt.Errorf("error when uploading compacted build: %w", err)
+	}
+
+	// The prefetch profiles reference the device offsets, so they stay valid for the compacted build.
+	for _, name := range []string{storage.MemfileName, storage.RootfsName} {
+		err = t.copyObject(ctx, sourceBuildId+"/"+name+storage.PrefetchSuffix, buildId+"/"+name+storage.PrefetchSuffix)
+		if err != nil && !errors.Is(err, storage.ErrorObjectNotExist) {
+			return fmt.Errorf("error when copying %s prefetch profile: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// compactFile writes the data of the fold builds to the diff and returns the header of the compacted build.
+// The fold builds are all the builds if the fold set is nil.
+func compactFile(
+	ctx context.Context,
+	templateCache *sbxtemplate.Cache,
+	sourceBuildId string,
+	id uuid.UUID,
+	fileType build.DiffType,
+	fold map[uuid.UUID]struct{},
+	diffPath string,
+) (*header.Header, error) {
+	source, err := templateCache.GetBuild(ctx, sourceBuildId, fileType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source build: %w", err)
+	}
+
+	defer source.Close()
+
+	diff, err := os.Create(diffPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer diff.Close()
+
+	return compactDiff(source, source.Header(), id, fold, diff)
+}
+
+// compactDiff writes the data of the fold builds mapped by the header to the diff and returns the header of the compacted build.
+func compactDiff(
+	source io.ReaderAt,
+	h *header.Header,
+	id uuid.UUID,
+	fold map[uuid.UUID]struct{},
+	diff io.Writer,
+) (*header.Header, error) {
+	w := header.NewChecksumWriter(diff)
+	buf := make([]byte, header.ChecksumChunkSize)
+
+	mappings := make([]*header.BuildMap, 0, len(h.Mapping))
+
+	var storageOffset uint64
+
+	for _, mapping := range h.Mapping {
+		_, folded := fold[mapping.BuildId]
+
+		// The empty mappings have no data to copy.
+		if mapping.BuildId == uuid.Nil || (fold != nil && !folded) {
+			kept := *mapping
+			mappings = append(mappings, &kept)
+
+			continue
+		}
+
+		_, err := io.CopyBuffer(w, io.NewSectionReader(source, int64(mapping.Offset), int64(mapping.Length)), buf)
+		if err != nil {
+			return nil, fmt.Errorf("error when copying data of build %s: %w", mapping.BuildId, err)
+		}
+
+		mappings = append(mappings, &header.BuildMap{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            id,
+			BuildStorageOffset: storageOffset,
+		})
+
+		storageOffset += mapping.Length
+	}
+
+	compacted := header.NewHeader(h.Metadata.NextGeneration(id), header.NormalizeMappings(mappings))
+	compacted.SetChecksums(w.Checksums())
+
+	return compacted, nil
+}
+
+func (t *Storage) download(ctx context.Context, objectPath string, path string) error {
+	object, err := t.persistence.OpenObject(ctx, objectPath)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = object.WriteTo(f)
+
+	return err
+}
+
+// downloadSnapfile downloads the snapfile of the build decrypted, it's encrypted again for the compacted build on upload.
+func (t *Storage) downloadSnapfile(ctx context.Context, buildId string, path string) error {
+	object, err := t.persistence.OpenObject(ctx, buildId+"/"+storage.SnapfileName)
+	if err != nil {
+		return err
+	}
+
+	var snapfile bytes.Buffer
+
+	_, err = object.WriteTo(&snapfile)
+	if err != nil {
+		return err
+	}
+
+	keys, err := storage.GetDataKeys()
+	if err != nil {
+		return err
+	}
+
+	data, err := storage.UnsealObject(ctx, keys, t.persistence, storage.SnapfileScope(buildId), snapfile.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// copyObject copies the small object through the memory.
+func (t *Storage) copyObject(ctx context.Context, sourcePath string, path string) error {
+	sourceObject, err := t.persistence.OpenObject(ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	var data bytes.Buffer
+
+	_, err = sourceObject.WriteTo(&data)
+	if err != nil {
+		return err
+	}
+
+	object, err := t.persistence.OpenObject(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	_, err = object.ReadFrom(&data)
+
+	return err
+}
@@ -0,0 +1,100 @@
+package template
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = 4096
+
+// testChain returns the device data and the header of the snapshot with the blocks mapped from the base build and two pauses.
+func testChain(base, first, second uuid.UUID) ([]byte, *header.Header) {
+	device := make([]byte, 5*testBlockSize)
+	for i := range 5 {
+		copy(device[i*testBlockSize:], bytes.Repeat([]byte{byte('a' + i)}, testBlockSize))
+	}
+
+	metadata := header.NewTemplateMetadata(base, testBlockSize, uint64(len(device)))
+	metadata.Generation = 2
+
+	h := header.NewHeader(metadata, []*header.BuildMap{
+		{Offset: 0, Length: testBlockSize, BuildId: base, BuildStorageOffset: 0},
+		{Offset: testBlockSize, Length: testBlockSize, BuildId: first, BuildStorageOffset: 0},
+		{Offset: 2 * testBlockSize, Length: testBlockSize, BuildId: second, BuildStorageOffset: 0},
+		{Offset: 3 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil, BuildStorageOffset: 0},
+		{Offset: 4 * testBlockSize, Length: testBlockSize, BuildId: first, BuildStorageOffset: testBlockSize},
+	})
+
+	return device, h
+}
+
+func TestCompactDiff(t *testing.T) {
+	base, first, second := uuid.New(), uuid.New(), uuid.New()
+	id := uuid.New()
+
+	t.Run("fold builds are copied and the base build stays referenced", func(t *testing.T) {
+		device, h := testChain(base, first, second)
+
+		var diff bytes.Buffer
+
+		compacted, err := compactDiff(bytes.NewReader(device), h, id, map[uuid.UUID]struct{}{first: {}, second: {}}, &diff)
+		require.NoError(t, err)
+
+		// The adjacent copied blocks are merged into one mapping.
+		assert.Equal(t, []*header.BuildMap{
+			{Offset: 0, Length: testBlockSize, BuildId: base, BuildStorageOffset: 0},
+			{Offset: testBlockSize, Length: 2 * testBlockSize, BuildId: id, BuildStorageOffset: 0},
+			{Offset: 3 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil, BuildStorageOffset: 0},
+			{Offset: 4 * testBlockSize, Length: testBlockSize, BuildId: id, BuildStorageOffset: 2 * testBlockSize},
+		}, compacted.Mapping)
+
+		expected := append(bytes.Clone(device[testBlockSize:3*testBlockSize]), device[4*testBlockSize:]...)
+		assert.Equal(t, expected, diff.Bytes())
+		assert.Equal(t, []uint32{header.Checksum(expected)}, compacted.Checksums)
+
+		assert.Equal(t, id, compacted.Metadata.BuildId)
+		assert.Equal(t, base, compacted.Metadata.BaseBuildId)
+		assert.Equal(t, h.Metadata.Generation+1, compacted.Metadata.Generation)
+	})
+
+	t.Run("all builds are copied without fold builds", func(t *testing.T) {
+		device, h := testChain(base, first, second)
+
+		var diff bytes.Buffer
+
+		compacted, err := compactDiff(bytes.NewReader(device), h, id, nil, &diff)
+		require.NoError(t, err)
+
+		for _, mapping := range compacted.Mapping {
+			if mapping.BuildId == uuid.Nil {
+				continue
+			}
+
+			assert.Equal(t, id, mapping.BuildId)
+			assert.Equal(t,
+				device[mapping.Offset:mapping.Offset+mapping.Length],
+				diff.Bytes()[mapping.BuildStorageOffset:mapping.BuildStorageOffset+mapping.Length],
+			)
+		}
+
+		expected := append(bytes.Clone(device[:3*testBlockSize]), device[4*testBlockSize:]...)
+		assert.Equal(t, expected, diff.Bytes())
+	})
+
+	t.Run("source header is not changed", func(t *testing.T) {
+		device, h := testChain(base, first, second)
+
+		_, err := compactDiff(bytes.NewReader(device), h, id, map[uuid.UUID]struct{}{first: {}}, &bytes.Buffer{})
+		require.NoError(t, err)
+
+		assert.Equal(t, first, h.Mapping[1].BuildId)
+		assert.Equal(t, base, h.Mapping[0].BuildId)
+		assert.Equal(t, testBlockSize, int(h.Mapping[4].BuildStorageOffset))
+	})
+}
@@ -1,10 +1,14 @@
 package template
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type Storage struct {
@@ -29,3 +33,78 @@ func (t *Storage) Remove(ctx context.Context, buildId string) error {
 func (t *Storage) NewBuild(files *storage.TemplateFiles, persistence storage.StorageProvider) *storage.TemplateBuild {
 	return storage.NewTemplateBuild(nil, nil, persistence, files)
 }
+
+// Link creates the build that shares the data of the source build.
+// Only the headers and the snapfile are copied, the headers still point to the diffs of the source build chain.
+func (t *Storage) Link(ctx context.Context, sourceBuildId string, buildId string) error {
+	id, err := uuid.Parse(buildId)
+	if err != nil {
+		return fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	for _, headerName := range []string{storage.MemfileName, storage.RootfsName} {
+		sourceObject, err := t.persistence.OpenObject(ctx, sourceBuildId+"/"+headerName+storage.HeaderSuffix)
+		if err != nil {
+			return fmt.Errorf("error when opening source %s header: %w", headerName, err)
+		}
+
+		h, err := header.Deserialize(sourceObject)
+		if err != nil {
+			return fmt.Errorf("error when deserializing source %s header: %w", headerName, err)
+		}
+
+		// The mappings are kept, so no diff is copied
+		metadata := *h.Metadata
+		metadata.BuildId = id
+
+		serialized, err := header.Serialize(&metadata, h.Mapping)
+		if err != nil {
+			return fmt.Errorf("error when serializing %s header: %w", headerName, err)
+		}
+
+		object, err := t.persistence.OpenObject(ctx, buildId+"/"+headerName+storage.HeaderSuffix)
+		if err != nil {
+			return fmt.Errorf("error when opening %s header: %w", headerName, err)
+		}
+
+		_, err = object.ReadFrom(serialized)
+		if err != nil {
+			return fmt.Errorf("error when uploading %s header: %w", headerName, err)
+		}
+	}
+
+	sourceSnapfile, err := t.persistence.OpenObject(ctx, sourceBuildId+"/"+storage.SnapfileName)
+	if err != nil {
+		return fmt.Errorf("error when opening source snapfile: %w", err)
+	}
+
+	// Snap-file is small enough so we can copy it through the memory.
+	var snapfile bytes.Buffer
+	_, err = sourceSnapfile.WriteTo(&snapfile)
+	if err != nil {
+		return fmt.Errorf("error when downloading source snapfile: %w", err)
+	}
+
+	keys, err := storage.GetDataKeys()
+	if err != nil {
+		return err
+	}
+
+	// The encrypted snapfile is bound to its build, so it's encrypted again for the linked build.
+	data, err := storage.ResealObject(ctx, keys, t.persistence, storage.SnapfileScope(sourceBuildId), storage.SnapfileScope(buildId), snapfile.Bytes())
+	if err != nil {
+		return fmt.Errorf("error when encrypting snapfile: %w", err)
+	}
+
+	object, err := t.persistence.OpenObject(ctx, buildId+"/"+storage.SnapfileName)
+	if err != nil {
+		return fmt.Errorf("error when opening snapfile: %w", err)
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error when uploading snapfile: %w", err)
+	}
+
+	return nil
+}
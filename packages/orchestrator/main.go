@@ -24,10 +24,12 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/server"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/service"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
 	tmplserver "github.com/e2b-dev/infra/packages/orchestrator/internal/template/server"
+	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -54,6 +56,8 @@ const (
 var (
 	forceStop = env.GetEnv("FORCE_STOP", "false") == "true"
 	commitSHA string
+
+	writeMetricsToClickHouse = env.GetEnv("WRITE_METRICS_TO_CLICKHOUSE", "false") == "true"
 )
 
 func main() {
@@ -240,12 +244,37 @@ func run(port, proxyPort uint) (success bool) {
 		zap.L().Fatal("failed to create feature flags client", zap.Error(err))
 	}
 
-	sandboxObserver, err := metrics.NewSandboxObserver(ctx, serviceInfo.SourceCommit, serviceInfo.ClientId, sandboxMetricExportPeriod, sandboxes)
+	var clickhouseStore chdb.Store = nil
+	if writeMetricsToClickHouse {
+		clickhouseStore, err = chdb.NewStore(chdb.ClickHouseConfig{
+			ConnectionString: os.Getenv("CLICKHOUSE_CONNECTION_STRING"),
+			Username:         os.Getenv("CLICKHOUSE_USERNAME"),
+			Password:         os.Getenv("CLICKHOUSE_PASSWORD"),
+			Database:         os.Getenv("CLICKHOUSE_DATABASE"),
+			Debug:            os.Getenv("CLICKHOUSE_DEBUG") == "true",
+		})
+		if err != nil {
+			zap.L().Fatal("failed to create ClickHouse store", zap.Error(err))
+		}
+		defer func() {
+			err := clickhouseStore.Close()
+			if err != nil {
+				log.Printf("error while closing ClickHouse store: %v", err)
+			}
+		}()
+	}
+
+	sandboxObserver, err := metrics.NewSandboxObserver(ctx, serviceInfo.SourceCommit, serviceInfo.ClientId, sandboxMetricExportPeriod, sandboxes, clickhouseStore)
 	if err != nil {
 		zap.L().Fatal("failed to create sandbox observer", zap.Error(err))
 	}
 
-	_, err = server.New(ctx, grpcSrv, tel, networkPool, devicePool, tracer, serviceInfo, sandboxProxy, sandboxes, featureFlags)
+	templateCache, err := template.NewCache(ctx)
+	if err != nil {
+		zap.L().Fatal("failed to create template cache", zap.Error(err))
+	}
+
+	orchestratorService, err := server.New(ctx, grpcSrv, tel, networkPool, devicePool, tracer, serviceInfo, sandboxProxy, sandboxes, templateCache, featureFlags)
 	if err != nil {
 		zap.L().Fatal("failed to create server", zap.Error(err))
 	}
@@ -290,6 +319,7 @@ func run(port, proxyPort uint) (success bool) {
 			devicePool,
 			sandboxProxy,
 			sandboxes,
+			templateCache,
 		)
 		if err != nil {
 			zap.L().Fatal("failed to create template manager", zap.Error(err))
@@ -299,7 +329,7 @@ func run(port, proxyPort uint) (success bool) {
 		closers = append([]Closeable{tmpl}, closers...)
 	}
 
-	service.NewInfoService(ctx, grpcSrv.GRPCServer(), serviceInfo, sandboxes)
+	service.NewInfoService(ctx, grpcSrv.GRPCServer(), serviceInfo, sandboxes, orchestratorService)
 
 	g.Go(func() error {
 		zap.L().Info("Starting session proxy")
@@ -21,6 +21,22 @@ func (c *ClickHouseStore) InsertMetrics(ctx context.Context, metrics chmodels.Me
 	return batch.Send()
 }
 
+func (c *ClickHouseStore) InsertMetricsBatch(ctx context.Context, metrics []chmodels.Metrics) error {
+	batch, err := c.Conn.PrepareBatch(ctx, "INSERT INTO metrics")
+	if err != nil {
+		return err
+	}
+	for _, m := range metrics {
+		err = batch.AppendStruct(&m)
+		if err != nil {
+			batch.Abort()
+			return fmt.Errorf("failed to append metrics struct to clickhouse batcher: %w", err)
+		}
+	}
+
+	return batch.Send()
+}
+
 func (c *ClickHouseStore) QueryMetrics(ctx context.Context, sandboxID, teamID string, start int64, limit int) ([]chmodels.Metrics, error) {
 	query := "SELECT * FROM metrics WHERE sandbox_id = (?) AND team_id = (?) AND timestamp >= (?) LIMIT (?)"
 
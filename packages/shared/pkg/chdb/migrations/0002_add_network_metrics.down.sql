@@ -0,0 +1,5 @@
+ALTER TABLE metrics
+	DROP COLUMN IF EXISTS net_egress_bytes,
+	DROP COLUMN IF EXISTS net_egress_packets,
+	DROP COLUMN IF EXISTS net_ingress_bytes,
+	DROP COLUMN IF EXISTS net_ingress_packets;
@@ -0,0 +1,5 @@
+ALTER TABLE metrics
+	ADD COLUMN IF NOT EXISTS net_egress_bytes UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_egress_packets UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_ingress_bytes UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_ingress_packets UInt64 DEFAULT 0;
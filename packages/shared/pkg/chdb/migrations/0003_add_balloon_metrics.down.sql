@@ -0,0 +1,3 @@
+ALTER TABLE metrics
+	DROP COLUMN IF EXISTS mem_balloon_mib,
+	DROP COLUMN IF EXISTS mem_free_mib;
@@ -0,0 +1,3 @@
+ALTER TABLE metrics
+	ADD COLUMN IF NOT EXISTS mem_balloon_mib UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS mem_free_mib UInt64 DEFAULT 0;
@@ -30,6 +30,10 @@ func (m *MockStore) InsertMetrics(ctx context.Context, metrics chmodels.Metrics)
 	return nil
 }
 
+func (m *MockStore) InsertMetricsBatch(ctx context.Context, metrics []chmodels.Metrics) error {
+	return nil
+}
+
 func (m *MockStore) QueryMetrics(ctx context.Context, sandboxID, teamID string, start int64, limit int) ([]chmodels.Metrics, error) {
 	return nil, nil
 }
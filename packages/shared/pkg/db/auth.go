@@ -44,3 +44,21 @@ func (db *DB) GetTeamByIDAndUserIDAuth(ctx context.Context, teamID string, userI
 
 	return result, result.Edges.TeamTier, nil
 }
+
+// GetTeamByID returns the team with its tier, the team usage is not validated so the running sandboxes of the team can still be managed.
+func (db *DB) GetTeamByID(ctx context.Context, teamID uuid.UUID) (*models.Team, *models.Tier, error) {
+	result, err := db.
+		Client.
+		Team.
+		Query().
+		Where(team.ID(teamID)).
+		WithTeamTier().
+		Only(ctx)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to get team '%s': %w", teamID, err)
+
+		return nil, nil, errMsg
+	}
+
+	return result, result.Edges.TeamTier, nil
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// NewCheckpointBuild creates a new named checkpoint of the sandbox with its own env and build.
+func (db *DB) NewCheckpointBuild(
+	ctx context.Context,
+	name string,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+) (*models.Checkpoint, *models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	e, err := tx.
+		Env.
+		Create().
+		SetPublic(false).
+		SetNillableCreatedBy(nil).
+		SetTeamID(teamID).
+		SetID(id.Generate()).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create checkpoint env for '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	create := tx.
+		Checkpoint.
+		Create().
+		SetName(name).
+		SetSandboxID(snapshotConfig.SandboxID).
+		SetBaseEnvID(snapshotConfig.BaseTemplateID).
+		SetEnv(e).
+		SetMetadata(snapshotConfig.Metadata).
+		SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
+		SetEnvSecure(snapshotConfig.EnvdSecured)
+	if snapshotConfig.Network != nil {
+		create.SetNetwork(snapshotConfig.Network)
+	}
+
+	c, err := create.Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create checkpoint '%s' for '%s': %w", name, snapshotConfig.SandboxID, err)
+	}
+
+	b, err := tx.
+		EnvBuild.
+		Create().
+		SetEnv(e).
+		SetVcpu(snapshotConfig.VCPU).
+		SetRAMMB(snapshotConfig.RAMMB).
+		SetFreeDiskSizeMB(0).
+		SetKernelVersion(snapshotConfig.KernelVersion).
+		SetFirecrackerVersion(snapshotConfig.FirecrackerVersion).
+		SetEnvdVersion(snapshotConfig.EnvdVersion).
+		SetStatus(envbuild.StatusSnapshotting).
+		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetDatasets(snapshotConfig.Datasets).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create checkpoint env build for '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return c, b, nil
+}
+
+// DeleteCheckpoint deletes the checkpoint env together with the checkpoint.
+// The builds of the checkpoint are detached from the env and marked for the garbage collection,
+// their data can't be deleted right away, the sandboxes restored from the checkpoint still map data from them.
+func (db *DB) DeleteCheckpoint(ctx context.Context, checkpointID uuid.UUID, sandboxID string, teamID uuid.UUID) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	envID, err := tx.
+		Env.
+		Query().
+		Where(
+			env.HasCheckpointsWith(
+				checkpoint.ID(checkpointID),
+				checkpoint.SandboxID(sandboxID),
+			),
+			env.TeamID(teamID),
+		).
+		OnlyID(ctx)
+	if models.IsNotFound(err) {
+		return CheckpointNotFound{}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get checkpoint '%s': %w", checkpointID, err)
+	}
+
+	err = tx.
+		EnvBuild.
+		Update().
+		Where(envbuild.EnvID(envID)).
+		ClearEnvID().
+		SetMarkedForGcAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark builds of checkpoint '%s': %w", checkpointID, err)
+	}
+
+	err = tx.Env.DeleteOneID(envID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete checkpoint '%s': %w", checkpointID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -67,6 +67,7 @@ func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID) (result []*Template
 			env.TeamID(teamID),
 			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded)),
 			env.Not(env.HasSnapshots()),
+			env.Not(env.HasCheckpoints()),
 		).
 		Order(models.Asc(env.FieldCreatedAt)).
 		WithEnvAliases().
@@ -152,6 +153,45 @@ func (db *DB) GetRunningEnvBuilds(ctx context.Context) ([]*models.EnvBuild, erro
 	return envBuilds, nil
 }
 
+// GetEnvBuildsForGC returns all the builds with only the fields needed to find the builds that are still in use.
+func (db *DB) GetEnvBuildsForGC(ctx context.Context) ([]*models.EnvBuild, error) {
+	envBuilds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Select(
+			envbuild.FieldID,
+			envbuild.FieldEnvID,
+			envbuild.FieldStatus,
+			envbuild.FieldMarkedForGcAt,
+			envbuild.FieldDatasets,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get env builds: %w", err)
+	}
+
+	return envBuilds, nil
+}
+
+// DeleteMarkedEnvBuilds deletes the builds marked for the garbage collection after their data were removed.
+func (db *DB) DeleteMarkedEnvBuilds(ctx context.Context, buildIDs []uuid.UUID) error {
+	_, err := db.
+		Client.
+		EnvBuild.
+		Delete().
+		Where(
+			envbuild.IDIn(buildIDs...),
+			envbuild.MarkedForGcAtNotNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete marked env builds: %w", err)
+	}
+
+	return nil
+}
+
 func (db *DB) GetEnvBuild(ctx context.Context, buildID uuid.UUID) (build *models.EnvBuild, err error) {
 	dbBuild, err := db.
 		Client.
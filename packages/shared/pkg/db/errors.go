@@ -31,3 +31,35 @@ type EnvNotFound struct{ ErrNotFound }
 func (EnvNotFound) Error() string {
 	return "Env not found"
 }
+
+type CheckpointNotFound struct{ ErrNotFound }
+
+func (CheckpointNotFound) Error() string {
+	return "Checkpoint not found"
+}
+
+type SnapshotChanged struct{}
+
+func (SnapshotChanged) Error() string {
+	return "Snapshot changed during the compaction"
+}
+
+type VolumeNotFound struct{ ErrNotFound }
+
+func (VolumeNotFound) Error() string {
+	return "Volume not found"
+}
+
+type VolumeAlreadyExists struct{}
+
+func (VolumeAlreadyExists) Error() string {
+	return "Volume already exists"
+}
+
+type VolumeInUse struct {
+	SandboxID string
+}
+
+func (e VolumeInUse) Error() string {
+	return "Volume is attached to sandbox " + e.SandboxID
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
@@ -26,6 +28,12 @@ type SnapshotInfo struct {
 	FirecrackerVersion string
 	EnvdVersion        string
 	EnvdSecured        bool
+	// Datasets maps the mount paths of the datasets attached to the sandbox to their builds.
+	Datasets map[string]string
+	// BuildID is the build the node already snapshotted the sandbox to, a new build ID is generated if not set.
+	BuildID *uuid.UUID
+	// Network are the egress rules of the sandbox, nil if the sandbox has the default egress.
+	Network *types.SandboxNetworkConfig
 }
 
 // Check if there exists snapshot with the ID, if yes then return a new
@@ -74,7 +82,7 @@ func (db *DB) NewSnapshotBuild(
 			return nil, fmt.Errorf("failed to create env '%s': %w", snapshotConfig.SandboxID, err)
 		}
 
-		err = tx.
+		create := tx.
 			Snapshot.
 			Create().
 			SetSandboxID(snapshotConfig.SandboxID).
@@ -82,25 +90,91 @@ func (db *DB) NewSnapshotBuild(
 			SetEnv(e).
 			SetMetadata(snapshotConfig.Metadata).
 			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
-			SetEnvSecure(snapshotConfig.EnvdSecured).
-			Exec(ctx)
+			SetEnvSecure(snapshotConfig.EnvdSecured)
+		if snapshotConfig.Network != nil {
+			create.SetNetwork(snapshotConfig.Network)
+		}
+
+		err = create.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
 	} else {
 		e = s.Edges.Env
-		// Update existing snapshot with new metadata and pause time
-		err = tx.
+		// Update existing snapshot with new metadata, pause time and egress rules
+		update := tx.
 			Snapshot.
 			UpdateOne(s).
 			SetMetadata(snapshotConfig.Metadata).
-			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
-			Exec(ctx)
+			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt)
+		if snapshotConfig.Network != nil {
+			update.SetNetwork(snapshotConfig.Network)
+		} else {
+			update.ClearNetwork()
+		}
+
+		err = update.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to update snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
 	}
 
+	buildCreate := tx.
+		EnvBuild.
+		Create()
+	if snapshotConfig.BuildID != nil {
+		buildCreate.SetID(*snapshotConfig.BuildID)
+	}
+
+	b, err := buildCreate.
+		SetEnv(e).
+		SetVcpu(snapshotConfig.VCPU).
+		SetRAMMB(snapshotConfig.RAMMB).
+		SetFreeDiskSizeMB(0).
+		SetKernelVersion(snapshotConfig.KernelVersion).
+		SetFirecrackerVersion(snapshotConfig.FirecrackerVersion).
+		SetEnvdVersion(snapshotConfig.EnvdVersion).
+		SetStatus(envbuild.StatusSnapshotting).
+		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetDatasets(snapshotConfig.Datasets).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env build '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return b, nil
+}
+
+// NewForkBuild creates a new private env with a build for the fork snapshot of the sandbox.
+// The env is not linked to any snapshot, so the sandbox can be forked multiple times.
+func (db *DB) NewForkBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	e, err := tx.
+		Env.
+		Create().
+		SetPublic(false).
+		SetNillableCreatedBy(nil).
+		SetTeamID(teamID).
+		SetID(id.Generate()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fork env for '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
 	b, err := tx.
 		EnvBuild.
 		Create().
@@ -113,9 +187,10 @@ func (db *DB) NewSnapshotBuild(
 		SetEnvdVersion(snapshotConfig.EnvdVersion).
 		SetStatus(envbuild.StatusSnapshotting).
 		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetDatasets(snapshotConfig.Datasets).
 		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create env build '%s': %w", snapshotConfig.SandboxID, err)
+		return nil, fmt.Errorf("failed to create fork env build for '%s': %w", snapshotConfig.SandboxID, err)
 	}
 
 	err = tx.Commit()
@@ -126,6 +201,61 @@ func (db *DB) NewSnapshotBuild(
 	return b, nil
 }
 
+// DeleteForkEnv deletes the env created by NewForkBuild once none of the fork children runs from it.
+// The fork envs are the only envs with just the snapshot builds that don't belong to a snapshot or a checkpoint,
+// the other envs are left untouched. The builds are detached and marked for the garbage collection the same way
+// as the builds of the deleted checkpoints, the snapshots of the paused children still map data from them.
+func (db *DB) DeleteForkEnv(ctx context.Context, envID string) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.
+		Env.
+		Query().
+		Where(
+			env.ID(envID),
+			env.Not(env.HasSnapshots()),
+			env.Not(env.HasCheckpoints()),
+			env.Not(env.HasEnvAliases()),
+			env.HasBuilds(),
+			env.Not(env.HasBuildsWith(envbuild.StatusNotIn(envbuild.StatusSnapshotting, envbuild.StatusSuccess))),
+		).
+		OnlyID(ctx)
+	if models.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get fork env '%s': %w", envID, err)
+	}
+
+	err = tx.
+		EnvBuild.
+		Update().
+		Where(envbuild.EnvID(envID)).
+		ClearEnvID().
+		SetMarkedForGcAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark builds of fork env '%s': %w", envID, err)
+	}
+
+	err = tx.Env.DeleteOneID(envID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete fork env '%s': %w", envID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (db *DB) GetSnapshotBuilds(ctx context.Context, sandboxID string, teamID uuid.UUID) (
 	*models.Env,
 	[]*models.EnvBuild,
@@ -154,3 +284,115 @@ func (db *DB) GetSnapshotBuilds(ctx context.Context, sandboxID string, teamID uu
 
 	return e, e.Edges.Builds, nil
 }
+
+// HasLinkedBuilds checks if there is a build that references the data of any of the builds.
+func (db *DB) HasLinkedBuilds(ctx context.Context, buildIDs []uuid.UUID) (bool, error) {
+	exists, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(envbuild.SourceBuildIDIn(buildIDs...)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check linked builds: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CommitCompactedSnapshotBuild saves the build with the compacted diff chain of the snapshot as its last build
+// and marks the builds it replaces for the garbage collection.
+// The build is saved only if the snapshot wasn't paused or resumed since the compaction started,
+// so the compacted build never hides a newer pause of the sandbox.
+func (db *DB) CommitCompactedSnapshotBuild(
+	ctx context.Context,
+	source *models.EnvBuild,
+	buildID uuid.UUID,
+	replacedBuildIDs []uuid.UUID,
+) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the snapshot, so the sandbox can't be paused until the builds are swapped.
+	locked, err := tx.
+		Snapshot.
+		Query().
+		Where(snapshot.EnvID(*source.EnvID)).
+		Select(snapshot.FieldSandboxID).
+		Modify(func(s *sql.Selector) {
+			s.ForUpdate()
+		}).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock snapshot of env '%s': %w", *source.EnvID, err)
+	}
+
+	if len(locked) == 0 {
+		return SnapshotChanged{}
+	}
+
+	last, err := tx.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.EnvID(*source.EnvID),
+			envbuild.StatusIn(envbuild.StatusSuccess, envbuild.StatusWaiting, envbuild.StatusBuilding, envbuild.StatusSnapshotting),
+		).
+		Order(models.Desc(envbuild.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get last build of env '%s': %w", *source.EnvID, err)
+	}
+
+	if last.ID != source.ID {
+		return SnapshotChanged{}
+	}
+
+	now := time.Now()
+
+	err = tx.
+		EnvBuild.
+		Create().
+		SetID(buildID).
+		SetEnvID(*source.EnvID).
+		SetStatus(envbuild.StatusSuccess).
+		SetFinishedAt(now).
+		SetVcpu(source.Vcpu).
+		SetRAMMB(source.RAMMB).
+		SetFreeDiskSizeMB(source.FreeDiskSizeMB).
+		SetNillableTotalDiskSizeMB(source.TotalDiskSizeMB).
+		SetKernelVersion(source.KernelVersion).
+		SetFirecrackerVersion(source.FirecrackerVersion).
+		SetNillableEnvdVersion(source.EnvdVersion).
+		SetNillableStartCmd(source.StartCmd).
+		SetNillableReadyCmd(source.ReadyCmd).
+		SetNillableClusterNodeID(source.ClusterNodeID).
+		SetDatasets(source.Datasets).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create compacted build of env '%s': %w", *source.EnvID, err)
+	}
+
+	err = tx.
+		EnvBuild.
+		Update().
+		Where(
+			envbuild.IDIn(replacedBuildIDs...),
+			envbuild.MarkedForGcAtIsNil(),
+		).
+		SetMarkedForGcAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark replaced builds of env '%s': %w", *source.EnvID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
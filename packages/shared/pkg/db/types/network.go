@@ -0,0 +1,9 @@
+package types
+
+// SandboxNetworkConfig are the egress rules of the sandbox stored with its snapshots, so they are applied again on resume.
+type SandboxNetworkConfig struct {
+	AllowedCidrs   []string `json:"allowed_cidrs,omitempty"`
+	DeniedCidrs    []string `json:"denied_cidrs,omitempty"`
+	DenyAll        bool     `json:"deny_all,omitempty"`
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+}
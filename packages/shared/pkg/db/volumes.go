@@ -0,0 +1,280 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+)
+
+func (db *DB) CreateVolume(ctx context.Context, teamID uuid.UUID, name string, sizeMB int64) (*models.Volume, error) {
+	v, err := db.
+		Client.
+		Volume.
+		Create().
+		SetTeamID(teamID).
+		SetName(name).
+		SetSizeMB(sizeMB).
+		Save(ctx)
+	if models.IsConstraintError(err) {
+		return nil, VolumeAlreadyExists{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume '%s': %w", name, err)
+	}
+
+	return v, nil
+}
+
+func (db *DB) GetVolumes(ctx context.Context, teamID uuid.UUID) ([]*models.Volume, error) {
+	volumes, err := db.
+		Client.
+		Volume.
+		Query().
+		Where(volume.TeamID(teamID)).
+		Order(models.Asc(volume.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// GetVolumeGenerations returns the current and the reserved next generations of all volumes.
+func (db *DB) GetVolumeGenerations(ctx context.Context) ([]uuid.UUID, error) {
+	volumes, err := db.
+		Client.
+		Volume.
+		Query().
+		Select(volume.FieldGenerationID, volume.FieldNextGenerationID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume generations: %w", err)
+	}
+
+	var generations []uuid.UUID
+
+	for _, v := range volumes {
+		if v.GenerationID != nil {
+			generations = append(generations, *v.GenerationID)
+		}
+
+		if v.NextGenerationID != nil {
+			generations = append(generations, *v.NextGenerationID)
+		}
+	}
+
+	return generations, nil
+}
+
+// DeleteVolume deletes the volume if it isn't attached to any sandbox.
+func (db *DB) DeleteVolume(ctx context.Context, teamID uuid.UUID, name string) error {
+	deleted, err := db.
+		Client.
+		Volume.
+		Delete().
+		Where(volume.TeamID(teamID), volume.Name(name), volume.SandboxIDIsNil()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume '%s': %w", name, err)
+	}
+
+	if deleted == 0 {
+		return db.volumeUnavailable(ctx, teamID, name)
+	}
+
+	return nil
+}
+
+// AttachVolume reserves the volume for the sandbox and assigns the generation the sandbox writes the volume back to.
+func (db *DB) AttachVolume(ctx context.Context, teamID uuid.UUID, name string, sandboxID string) (*models.Volume, error) {
+	// The condition makes the reservation atomic, the volume can't be attached to two sandboxes at once.
+	updated, err := db.
+		Client.
+		Volume.
+		Update().
+		Where(
+			volume.TeamID(teamID),
+			volume.Name(name),
+			volume.Or(volume.SandboxIDIsNil(), volume.SandboxID(sandboxID)),
+		).
+		SetSandboxID(sandboxID).
+		SetNextGenerationID(uuid.New()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach volume '%s': %w", name, err)
+	}
+
+	if updated == 0 {
+		return nil, db.volumeUnavailable(ctx, teamID, name)
+	}
+
+	v, err := db.
+		Client.
+		Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.Name(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume '%s': %w", name, err)
+	}
+
+	return v, nil
+}
+
+// volumeUnavailable returns the reason why the volume couldn't be reserved.
+func (db *DB) volumeUnavailable(ctx context.Context, teamID uuid.UUID, name string) error {
+	v, err := db.
+		Client.
+		Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.Name(name)).
+		Only(ctx)
+	if models.IsNotFound(err) {
+		return VolumeNotFound{}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get volume '%s': %w", name, err)
+	}
+
+	if v.SandboxID != nil {
+		return VolumeInUse{SandboxID: *v.SandboxID}
+	}
+
+	return fmt.Errorf("volume '%s' was changed concurrently", name)
+}
+
+// ReattachSandboxVolumes assigns new write back generations to the volumes of the paused sandbox that is being resumed.
+func (db *DB) ReattachSandboxVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) ([]*models.Volume, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	volumes, err := tx.
+		Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.SandboxID(sandboxID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volumes of sandbox '%s': %w", sandboxID, err)
+	}
+
+	for i, v := range volumes {
+		volumes[i], err = tx.
+			Volume.
+			UpdateOne(v).
+			SetNextGenerationID(uuid.New()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach volume '%s': %w", v.Name, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// CommitSandboxVolumes marks the generations written back by the sandbox as the current ones.
+// The volumes are released when the sandbox was killed, the paused sandbox keeps them for the resume.
+func (db *DB) CommitSandboxVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string, release bool) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	volumes, err := tx.
+		Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.SandboxID(sandboxID), volume.NextGenerationIDNotNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get volumes of sandbox '%s': %w", sandboxID, err)
+	}
+
+	for _, v := range volumes {
+		update := tx.
+			Volume.
+			UpdateOne(v).
+			Where(volume.NextGenerationID(*v.NextGenerationID)).
+			SetGenerationID(*v.NextGenerationID).
+			ClearNextGenerationID()
+		if release {
+			update = update.ClearSandboxID()
+		}
+
+		err = update.Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to commit volume '%s': %w", v.Name, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseSandboxVolumes detaches the volumes from the sandbox without changing their generation,
+// the changes made by the sandbox since the volume was attached or last written back are discarded.
+func (db *DB) ReleaseSandboxVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) error {
+	err := db.
+		Client.
+		Volume.
+		Update().
+		Where(volume.TeamID(teamID), volume.SandboxID(sandboxID)).
+		ClearSandboxID().
+		ClearNextGenerationID().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release volumes of sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// GetWritableVolumes returns the volumes attached to the sandboxes that can write them back, the volumes of the paused sandboxes are not included.
+func (db *DB) GetWritableVolumes(ctx context.Context) ([]*models.Volume, error) {
+	volumes, err := db.
+		Client.
+		Volume.
+		Query().
+		Where(volume.SandboxIDNotNil(), volume.NextGenerationIDNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attached volumes: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// ReleaseVolume detaches the volume if it still has the write back generation, so the volume reattached in the meantime is kept.
+func (db *DB) ReleaseVolume(ctx context.Context, volumeID uuid.UUID, nextGenerationID uuid.UUID) error {
+	err := db.
+		Client.
+		Volume.
+		Update().
+		Where(volume.ID(volumeID), volume.NextGenerationID(nextGenerationID)).
+		ClearSandboxID().
+		ClearNextGenerationID().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release volume '%s': %w", volumeID, err)
+	}
+
+	return nil
+}
@@ -72,6 +72,55 @@ func (ServiceInfoStatus) EnumDescriptor() ([]byte, []int) {
 	return file_info_proto_rawDescGZIP(), []int{0}
 }
 
+// How the running sandboxes leave the draining node.
+type ServiceDrainMode int32
+
+const (
+	// The sandboxes run until they end.
+	ServiceDrainMode_DrainWait ServiceDrainMode = 0
+	// The sandboxes are paused and their snapshots uploaded, so they can be resumed on the other nodes.
+	ServiceDrainMode_DrainEvacuate ServiceDrainMode = 1
+)
+
+// Enum value maps for ServiceDrainMode.
+var (
+	ServiceDrainMode_name = map[int32]string{
+		0: "DrainWait",
+		1: "DrainEvacuate",
+	}
+	ServiceDrainMode_value = map[string]int32{
+		"DrainWait":     0,
+		"DrainEvacuate": 1,
+	}
+)
+
+func (x ServiceDrainMode) Enum() *ServiceDrainMode {
+	p := new(ServiceDrainMode)
+	*p = x
+	return p
+}
+
+func (x ServiceDrainMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceDrainMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_info_proto_enumTypes[1].Descriptor()
+}
+
+func (ServiceDrainMode) Type() protoreflect.EnumType {
+	return &file_info_proto_enumTypes[1]
+}
+
+func (x ServiceDrainMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceDrainMode.Descriptor instead.
+func (ServiceDrainMode) EnumDescriptor() ([]byte, []int) {
+	return file_info_proto_rawDescGZIP(), []int{1}
+}
+
 type ServiceInfoRole int32
 
 const (
@@ -102,11 +151,11 @@ func (x ServiceInfoRole) String() string {
 }
 
 func (ServiceInfoRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_info_proto_enumTypes[1].Descriptor()
+	return file_info_proto_enumTypes[2].Descriptor()
 }
 
 func (ServiceInfoRole) Type() protoreflect.EnumType {
-	return &file_info_proto_enumTypes[1]
+	return &file_info_proto_enumTypes[2]
 }
 
 func (x ServiceInfoRole) Number() protoreflect.EnumNumber {
@@ -115,7 +164,7 @@ func (x ServiceInfoRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServiceInfoRole.Descriptor instead.
 func (ServiceInfoRole) EnumDescriptor() ([]byte, []int) {
-	return file_info_proto_rawDescGZIP(), []int{1}
+	return file_info_proto_rawDescGZIP(), []int{2}
 }
 
 type ServiceInfoResponse struct {
@@ -134,6 +183,8 @@ type ServiceInfoResponse struct {
 	MetricMemoryUsedMb     int64                  `protobuf:"varint,102,opt,name=metric_memory_used_mb,json=metricMemoryUsedMb,proto3" json:"metric_memory_used_mb,omitempty"`
 	MetricDiskMb           int64                  `protobuf:"varint,103,opt,name=metric_disk_mb,json=metricDiskMb,proto3" json:"metric_disk_mb,omitempty"`
 	MetricSandboxesRunning int64                  `protobuf:"varint,104,opt,name=metric_sandboxes_running,json=metricSandboxesRunning,proto3" json:"metric_sandboxes_running,omitempty"`
+	// The progress of the evacuation, set since the node is drained in the evacuate mode.
+	Evacuation *ServiceEvacuation `protobuf:"bytes,201,opt,name=evacuation,proto3" json:"evacuation,omitempty"`
 }
 
 func (x *ServiceInfoResponse) Reset() {
@@ -245,18 +296,164 @@ func (x *ServiceInfoResponse) GetMetricSandboxesRunning() int64 {
 	return 0
 }
 
+func (x *ServiceInfoResponse) GetEvacuation() *ServiceEvacuation {
+	if x != nil {
+		return x.Evacuation
+	}
+	return nil
+}
+
+type EvacuatedSandbox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SandboxId   string `protobuf:"bytes,1,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// The snapshot build the sandbox was paused to, it is uploaded.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (x *EvacuatedSandbox) Reset() {
+	*x = EvacuatedSandbox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_info_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvacuatedSandbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvacuatedSandbox) ProtoMessage() {}
+
+func (x *EvacuatedSandbox) ProtoReflect() protoreflect.Message {
+	mi := &file_info_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvacuatedSandbox.ProtoReflect.Descriptor instead.
+func (*EvacuatedSandbox) Descriptor() ([]byte, []int) {
+	return file_info_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EvacuatedSandbox) GetSandboxId() string {
+	if x != nil {
+		return x.SandboxId
+	}
+	return ""
+}
+
+func (x *EvacuatedSandbox) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *EvacuatedSandbox) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+type ServiceEvacuation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sandboxes running when the evacuation started.
+	SandboxesTotal int64 `protobuf:"varint,1,opt,name=sandboxes_total,json=sandboxesTotal,proto3" json:"sandboxes_total,omitempty"`
+	// The sandboxes that couldn't be paused or their snapshot couldn't be uploaded, they are stopped.
+	SandboxesFailed int64               `protobuf:"varint,2,opt,name=sandboxes_failed,json=sandboxesFailed,proto3" json:"sandboxes_failed,omitempty"`
+	SandboxesPaused []*EvacuatedSandbox `protobuf:"bytes,3,rep,name=sandboxes_paused,json=sandboxesPaused,proto3" json:"sandboxes_paused,omitempty"`
+	Finished        bool                `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (x *ServiceEvacuation) Reset() {
+	*x = ServiceEvacuation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_info_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceEvacuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEvacuation) ProtoMessage() {}
+
+func (x *ServiceEvacuation) ProtoReflect() protoreflect.Message {
+	mi := &file_info_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEvacuation.ProtoReflect.Descriptor instead.
+func (*ServiceEvacuation) Descriptor() ([]byte, []int) {
+	return file_info_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceEvacuation) GetSandboxesTotal() int64 {
+	if x != nil {
+		return x.SandboxesTotal
+	}
+	return 0
+}
+
+func (x *ServiceEvacuation) GetSandboxesFailed() int64 {
+	if x != nil {
+		return x.SandboxesFailed
+	}
+	return 0
+}
+
+func (x *ServiceEvacuation) GetSandboxesPaused() []*EvacuatedSandbox {
+	if x != nil {
+		return x.SandboxesPaused
+	}
+	return nil
+}
+
+func (x *ServiceEvacuation) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
 type ServiceStatusChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ServiceStatus ServiceInfoStatus `protobuf:"varint,2,opt,name=service_status,json=serviceStatus,proto3,enum=ServiceInfoStatus" json:"service_status,omitempty"`
+	// Applies only to the draining status.
+	DrainMode ServiceDrainMode `protobuf:"varint,3,opt,name=drain_mode,json=drainMode,proto3,enum=ServiceDrainMode" json:"drain_mode,omitempty"`
 }
 
 func (x *ServiceStatusChangeRequest) Reset() {
 	*x = ServiceStatusChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_info_proto_msgTypes[1]
+		mi := &file_info_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -269,7 +466,7 @@ func (x *ServiceStatusChangeRequest) String() string {
 func (*ServiceStatusChangeRequest) ProtoMessage() {}
 
 func (x *ServiceStatusChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_info_proto_msgTypes[1]
+	mi := &file_info_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +479,7 @@ func (x *ServiceStatusChangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceStatusChangeRequest.ProtoReflect.Descriptor instead.
 func (*ServiceStatusChangeRequest) Descriptor() ([]byte, []int) {
-	return file_info_proto_rawDescGZIP(), []int{1}
+	return file_info_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ServiceStatusChangeRequest) GetServiceStatus() ServiceInfoStatus {
@@ -292,6 +489,13 @@ func (x *ServiceStatusChangeRequest) GetServiceStatus() ServiceInfoStatus {
 	return ServiceInfoStatus_OrchestratorHealthy
 }
 
+func (x *ServiceStatusChangeRequest) GetDrainMode() ServiceDrainMode {
+	if x != nil {
+		return x.DrainMode
+	}
+	return ServiceDrainMode_DrainWait
+}
+
 var File_info_proto protoreflect.FileDescriptor
 
 var file_info_proto_rawDesc = []byte{
@@ -299,7 +503,7 @@ var file_info_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
 	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x04, 0x0a, 0x13, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x04, 0x0a, 0x13, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
@@ -332,36 +536,65 @@ var file_info_proto_rawDesc = []byte{
 	0x73, 0x6b, 0x4d, 0x62, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x73,
 	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
 	0x18, 0x68, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61,
-	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x57,
-	0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x61, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13,
-	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x79, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12,
-	0x19, 0x0a, 0x15, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55,
-	0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x0f, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x0a,
-	0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
-	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x10, 0x01, 0x32, 0x98, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x53, 0x65, 0x72,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x33,
+	0x0a, 0x0a, 0x65, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xc9, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x61,
+	0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x10, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x64, 0x62,
+	0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6e,
+	0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x61,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3c,
+	0x0a, 0x10, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x0f, 0x73, 0x61, 0x6e,
+	0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x2f, 0x5a, 0x2d, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e,
-	0x66, 0x72, 0x61, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x2a, 0x61, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x10, 0x02, 0x2a, 0x34, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x65, 0x10, 0x01, 0x2a, 0x38, 0x0a,
+	0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x01, 0x32, 0x98, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1b, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x2f, 0x5a, 0x2d, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76,
+	0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -376,30 +609,36 @@ func file_info_proto_rawDescGZIP() []byte {
 	return file_info_proto_rawDescData
 }
 
-var file_info_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_info_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_info_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_info_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_info_proto_goTypes = []interface{}{
 	(ServiceInfoStatus)(0),             // 0: ServiceInfoStatus
-	(ServiceInfoRole)(0),               // 1: ServiceInfoRole
-	(*ServiceInfoResponse)(nil),        // 2: ServiceInfoResponse
-	(*ServiceStatusChangeRequest)(nil), // 3: ServiceStatusChangeRequest
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 5: google.protobuf.Empty
+	(ServiceDrainMode)(0),              // 1: ServiceDrainMode
+	(ServiceInfoRole)(0),               // 2: ServiceInfoRole
+	(*ServiceInfoResponse)(nil),        // 3: ServiceInfoResponse
+	(*EvacuatedSandbox)(nil),           // 4: EvacuatedSandbox
+	(*ServiceEvacuation)(nil),          // 5: ServiceEvacuation
+	(*ServiceStatusChangeRequest)(nil), // 6: ServiceStatusChangeRequest
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 8: google.protobuf.Empty
 }
 var file_info_proto_depIdxs = []int32{
 	0, // 0: ServiceInfoResponse.service_status:type_name -> ServiceInfoStatus
-	1, // 1: ServiceInfoResponse.service_roles:type_name -> ServiceInfoRole
-	4, // 2: ServiceInfoResponse.service_startup:type_name -> google.protobuf.Timestamp
-	0, // 3: ServiceStatusChangeRequest.service_status:type_name -> ServiceInfoStatus
-	5, // 4: InfoService.ServiceInfo:input_type -> google.protobuf.Empty
-	3, // 5: InfoService.ServiceStatusOverride:input_type -> ServiceStatusChangeRequest
-	2, // 6: InfoService.ServiceInfo:output_type -> ServiceInfoResponse
-	5, // 7: InfoService.ServiceStatusOverride:output_type -> google.protobuf.Empty
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 1: ServiceInfoResponse.service_roles:type_name -> ServiceInfoRole
+	7, // 2: ServiceInfoResponse.service_startup:type_name -> google.protobuf.Timestamp
+	5, // 3: ServiceInfoResponse.evacuation:type_name -> ServiceEvacuation
+	4, // 4: ServiceEvacuation.sandboxes_paused:type_name -> EvacuatedSandbox
+	0, // 5: ServiceStatusChangeRequest.service_status:type_name -> ServiceInfoStatus
+	1, // 6: ServiceStatusChangeRequest.drain_mode:type_name -> ServiceDrainMode
+	8, // 7: InfoService.ServiceInfo:input_type -> google.protobuf.Empty
+	6, // 8: InfoService.ServiceStatusOverride:input_type -> ServiceStatusChangeRequest
+	3, // 9: InfoService.ServiceInfo:output_type -> ServiceInfoResponse
+	8, // 10: InfoService.ServiceStatusOverride:output_type -> google.protobuf.Empty
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_info_proto_init() }
@@ -421,6 +660,30 @@ func file_info_proto_init() {
 			}
 		}
 		file_info_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvacuatedSandbox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_info_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceEvacuation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_info_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServiceStatusChangeRequest); i {
 			case 0:
 				return &v.state
@@ -438,8 +701,8 @@ func file_info_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_info_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
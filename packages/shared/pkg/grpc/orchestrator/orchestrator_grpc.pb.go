@@ -25,10 +25,17 @@ const _ = grpc.SupportPackageIsVersion7
 type SandboxServiceClient interface {
 	Create(ctx context.Context, in *SandboxCreateRequest, opts ...grpc.CallOption) (*SandboxCreateResponse, error)
 	Update(ctx context.Context, in *SandboxUpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateNetwork(ctx context.Context, in *SandboxUpdateNetworkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error)
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error)
+	Checkpoint(ctx context.Context, in *SandboxCheckpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Reset(ctx context.Context, in *SandboxResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
+	// WatchEvents streams the lifecycle events of the sandboxes on the node.
+	// The events are not replayed, the watcher reconciles the missed events by listing the sandboxes.
+	WatchEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (SandboxService_WatchEventsClient, error)
 }
 
 type sandboxServiceClient struct {
@@ -57,6 +64,15 @@ func (c *sandboxServiceClient) Update(ctx context.Context, in *SandboxUpdateRequ
 	return out, nil
 }
 
+func (c *sandboxServiceClient) UpdateNetwork(ctx context.Context, in *SandboxUpdateNetworkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/UpdateNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error) {
 	out := new(SandboxListResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/List", in, out, opts...)
@@ -84,6 +100,33 @@ func (c *sandboxServiceClient) Pause(ctx context.Context, in *SandboxPauseReques
 	return out, nil
 }
 
+func (c *sandboxServiceClient) Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error) {
+	out := new(SandboxForkResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Fork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Checkpoint(ctx context.Context, in *SandboxCheckpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Reset(ctx context.Context, in *SandboxResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/Reset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	out := new(SandboxListCachedBuildsResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/ListCachedBuilds", in, out, opts...)
@@ -93,16 +136,55 @@ func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *sandboxServiceClient) WatchEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (SandboxService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SandboxService_ServiceDesc.Streams[0], "/SandboxService/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sandboxServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SandboxService_WatchEventsClient interface {
+	Recv() (*SandboxEvent, error)
+	grpc.ClientStream
+}
+
+type sandboxServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sandboxServiceWatchEventsClient) Recv() (*SandboxEvent, error) {
+	m := new(SandboxEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SandboxServiceServer is the server API for SandboxService service.
 // All implementations must embed UnimplementedSandboxServiceServer
 // for forward compatibility
 type SandboxServiceServer interface {
 	Create(context.Context, *SandboxCreateRequest) (*SandboxCreateResponse, error)
 	Update(context.Context, *SandboxUpdateRequest) (*emptypb.Empty, error)
+	UpdateNetwork(context.Context, *SandboxUpdateNetworkRequest) (*emptypb.Empty, error)
 	List(context.Context, *emptypb.Empty) (*SandboxListResponse, error)
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
+	Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error)
+	Checkpoint(context.Context, *SandboxCheckpointRequest) (*emptypb.Empty, error)
+	Reset(context.Context, *SandboxResetRequest) (*emptypb.Empty, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
+	// WatchEvents streams the lifecycle events of the sandboxes on the node.
+	// The events are not replayed, the watcher reconciles the missed events by listing the sandboxes.
+	WatchEvents(*emptypb.Empty, SandboxService_WatchEventsServer) error
 	mustEmbedUnimplementedSandboxServiceServer()
 }
 
@@ -116,6 +198,9 @@ func (UnimplementedSandboxServiceServer) Create(context.Context, *SandboxCreateR
 func (UnimplementedSandboxServiceServer) Update(context.Context, *SandboxUpdateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedSandboxServiceServer) UpdateNetwork(context.Context, *SandboxUpdateNetworkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNetwork not implemented")
+}
 func (UnimplementedSandboxServiceServer) List(context.Context, *emptypb.Empty) (*SandboxListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -125,9 +210,21 @@ func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteR
 func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
+func (UnimplementedSandboxServiceServer) Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fork not implemented")
+}
+func (UnimplementedSandboxServiceServer) Checkpoint(context.Context, *SandboxCheckpointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (UnimplementedSandboxServiceServer) Reset(context.Context, *SandboxResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
+func (UnimplementedSandboxServiceServer) WatchEvents(*emptypb.Empty, SandboxService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedSandboxServiceServer) mustEmbedUnimplementedSandboxServiceServer() {}
 
 // UnsafeSandboxServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -177,6 +274,24 @@ func _SandboxService_Update_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_UpdateNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxUpdateNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).UpdateNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/UpdateNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).UpdateNetwork(ctx, req.(*SandboxUpdateNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -231,6 +346,60 @@ func _SandboxService_Pause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_Fork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Fork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Fork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Fork(ctx, req.(*SandboxForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Checkpoint(ctx, req.(*SandboxCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Reset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Reset(ctx, req.(*SandboxResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -249,6 +418,27 @@ func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SandboxServiceServer).WatchEvents(m, &sandboxServiceWatchEventsServer{stream})
+}
+
+type SandboxService_WatchEventsServer interface {
+	Send(*SandboxEvent) error
+	grpc.ServerStream
+}
+
+type sandboxServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sandboxServiceWatchEventsServer) Send(m *SandboxEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SandboxService_ServiceDesc is the grpc.ServiceDesc for SandboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -264,6 +454,10 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _SandboxService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateNetwork",
+			Handler:    _SandboxService_UpdateNetwork_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _SandboxService_List_Handler,
@@ -276,11 +470,157 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _SandboxService_Pause_Handler,
 		},
+		{
+			MethodName: "Fork",
+			Handler:    _SandboxService_Fork_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _SandboxService_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _SandboxService_Reset_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _SandboxService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "orchestrator.proto",
+}
+
+// ChunkServiceClient is the client API for ChunkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChunkServiceClient interface {
+	// Read returns the NotFound error if the chunk is not in the local cache, the node doesn't fetch it from the storage.
+	Read(ctx context.Context, in *ChunkReadRequest, opts ...grpc.CallOption) (*ChunkReadResponse, error)
+	// ReadSnapshot returns the headers and the snapfile of the snapshot paused on the node, so it can be resumed before its upload finishes.
+	// It returns the NotFound error if the snapshot is not in the local cache.
+	ReadSnapshot(ctx context.Context, in *SnapshotReadRequest, opts ...grpc.CallOption) (*SnapshotReadResponse, error)
+}
+
+type chunkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChunkServiceClient(cc grpc.ClientConnInterface) ChunkServiceClient {
+	return &chunkServiceClient{cc}
+}
+
+func (c *chunkServiceClient) Read(ctx context.Context, in *ChunkReadRequest, opts ...grpc.CallOption) (*ChunkReadResponse, error) {
+	out := new(ChunkReadResponse)
+	err := c.cc.Invoke(ctx, "/ChunkService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chunkServiceClient) ReadSnapshot(ctx context.Context, in *SnapshotReadRequest, opts ...grpc.CallOption) (*SnapshotReadResponse, error) {
+	out := new(SnapshotReadResponse)
+	err := c.cc.Invoke(ctx, "/ChunkService/ReadSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChunkServiceServer is the server API for ChunkService service.
+// All implementations must embed UnimplementedChunkServiceServer
+// for forward compatibility
+type ChunkServiceServer interface {
+	// Read returns the NotFound error if the chunk is not in the local cache, the node doesn't fetch it from the storage.
+	Read(context.Context, *ChunkReadRequest) (*ChunkReadResponse, error)
+	// ReadSnapshot returns the headers and the snapfile of the snapshot paused on the node, so it can be resumed before its upload finishes.
+	// It returns the NotFound error if the snapshot is not in the local cache.
+	ReadSnapshot(context.Context, *SnapshotReadRequest) (*SnapshotReadResponse, error)
+	mustEmbedUnimplementedChunkServiceServer()
+}
+
+// UnimplementedChunkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChunkServiceServer struct {
+}
+
+func (UnimplementedChunkServiceServer) Read(context.Context, *ChunkReadRequest) (*ChunkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedChunkServiceServer) ReadSnapshot(context.Context, *SnapshotReadRequest) (*SnapshotReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSnapshot not implemented")
+}
+func (UnimplementedChunkServiceServer) mustEmbedUnimplementedChunkServiceServer() {}
+
+// UnsafeChunkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChunkServiceServer will
+// result in compilation errors.
+type UnsafeChunkServiceServer interface {
+	mustEmbedUnimplementedChunkServiceServer()
+}
+
+func RegisterChunkServiceServer(s grpc.ServiceRegistrar, srv ChunkServiceServer) {
+	s.RegisterService(&ChunkService_ServiceDesc, srv)
+}
+
+func _ChunkService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChunkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChunkService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).Read(ctx, req.(*ChunkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChunkService_ReadSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkServiceServer).ReadSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChunkService/ReadSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkServiceServer).ReadSnapshot(ctx, req.(*SnapshotReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChunkService_ServiceDesc is the grpc.ServiceDesc for ChunkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChunkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ChunkService",
+	HandlerType: (*ChunkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _ChunkService_Read_Handler,
+		},
+		{
+			MethodName: "ReadSnapshot",
+			Handler:    _ChunkService_ReadSnapshot_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orchestrator.proto",
 }
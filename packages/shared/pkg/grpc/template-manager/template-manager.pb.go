@@ -131,6 +131,7 @@ type TemplateConfig struct {
 	StartCommand       string `protobuf:"bytes,8,opt,name=startCommand,proto3" json:"startCommand,omitempty"`
 	HugePages          bool   `protobuf:"varint,9,opt,name=hugePages,proto3" json:"hugePages,omitempty"`
 	ReadyCommand       string `protobuf:"bytes,10,opt,name=readyCommand,proto3" json:"readyCommand,omitempty"`
+	TeamID             string `protobuf:"bytes,11,opt,name=teamID,proto3" json:"teamID,omitempty"`
 }
 
 func (x *TemplateConfig) Reset() {
@@ -235,6 +236,13 @@ func (x *TemplateConfig) GetReadyCommand() string {
 	return ""
 }
 
+func (x *TemplateConfig) GetTeamID() string {
+	if x != nil {
+		return x.TeamID
+	}
+	return ""
+}
+
 type TemplateCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,6 +401,152 @@ func (x *TemplateBuildDeleteRequest) GetTemplateID() string {
 	return ""
 }
 
+// Data required for creating a build that shares the data of an existing build.
+type TemplateBuildLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID    string `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID       string `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	SourceBuildID string `protobuf:"bytes,3,opt,name=sourceBuildID,proto3" json:"sourceBuildID,omitempty"`
+}
+
+func (x *TemplateBuildLinkRequest) Reset() {
+	*x = TemplateBuildLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildLinkRequest) ProtoMessage() {}
+
+func (x *TemplateBuildLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildLinkRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildLinkRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TemplateBuildLinkRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateBuildLinkRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateBuildLinkRequest) GetSourceBuildID() string {
+	if x != nil {
+		return x.SourceBuildID
+	}
+	return ""
+}
+
+// Data required for compacting the diff chain of a build into a new build.
+type TemplateBuildCompactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID    string `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID       string `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	SourceBuildID string `protobuf:"bytes,3,opt,name=sourceBuildID,proto3" json:"sourceBuildID,omitempty"`
+	// Builds whose data are copied into the new build, the data of the other builds stay referenced.
+	// If empty, the data of all builds are copied and the new build is self-contained.
+	FoldBuildIDs []string `protobuf:"bytes,4,rep,name=foldBuildIDs,proto3" json:"foldBuildIDs,omitempty"`
+	TeamID       string   `protobuf:"bytes,5,opt,name=teamID,proto3" json:"teamID,omitempty"`
+}
+
+func (x *TemplateBuildCompactRequest) Reset() {
+	*x = TemplateBuildCompactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildCompactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildCompactRequest) ProtoMessage() {}
+
+func (x *TemplateBuildCompactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildCompactRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildCompactRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TemplateBuildCompactRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateBuildCompactRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateBuildCompactRequest) GetSourceBuildID() string {
+	if x != nil {
+		return x.SourceBuildID
+	}
+	return ""
+}
+
+func (x *TemplateBuildCompactRequest) GetFoldBuildIDs() []string {
+	if x != nil {
+		return x.FoldBuildIDs
+	}
+	return nil
+}
+
+func (x *TemplateBuildCompactRequest) GetTeamID() string {
+	if x != nil {
+		return x.TeamID
+	}
+	return ""
+}
+
 type TemplateBuildMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -405,7 +559,7 @@ type TemplateBuildMetadata struct {
 func (x *TemplateBuildMetadata) Reset() {
 	*x = TemplateBuildMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[4]
+		mi := &file_template_manager_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -418,7 +572,7 @@ func (x *TemplateBuildMetadata) String() string {
 func (*TemplateBuildMetadata) ProtoMessage() {}
 
 func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[4]
+	mi := &file_template_manager_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +585,7 @@ func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildMetadata.ProtoReflect.Descriptor instead.
 func (*TemplateBuildMetadata) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{4}
+	return file_template_manager_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TemplateBuildMetadata) GetRootfsSizeKey() int32 {
@@ -461,7 +615,7 @@ type TemplateBuildStatusResponse struct {
 func (x *TemplateBuildStatusResponse) Reset() {
 	*x = TemplateBuildStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[5]
+		mi := &file_template_manager_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -474,7 +628,7 @@ func (x *TemplateBuildStatusResponse) String() string {
 func (*TemplateBuildStatusResponse) ProtoMessage() {}
 
 func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[5]
+	mi := &file_template_manager_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +641,7 @@ func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildStatusResponse.ProtoReflect.Descriptor instead.
 func (*TemplateBuildStatusResponse) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{5}
+	return file_template_manager_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TemplateBuildStatusResponse) GetStatus() TemplateBuildState {
@@ -515,7 +669,7 @@ type HealthStatusResponse struct {
 func (x *HealthStatusResponse) Reset() {
 	*x = HealthStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[6]
+		mi := &file_template_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -528,7 +682,7 @@ func (x *HealthStatusResponse) String() string {
 func (*HealthStatusResponse) ProtoMessage() {}
 
 func (x *HealthStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[6]
+	mi := &file_template_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +695,7 @@ func (x *HealthStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthStatusResponse.ProtoReflect.Descriptor instead.
 func (*HealthStatusResponse) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{6}
+	return file_template_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HealthStatusResponse) GetStatus() HealthState {
@@ -557,7 +711,7 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67,
 	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x02, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x02, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
 	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70,
 	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
 	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
@@ -579,22 +733,43 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50,
 	0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d,
 	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x51,
-	0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x44, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x15, 0x54, 0x65, 0x6d,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x22, 0x44, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
+	0x44, 0x22, 0x7a, 0x0a, 0x18, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0xb9, 0x01,
+	0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x66, 0x6f, 0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x15, 0x54, 0x65, 0x6d,
 	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65,
 	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66,
@@ -619,7 +794,7 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x28, 0x0a,
 	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07,
 	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x61,
-	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x32, 0xab, 0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x32, 0xc1, 0x03, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70,
 	0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x54,
 	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
 	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
@@ -634,15 +809,24 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x65, 0x12, 0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
 	0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15,
-	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
-	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d,
-	0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c,
+	0x0a, 0x14, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -658,7 +842,7 @@ func file_template_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_template_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_template_manager_proto_goTypes = []interface{}{
 	(TemplateBuildState)(0),             // 0: TemplateBuildState
 	(HealthState)(0),                    // 1: HealthState
@@ -666,29 +850,35 @@ var file_template_manager_proto_goTypes = []interface{}{
 	(*TemplateCreateRequest)(nil),       // 3: TemplateCreateRequest
 	(*TemplateStatusRequest)(nil),       // 4: TemplateStatusRequest
 	(*TemplateBuildDeleteRequest)(nil),  // 5: TemplateBuildDeleteRequest
-	(*TemplateBuildMetadata)(nil),       // 6: TemplateBuildMetadata
-	(*TemplateBuildStatusResponse)(nil), // 7: TemplateBuildStatusResponse
-	(*HealthStatusResponse)(nil),        // 8: HealthStatusResponse
-	(*emptypb.Empty)(nil),               // 9: google.protobuf.Empty
+	(*TemplateBuildLinkRequest)(nil),    // 6: TemplateBuildLinkRequest
+	(*TemplateBuildCompactRequest)(nil), // 7: TemplateBuildCompactRequest
+	(*TemplateBuildMetadata)(nil),       // 8: TemplateBuildMetadata
+	(*TemplateBuildStatusResponse)(nil), // 9: TemplateBuildStatusResponse
+	(*HealthStatusResponse)(nil),        // 10: HealthStatusResponse
+	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
 }
 var file_template_manager_proto_depIdxs = []int32{
-	2, // 0: TemplateCreateRequest.template:type_name -> TemplateConfig
-	0, // 1: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
-	6, // 2: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
-	1, // 3: HealthStatusResponse.status:type_name -> HealthState
-	3, // 4: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
-	4, // 5: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
-	5, // 6: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
-	9, // 7: TemplateService.HealthStatus:input_type -> google.protobuf.Empty
-	9, // 8: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
-	7, // 9: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
-	9, // 10: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
-	8, // 11: TemplateService.HealthStatus:output_type -> HealthStatusResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: TemplateCreateRequest.template:type_name -> TemplateConfig
+	0,  // 1: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
+	8,  // 2: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
+	1,  // 3: HealthStatusResponse.status:type_name -> HealthState
+	3,  // 4: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
+	4,  // 5: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
+	5,  // 6: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
+	6,  // 7: TemplateService.TemplateBuildLink:input_type -> TemplateBuildLinkRequest
+	7,  // 8: TemplateService.TemplateBuildCompact:input_type -> TemplateBuildCompactRequest
+	11, // 9: TemplateService.HealthStatus:input_type -> google.protobuf.Empty
+	11, // 10: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
+	9,  // 11: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
+	11, // 12: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
+	11, // 13: TemplateService.TemplateBuildLink:output_type -> google.protobuf.Empty
+	11, // 14: TemplateService.TemplateBuildCompact:output_type -> google.protobuf.Empty
+	10, // 15: TemplateService.HealthStatus:output_type -> HealthStatusResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_template_manager_proto_init() }
@@ -746,7 +936,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildMetadata); i {
+			switch v := v.(*TemplateBuildLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -758,7 +948,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildStatusResponse); i {
+			switch v := v.(*TemplateBuildCompactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -770,6 +960,30 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthStatusResponse); i {
 			case 0:
 				return &v.state
@@ -788,7 +1002,7 @@ func file_template_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_template_manager_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,10 @@ type TemplateServiceClient interface {
 	TemplateBuildStatus(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildLink is a gRPC service that creates a template build referencing the data of an existing build, the data is not copied
+	TemplateBuildLink(ctx context.Context, in *TemplateBuildLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildCompact is a gRPC service that creates a template build with the data of the source build chain copied into its own diffs
+	TemplateBuildCompact(ctx context.Context, in *TemplateBuildCompactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// todo (2025-05): this is deprecated, please use InfoService that is used for both orchestrator and template manager
 	HealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatusResponse, error)
 }
@@ -68,6 +72,24 @@ func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildLink(ctx context.Context, in *TemplateBuildLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateBuildCompact(ctx context.Context, in *TemplateBuildCompactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildCompact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *templateServiceClient) HealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatusResponse, error) {
 	out := new(HealthStatusResponse)
 	err := c.cc.Invoke(ctx, "/TemplateService/HealthStatus", in, out, opts...)
@@ -87,6 +109,10 @@ type TemplateServiceServer interface {
 	TemplateBuildStatus(context.Context, *TemplateStatusRequest) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
+	// TemplateBuildLink is a gRPC service that creates a template build referencing the data of an existing build, the data is not copied
+	TemplateBuildLink(context.Context, *TemplateBuildLinkRequest) (*emptypb.Empty, error)
+	// TemplateBuildCompact is a gRPC service that creates a template build with the data of the source build chain copied into its own diffs
+	TemplateBuildCompact(context.Context, *TemplateBuildCompactRequest) (*emptypb.Empty, error)
 	// todo (2025-05): this is deprecated, please use InfoService that is used for both orchestrator and template manager
 	HealthStatus(context.Context, *emptypb.Empty) (*HealthStatusResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
@@ -105,6 +131,12 @@ func (UnimplementedTemplateServiceServer) TemplateBuildStatus(context.Context, *
 func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildDelete not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildLink(context.Context, *TemplateBuildLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildLink not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildCompact(context.Context, *TemplateBuildCompactRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildCompact not implemented")
+}
 func (UnimplementedTemplateServiceServer) HealthStatus(context.Context, *emptypb.Empty) (*HealthStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthStatus not implemented")
 }
@@ -175,6 +207,42 @@ func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildLink(ctx, req.(*TemplateBuildLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateBuildCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildCompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildCompact(ctx, req.(*TemplateBuildCompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TemplateService_HealthStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -212,6 +280,14 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateBuildDelete",
 			Handler:    _TemplateService_TemplateBuildDelete_Handler,
 		},
+		{
+			MethodName: "TemplateBuildLink",
+			Handler:    _TemplateService_TemplateBuildLink_Handler,
+		},
+		{
+			MethodName: "TemplateBuildCompact",
+			Handler:    _TemplateService_TemplateBuildCompact_Handler,
+		},
 		{
 			MethodName: "HealthStatus",
 			Handler:    _TemplateService_HealthStatus_Handler,
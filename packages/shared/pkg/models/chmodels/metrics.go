@@ -10,4 +10,12 @@ type Metrics struct {
 	CPUUsedPercent float32   `ch:"cpu_used_pct"`
 	MemTotalMiB    uint64    `ch:"mem_total_mib"`
 	MemUsedMiB     uint64    `ch:"mem_used_mib"`
+
+	NetEgressBytes    uint64 `ch:"net_egress_bytes"`
+	NetEgressPackets  uint64 `ch:"net_egress_packets"`
+	NetIngressBytes   uint64 `ch:"net_ingress_bytes"`
+	NetIngressPackets uint64 `ch:"net_ingress_packets"`
+
+	MemBalloonMiB uint64 `ch:"mem_balloon_mib"`
+	MemFreeMiB    uint64 `ch:"mem_free_mib"`
 }
@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
@@ -27,6 +28,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 )
@@ -38,6 +40,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// AccessToken is the client for interacting with the AccessToken builders.
 	AccessToken *AccessTokenClient
+	// Checkpoint is the client for interacting with the Checkpoint builders.
+	Checkpoint *CheckpointClient
 	// Cluster is the client for interacting with the Cluster builders.
 	Cluster *ClusterClient
 	// Env is the client for interacting with the Env builders.
@@ -58,6 +62,8 @@ type Client struct {
 	User *UserClient
 	// UsersTeams is the client for interacting with the UsersTeams builders.
 	UsersTeams *UsersTeamsClient
+	// Volume is the client for interacting with the Volume builders.
+	Volume *VolumeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -70,6 +76,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AccessToken = NewAccessTokenClient(c.config)
+	c.Checkpoint = NewCheckpointClient(c.config)
 	c.Cluster = NewClusterClient(c.config)
 	c.Env = NewEnvClient(c.config)
 	c.EnvAlias = NewEnvAliasClient(c.config)
@@ -80,6 +87,7 @@ func (c *Client) init() {
 	c.Tier = NewTierClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UsersTeams = NewUsersTeamsClient(c.config)
+	c.Volume = NewVolumeClient(c.config)
 }
 
 type (
@@ -176,6 +184,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:         ctx,
 		config:      cfg,
 		AccessToken: NewAccessTokenClient(cfg),
+		Checkpoint:  NewCheckpointClient(cfg),
 		Cluster:     NewClusterClient(cfg),
 		Env:         NewEnvClient(cfg),
 		EnvAlias:    NewEnvAliasClient(cfg),
@@ -186,6 +195,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Tier:        NewTierClient(cfg),
 		User:        NewUserClient(cfg),
 		UsersTeams:  NewUsersTeamsClient(cfg),
+		Volume:      NewVolumeClient(cfg),
 	}, nil
 }
 
@@ -206,6 +216,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:         ctx,
 		config:      cfg,
 		AccessToken: NewAccessTokenClient(cfg),
+		Checkpoint:  NewCheckpointClient(cfg),
 		Cluster:     NewClusterClient(cfg),
 		Env:         NewEnvClient(cfg),
 		EnvAlias:    NewEnvAliasClient(cfg),
@@ -216,6 +227,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Tier:        NewTierClient(cfg),
 		User:        NewUserClient(cfg),
 		UsersTeams:  NewUsersTeamsClient(cfg),
+		Volume:      NewVolumeClient(cfg),
 	}, nil
 }
 
@@ -245,8 +257,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
-		c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Checkpoint, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild,
+		c.Snapshot, c.Team, c.TeamAPIKey, c.Tier, c.User, c.UsersTeams, c.Volume,
 	} {
 		n.Use(hooks...)
 	}
@@ -256,8 +268,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
-		c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Checkpoint, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild,
+		c.Snapshot, c.Team, c.TeamAPIKey, c.Tier, c.User, c.UsersTeams, c.Volume,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -268,6 +280,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AccessTokenMutation:
 		return c.AccessToken.mutate(ctx, m)
+	case *CheckpointMutation:
+		return c.Checkpoint.mutate(ctx, m)
 	case *ClusterMutation:
 		return c.Cluster.mutate(ctx, m)
 	case *EnvMutation:
@@ -288,6 +302,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.User.mutate(ctx, m)
 	case *UsersTeamsMutation:
 		return c.UsersTeams.mutate(ctx, m)
+	case *VolumeMutation:
+		return c.Volume.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("models: unknown mutation type %T", m)
 	}
@@ -445,6 +461,158 @@ func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation)
 	}
 }
 
+// CheckpointClient is a client for the Checkpoint schema.
+type CheckpointClient struct {
+	config
+}
+
+// NewCheckpointClient returns a client for the Checkpoint from the given config.
+func NewCheckpointClient(c config) *CheckpointClient {
+	return &CheckpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkpoint.Hooks(f(g(h())))`.
+func (c *CheckpointClient) Use(hooks ...Hook) {
+	c.hooks.Checkpoint = append(c.hooks.Checkpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkpoint.Intercept(f(g(h())))`.
+func (c *CheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Checkpoint = append(c.inters.Checkpoint, interceptors...)
+}
+
+// Create returns a builder for creating a Checkpoint entity.
+func (c *CheckpointClient) Create() *CheckpointCreate {
+	mutation := newCheckpointMutation(c.config, OpCreate)
+	return &CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Checkpoint entities.
+func (c *CheckpointClient) CreateBulk(builders ...*CheckpointCreate) *CheckpointCreateBulk {
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckpointClient) MapCreateBulk(slice any, setFunc func(*CheckpointCreate, int)) *CheckpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckpointCreateBulk{err: fmt.Errorf("calling to CheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Checkpoint.
+func (c *CheckpointClient) Update() *CheckpointUpdate {
+	mutation := newCheckpointMutation(c.config, OpUpdate)
+	return &CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckpointClient) UpdateOne(ch *Checkpoint) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpoint(ch))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckpointClient) UpdateOneID(id uuid.UUID) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpointID(id))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Checkpoint.
+func (c *CheckpointClient) Delete() *CheckpointDelete {
+	mutation := newCheckpointMutation(c.config, OpDelete)
+	return &CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckpointClient) DeleteOne(ch *Checkpoint) *CheckpointDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckpointClient) DeleteOneID(id uuid.UUID) *CheckpointDeleteOne {
+	builder := c.Delete().Where(checkpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckpointDeleteOne{builder}
+}
+
+// Query returns a query builder for Checkpoint.
+func (c *CheckpointClient) Query() *CheckpointQuery {
+	return &CheckpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Checkpoint entity by its id.
+func (c *CheckpointClient) Get(ctx context.Context, id uuid.UUID) (*Checkpoint, error) {
+	return c.Query().Where(checkpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckpointClient) GetX(ctx context.Context, id uuid.UUID) *Checkpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnv queries the env edge of a Checkpoint.
+func (c *CheckpointClient) QueryEnv(ch *Checkpoint) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checkpoint.Table, checkpoint.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checkpoint.EnvTable, checkpoint.EnvColumn),
+		)
+		schemaConfig := ch.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CheckpointClient) Hooks() []Hook {
+	return c.hooks.Checkpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckpointClient) Interceptors() []Interceptor {
+	return c.inters.Checkpoint
+}
+
+func (c *CheckpointClient) mutate(ctx context.Context, m *CheckpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Checkpoint mutation op: %q", m.Op())
+	}
+}
+
 // ClusterClient is a client for the Cluster schema.
 type ClusterClient struct {
 	config
@@ -781,6 +949,25 @@ func (c *EnvClient) QuerySnapshots(e *Env) *SnapshotQuery {
 	return query
 }
 
+// QueryCheckpoints queries the checkpoints edge of a Env.
+func (c *EnvClient) QueryCheckpoints(e *Env) *CheckpointQuery {
+	query := (&CheckpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, id),
+			sqlgraph.To(checkpoint.Table, checkpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.CheckpointsTable, env.CheckpointsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvClient) Hooks() []Hook {
 	return c.hooks.Env
@@ -1446,6 +1633,25 @@ func (c *TeamClient) QueryEnvs(t *Team) *EnvQuery {
 	return query
 }
 
+// QueryVolumes queries the volumes edge of a Team.
+func (c *TeamClient) QueryVolumes(t *Team) *VolumeQuery {
+	query := (&VolumeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(volume.Table, volume.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.VolumesTable, team.VolumesColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Volume
+		step.Edge.Schema = schemaConfig.Volume
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryUsersTeams queries the users_teams edge of a Team.
 func (c *TeamClient) QueryUsersTeams(t *Team) *UsersTeamsQuery {
 	query := (&UsersTeamsClient{config: c.config}).Query()
@@ -2212,15 +2418,167 @@ func (c *UsersTeamsClient) mutate(ctx context.Context, m *UsersTeamsMutation) (V
 	}
 }
 
+// VolumeClient is a client for the Volume schema.
+type VolumeClient struct {
+	config
+}
+
+// NewVolumeClient returns a client for the Volume from the given config.
+func NewVolumeClient(c config) *VolumeClient {
+	return &VolumeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `volume.Hooks(f(g(h())))`.
+func (c *VolumeClient) Use(hooks ...Hook) {
+	c.hooks.Volume = append(c.hooks.Volume, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `volume.Intercept(f(g(h())))`.
+func (c *VolumeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Volume = append(c.inters.Volume, interceptors...)
+}
+
+// Create returns a builder for creating a Volume entity.
+func (c *VolumeClient) Create() *VolumeCreate {
+	mutation := newVolumeMutation(c.config, OpCreate)
+	return &VolumeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Volume entities.
+func (c *VolumeClient) CreateBulk(builders ...*VolumeCreate) *VolumeCreateBulk {
+	return &VolumeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VolumeClient) MapCreateBulk(slice any, setFunc func(*VolumeCreate, int)) *VolumeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VolumeCreateBulk{err: fmt.Errorf("calling to VolumeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VolumeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VolumeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Volume.
+func (c *VolumeClient) Update() *VolumeUpdate {
+	mutation := newVolumeMutation(c.config, OpUpdate)
+	return &VolumeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VolumeClient) UpdateOne(v *Volume) *VolumeUpdateOne {
+	mutation := newVolumeMutation(c.config, OpUpdateOne, withVolume(v))
+	return &VolumeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VolumeClient) UpdateOneID(id uuid.UUID) *VolumeUpdateOne {
+	mutation := newVolumeMutation(c.config, OpUpdateOne, withVolumeID(id))
+	return &VolumeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Volume.
+func (c *VolumeClient) Delete() *VolumeDelete {
+	mutation := newVolumeMutation(c.config, OpDelete)
+	return &VolumeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VolumeClient) DeleteOne(v *Volume) *VolumeDeleteOne {
+	return c.DeleteOneID(v.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VolumeClient) DeleteOneID(id uuid.UUID) *VolumeDeleteOne {
+	builder := c.Delete().Where(volume.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VolumeDeleteOne{builder}
+}
+
+// Query returns a query builder for Volume.
+func (c *VolumeClient) Query() *VolumeQuery {
+	return &VolumeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVolume},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Volume entity by its id.
+func (c *VolumeClient) Get(ctx context.Context, id uuid.UUID) (*Volume, error) {
+	return c.Query().Where(volume.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VolumeClient) GetX(ctx context.Context, id uuid.UUID) *Volume {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeam queries the team edge of a Volume.
+func (c *VolumeClient) QueryTeam(v *Volume) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(volume.Table, volume.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, volume.TeamTable, volume.TeamColumn),
+		)
+		schemaConfig := v.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.Volume
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VolumeClient) Hooks() []Hook {
+	return c.hooks.Volume
+}
+
+// Interceptors returns the client interceptors.
+func (c *VolumeClient) Interceptors() []Interceptor {
+	return c.inters.Volume
+}
+
+func (c *VolumeClient) mutate(ctx context.Context, m *VolumeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VolumeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VolumeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VolumeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VolumeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Volume mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier,
-		User, UsersTeams []ent.Hook
+		AccessToken, Checkpoint, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team,
+		TeamAPIKey, Tier, User, UsersTeams, Volume []ent.Hook
 	}
 	inters struct {
-		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier,
-		User, UsersTeams []ent.Interceptor
+		AccessToken, Checkpoint, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team,
+		TeamAPIKey, Tier, User, UsersTeams, Volume []ent.Interceptor
 	}
 )
 
@@ -2228,6 +2586,7 @@ var (
 	// DefaultSchemaConfig represents the default schema names for all tables as defined in ent/schema.
 	DefaultSchemaConfig = SchemaConfig{
 		AccessToken: tableSchemas[1],
+		Checkpoint:  tableSchemas[1],
 		Cluster:     tableSchemas[1],
 		Env:         tableSchemas[1],
 		EnvAlias:    tableSchemas[1],
@@ -2238,6 +2597,7 @@ var (
 		Tier:        tableSchemas[1],
 		User:        tableSchemas[0],
 		UsersTeams:  tableSchemas[1],
+		Volume:      tableSchemas[1],
 	}
 	tableSchemas = [...]string{"auth", "public"}
 )
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
@@ -23,6 +24,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -84,6 +86,7 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			accesstoken.Table: accesstoken.ValidColumn,
+			checkpoint.Table:  checkpoint.ValidColumn,
 			cluster.Table:     cluster.ValidColumn,
 			env.Table:         env.ValidColumn,
 			envalias.Table:    envalias.ValidColumn,
@@ -94,6 +97,7 @@ func checkColumn(table, column string) error {
 			tier.Table:        tier.ValidColumn,
 			user.Table:        user.ValidColumn,
 			usersteams.Table:  usersteams.ValidColumn,
+			volume.Table:      volume.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -577,6 +577,35 @@ func HasSnapshotsWith(preds ...predicate.Snapshot) predicate.Env {
 	})
 }
 
+// HasCheckpoints applies the HasEdge predicate on the "checkpoints" edge.
+func HasCheckpoints() predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckpointsWith applies the HasEdge predicate on the "checkpoints" edge with a given conditions (other predicates).
+func HasCheckpointsWith(preds ...predicate.Checkpoint) predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := newCheckpointsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Env) predicate.Env {
 	return predicate.Env(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
@@ -214,6 +215,21 @@ func (ec *EnvCreate) AddSnapshots(s ...*Snapshot) *EnvCreate {
 	return ec.AddSnapshotIDs(ids...)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ec *EnvCreate) AddCheckpointIDs(ids ...uuid.UUID) *EnvCreate {
+	ec.mutation.AddCheckpointIDs(ids...)
+	return ec
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ec *EnvCreate) AddCheckpoints(c ...*Checkpoint) *EnvCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ec.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (ec *EnvCreate) Mutation() *EnvMutation {
 	return ec.mutation
@@ -442,6 +458,23 @@ func (ec *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ec.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
@@ -249,6 +250,21 @@ func (eu *EnvUpdate) AddSnapshots(s ...*Snapshot) *EnvUpdate {
 	return eu.AddSnapshotIDs(ids...)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (eu *EnvUpdate) AddCheckpointIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.AddCheckpointIDs(ids...)
+	return eu
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (eu *EnvUpdate) AddCheckpoints(c ...*Checkpoint) *EnvUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (eu *EnvUpdate) Mutation() *EnvMutation {
 	return eu.mutation
@@ -329,6 +345,27 @@ func (eu *EnvUpdate) RemoveSnapshots(s ...*Snapshot) *EnvUpdate {
 	return eu.RemoveSnapshotIDs(ids...)
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (eu *EnvUpdate) ClearCheckpoints() *EnvUpdate {
+	eu.mutation.ClearCheckpoints()
+	return eu
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (eu *EnvUpdate) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.RemoveCheckpointIDs(ids...)
+	return eu
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (eu *EnvUpdate) RemoveCheckpoints(c ...*Checkpoint) *EnvUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.RemoveCheckpointIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EnvUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
@@ -618,6 +655,54 @@ func (eu *EnvUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !eu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = eu.schemaConfig.Env
 	ctx = internal.NewSchemaConfigContext(ctx, eu.schemaConfig)
 	_spec.AddModifiers(eu.modifiers...)
@@ -855,6 +940,21 @@ func (euo *EnvUpdateOne) AddSnapshots(s ...*Snapshot) *EnvUpdateOne {
 	return euo.AddSnapshotIDs(ids...)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (euo *EnvUpdateOne) AddCheckpointIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.AddCheckpointIDs(ids...)
+	return euo
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (euo *EnvUpdateOne) AddCheckpoints(c ...*Checkpoint) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (euo *EnvUpdateOne) Mutation() *EnvMutation {
 	return euo.mutation
@@ -935,6 +1035,27 @@ func (euo *EnvUpdateOne) RemoveSnapshots(s ...*Snapshot) *EnvUpdateOne {
 	return euo.RemoveSnapshotIDs(ids...)
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (euo *EnvUpdateOne) ClearCheckpoints() *EnvUpdateOne {
+	euo.mutation.ClearCheckpoints()
+	return euo
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (euo *EnvUpdateOne) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.RemoveCheckpointIDs(ids...)
+	return euo
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (euo *EnvUpdateOne) RemoveCheckpoints(c ...*Checkpoint) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.RemoveCheckpointIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvUpdate builder.
 func (euo *EnvUpdateOne) Where(ps ...predicate.Env) *EnvUpdateOne {
 	euo.mutation.Where(ps...)
@@ -1254,6 +1375,54 @@ func (euo *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !euo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CheckpointsTable,
+			Columns: []string{env.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = euo.schemaConfig.Env
 	ctx = internal.NewSchemaConfigContext(ctx, euo.schemaConfig)
 	_spec.AddModifiers(euo.modifiers...)
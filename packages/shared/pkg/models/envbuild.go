@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -51,6 +52,12 @@ type EnvBuild struct {
 	EnvdVersion *string `json:"envd_version,omitempty"`
 	// ClusterNodeID holds the value of the "cluster_node_id" field.
 	ClusterNodeID *string `json:"cluster_node_id,omitempty"`
+	// SourceBuildID holds the value of the "source_build_id" field.
+	SourceBuildID *uuid.UUID `json:"source_build_id,omitempty"`
+	// Datasets holds the value of the "datasets" field.
+	Datasets map[string]string `json:"datasets,omitempty"`
+	// MarkedForGcAt holds the value of the "marked_for_gc_at" field.
+	MarkedForGcAt *time.Time `json:"marked_for_gc_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvBuildQuery when eager-loading is set.
 	Edges        EnvBuildEdges `json:"edges"`
@@ -84,11 +91,15 @@ func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case envbuild.FieldSourceBuildID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case envbuild.FieldDatasets:
+			values[i] = new([]byte)
 		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB:
 			values[i] = new(sql.NullInt64)
 		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID:
 			values[i] = new(sql.NullString)
-		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt:
+		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt, envbuild.FieldMarkedForGcAt:
 			values[i] = new(sql.NullTime)
 		case envbuild.FieldID:
 			values[i] = new(uuid.UUID)
@@ -217,6 +228,28 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.ClusterNodeID = new(string)
 				*eb.ClusterNodeID = value.String
 			}
+		case envbuild.FieldSourceBuildID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field source_build_id", values[i])
+			} else if value.Valid {
+				eb.SourceBuildID = new(uuid.UUID)
+				*eb.SourceBuildID = *value.S.(*uuid.UUID)
+			}
+		case envbuild.FieldDatasets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field datasets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eb.Datasets); err != nil {
+					return fmt.Errorf("unmarshal field datasets: %w", err)
+				}
+			}
+		case envbuild.FieldMarkedForGcAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field marked_for_gc_at", values[i])
+			} else if value.Valid {
+				eb.MarkedForGcAt = new(time.Time)
+				*eb.MarkedForGcAt = value.Time
+			}
 		default:
 			eb.selectValues.Set(columns[i], values[i])
 		}
@@ -321,6 +354,19 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString("cluster_node_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := eb.SourceBuildID; v != nil {
+		builder.WriteString("source_build_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("datasets=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Datasets))
+	builder.WriteString(", ")
+	if v := eb.MarkedForGcAt; v != nil {
+		builder.WriteString("marked_for_gc_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -47,6 +47,12 @@ const (
 	FieldEnvdVersion = "envd_version"
 	// FieldClusterNodeID holds the string denoting the cluster_node_id field in the database.
 	FieldClusterNodeID = "cluster_node_id"
+	// FieldSourceBuildID holds the string denoting the source_build_id field in the database.
+	FieldSourceBuildID = "source_build_id"
+	// FieldDatasets holds the string denoting the datasets field in the database.
+	FieldDatasets = "datasets"
+	// FieldMarkedForGcAt holds the string denoting the marked_for_gc_at field in the database.
+	FieldMarkedForGcAt = "marked_for_gc_at"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the envbuild in the database.
@@ -79,6 +85,9 @@ var Columns = []string{
 	FieldFirecrackerVersion,
 	FieldEnvdVersion,
 	FieldClusterNodeID,
+	FieldSourceBuildID,
+	FieldDatasets,
+	FieldMarkedForGcAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -220,6 +229,16 @@ func ByClusterNodeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClusterNodeID, opts...).ToFunc()
 }
 
+// BySourceBuildID orders the results by the source_build_id field.
+func BySourceBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceBuildID, opts...).ToFunc()
+}
+
+// ByMarkedForGcAt orders the results by the marked_for_gc_at field.
+func ByMarkedForGcAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMarkedForGcAt, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
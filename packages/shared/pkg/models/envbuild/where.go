@@ -132,6 +132,16 @@ func ClusterNodeID(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldClusterNodeID, v))
 }
 
+// SourceBuildID applies equality check predicate on the "source_build_id" field. It's identical to SourceBuildIDEQ.
+func SourceBuildID(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSourceBuildID, v))
+}
+
+// MarkedForGcAt applies equality check predicate on the "marked_for_gc_at" field. It's identical to MarkedForGcAtEQ.
+func MarkedForGcAt(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldMarkedForGcAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldCreatedAt, v))
@@ -1032,6 +1042,116 @@ func ClusterNodeIDContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldClusterNodeID, v))
 }
 
+// SourceBuildIDEQ applies the EQ predicate on the "source_build_id" field.
+func SourceBuildIDEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDNEQ applies the NEQ predicate on the "source_build_id" field.
+func SourceBuildIDNEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDIn applies the In predicate on the "source_build_id" field.
+func SourceBuildIDIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldSourceBuildID, vs...))
+}
+
+// SourceBuildIDNotIn applies the NotIn predicate on the "source_build_id" field.
+func SourceBuildIDNotIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldSourceBuildID, vs...))
+}
+
+// SourceBuildIDGT applies the GT predicate on the "source_build_id" field.
+func SourceBuildIDGT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDGTE applies the GTE predicate on the "source_build_id" field.
+func SourceBuildIDGTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDLT applies the LT predicate on the "source_build_id" field.
+func SourceBuildIDLT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDLTE applies the LTE predicate on the "source_build_id" field.
+func SourceBuildIDLTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldSourceBuildID, v))
+}
+
+// SourceBuildIDIsNil applies the IsNil predicate on the "source_build_id" field.
+func SourceBuildIDIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldSourceBuildID))
+}
+
+// SourceBuildIDNotNil applies the NotNil predicate on the "source_build_id" field.
+func SourceBuildIDNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldSourceBuildID))
+}
+
+// DatasetsIsNil applies the IsNil predicate on the "datasets" field.
+func DatasetsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldDatasets))
+}
+
+// DatasetsNotNil applies the NotNil predicate on the "datasets" field.
+func DatasetsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldDatasets))
+}
+
+// MarkedForGcAtEQ applies the EQ predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtNEQ applies the NEQ predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtNEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtIn applies the In predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldMarkedForGcAt, vs...))
+}
+
+// MarkedForGcAtNotIn applies the NotIn predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtNotIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldMarkedForGcAt, vs...))
+}
+
+// MarkedForGcAtGT applies the GT predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtGT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtGTE applies the GTE predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtGTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtLT applies the LT predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtLT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtLTE applies the LTE predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtLTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldMarkedForGcAt, v))
+}
+
+// MarkedForGcAtIsNil applies the IsNil predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldMarkedForGcAt))
+}
+
+// MarkedForGcAtNotNil applies the NotNil predicate on the "marked_for_gc_at" field.
+func MarkedForGcAtNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldMarkedForGcAt))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.EnvBuild {
 	return predicate.EnvBuild(func(s *sql.Selector) {
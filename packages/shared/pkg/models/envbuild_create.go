@@ -225,6 +225,40 @@ func (ebc *EnvBuildCreate) SetNillableClusterNodeID(s *string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebc *EnvBuildCreate) SetSourceBuildID(u uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.SetSourceBuildID(u)
+	return ebc
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildCreate {
+	if u != nil {
+		ebc.SetSourceBuildID(*u)
+	}
+	return ebc
+}
+
+// SetDatasets sets the "datasets" field.
+func (ebc *EnvBuildCreate) SetDatasets(m map[string]string) *EnvBuildCreate {
+	ebc.mutation.SetDatasets(m)
+	return ebc
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (ebc *EnvBuildCreate) SetMarkedForGcAt(t time.Time) *EnvBuildCreate {
+	ebc.mutation.SetMarkedForGcAt(t)
+	return ebc
+}
+
+// SetNillableMarkedForGcAt sets the "marked_for_gc_at" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableMarkedForGcAt(t *time.Time) *EnvBuildCreate {
+	if t != nil {
+		ebc.SetMarkedForGcAt(*t)
+	}
+	return ebc
+}
+
 // SetID sets the "id" field.
 func (ebc *EnvBuildCreate) SetID(u uuid.UUID) *EnvBuildCreate {
 	ebc.mutation.SetID(u)
@@ -421,6 +455,18 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldClusterNodeID, field.TypeString, value)
 		_node.ClusterNodeID = &value
 	}
+	if value, ok := ebc.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+		_node.SourceBuildID = &value
+	}
+	if value, ok := ebc.mutation.Datasets(); ok {
+		_spec.SetField(envbuild.FieldDatasets, field.TypeJSON, value)
+		_node.Datasets = value
+	}
+	if value, ok := ebc.mutation.MarkedForGcAt(); ok {
+		_spec.SetField(envbuild.FieldMarkedForGcAt, field.TypeTime, value)
+		_node.MarkedForGcAt = &value
+	}
 	if nodes := ebc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -743,6 +789,60 @@ func (u *EnvBuildUpsert) ClearClusterNodeID() *EnvBuildUpsert {
 	return u
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsert) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsert {
+	u.Set(envbuild.FieldSourceBuildID, v)
+	return u
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateSourceBuildID() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldSourceBuildID)
+	return u
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsert) ClearSourceBuildID() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldSourceBuildID)
+	return u
+}
+
+// SetDatasets sets the "datasets" field.
+func (u *EnvBuildUpsert) SetDatasets(v map[string]string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldDatasets, v)
+	return u
+}
+
+// UpdateDatasets sets the "datasets" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateDatasets() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldDatasets)
+	return u
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (u *EnvBuildUpsert) ClearDatasets() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldDatasets)
+	return u
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (u *EnvBuildUpsert) SetMarkedForGcAt(v time.Time) *EnvBuildUpsert {
+	u.Set(envbuild.FieldMarkedForGcAt, v)
+	return u
+}
+
+// UpdateMarkedForGcAt sets the "marked_for_gc_at" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateMarkedForGcAt() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldMarkedForGcAt)
+	return u
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (u *EnvBuildUpsert) ClearMarkedForGcAt() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldMarkedForGcAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1088,6 +1188,69 @@ func (u *EnvBuildUpsertOne) ClearClusterNodeID() *EnvBuildUpsertOne {
 	})
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsertOne) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSourceBuildID(v)
+	})
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateSourceBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSourceBuildID()
+	})
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsertOne) ClearSourceBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSourceBuildID()
+	})
+}
+
+// SetDatasets sets the "datasets" field.
+func (u *EnvBuildUpsertOne) SetDatasets(v map[string]string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDatasets(v)
+	})
+}
+
+// UpdateDatasets sets the "datasets" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateDatasets() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDatasets()
+	})
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (u *EnvBuildUpsertOne) ClearDatasets() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDatasets()
+	})
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (u *EnvBuildUpsertOne) SetMarkedForGcAt(v time.Time) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetMarkedForGcAt(v)
+	})
+}
+
+// UpdateMarkedForGcAt sets the "marked_for_gc_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateMarkedForGcAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateMarkedForGcAt()
+	})
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (u *EnvBuildUpsertOne) ClearMarkedForGcAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearMarkedForGcAt()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1600,6 +1763,69 @@ func (u *EnvBuildUpsertBulk) ClearClusterNodeID() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (u *EnvBuildUpsertBulk) SetSourceBuildID(v uuid.UUID) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetSourceBuildID(v)
+	})
+}
+
+// UpdateSourceBuildID sets the "source_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateSourceBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateSourceBuildID()
+	})
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (u *EnvBuildUpsertBulk) ClearSourceBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearSourceBuildID()
+	})
+}
+
+// SetDatasets sets the "datasets" field.
+func (u *EnvBuildUpsertBulk) SetDatasets(v map[string]string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDatasets(v)
+	})
+}
+
+// UpdateDatasets sets the "datasets" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateDatasets() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDatasets()
+	})
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (u *EnvBuildUpsertBulk) ClearDatasets() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDatasets()
+	})
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (u *EnvBuildUpsertBulk) SetMarkedForGcAt(v time.Time) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetMarkedForGcAt(v)
+	})
+}
+
+// UpdateMarkedForGcAt sets the "marked_for_gc_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateMarkedForGcAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateMarkedForGcAt()
+	})
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (u *EnvBuildUpsertBulk) ClearMarkedForGcAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearMarkedForGcAt()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
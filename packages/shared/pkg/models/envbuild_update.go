@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
 )
 
 // EnvBuildUpdate is the builder for updating EnvBuild entities.
@@ -317,6 +318,58 @@ func (ebu *EnvBuildUpdate) ClearClusterNodeID() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebu *EnvBuildUpdate) SetSourceBuildID(u uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.SetSourceBuildID(u)
+	return ebu
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildUpdate {
+	if u != nil {
+		ebu.SetSourceBuildID(*u)
+	}
+	return ebu
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (ebu *EnvBuildUpdate) ClearSourceBuildID() *EnvBuildUpdate {
+	ebu.mutation.ClearSourceBuildID()
+	return ebu
+}
+
+// SetDatasets sets the "datasets" field.
+func (ebu *EnvBuildUpdate) SetDatasets(m map[string]string) *EnvBuildUpdate {
+	ebu.mutation.SetDatasets(m)
+	return ebu
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (ebu *EnvBuildUpdate) ClearDatasets() *EnvBuildUpdate {
+	ebu.mutation.ClearDatasets()
+	return ebu
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (ebu *EnvBuildUpdate) SetMarkedForGcAt(t time.Time) *EnvBuildUpdate {
+	ebu.mutation.SetMarkedForGcAt(t)
+	return ebu
+}
+
+// SetNillableMarkedForGcAt sets the "marked_for_gc_at" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableMarkedForGcAt(t *time.Time) *EnvBuildUpdate {
+	if t != nil {
+		ebu.SetMarkedForGcAt(*t)
+	}
+	return ebu
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (ebu *EnvBuildUpdate) ClearMarkedForGcAt() *EnvBuildUpdate {
+	ebu.mutation.ClearMarkedForGcAt()
+	return ebu
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebu *EnvBuildUpdate) SetEnv(e *Env) *EnvBuildUpdate {
 	return ebu.SetEnvID(e.ID)
@@ -463,6 +516,24 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.ClusterNodeIDCleared() {
 		_spec.ClearField(envbuild.FieldClusterNodeID, field.TypeString)
 	}
+	if value, ok := ebu.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+	}
+	if ebu.mutation.SourceBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldSourceBuildID, field.TypeUUID)
+	}
+	if value, ok := ebu.mutation.Datasets(); ok {
+		_spec.SetField(envbuild.FieldDatasets, field.TypeJSON, value)
+	}
+	if ebu.mutation.DatasetsCleared() {
+		_spec.ClearField(envbuild.FieldDatasets, field.TypeJSON)
+	}
+	if value, ok := ebu.mutation.MarkedForGcAt(); ok {
+		_spec.SetField(envbuild.FieldMarkedForGcAt, field.TypeTime, value)
+	}
+	if ebu.mutation.MarkedForGcAtCleared() {
+		_spec.ClearField(envbuild.FieldMarkedForGcAt, field.TypeTime)
+	}
 	if ebu.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -804,6 +875,58 @@ func (ebuo *EnvBuildUpdateOne) ClearClusterNodeID() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetSourceBuildID sets the "source_build_id" field.
+func (ebuo *EnvBuildUpdateOne) SetSourceBuildID(u uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.SetSourceBuildID(u)
+	return ebuo
+}
+
+// SetNillableSourceBuildID sets the "source_build_id" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableSourceBuildID(u *uuid.UUID) *EnvBuildUpdateOne {
+	if u != nil {
+		ebuo.SetSourceBuildID(*u)
+	}
+	return ebuo
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (ebuo *EnvBuildUpdateOne) ClearSourceBuildID() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearSourceBuildID()
+	return ebuo
+}
+
+// SetDatasets sets the "datasets" field.
+func (ebuo *EnvBuildUpdateOne) SetDatasets(m map[string]string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetDatasets(m)
+	return ebuo
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (ebuo *EnvBuildUpdateOne) ClearDatasets() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearDatasets()
+	return ebuo
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (ebuo *EnvBuildUpdateOne) SetMarkedForGcAt(t time.Time) *EnvBuildUpdateOne {
+	ebuo.mutation.SetMarkedForGcAt(t)
+	return ebuo
+}
+
+// SetNillableMarkedForGcAt sets the "marked_for_gc_at" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableMarkedForGcAt(t *time.Time) *EnvBuildUpdateOne {
+	if t != nil {
+		ebuo.SetMarkedForGcAt(*t)
+	}
+	return ebuo
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (ebuo *EnvBuildUpdateOne) ClearMarkedForGcAt() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearMarkedForGcAt()
+	return ebuo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebuo *EnvBuildUpdateOne) SetEnv(e *Env) *EnvBuildUpdateOne {
 	return ebuo.SetEnvID(e.ID)
@@ -980,6 +1103,24 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.ClusterNodeIDCleared() {
 		_spec.ClearField(envbuild.FieldClusterNodeID, field.TypeString)
 	}
+	if value, ok := ebuo.mutation.SourceBuildID(); ok {
+		_spec.SetField(envbuild.FieldSourceBuildID, field.TypeUUID, value)
+	}
+	if ebuo.mutation.SourceBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldSourceBuildID, field.TypeUUID)
+	}
+	if value, ok := ebuo.mutation.Datasets(); ok {
+		_spec.SetField(envbuild.FieldDatasets, field.TypeJSON, value)
+	}
+	if ebuo.mutation.DatasetsCleared() {
+		_spec.ClearField(envbuild.FieldDatasets, field.TypeJSON)
+	}
+	if value, ok := ebuo.mutation.MarkedForGcAt(); ok {
+		_spec.SetField(envbuild.FieldMarkedForGcAt, field.TypeTime, value)
+	}
+	if ebuo.mutation.MarkedForGcAtCleared() {
+		_spec.ClearField(envbuild.FieldMarkedForGcAt, field.TypeTime)
+	}
 	if ebuo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -21,6 +21,18 @@ func (f AccessTokenFunc) Mutate(ctx context.Context, m models.Mutation) (models.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.AccessTokenMutation", m)
 }
 
+// The CheckpointFunc type is an adapter to allow the use of ordinary
+// function as Checkpoint mutator.
+type CheckpointFunc func(context.Context, *models.CheckpointMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckpointFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.CheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.CheckpointMutation", m)
+}
+
 // The ClusterFunc type is an adapter to allow the use of ordinary
 // function as Cluster mutator.
 type ClusterFunc func(context.Context, *models.ClusterMutation) (models.Value, error)
@@ -141,6 +153,18 @@ func (f UsersTeamsFunc) Mutate(ctx context.Context, m models.Mutation) (models.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.UsersTeamsMutation", m)
 }
 
+// The VolumeFunc type is an adapter to allow the use of ordinary
+// function as Volume mutator.
+type VolumeFunc func(context.Context, *models.VolumeMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VolumeFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.VolumeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.VolumeMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, models.Mutation) bool
 
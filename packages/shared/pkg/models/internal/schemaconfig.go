@@ -8,6 +8,7 @@ import "context"
 // that can be passed at runtime.
 type SchemaConfig struct {
 	AccessToken string // AccessToken table.
+	Checkpoint  string // Checkpoint table.
 	Cluster     string // Cluster table.
 	Env         string // Env table.
 	EnvAlias    string // EnvAlias table.
@@ -18,6 +19,7 @@ type SchemaConfig struct {
 	Tier        string // Tier table.
 	User        string // User table.
 	UsersTeams  string // UsersTeams table.
+	Volume      string // Volume table.
 }
 
 type schemaCtxKey struct{}
@@ -36,6 +36,40 @@ var (
 			},
 		},
 	}
+	// CheckpointsColumns holds the columns for the "checkpoints" table.
+	CheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "base_env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "sandbox_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "metadata", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "sandbox_started_at", Type: field.TypeTime},
+		{Name: "env_secure", Type: field.TypeBool, Default: false},
+		{Name: "network", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// CheckpointsTable holds the schema information for the "checkpoints" table.
+	CheckpointsTable = &schema.Table{
+		Name:       "checkpoints",
+		Columns:    CheckpointsColumns,
+		PrimaryKey: []*schema.Column{CheckpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checkpoints_envs_checkpoints",
+				Columns:    []*schema.Column{CheckpointsColumns[9]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkpoint_sandbox_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CheckpointsColumns[4], CheckpointsColumns[2]},
+			},
+		},
+	}
 	// ClustersColumns holds the columns for the "clusters" table.
 	ClustersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
@@ -120,6 +154,9 @@ var (
 		{Name: "firecracker_version", Type: field.TypeString, Default: "v1.10.1_1fcdaec", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "envd_version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "cluster_node_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "source_build_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "datasets", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "marked_for_gc_at", Type: field.TypeTime, Nullable: true},
 		{Name: "env_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// EnvBuildsTable holds the schema information for the "env_builds" table.
@@ -130,7 +167,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "env_builds_envs_builds",
-				Columns:    []*schema.Column{EnvBuildsColumns[16]},
+				Columns:    []*schema.Column{EnvBuildsColumns[19]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -145,6 +182,7 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "sandbox_started_at", Type: field.TypeTime},
 		{Name: "env_secure", Type: field.TypeBool, Default: false},
+		{Name: "network", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// SnapshotsTable holds the schema information for the "snapshots" table.
@@ -155,7 +193,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "snapshots_envs_snapshots",
-				Columns:    []*schema.Column{SnapshotsColumns[7]},
+				Columns:    []*schema.Column{SnapshotsColumns[8]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -230,6 +268,9 @@ var (
 		{Name: "disk_mb", Type: field.TypeInt64, Default: "512"},
 		{Name: "concurrent_instances", Type: field.TypeInt64, Comment: "The number of instances the team can run concurrently"},
 		{Name: "max_length_hours", Type: field.TypeInt64},
+		{Name: "disk_bandwidth_mb", Type: field.TypeInt64, Comment: "The rootfs disk bandwidth limit of the team sandboxes in MB/s, 0 means unlimited", Default: "0"},
+		{Name: "disk_iops", Type: field.TypeInt64, Comment: "The rootfs disk IOPS limit of the team sandboxes, 0 means unlimited", Default: "0"},
+		{Name: "network_bandwidth_mb", Type: field.TypeInt64, Comment: "The network bandwidth limit of the team sandboxes in MB/s for each direction, 0 means unlimited", Default: "0"},
 	}
 	// TiersTable holds the schema information for the "tiers" table.
 	TiersTable = &schema.Table{
@@ -282,9 +323,42 @@ var (
 			},
 		},
 	}
+	// VolumesColumns holds the columns for the "volumes" table.
+	VolumesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "size_mb", Type: field.TypeInt64},
+		{Name: "generation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "sandbox_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "next_generation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "team_id", Type: field.TypeUUID},
+	}
+	// VolumesTable holds the schema information for the "volumes" table.
+	VolumesTable = &schema.Table{
+		Name:       "volumes",
+		Columns:    VolumesColumns,
+		PrimaryKey: []*schema.Column{VolumesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "volumes_teams_volumes",
+				Columns:    []*schema.Column{VolumesColumns[7]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "volume_team_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{VolumesColumns[7], VolumesColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccessTokensTable,
+		CheckpointsTable,
 		ClustersTable,
 		EnvsTable,
 		EnvAliasesTable,
@@ -295,12 +369,15 @@ var (
 		TiersTable,
 		UsersTable,
 		UsersTeamsTable,
+		VolumesTable,
 	}
 )
 
 func init() {
 	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AccessTokensTable.Annotation = &entsql.Annotation{}
+	CheckpointsTable.ForeignKeys[0].RefTable = EnvsTable
+	CheckpointsTable.Annotation = &entsql.Annotation{}
 	ClustersTable.Annotation = &entsql.Annotation{}
 	EnvsTable.ForeignKeys[0].RefTable = TeamsTable
 	EnvsTable.ForeignKeys[1].RefTable = UsersTable
@@ -327,4 +404,6 @@ func init() {
 	UsersTeamsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTeamsTable.ForeignKeys[1].RefTable = TeamsTable
 	UsersTeamsTable.Annotation = &entsql.Annotation{}
+	VolumesTable.ForeignKeys[0].RefTable = TeamsTable
+	VolumesTable.Annotation = &entsql.Annotation{}
 }
@@ -11,7 +11,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
@@ -23,6 +25,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/google/uuid"
 )
 
@@ -36,6 +39,7 @@ const (
 
 	// Node types.
 	TypeAccessToken = "AccessToken"
+	TypeCheckpoint  = "Checkpoint"
 	TypeCluster     = "Cluster"
 	TypeEnv         = "Env"
 	TypeEnvAlias    = "EnvAlias"
@@ -46,6 +50,7 @@ const (
 	TypeTier        = "Tier"
 	TypeUser        = "User"
 	TypeUsersTeams  = "UsersTeams"
+	TypeVolume      = "Volume"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -924,32 +929,39 @@ func (m *AccessTokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// ClusterMutation represents an operation that mutates the Cluster nodes in the graph.
-type ClusterMutation struct {
+// CheckpointMutation represents an operation that mutates the Checkpoint nodes in the graph.
+type CheckpointMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	endpoint      *string
-	endpoint_tls  *bool
-	token         *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Cluster, error)
-	predicates    []predicate.Cluster
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	name               *string
+	base_env_id        *string
+	sandbox_id         *string
+	metadata           *map[string]string
+	sandbox_started_at *time.Time
+	env_secure         *bool
+	network            **types.SandboxNetworkConfig
+	clearedFields      map[string]struct{}
+	env                *string
+	clearedenv         bool
+	done               bool
+	oldValue           func(context.Context) (*Checkpoint, error)
+	predicates         []predicate.Checkpoint
 }
 
-var _ ent.Mutation = (*ClusterMutation)(nil)
+var _ ent.Mutation = (*CheckpointMutation)(nil)
 
-// clusterOption allows management of the mutation configuration using functional options.
-type clusterOption func(*ClusterMutation)
+// checkpointOption allows management of the mutation configuration using functional options.
+type checkpointOption func(*CheckpointMutation)
 
-// newClusterMutation creates new mutation for the Cluster entity.
-func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation {
-	m := &ClusterMutation{
+// newCheckpointMutation creates new mutation for the Checkpoint entity.
+func newCheckpointMutation(c config, op Op, opts ...checkpointOption) *CheckpointMutation {
+	m := &CheckpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCluster,
+		typ:           TypeCheckpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -958,20 +970,20 @@ func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation
 	return m
 }
 
-// withClusterID sets the ID field of the mutation.
-func withClusterID(id uuid.UUID) clusterOption {
-	return func(m *ClusterMutation) {
+// withCheckpointID sets the ID field of the mutation.
+func withCheckpointID(id uuid.UUID) checkpointOption {
+	return func(m *CheckpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Cluster
+			value *Checkpoint
 		)
-		m.oldValue = func(ctx context.Context) (*Cluster, error) {
+		m.oldValue = func(ctx context.Context) (*Checkpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Cluster.Get(ctx, id)
+					value, err = m.Client().Checkpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -980,10 +992,10 @@ func withClusterID(id uuid.UUID) clusterOption {
 	}
 }
 
-// withCluster sets the old Cluster of the mutation.
-func withCluster(node *Cluster) clusterOption {
-	return func(m *ClusterMutation) {
-		m.oldValue = func(context.Context) (*Cluster, error) {
+// withCheckpoint sets the old Checkpoint of the mutation.
+func withCheckpoint(node *Checkpoint) checkpointOption {
+	return func(m *CheckpointMutation) {
+		m.oldValue = func(context.Context) (*Checkpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -992,7 +1004,7 @@ func withCluster(node *Cluster) clusterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClusterMutation) Client() *Client {
+func (m CheckpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1000,7 +1012,7 @@ func (m ClusterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClusterMutation) Tx() (*Tx, error) {
+func (m CheckpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -1010,14 +1022,14 @@ func (m ClusterMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Cluster entities.
-func (m *ClusterMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Checkpoint entities.
+func (m *CheckpointMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CheckpointMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1028,7 +1040,7 @@ func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CheckpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1037,378 +1049,752 @@ func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Cluster.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Checkpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEndpoint sets the "endpoint" field.
-func (m *ClusterMutation) SetEndpoint(s string) {
-	m.endpoint = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CheckpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *ClusterMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CheckpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpoint returns the old "endpoint" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Endpoint, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *ClusterMutation) ResetEndpoint() {
-	m.endpoint = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CheckpointMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEndpointTLS sets the "endpoint_tls" field.
-func (m *ClusterMutation) SetEndpointTLS(b bool) {
-	m.endpoint_tls = &b
+// SetName sets the "name" field.
+func (m *CheckpointMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EndpointTLS returns the value of the "endpoint_tls" field in the mutation.
-func (m *ClusterMutation) EndpointTLS() (r bool, exists bool) {
-	v := m.endpoint_tls
+// Name returns the value of the "name" field in the mutation.
+func (m *CheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpointTLS returns the old "endpoint_tls" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldEndpointTLS(ctx context.Context) (v bool, err error) {
+func (m *CheckpointMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpointTLS is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpointTLS requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpointTLS: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.EndpointTLS, nil
+	return oldValue.Name, nil
 }
 
-// ResetEndpointTLS resets all changes to the "endpoint_tls" field.
-func (m *ClusterMutation) ResetEndpointTLS() {
-	m.endpoint_tls = nil
+// ResetName resets all changes to the "name" field.
+func (m *CheckpointMutation) ResetName() {
+	m.name = nil
 }
 
-// SetToken sets the "token" field.
-func (m *ClusterMutation) SetToken(s string) {
-	m.token = &s
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *CheckpointMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *ClusterMutation) Token() (r string, exists bool) {
-	v := m.token
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *CheckpointMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldBaseEnvID returns the old "base_env_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.BaseEnvID, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *ClusterMutation) ResetToken() {
-	m.token = nil
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *CheckpointMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
 }
 
-// Where appends a list predicates to the ClusterMutation builder.
-func (m *ClusterMutation) Where(ps ...predicate.Cluster) {
-	m.predicates = append(m.predicates, ps...)
+// SetEnvID sets the "env_id" field.
+func (m *CheckpointMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// WhereP appends storage-level predicates to the ClusterMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClusterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Cluster, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *CheckpointMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ClusterMutation) Op() Op {
-	return m.op
+// OldEnvID returns the old "env_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ClusterMutation) SetOp(op Op) {
-	m.op = op
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *CheckpointMutation) ResetEnvID() {
+	m.env = nil
 }
 
-// Type returns the node type of this mutation (Cluster).
-func (m *ClusterMutation) Type() string {
-	return m.typ
+// SetSandboxID sets the "sandbox_id" field.
+func (m *CheckpointMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ClusterMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.endpoint != nil {
-		fields = append(fields, cluster.FieldEndpoint)
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *CheckpointMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
 	}
-	if m.endpoint_tls != nil {
-		fields = append(fields, cluster.FieldEndpointTLS)
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
 	}
-	if m.token != nil {
-		fields = append(fields, cluster.FieldToken)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ClusterMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case cluster.FieldEndpoint:
-		return m.Endpoint()
-	case cluster.FieldEndpointTLS:
-		return m.EndpointTLS()
-	case cluster.FieldToken:
-		return m.Token()
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *CheckpointMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *CheckpointMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *CheckpointMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case cluster.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case cluster.FieldEndpointTLS:
-		return m.OldEndpointTLS(ctx)
-	case cluster.FieldToken:
-		return m.OldToken(ctx)
+// OldMetadata returns the old "metadata" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Cluster field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ClusterMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case cluster.FieldEndpoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndpoint(v)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *CheckpointMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// SetSandboxStartedAt sets the "sandbox_started_at" field.
+func (m *CheckpointMutation) SetSandboxStartedAt(t time.Time) {
+	m.sandbox_started_at = &t
+}
+
+// SandboxStartedAt returns the value of the "sandbox_started_at" field in the mutation.
+func (m *CheckpointMutation) SandboxStartedAt() (r time.Time, exists bool) {
+	v := m.sandbox_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxStartedAt returns the old "sandbox_started_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldSandboxStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxStartedAt: %w", err)
+	}
+	return oldValue.SandboxStartedAt, nil
+}
+
+// ResetSandboxStartedAt resets all changes to the "sandbox_started_at" field.
+func (m *CheckpointMutation) ResetSandboxStartedAt() {
+	m.sandbox_started_at = nil
+}
+
+// SetEnvSecure sets the "env_secure" field.
+func (m *CheckpointMutation) SetEnvSecure(b bool) {
+	m.env_secure = &b
+}
+
+// EnvSecure returns the value of the "env_secure" field in the mutation.
+func (m *CheckpointMutation) EnvSecure() (r bool, exists bool) {
+	v := m.env_secure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvSecure returns the old "env_secure" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldEnvSecure(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvSecure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvSecure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvSecure: %w", err)
+	}
+	return oldValue.EnvSecure, nil
+}
+
+// ResetEnvSecure resets all changes to the "env_secure" field.
+func (m *CheckpointMutation) ResetEnvSecure() {
+	m.env_secure = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *CheckpointMutation) SetNetwork(tnc *types.SandboxNetworkConfig) {
+	m.network = &tnc
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *CheckpointMutation) Network() (r *types.SandboxNetworkConfig, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldNetwork(ctx context.Context) (v *types.SandboxNetworkConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (m *CheckpointMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[checkpoint.FieldNetwork] = struct{}{}
+}
+
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *CheckpointMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldNetwork]
+	return ok
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *CheckpointMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, checkpoint.FieldNetwork)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *CheckpointMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[checkpoint.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *CheckpointMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *CheckpointMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *CheckpointMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the CheckpointMutation builder.
+func (m *CheckpointMutation) Where(ps ...predicate.Checkpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CheckpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checkpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CheckpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CheckpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Checkpoint).
+func (m *CheckpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, checkpoint.FieldCreatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, checkpoint.FieldName)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, checkpoint.FieldBaseEnvID)
+	}
+	if m.env != nil {
+		fields = append(fields, checkpoint.FieldEnvID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, checkpoint.FieldSandboxID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, checkpoint.FieldMetadata)
+	}
+	if m.sandbox_started_at != nil {
+		fields = append(fields, checkpoint.FieldSandboxStartedAt)
+	}
+	if m.env_secure != nil {
+		fields = append(fields, checkpoint.FieldEnvSecure)
+	}
+	if m.network != nil {
+		fields = append(fields, checkpoint.FieldNetwork)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CheckpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		return m.CreatedAt()
+	case checkpoint.FieldName:
+		return m.Name()
+	case checkpoint.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case checkpoint.FieldEnvID:
+		return m.EnvID()
+	case checkpoint.FieldSandboxID:
+		return m.SandboxID()
+	case checkpoint.FieldMetadata:
+		return m.Metadata()
+	case checkpoint.FieldSandboxStartedAt:
+		return m.SandboxStartedAt()
+	case checkpoint.FieldEnvSecure:
+		return m.EnvSecure()
+	case checkpoint.FieldNetwork:
+		return m.Network()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case checkpoint.FieldName:
+		return m.OldName(ctx)
+	case checkpoint.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case checkpoint.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case checkpoint.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case checkpoint.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case checkpoint.FieldSandboxStartedAt:
+		return m.OldSandboxStartedAt(ctx)
+	case checkpoint.FieldEnvSecure:
+		return m.OldEnvSecure(ctx)
+	case checkpoint.FieldNetwork:
+		return m.OldNetwork(ctx)
+	}
+	return nil, fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
-	case cluster.FieldEndpointTLS:
-		v, ok := value.(bool)
+	case checkpoint.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpointTLS(v)
+		m.SetName(v)
 		return nil
-	case cluster.FieldToken:
+	case checkpoint.FieldBaseEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetBaseEnvID(v)
+		return nil
+	case checkpoint.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case checkpoint.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case checkpoint.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case checkpoint.FieldSandboxStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxStartedAt(v)
+		return nil
+	case checkpoint.FieldEnvSecure:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvSecure(v)
+		return nil
+	case checkpoint.FieldNetwork:
+		v, ok := value.(*types.SandboxNetworkConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Cluster field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ClusterMutation) AddedFields() []string {
+func (m *CheckpointMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ClusterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CheckpointMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClusterMutation) AddField(name string, value ent.Value) error {
+func (m *CheckpointMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Cluster numeric field %s", name)
+	return fmt.Errorf("unknown Checkpoint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ClusterMutation) ClearedFields() []string {
-	return nil
+func (m *CheckpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checkpoint.FieldNetwork) {
+		fields = append(fields, checkpoint.FieldNetwork)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ClusterMutation) FieldCleared(name string) bool {
+func (m *CheckpointMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ClusterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Cluster nullable field %s", name)
+func (m *CheckpointMutation) ClearField(name string) error {
+	switch name {
+	case checkpoint.FieldNetwork:
+		m.ClearNetwork()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ClusterMutation) ResetField(name string) error {
+func (m *CheckpointMutation) ResetField(name string) error {
 	switch name {
-	case cluster.FieldEndpoint:
-		m.ResetEndpoint()
+	case checkpoint.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case cluster.FieldEndpointTLS:
-		m.ResetEndpointTLS()
+	case checkpoint.FieldName:
+		m.ResetName()
 		return nil
-	case cluster.FieldToken:
-		m.ResetToken()
+	case checkpoint.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case checkpoint.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case checkpoint.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case checkpoint.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case checkpoint.FieldSandboxStartedAt:
+		m.ResetSandboxStartedAt()
+		return nil
+	case checkpoint.FieldEnvSecure:
+		m.ResetEnvSecure()
+		return nil
+	case checkpoint.FieldNetwork:
+		m.ResetNetwork()
 		return nil
 	}
-	return fmt.Errorf("unknown Cluster field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ClusterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *CheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, checkpoint.EdgeEnv)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ClusterMutation) AddedIDs(name string) []ent.Value {
+func (m *CheckpointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checkpoint.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ClusterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *CheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ClusterMutation) RemovedIDs(name string) []ent.Value {
+func (m *CheckpointMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ClusterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *CheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, checkpoint.EdgeEnv)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ClusterMutation) EdgeCleared(name string) bool {
+func (m *CheckpointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checkpoint.EdgeEnv:
+		return m.clearedenv
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ClusterMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Cluster unique edge %s", name)
+func (m *CheckpointMutation) ClearEdge(name string) error {
+	switch name {
+	case checkpoint.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ClusterMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Cluster edge %s", name)
+func (m *CheckpointMutation) ResetEdge(name string) error {
+	switch name {
+	case checkpoint.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint edge %s", name)
 }
 
-// EnvMutation represents an operation that mutates the Env nodes in the graph.
-type EnvMutation struct {
+// ClusterMutation represents an operation that mutates the Cluster nodes in the graph.
+type ClusterMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	public             *bool
-	build_count        *int32
-	addbuild_count     *int32
-	spawn_count        *int64
-	addspawn_count     *int64
-	last_spawned_at    *time.Time
-	cluster_id         *uuid.UUID
-	clearedFields      map[string]struct{}
-	team               *uuid.UUID
-	clearedteam        bool
-	creator            *uuid.UUID
-	clearedcreator     bool
-	env_aliases        map[string]struct{}
-	removedenv_aliases map[string]struct{}
-	clearedenv_aliases bool
-	builds             map[uuid.UUID]struct{}
-	removedbuilds      map[uuid.UUID]struct{}
-	clearedbuilds      bool
-	snapshots          map[uuid.UUID]struct{}
-	removedsnapshots   map[uuid.UUID]struct{}
-	clearedsnapshots   bool
-	done               bool
-	oldValue           func(context.Context) (*Env, error)
-	predicates         []predicate.Env
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	endpoint      *string
+	endpoint_tls  *bool
+	token         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Cluster, error)
+	predicates    []predicate.Cluster
 }
 
-var _ ent.Mutation = (*EnvMutation)(nil)
+var _ ent.Mutation = (*ClusterMutation)(nil)
 
-// envOption allows management of the mutation configuration using functional options.
-type envOption func(*EnvMutation)
+// clusterOption allows management of the mutation configuration using functional options.
+type clusterOption func(*ClusterMutation)
 
-// newEnvMutation creates new mutation for the Env entity.
-func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
-	m := &EnvMutation{
+// newClusterMutation creates new mutation for the Cluster entity.
+func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation {
+	m := &ClusterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnv,
+		typ:           TypeCluster,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1417,20 +1803,20 @@ func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
 	return m
 }
 
-// withEnvID sets the ID field of the mutation.
-func withEnvID(id string) envOption {
-	return func(m *EnvMutation) {
+// withClusterID sets the ID field of the mutation.
+func withClusterID(id uuid.UUID) clusterOption {
+	return func(m *ClusterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Env
+			value *Cluster
 		)
-		m.oldValue = func(ctx context.Context) (*Env, error) {
+		m.oldValue = func(ctx context.Context) (*Cluster, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Env.Get(ctx, id)
+					value, err = m.Client().Cluster.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1439,10 +1825,10 @@ func withEnvID(id string) envOption {
 	}
 }
 
-// withEnv sets the old Env of the mutation.
-func withEnv(node *Env) envOption {
-	return func(m *EnvMutation) {
-		m.oldValue = func(context.Context) (*Env, error) {
+// withCluster sets the old Cluster of the mutation.
+func withCluster(node *Cluster) clusterOption {
+	return func(m *ClusterMutation) {
+		m.oldValue = func(context.Context) (*Cluster, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1451,7 +1837,7 @@ func withEnv(node *Env) envOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvMutation) Client() *Client {
+func (m ClusterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1459,7 +1845,7 @@ func (m EnvMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvMutation) Tx() (*Tx, error) {
+func (m ClusterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -1469,14 +1855,14 @@ func (m EnvMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Env entities.
-func (m *EnvMutation) SetID(id string) {
+// operation is only accepted on creation of Cluster entities.
+func (m *ClusterMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvMutation) ID() (id string, exists bool) {
+func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1487,1367 +1873,1178 @@ func (m *EnvMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Cluster.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EnvMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEndpoint sets the "endpoint" field.
+func (m *ClusterMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *ClusterMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ClusterMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *ClusterMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEndpointTLS sets the "endpoint_tls" field.
+func (m *ClusterMutation) SetEndpointTLS(b bool) {
+	m.endpoint_tls = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// EndpointTLS returns the value of the "endpoint_tls" field in the mutation.
+func (m *ClusterMutation) EndpointTLS() (r bool, exists bool) {
+	v := m.endpoint_tls
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpointTLS returns the old "endpoint_tls" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ClusterMutation) OldEndpointTLS(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpointTLS is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEndpointTLS requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpointTLS: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.EndpointTLS, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetEndpointTLS resets all changes to the "endpoint_tls" field.
+func (m *ClusterMutation) ResetEndpointTLS() {
+	m.endpoint_tls = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *EnvMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetToken sets the "token" field.
+func (m *ClusterMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// Token returns the value of the "token" field in the mutation.
+func (m *ClusterMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ClusterMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.Token, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *EnvMutation) ResetTeamID() {
-	m.team = nil
+// ResetToken resets all changes to the "token" field.
+func (m *ClusterMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// Where appends a list predicates to the ClusterMutation builder.
+func (m *ClusterMutation) Where(ps ...predicate.Cluster) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ClusterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClusterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Cluster, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
-	}
-	return oldValue.CreatedBy, nil
+// Op returns the operation name.
+func (m *ClusterMutation) Op() Op {
+	return m.op
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EnvMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[env.FieldCreatedBy] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *ClusterMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EnvMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[env.FieldCreatedBy]
-	return ok
+// Type returns the node type of this mutation (Cluster).
+func (m *ClusterMutation) Type() string {
+	return m.typ
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EnvMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, env.FieldCreatedBy)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClusterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.endpoint != nil {
+		fields = append(fields, cluster.FieldEndpoint)
+	}
+	if m.endpoint_tls != nil {
+		fields = append(fields, cluster.FieldEndpointTLS)
+	}
+	if m.token != nil {
+		fields = append(fields, cluster.FieldToken)
+	}
+	return fields
 }
 
-// SetPublic sets the "public" field.
-func (m *EnvMutation) SetPublic(b bool) {
-	m.public = &b
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClusterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cluster.FieldEndpoint:
+		return m.Endpoint()
+	case cluster.FieldEndpointTLS:
+		return m.EndpointTLS()
+	case cluster.FieldToken:
+		return m.Token()
+	}
+	return nil, false
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *EnvMutation) Public() (r bool, exists bool) {
-	v := m.public
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cluster.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case cluster.FieldEndpointTLS:
+		return m.OldEndpointTLS(ctx)
+	case cluster.FieldToken:
+		return m.OldToken(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Cluster field %s", name)
 }
 
-// OldPublic returns the old "public" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClusterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cluster.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case cluster.FieldEndpointTLS:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpointTLS(v)
+		return nil
+	case cluster.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
 	}
-	return oldValue.Public, nil
+	return fmt.Errorf("unknown Cluster field %s", name)
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *EnvMutation) ResetPublic() {
-	m.public = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClusterMutation) AddedFields() []string {
+	return nil
 }
 
-// SetBuildCount sets the "build_count" field.
-func (m *EnvMutation) SetBuildCount(i int32) {
-	m.build_count = &i
-	m.addbuild_count = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClusterMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// BuildCount returns the value of the "build_count" field in the mutation.
-func (m *EnvMutation) BuildCount() (r int32, exists bool) {
-	v := m.build_count
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClusterMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Cluster numeric field %s", name)
 }
 
-// OldBuildCount returns the old "build_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuildCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
-	}
-	return oldValue.BuildCount, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClusterMutation) ClearedFields() []string {
+	return nil
 }
 
-// AddBuildCount adds i to the "build_count" field.
-func (m *EnvMutation) AddBuildCount(i int32) {
-	if m.addbuild_count != nil {
-		*m.addbuild_count += i
-	} else {
-		m.addbuild_count = &i
-	}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClusterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
-func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
-	v := m.addbuild_count
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClusterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Cluster nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClusterMutation) ResetField(name string) error {
+	switch name {
+	case cluster.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case cluster.FieldEndpointTLS:
+		m.ResetEndpointTLS()
+		return nil
+	case cluster.FieldToken:
+		m.ResetToken()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Cluster field %s", name)
 }
 
-// ResetBuildCount resets all changes to the "build_count" field.
-func (m *EnvMutation) ResetBuildCount() {
-	m.build_count = nil
-	m.addbuild_count = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClusterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetSpawnCount sets the "spawn_count" field.
-func (m *EnvMutation) SetSpawnCount(i int64) {
-	m.spawn_count = &i
-	m.addspawn_count = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClusterMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SpawnCount returns the value of the "spawn_count" field in the mutation.
-func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
-	v := m.spawn_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClusterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClusterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClusterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClusterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClusterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Cluster unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClusterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Cluster edge %s", name)
+}
+
+// EnvMutation represents an operation that mutates the Env nodes in the graph.
+type EnvMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	public             *bool
+	build_count        *int32
+	addbuild_count     *int32
+	spawn_count        *int64
+	addspawn_count     *int64
+	last_spawned_at    *time.Time
+	cluster_id         *uuid.UUID
+	clearedFields      map[string]struct{}
+	team               *uuid.UUID
+	clearedteam        bool
+	creator            *uuid.UUID
+	clearedcreator     bool
+	env_aliases        map[string]struct{}
+	removedenv_aliases map[string]struct{}
+	clearedenv_aliases bool
+	builds             map[uuid.UUID]struct{}
+	removedbuilds      map[uuid.UUID]struct{}
+	clearedbuilds      bool
+	snapshots          map[uuid.UUID]struct{}
+	removedsnapshots   map[uuid.UUID]struct{}
+	clearedsnapshots   bool
+	checkpoints        map[uuid.UUID]struct{}
+	removedcheckpoints map[uuid.UUID]struct{}
+	clearedcheckpoints bool
+	done               bool
+	oldValue           func(context.Context) (*Env, error)
+	predicates         []predicate.Env
+}
+
+var _ ent.Mutation = (*EnvMutation)(nil)
+
+// envOption allows management of the mutation configuration using functional options.
+type envOption func(*EnvMutation)
+
+// newEnvMutation creates new mutation for the Env entity.
+func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
+	m := &EnvMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnv,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.SpawnCount, nil
+	return m
 }
 
-// AddSpawnCount adds i to the "spawn_count" field.
-func (m *EnvMutation) AddSpawnCount(i int64) {
-	if m.addspawn_count != nil {
-		*m.addspawn_count += i
-	} else {
-		m.addspawn_count = &i
+// withEnvID sets the ID field of the mutation.
+func withEnvID(id string) envOption {
+	return func(m *EnvMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Env
+		)
+		m.oldValue = func(ctx context.Context) (*Env, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Env.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
-func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
-	v := m.addspawn_count
-	if v == nil {
+// withEnv sets the old Env of the mutation.
+func withEnv(node *Env) envOption {
+	return func(m *EnvMutation) {
+		m.oldValue = func(context.Context) (*Env, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Env entities.
+func (m *EnvMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetSpawnCount resets all changes to the "spawn_count" field.
-func (m *EnvMutation) ResetSpawnCount() {
-	m.spawn_count = nil
-	m.addspawn_count = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLastSpawnedAt sets the "last_spawned_at" field.
-func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
-	m.last_spawned_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
-func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
-	v := m.last_spawned_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
+// OldCreatedAt returns the old "created_at" field's value of the Env entity.
 // If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LastSpawnedAt, nil
-}
-
-// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
-func (m *EnvMutation) ClearLastSpawnedAt() {
-	m.last_spawned_at = nil
-	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
-}
-
-// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
-func (m *EnvMutation) LastSpawnedAtCleared() bool {
-	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
-func (m *EnvMutation) ResetLastSpawnedAt() {
-	m.last_spawned_at = nil
-	delete(m.clearedFields, env.FieldLastSpawnedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetClusterID sets the "cluster_id" field.
-func (m *EnvMutation) SetClusterID(u uuid.UUID) {
-	m.cluster_id = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClusterID returns the value of the "cluster_id" field in the mutation.
-func (m *EnvMutation) ClusterID() (r uuid.UUID, exists bool) {
-	v := m.cluster_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClusterID returns the old "cluster_id" field's value of the Env entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
 // If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ClusterID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearClusterID clears the value of the "cluster_id" field.
-func (m *EnvMutation) ClearClusterID() {
-	m.cluster_id = nil
-	m.clearedFields[env.FieldClusterID] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
-func (m *EnvMutation) ClusterIDCleared() bool {
-	_, ok := m.clearedFields[env.FieldClusterID]
-	return ok
+// SetTeamID sets the "team_id" field.
+func (m *EnvMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// ResetClusterID resets all changes to the "cluster_id" field.
-func (m *EnvMutation) ResetClusterID() {
-	m.cluster_id = nil
-	delete(m.clearedFields, env.FieldClusterID)
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *EnvMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[env.FieldTeamID] = struct{}{}
+// OldTeamID returns the old "team_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *EnvMutation) TeamCleared() bool {
-	return m.clearedteam
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *EnvMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetCreatedBy sets the "created_by" field.
+func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *EnvMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// OldCreatedBy returns the old "created_by" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *EnvMutation) ClearCreator() {
-	m.clearedcreator = true
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EnvMutation) ClearCreatedBy() {
+	m.creator = nil
 	m.clearedFields[env.FieldCreatedBy] = struct{}{}
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *EnvMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EnvMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[env.FieldCreatedBy]
+	return ok
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
-	}
-	return
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EnvMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, env.FieldCreatedBy)
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetPublic sets the "public" field.
+func (m *EnvMutation) SetPublic(b bool) {
+	m.public = &b
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *EnvMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// Public returns the value of the "public" field in the mutation.
+func (m *EnvMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
-func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
-	if m.env_aliases == nil {
-		m.env_aliases = make(map[string]struct{})
+// OldPublic returns the old "public" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.env_aliases[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
 	}
+	return oldValue.Public, nil
 }
 
-// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) ClearEnvAliases() {
-	m.clearedenv_aliases = true
+// ResetPublic resets all changes to the "public" field.
+func (m *EnvMutation) ResetPublic() {
+	m.public = nil
 }
 
-// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
-func (m *EnvMutation) EnvAliasesCleared() bool {
-	return m.clearedenv_aliases
+// SetBuildCount sets the "build_count" field.
+func (m *EnvMutation) SetBuildCount(i int32) {
+	m.build_count = &i
+	m.addbuild_count = nil
 }
 
-// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
-func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
-	if m.removedenv_aliases == nil {
-		m.removedenv_aliases = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.env_aliases, ids[i])
-		m.removedenv_aliases[ids[i]] = struct{}{}
+// BuildCount returns the value of the "build_count" field in the mutation.
+func (m *EnvMutation) BuildCount() (r int32, exists bool) {
+	v := m.build_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
-	for id := range m.removedenv_aliases {
-		ids = append(ids, id)
+// OldBuildCount returns the old "build_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
-func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
-	for id := range m.env_aliases {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildCount requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
+	}
+	return oldValue.BuildCount, nil
 }
 
-// ResetEnvAliases resets all changes to the "env_aliases" edge.
-func (m *EnvMutation) ResetEnvAliases() {
-	m.env_aliases = nil
-	m.clearedenv_aliases = false
-	m.removedenv_aliases = nil
+// AddBuildCount adds i to the "build_count" field.
+func (m *EnvMutation) AddBuildCount(i int32) {
+	if m.addbuild_count != nil {
+		*m.addbuild_count += i
+	} else {
+		m.addbuild_count = &i
+	}
 }
 
-// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
-func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
-	if m.builds == nil {
-		m.builds = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.builds[ids[i]] = struct{}{}
+// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
+func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
+	v := m.addbuild_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearBuilds clears the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) ClearBuilds() {
-	m.clearedbuilds = true
+// ResetBuildCount resets all changes to the "build_count" field.
+func (m *EnvMutation) ResetBuildCount() {
+	m.build_count = nil
+	m.addbuild_count = nil
 }
 
-// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
-func (m *EnvMutation) BuildsCleared() bool {
-	return m.clearedbuilds
+// SetSpawnCount sets the "spawn_count" field.
+func (m *EnvMutation) SetSpawnCount(i int64) {
+	m.spawn_count = &i
+	m.addspawn_count = nil
 }
 
-// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
-func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
-	if m.removedbuilds == nil {
-		m.removedbuilds = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.builds, ids[i])
-		m.removedbuilds[ids[i]] = struct{}{}
+// SpawnCount returns the value of the "spawn_count" field in the mutation.
+func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
+	v := m.spawn_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
-	for id := range m.removedbuilds {
-		ids = append(ids, id)
+// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// BuildsIDs returns the "builds" edge IDs in the mutation.
-func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
-	for id := range m.builds {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+	}
+	return oldValue.SpawnCount, nil
 }
 
-// ResetBuilds resets all changes to the "builds" edge.
-func (m *EnvMutation) ResetBuilds() {
-	m.builds = nil
-	m.clearedbuilds = false
-	m.removedbuilds = nil
+// AddSpawnCount adds i to the "spawn_count" field.
+func (m *EnvMutation) AddSpawnCount(i int64) {
+	if m.addspawn_count != nil {
+		*m.addspawn_count += i
+	} else {
+		m.addspawn_count = &i
+	}
 }
 
-// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
-func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
-	if m.snapshots == nil {
-		m.snapshots = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.snapshots[ids[i]] = struct{}{}
+// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
+func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
+	v := m.addspawn_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) ClearSnapshots() {
-	m.clearedsnapshots = true
+// ResetSpawnCount resets all changes to the "spawn_count" field.
+func (m *EnvMutation) ResetSpawnCount() {
+	m.spawn_count = nil
+	m.addspawn_count = nil
 }
 
-// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
-func (m *EnvMutation) SnapshotsCleared() bool {
-	return m.clearedsnapshots
+// SetLastSpawnedAt sets the "last_spawned_at" field.
+func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
+	m.last_spawned_at = &t
 }
 
-// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
-func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
-	if m.removedsnapshots == nil {
-		m.removedsnapshots = make(map[uuid.UUID]struct{})
+// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
+func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
+	v := m.last_spawned_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.snapshots, ids[i])
-		m.removedsnapshots[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
+	}
+	return oldValue.LastSpawnedAt, nil
 }
 
-// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsnapshots {
-		ids = append(ids, id)
+// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
+func (m *EnvMutation) ClearLastSpawnedAt() {
+	m.last_spawned_at = nil
+	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
+}
+
+// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
+func (m *EnvMutation) LastSpawnedAtCleared() bool {
+	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
+	return ok
+}
+
+// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
+func (m *EnvMutation) ResetLastSpawnedAt() {
+	m.last_spawned_at = nil
+	delete(m.clearedFields, env.FieldLastSpawnedAt)
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (m *EnvMutation) SetClusterID(u uuid.UUID) {
+	m.cluster_id = &u
+}
+
+// ClusterID returns the value of the "cluster_id" field in the mutation.
+func (m *EnvMutation) ClusterID() (r uuid.UUID, exists bool) {
+	v := m.cluster_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
-func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.snapshots {
-		ids = append(ids, id)
+// OldClusterID returns the old "cluster_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+	}
+	return oldValue.ClusterID, nil
 }
 
-// ResetSnapshots resets all changes to the "snapshots" edge.
-func (m *EnvMutation) ResetSnapshots() {
-	m.snapshots = nil
-	m.clearedsnapshots = false
-	m.removedsnapshots = nil
+// ClearClusterID clears the value of the "cluster_id" field.
+func (m *EnvMutation) ClearClusterID() {
+	m.cluster_id = nil
+	m.clearedFields[env.FieldClusterID] = struct{}{}
 }
 
-// Where appends a list predicates to the EnvMutation builder.
-func (m *EnvMutation) Where(ps ...predicate.Env) {
-	m.predicates = append(m.predicates, ps...)
+// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
+func (m *EnvMutation) ClusterIDCleared() bool {
+	_, ok := m.clearedFields[env.FieldClusterID]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Env, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ResetClusterID resets all changes to the "cluster_id" field.
+func (m *EnvMutation) ResetClusterID() {
+	m.cluster_id = nil
+	delete(m.clearedFields, env.FieldClusterID)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *EnvMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[env.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *EnvMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
 	}
-	m.Where(p...)
+	return
 }
 
-// Op returns the operation name.
-func (m *EnvMutation) Op() Op {
-	return m.op
+// ResetTeam resets all changes to the "team" edge.
+func (m *EnvMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EnvMutation) SetOp(op Op) {
-	m.op = op
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
 }
 
-// Type returns the node type of this mutation (Env).
-func (m *EnvMutation) Type() string {
-	return m.typ
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *EnvMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[env.FieldCreatedBy] = struct{}{}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.created_at != nil {
-		fields = append(fields, env.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, env.FieldUpdatedAt)
-	}
-	if m.team != nil {
-		fields = append(fields, env.FieldTeamID)
-	}
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *EnvMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
 	if m.creator != nil {
-		fields = append(fields, env.FieldCreatedBy)
-	}
-	if m.public != nil {
-		fields = append(fields, env.FieldPublic)
-	}
-	if m.build_count != nil {
-		fields = append(fields, env.FieldBuildCount)
-	}
-	if m.spawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
-	}
-	if m.last_spawned_at != nil {
-		fields = append(fields, env.FieldLastSpawnedAt)
+		return *m.creator, true
 	}
-	if m.cluster_id != nil {
-		fields = append(fields, env.FieldClusterID)
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EnvMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case env.FieldCreatedAt:
-		return m.CreatedAt()
-	case env.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case env.FieldTeamID:
-		return m.TeamID()
-	case env.FieldCreatedBy:
-		return m.CreatedBy()
-	case env.FieldPublic:
-		return m.Public()
-	case env.FieldBuildCount:
-		return m.BuildCount()
-	case env.FieldSpawnCount:
-		return m.SpawnCount()
-	case env.FieldLastSpawnedAt:
-		return m.LastSpawnedAt()
-	case env.FieldClusterID:
-		return m.ClusterID()
-	}
-	return nil, false
+// ResetCreator resets all changes to the "creator" edge.
+func (m *EnvMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case env.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case env.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case env.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case env.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case env.FieldPublic:
-		return m.OldPublic(ctx)
-	case env.FieldBuildCount:
-		return m.OldBuildCount(ctx)
-	case env.FieldSpawnCount:
-		return m.OldSpawnCount(ctx)
-	case env.FieldLastSpawnedAt:
-		return m.OldLastSpawnedAt(ctx)
-	case env.FieldClusterID:
-		return m.OldClusterID(ctx)
+// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
+func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
+	if m.env_aliases == nil {
+		m.env_aliases = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.env_aliases[ids[i]] = struct{}{}
 	}
-	return nil, fmt.Errorf("unknown Env field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case env.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case env.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case env.FieldTeamID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTeamID(v)
-		return nil
-	case env.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case env.FieldPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublic(v)
-		return nil
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBuildCount(v)
-		return nil
-	case env.FieldSpawnCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpawnCount(v)
-		return nil
-	case env.FieldLastSpawnedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastSpawnedAt(v)
-		return nil
-	case env.FieldClusterID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClusterID(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Env field %s", name)
+// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) ClearEnvAliases() {
+	m.clearedenv_aliases = true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EnvMutation) AddedFields() []string {
-	var fields []string
-	if m.addbuild_count != nil {
-		fields = append(fields, env.FieldBuildCount)
+// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
+func (m *EnvMutation) EnvAliasesCleared() bool {
+	return m.clearedenv_aliases
+}
+
+// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
+func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
+	if m.removedenv_aliases == nil {
+		m.removedenv_aliases = make(map[string]struct{})
 	}
-	if m.addspawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
+	for i := range ids {
+		delete(m.env_aliases, ids[i])
+		m.removedenv_aliases[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case env.FieldBuildCount:
-		return m.AddedBuildCount()
-	case env.FieldSpawnCount:
-		return m.AddedSpawnCount()
+// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
+	for id := range m.removedenv_aliases {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBuildCount(v)
-		return nil
-	case env.FieldSpawnCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpawnCount(v)
-		return nil
+// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
+func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
+	for id := range m.env_aliases {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Env numeric field %s", name)
+	return
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EnvMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(env.FieldCreatedBy) {
-		fields = append(fields, env.FieldCreatedBy)
-	}
-	if m.FieldCleared(env.FieldLastSpawnedAt) {
-		fields = append(fields, env.FieldLastSpawnedAt)
+// ResetEnvAliases resets all changes to the "env_aliases" edge.
+func (m *EnvMutation) ResetEnvAliases() {
+	m.env_aliases = nil
+	m.clearedenv_aliases = false
+	m.removedenv_aliases = nil
+}
+
+// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
+func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
+	if m.builds == nil {
+		m.builds = make(map[uuid.UUID]struct{})
 	}
-	if m.FieldCleared(env.FieldClusterID) {
-		fields = append(fields, env.FieldClusterID)
+	for i := range ids {
+		m.builds[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EnvMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ClearBuilds clears the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) ClearBuilds() {
+	m.clearedbuilds = true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EnvMutation) ClearField(name string) error {
-	switch name {
-	case env.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case env.FieldLastSpawnedAt:
-		m.ClearLastSpawnedAt()
-		return nil
-	case env.FieldClusterID:
-		m.ClearClusterID()
-		return nil
+// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
+func (m *EnvMutation) BuildsCleared() bool {
+	return m.clearedbuilds
+}
+
+// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
+func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
+	if m.removedbuilds == nil {
+		m.removedbuilds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.builds, ids[i])
+		m.removedbuilds[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Env nullable field %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EnvMutation) ResetField(name string) error {
-	switch name {
-	case env.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case env.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case env.FieldTeamID:
-		m.ResetTeamID()
-		return nil
-	case env.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case env.FieldPublic:
-		m.ResetPublic()
-		return nil
-	case env.FieldBuildCount:
-		m.ResetBuildCount()
-		return nil
-	case env.FieldSpawnCount:
-		m.ResetSpawnCount()
-		return nil
-	case env.FieldLastSpawnedAt:
-		m.ResetLastSpawnedAt()
-		return nil
-	case env.FieldClusterID:
-		m.ResetClusterID()
-		return nil
+// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuilds {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.team != nil {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.env_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.builds != nil {
-		edges = append(edges, env.EdgeBuilds)
-	}
-	if m.snapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
+// BuildsIDs returns the "builds" edge IDs in the mutation.
+func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
+	for id := range m.builds {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EnvMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case env.EdgeCreator:
-		if id := m.creator; id != nil {
-			return []ent.Value{*id}
-		}
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.env_aliases))
-		for id := range m.env_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.builds))
-		for id := range m.builds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.snapshots))
-		for id := range m.snapshots {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetBuilds resets all changes to the "builds" edge.
+func (m *EnvMutation) ResetBuilds() {
+	m.builds = nil
+	m.clearedbuilds = false
+	m.removedbuilds = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedenv_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.removedbuilds != nil {
-		edges = append(edges, env.EdgeBuilds)
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
+func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[uuid.UUID]struct{})
 	}
-	if m.removedsnapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
-		for id := range m.removedenv_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.removedbuilds))
-		for id := range m.removedbuilds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.removedsnapshots))
-		for id := range m.removedsnapshots {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteam {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.clearedenv_aliases {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.clearedbuilds {
-		edges = append(edges, env.EdgeBuilds)
+// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
+func (m *EnvMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
+func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[uuid.UUID]struct{})
 	}
-	if m.clearedsnapshots {
-		edges = append(edges, env.EdgeSnapshots)
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EnvMutation) EdgeCleared(name string) bool {
-	switch name {
-	case env.EdgeTeam:
-		return m.clearedteam
-	case env.EdgeCreator:
-		return m.clearedcreator
-	case env.EdgeEnvAliases:
-		return m.clearedenv_aliases
-	case env.EdgeBuilds:
-		return m.clearedbuilds
-	case env.EdgeSnapshots:
-		return m.clearedsnapshots
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EnvMutation) ClearEdge(name string) error {
-	switch name {
-	case env.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ClearCreator()
-		return nil
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Env unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EnvMutation) ResetEdge(name string) error {
-	switch name {
-	case env.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case env.EdgeEnvAliases:
-		m.ResetEnvAliases()
-		return nil
-	case env.EdgeBuilds:
-		m.ResetBuilds()
-		return nil
-	case env.EdgeSnapshots:
-		m.ResetSnapshots()
-		return nil
-	}
-	return fmt.Errorf("unknown Env edge %s", name)
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *EnvMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
 }
 
-// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
-type EnvAliasMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *string
-	is_renamable  *bool
-	clearedFields map[string]struct{}
-	env           *string
-	clearedenv    bool
-	done          bool
-	oldValue      func(context.Context) (*EnvAlias, error)
-	predicates    []predicate.EnvAlias
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by ids.
+func (m *EnvMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checkpoints[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*EnvAliasMutation)(nil)
+// ClearCheckpoints clears the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
+}
 
-// envaliasOption allows management of the mutation configuration using functional options.
-type envaliasOption func(*EnvAliasMutation)
+// CheckpointsCleared reports if the "checkpoints" edge to the Checkpoint entity was cleared.
+func (m *EnvMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
+}
 
-// newEnvAliasMutation creates new mutation for the EnvAlias entity.
-func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
-	m := &EnvAliasMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeEnvAlias,
-		clearedFields: make(map[string]struct{}),
+// RemoveCheckpointIDs removes the "checkpoints" edge to the Checkpoint entity by IDs.
+func (m *EnvMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withEnvAliasID sets the ID field of the mutation.
-func withEnvAliasID(id string) envaliasOption {
-	return func(m *EnvAliasMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *EnvAlias
-		)
-		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().EnvAlias.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withEnvAlias sets the old EnvAlias of the mutation.
-func withEnvAlias(node *EnvAlias) envaliasOption {
-	return func(m *EnvAliasMutation) {
-		m.oldValue = func(context.Context) (*EnvAlias, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvAliasMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m EnvAliasMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvAlias entities.
-func (m *EnvAliasMutation) SetID(id string) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EnvAliasMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetEnvID sets the "env_id" field.
-func (m *EnvAliasMutation) SetEnvID(s string) {
-	m.env = &s
-}
-
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
-	v := m.env
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
-	}
-	return oldValue.EnvID, nil
-}
-
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvAliasMutation) ResetEnvID() {
-	m.env = nil
-}
-
-// SetIsRenamable sets the "is_renamable" field.
-func (m *EnvAliasMutation) SetIsRenamable(b bool) {
-	m.is_renamable = &b
-}
-
-// IsRenamable returns the value of the "is_renamable" field in the mutation.
-func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
-	v := m.is_renamable
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
+		ids = append(ids, id)
 	}
-	return oldValue.IsRenamable, nil
-}
-
-// ResetIsRenamable resets all changes to the "is_renamable" field.
-func (m *EnvAliasMutation) ResetIsRenamable() {
-	m.is_renamable = nil
-}
-
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvAliasMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envalias.FieldEnvID] = struct{}{}
-}
-
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvAliasMutation) EnvCleared() bool {
-	return m.clearedenv
+	return
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvAliasMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *EnvMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvAliasMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *EnvMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
 }
 
-// Where appends a list predicates to the EnvAliasMutation builder.
-func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+// Where appends a list predicates to the EnvMutation builder.
+func (m *EnvMutation) Where(ps ...predicate.Env) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvAlias, len(ps))
+func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Env, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2855,30 +3052,51 @@ func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvAliasMutation) Op() Op {
+func (m *EnvMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvAliasMutation) SetOp(op Op) {
+func (m *EnvMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvAlias).
-func (m *EnvAliasMutation) Type() string {
+// Type returns the node type of this mutation (Env).
+func (m *EnvMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvAliasMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.env != nil {
-		fields = append(fields, envalias.FieldEnvID)
+func (m *EnvMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, env.FieldCreatedAt)
 	}
-	if m.is_renamable != nil {
-		fields = append(fields, envalias.FieldIsRenamable)
+	if m.updated_at != nil {
+		fields = append(fields, env.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, env.FieldTeamID)
+	}
+	if m.creator != nil {
+		fields = append(fields, env.FieldCreatedBy)
+	}
+	if m.public != nil {
+		fields = append(fields, env.FieldPublic)
+	}
+	if m.build_count != nil {
+		fields = append(fields, env.FieldBuildCount)
+	}
+	if m.spawn_count != nil {
+		fields = append(fields, env.FieldSpawnCount)
+	}
+	if m.last_spawned_at != nil {
+		fields = append(fields, env.FieldLastSpawnedAt)
+	}
+	if m.cluster_id != nil {
+		fields = append(fields, env.FieldClusterID)
 	}
 	return fields
 }
@@ -2886,12 +3104,26 @@ func (m *EnvAliasMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
+func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envalias.FieldEnvID:
-		return m.EnvID()
-	case envalias.FieldIsRenamable:
-		return m.IsRenamable()
+	case env.FieldCreatedAt:
+		return m.CreatedAt()
+	case env.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case env.FieldTeamID:
+		return m.TeamID()
+	case env.FieldCreatedBy:
+		return m.CreatedBy()
+	case env.FieldPublic:
+		return m.Public()
+	case env.FieldBuildCount:
+		return m.BuildCount()
+	case env.FieldSpawnCount:
+		return m.SpawnCount()
+	case env.FieldLastSpawnedAt:
+		return m.LastSpawnedAt()
+	case env.FieldClusterID:
+		return m.ClusterID()
 	}
 	return nil, false
 }
@@ -2899,212 +3131,450 @@ func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envalias.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envalias.FieldIsRenamable:
-		return m.OldIsRenamable(ctx)
+	case env.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case env.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case env.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case env.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case env.FieldPublic:
+		return m.OldPublic(ctx)
+	case env.FieldBuildCount:
+		return m.OldBuildCount(ctx)
+	case env.FieldSpawnCount:
+		return m.OldSpawnCount(ctx)
+	case env.FieldLastSpawnedAt:
+		return m.OldLastSpawnedAt(ctx)
+	case env.FieldClusterID:
+		return m.OldClusterID(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
+	return nil, fmt.Errorf("unknown Env field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
+func (m *EnvMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envalias.FieldEnvID:
-		v, ok := value.(string)
+	case env.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case envalias.FieldIsRenamable:
+	case env.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case env.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case env.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case env.FieldPublic:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRenamable(v)
+		m.SetPublic(v)
+		return nil
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildCount(v)
+		return nil
+	case env.FieldSpawnCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpawnCount(v)
+		return nil
+	case env.FieldLastSpawnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSpawnedAt(v)
+		return nil
+	case env.FieldClusterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Env field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvAliasMutation) AddedFields() []string {
-	return nil
+func (m *EnvMutation) AddedFields() []string {
+	var fields []string
+	if m.addbuild_count != nil {
+		fields = append(fields, env.FieldBuildCount)
+	}
+	if m.addspawn_count != nil {
+		fields = append(fields, env.FieldSpawnCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case env.FieldBuildCount:
+		return m.AddedBuildCount()
+	case env.FieldSpawnCount:
+		return m.AddedSpawnCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
+func (m *EnvMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuildCount(v)
+		return nil
+	case env.FieldSpawnCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpawnCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
+	return fmt.Errorf("unknown Env numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvAliasMutation) ClearedFields() []string {
-	return nil
+func (m *EnvMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(env.FieldCreatedBy) {
+		fields = append(fields, env.FieldCreatedBy)
+	}
+	if m.FieldCleared(env.FieldLastSpawnedAt) {
+		fields = append(fields, env.FieldLastSpawnedAt)
+	}
+	if m.FieldCleared(env.FieldClusterID) {
+		fields = append(fields, env.FieldClusterID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvAliasMutation) FieldCleared(name string) bool {
+func (m *EnvMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
+func (m *EnvMutation) ClearField(name string) error {
+	switch name {
+	case env.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case env.FieldLastSpawnedAt:
+		m.ClearLastSpawnedAt()
+		return nil
+	case env.FieldClusterID:
+		m.ClearClusterID()
+		return nil
+	}
+	return fmt.Errorf("unknown Env nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ResetField(name string) error {
+func (m *EnvMutation) ResetField(name string) error {
 	switch name {
-	case envalias.FieldEnvID:
-		m.ResetEnvID()
+	case env.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case envalias.FieldIsRenamable:
-		m.ResetIsRenamable()
+	case env.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case env.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case env.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case env.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case env.FieldBuildCount:
+		m.ResetBuildCount()
+		return nil
+	case env.FieldSpawnCount:
+		m.ResetSpawnCount()
+		return nil
+	case env.FieldLastSpawnedAt:
+		m.ResetLastSpawnedAt()
+		return nil
+	case env.FieldClusterID:
+		m.ResetClusterID()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Env field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvAliasMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envalias.EdgeEnv)
+func (m *EnvMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.team != nil {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.env_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.builds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.snapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.checkpoints != nil {
+		edges = append(edges, env.EdgeCheckpoints)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
+func (m *EnvMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case envalias.EdgeEnv:
-		if id := m.env; id != nil {
+	case env.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case env.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.env_aliases))
+		for id := range m.env_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.builds))
+		for id := range m.builds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvAliasMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EnvMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedenv_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.removedbuilds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.removedsnapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.removedcheckpoints != nil {
+		edges = append(edges, env.EdgeCheckpoints)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvAliasMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envalias.EdgeEnv)
+func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
+		for id := range m.removedenv_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.removedbuilds))
+		for id := range m.removedbuilds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedteam {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.clearedenv_aliases {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.clearedbuilds {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.clearedsnapshots {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.clearedcheckpoints {
+		edges = append(edges, env.EdgeCheckpoints)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvAliasMutation) EdgeCleared(name string) bool {
+func (m *EnvMutation) EdgeCleared(name string) bool {
 	switch name {
-	case envalias.EdgeEnv:
-		return m.clearedenv
+	case env.EdgeTeam:
+		return m.clearedteam
+	case env.EdgeCreator:
+		return m.clearedcreator
+	case env.EdgeEnvAliases:
+		return m.clearedenv_aliases
+	case env.EdgeBuilds:
+		return m.clearedbuilds
+	case env.EdgeSnapshots:
+		return m.clearedsnapshots
+	case env.EdgeCheckpoints:
+		return m.clearedcheckpoints
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvAliasMutation) ClearEdge(name string) error {
+func (m *EnvMutation) ClearEdge(name string) error {
 	switch name {
-	case envalias.EdgeEnv:
-		m.ClearEnv()
+	case env.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
+	return fmt.Errorf("unknown Env unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvAliasMutation) ResetEdge(name string) error {
+func (m *EnvMutation) ResetEdge(name string) error {
 	switch name {
-	case envalias.EdgeEnv:
-		m.ResetEnv()
+	case env.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case env.EdgeEnvAliases:
+		m.ResetEnvAliases()
+		return nil
+	case env.EdgeBuilds:
+		m.ResetBuilds()
+		return nil
+	case env.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	case env.EdgeCheckpoints:
+		m.ResetCheckpoints()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias edge %s", name)
+	return fmt.Errorf("unknown Env edge %s", name)
 }
 
-// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
-type EnvBuildMutation struct {
+// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
+type EnvAliasMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	created_at            *time.Time
-	updated_at            *time.Time
-	finished_at           *time.Time
-	status                *envbuild.Status
-	dockerfile            *string
-	start_cmd             *string
-	ready_cmd             *string
-	vcpu                  *int64
-	addvcpu               *int64
-	ram_mb                *int64
-	addram_mb             *int64
-	free_disk_size_mb     *int64
-	addfree_disk_size_mb  *int64
-	total_disk_size_mb    *int64
-	addtotal_disk_size_mb *int64
-	kernel_version        *string
-	firecracker_version   *string
-	envd_version          *string
-	cluster_node_id       *string
-	clearedFields         map[string]struct{}
-	env                   *string
-	clearedenv            bool
-	done                  bool
-	oldValue              func(context.Context) (*EnvBuild, error)
-	predicates            []predicate.EnvBuild
+	op            Op
+	typ           string
+	id            *string
+	is_renamable  *bool
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*EnvAlias, error)
+	predicates    []predicate.EnvAlias
 }
 
-var _ ent.Mutation = (*EnvBuildMutation)(nil)
+var _ ent.Mutation = (*EnvAliasMutation)(nil)
 
-// envbuildOption allows management of the mutation configuration using functional options.
-type envbuildOption func(*EnvBuildMutation)
+// envaliasOption allows management of the mutation configuration using functional options.
+type envaliasOption func(*EnvAliasMutation)
 
-// newEnvBuildMutation creates new mutation for the EnvBuild entity.
-func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
-	m := &EnvBuildMutation{
+// newEnvAliasMutation creates new mutation for the EnvAlias entity.
+func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
+	m := &EnvAliasMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvBuild,
+		typ:           TypeEnvAlias,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3113,20 +3583,20 @@ func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutat
 	return m
 }
 
-// withEnvBuildID sets the ID field of the mutation.
-func withEnvBuildID(id uuid.UUID) envbuildOption {
-	return func(m *EnvBuildMutation) {
+// withEnvAliasID sets the ID field of the mutation.
+func withEnvAliasID(id string) envaliasOption {
+	return func(m *EnvAliasMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvBuild
+			value *EnvAlias
 		)
-		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
+		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvBuild.Get(ctx, id)
+					value, err = m.Client().EnvAlias.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3135,10 +3605,10 @@ func withEnvBuildID(id uuid.UUID) envbuildOption {
 	}
 }
 
-// withEnvBuild sets the old EnvBuild of the mutation.
-func withEnvBuild(node *EnvBuild) envbuildOption {
-	return func(m *EnvBuildMutation) {
-		m.oldValue = func(context.Context) (*EnvBuild, error) {
+// withEnvAlias sets the old EnvAlias of the mutation.
+func withEnvAlias(node *EnvAlias) envaliasOption {
+	return func(m *EnvAliasMutation) {
+		m.oldValue = func(context.Context) (*EnvAlias, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3147,7 +3617,7 @@ func withEnvBuild(node *EnvBuild) envbuildOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvBuildMutation) Client() *Client {
+func (m EnvAliasMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3155,7 +3625,7 @@ func (m EnvBuildMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvBuildMutation) Tx() (*Tx, error) {
+func (m EnvAliasMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -3165,14 +3635,14 @@ func (m EnvBuildMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvBuild entities.
-func (m *EnvBuildMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EnvAlias entities.
+func (m *EnvAliasMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EnvAliasMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3183,891 +3653,2559 @@ func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEnvID sets the "env_id" field.
+func (m *EnvAliasMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.EnvID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvBuildMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvAliasMutation) ResetEnvID() {
+	m.env = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetIsRenamable sets the "is_renamable" field.
+func (m *EnvAliasMutation) SetIsRenamable(b bool) {
+	m.is_renamable = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// IsRenamable returns the value of the "is_renamable" field in the mutation.
+func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
+	v := m.is_renamable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.IsRenamable, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvBuildMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetIsRenamable resets all changes to the "is_renamable" field.
+func (m *EnvAliasMutation) ResetIsRenamable() {
+	m.is_renamable = nil
 }
 
-// SetFinishedAt sets the "finished_at" field.
-func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvAliasMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envalias.FieldEnvID] = struct{}{}
 }
 
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvAliasMutation) EnvCleared() bool {
+	return m.clearedenv
 }
 
-// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvAliasMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.FinishedAt, nil
+	return
 }
 
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *EnvBuildMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvAliasMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
 }
 
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *EnvBuildMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
-	return ok
+// Where appends a list predicates to the EnvAliasMutation builder.
+func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *EnvBuildMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, envbuild.FieldFinishedAt)
+// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *EnvBuildMutation) SetEnvID(s string) {
-	m.env = &s
+// Op returns the operation name.
+func (m *EnvAliasMutation) Op() Op {
+	return m.op
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
-	v := m.env
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *EnvAliasMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (EnvAlias).
+func (m *EnvAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvAliasMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.env != nil {
+		fields = append(fields, envalias.FieldEnvID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	if m.is_renamable != nil {
+		fields = append(fields, envalias.FieldIsRenamable)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envalias.FieldEnvID:
+		return m.EnvID()
+	case envalias.FieldIsRenamable:
+		return m.IsRenamable()
 	}
-	return oldValue.EnvID, nil
+	return nil, false
 }
 
-// ClearEnvID clears the value of the "env_id" field.
-func (m *EnvBuildMutation) ClearEnvID() {
-	m.env = nil
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envalias.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envalias.FieldIsRenamable:
+		return m.OldIsRenamable(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
-// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvIDCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvID]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envalias.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envalias.FieldIsRenamable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRenamable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvBuildMutation) ResetEnvID() {
-	m.env = nil
-	delete(m.clearedFields, envbuild.FieldEnvID)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvAliasMutation) AddedFields() []string {
+	return nil
 }
 
-// SetStatus sets the "status" field.
-func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
-	m.status = &e
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvAliasMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EnvBuildMutation) ResetStatus() {
-	m.status = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetDockerfile sets the "dockerfile" field.
-func (m *EnvBuildMutation) SetDockerfile(s string) {
-	m.dockerfile = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
 }
 
-// Dockerfile returns the value of the "dockerfile" field in the mutation.
-func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
-	v := m.dockerfile
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvAliasMutation) ResetField(name string) error {
+	switch name {
+	case envalias.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envalias.FieldIsRenamable:
+		m.ResetIsRenamable()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
-// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDockerfile requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, envalias.EdgeEnv)
 	}
-	return oldValue.Dockerfile, nil
+	return edges
 }
 
-// ClearDockerfile clears the value of the "dockerfile" field.
-func (m *EnvBuildMutation) ClearDockerfile() {
-	m.dockerfile = nil
-	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
-}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envalias.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
 
-// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
-func (m *EnvBuildMutation) DockerfileCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldDockerfile]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ResetDockerfile resets all changes to the "dockerfile" field.
-func (m *EnvBuildMutation) ResetDockerfile() {
-	m.dockerfile = nil
-	delete(m.clearedFields, envbuild.FieldDockerfile)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetStartCmd sets the "start_cmd" field.
-func (m *EnvBuildMutation) SetStartCmd(s string) {
-	m.start_cmd = &s
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, envalias.EdgeEnv)
+	}
+	return edges
 }
 
-// StartCmd returns the value of the "start_cmd" field in the mutation.
-func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
-	v := m.start_cmd
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvAliasMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envalias.EdgeEnv:
+		return m.clearedenv
 	}
-	return *v, true
+	return false
 }
 
-// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvAliasMutation) ClearEdge(name string) error {
+	switch name {
+	case envalias.EdgeEnv:
+		m.ClearEnv()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartCmd requires an ID field in the mutation")
+	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvAliasMutation) ResetEdge(name string) error {
+	switch name {
+	case envalias.EdgeEnv:
+		m.ResetEnv()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
+	return fmt.Errorf("unknown EnvAlias edge %s", name)
+}
+
+// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
+type EnvBuildMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	finished_at           *time.Time
+	status                *envbuild.Status
+	dockerfile            *string
+	start_cmd             *string
+	ready_cmd             *string
+	vcpu                  *int64
+	addvcpu               *int64
+	ram_mb                *int64
+	addram_mb             *int64
+	free_disk_size_mb     *int64
+	addfree_disk_size_mb  *int64
+	total_disk_size_mb    *int64
+	addtotal_disk_size_mb *int64
+	kernel_version        *string
+	firecracker_version   *string
+	envd_version          *string
+	cluster_node_id       *string
+	source_build_id       *uuid.UUID
+	datasets              *map[string]string
+	marked_for_gc_at      *time.Time
+	clearedFields         map[string]struct{}
+	env                   *string
+	clearedenv            bool
+	done                  bool
+	oldValue              func(context.Context) (*EnvBuild, error)
+	predicates            []predicate.EnvBuild
+}
+
+var _ ent.Mutation = (*EnvBuildMutation)(nil)
+
+// envbuildOption allows management of the mutation configuration using functional options.
+type envbuildOption func(*EnvBuildMutation)
+
+// newEnvBuildMutation creates new mutation for the EnvBuild entity.
+func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
+	m := &EnvBuildMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvBuild,
+		clearedFields: make(map[string]struct{}),
 	}
-	return oldValue.StartCmd, nil
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ClearStartCmd clears the value of the "start_cmd" field.
-func (m *EnvBuildMutation) ClearStartCmd() {
-	m.start_cmd = nil
-	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
+// withEnvBuildID sets the ID field of the mutation.
+func withEnvBuildID(id uuid.UUID) envbuildOption {
+	return func(m *EnvBuildMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvBuild
+		)
+		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvBuild.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
-func (m *EnvBuildMutation) StartCmdCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldStartCmd]
-	return ok
+// withEnvBuild sets the old EnvBuild of the mutation.
+func withEnvBuild(node *EnvBuild) envbuildOption {
+	return func(m *EnvBuildMutation) {
+		m.oldValue = func(context.Context) (*EnvBuild, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetStartCmd resets all changes to the "start_cmd" field.
-func (m *EnvBuildMutation) ResetStartCmd() {
-	m.start_cmd = nil
-	delete(m.clearedFields, envbuild.FieldStartCmd)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvBuildMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetReadyCmd sets the "ready_cmd" field.
-func (m *EnvBuildMutation) SetReadyCmd(s string) {
-	m.ready_cmd = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvBuildMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ReadyCmd returns the value of the "ready_cmd" field in the mutation.
-func (m *EnvBuildMutation) ReadyCmd() (r string, exists bool) {
-	v := m.ready_cmd
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvBuild entities.
+func (m *EnvBuildMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReadyCmd returns the old "ready_cmd" field's value of the EnvBuild entity.
+// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldReadyCmd(ctx context.Context) (v *string, err error) {
+func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadyCmd is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadyCmd requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadyCmd: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ReadyCmd, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearReadyCmd clears the value of the "ready_cmd" field.
-func (m *EnvBuildMutation) ClearReadyCmd() {
-	m.ready_cmd = nil
-	m.clearedFields[envbuild.FieldReadyCmd] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvBuildMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ReadyCmdCleared returns if the "ready_cmd" field was cleared in this mutation.
-func (m *EnvBuildMutation) ReadyCmdCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldReadyCmd]
-	return ok
-}
-
-// ResetReadyCmd resets all changes to the "ready_cmd" field.
-func (m *EnvBuildMutation) ResetReadyCmd() {
-	m.ready_cmd = nil
-	delete(m.clearedFields, envbuild.FieldReadyCmd)
-}
-
-// SetVcpu sets the "vcpu" field.
-func (m *EnvBuildMutation) SetVcpu(i int64) {
-	m.vcpu = &i
-	m.addvcpu = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Vcpu returns the value of the "vcpu" field in the mutation.
-func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
-	v := m.vcpu
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVcpu requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
-	}
-	return oldValue.Vcpu, nil
-}
-
-// AddVcpu adds i to the "vcpu" field.
-func (m *EnvBuildMutation) AddVcpu(i int64) {
-	if m.addvcpu != nil {
-		*m.addvcpu += i
-	} else {
-		m.addvcpu = &i
-	}
-}
-
-// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
-func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
-	v := m.addvcpu
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetVcpu resets all changes to the "vcpu" field.
-func (m *EnvBuildMutation) ResetVcpu() {
-	m.vcpu = nil
-	m.addvcpu = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvBuildMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetRAMMB sets the "ram_mb" field.
-func (m *EnvBuildMutation) SetRAMMB(i int64) {
-	m.ram_mb = &i
-	m.addram_mb = nil
+// SetFinishedAt sets the "finished_at" field.
+func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// RAMMB returns the value of the "ram_mb" field in the mutation.
-func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
-	v := m.ram_mb
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
+// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.RAMMB, nil
+	return oldValue.FinishedAt, nil
 }
 
-// AddRAMMB adds i to the "ram_mb" field.
-func (m *EnvBuildMutation) AddRAMMB(i int64) {
-	if m.addram_mb != nil {
-		*m.addram_mb += i
-	} else {
-		m.addram_mb = &i
-	}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *EnvBuildMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
 }
 
-// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
-	v := m.addram_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
+	return ok
 }
 
-// ResetRAMMB resets all changes to the "ram_mb" field.
-func (m *EnvBuildMutation) ResetRAMMB() {
-	m.ram_mb = nil
-	m.addram_mb = nil
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *EnvBuildMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, envbuild.FieldFinishedAt)
 }
 
-// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
-	m.free_disk_size_mb = &i
-	m.addfree_disk_size_mb = nil
+// SetEnvID sets the "env_id" field.
+func (m *EnvBuildMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
-	v := m.free_disk_size_mb
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
+// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.FreeDiskSizeMB, nil
+	return oldValue.EnvID, nil
 }
 
-// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
-	if m.addfree_disk_size_mb != nil {
-		*m.addfree_disk_size_mb += i
-	} else {
-		m.addfree_disk_size_mb = &i
-	}
+// ClearEnvID clears the value of the "env_id" field.
+func (m *EnvBuildMutation) ClearEnvID() {
+	m.env = nil
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
 }
 
-// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
-	v := m.addfree_disk_size_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvID]
+	return ok
 }
 
-// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
-	m.free_disk_size_mb = nil
-	m.addfree_disk_size_mb = nil
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvBuildMutation) ResetEnvID() {
+	m.env = nil
+	delete(m.clearedFields, envbuild.FieldEnvID)
 }
 
-// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
-	m.total_disk_size_mb = &i
-	m.addtotal_disk_size_mb = nil
+// SetStatus sets the "status" field.
+func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
+	m.status = &e
 }
 
-// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
-	v := m.total_disk_size_mb
+// Status returns the value of the "status" field in the mutation.
+func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
+// OldStatus returns the old "status" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
+func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
-	}
-	return oldValue.TotalDiskSizeMB, nil
-}
-
-// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
-	if m.addtotal_disk_size_mb != nil {
-		*m.addtotal_disk_size_mb += i
-	} else {
-		m.addtotal_disk_size_mb = &i
-	}
-}
-
-// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
-	v := m.addtotal_disk_size_mb
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return *v, true
-}
-
-// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
-}
-
-// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
+// ResetStatus resets all changes to the "status" field.
+func (m *EnvBuildMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetKernelVersion sets the "kernel_version" field.
-func (m *EnvBuildMutation) SetKernelVersion(s string) {
-	m.kernel_version = &s
+// SetDockerfile sets the "dockerfile" field.
+func (m *EnvBuildMutation) SetDockerfile(s string) {
+	m.dockerfile = &s
 }
 
-// KernelVersion returns the value of the "kernel_version" field in the mutation.
-func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
-	v := m.kernel_version
+// Dockerfile returns the value of the "dockerfile" field in the mutation.
+func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
+	v := m.dockerfile
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
+// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+		return v, errors.New("OldDockerfile requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
 	}
-	return oldValue.KernelVersion, nil
+	return oldValue.Dockerfile, nil
 }
 
-// ResetKernelVersion resets all changes to the "kernel_version" field.
-func (m *EnvBuildMutation) ResetKernelVersion() {
-	m.kernel_version = nil
+// ClearDockerfile clears the value of the "dockerfile" field.
+func (m *EnvBuildMutation) ClearDockerfile() {
+	m.dockerfile = nil
+	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
 }
 
-// SetFirecrackerVersion sets the "firecracker_version" field.
-func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
-	m.firecracker_version = &s
+// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
+func (m *EnvBuildMutation) DockerfileCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDockerfile]
+	return ok
 }
 
-// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
-func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
-	v := m.firecracker_version
+// ResetDockerfile resets all changes to the "dockerfile" field.
+func (m *EnvBuildMutation) ResetDockerfile() {
+	m.dockerfile = nil
+	delete(m.clearedFields, envbuild.FieldDockerfile)
+}
+
+// SetStartCmd sets the "start_cmd" field.
+func (m *EnvBuildMutation) SetStartCmd(s string) {
+	m.start_cmd = &s
+}
+
+// StartCmd returns the value of the "start_cmd" field in the mutation.
+func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
+	v := m.start_cmd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+		return v, errors.New("OldStartCmd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
 	}
-	return oldValue.FirecrackerVersion, nil
+	return oldValue.StartCmd, nil
 }
 
-// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
-func (m *EnvBuildMutation) ResetFirecrackerVersion() {
-	m.firecracker_version = nil
+// ClearStartCmd clears the value of the "start_cmd" field.
+func (m *EnvBuildMutation) ClearStartCmd() {
+	m.start_cmd = nil
+	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
 }
 
-// SetEnvdVersion sets the "envd_version" field.
-func (m *EnvBuildMutation) SetEnvdVersion(s string) {
-	m.envd_version = &s
+// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
+func (m *EnvBuildMutation) StartCmdCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldStartCmd]
+	return ok
 }
 
-// EnvdVersion returns the value of the "envd_version" field in the mutation.
-func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
-	v := m.envd_version
+// ResetStartCmd resets all changes to the "start_cmd" field.
+func (m *EnvBuildMutation) ResetStartCmd() {
+	m.start_cmd = nil
+	delete(m.clearedFields, envbuild.FieldStartCmd)
+}
+
+// SetReadyCmd sets the "ready_cmd" field.
+func (m *EnvBuildMutation) SetReadyCmd(s string) {
+	m.ready_cmd = &s
+}
+
+// ReadyCmd returns the value of the "ready_cmd" field in the mutation.
+func (m *EnvBuildMutation) ReadyCmd() (r string, exists bool) {
+	v := m.ready_cmd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// OldReadyCmd returns the old "ready_cmd" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+func (m *EnvBuildMutation) OldReadyCmd(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldReadyCmd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+		return v, errors.New("OldReadyCmd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldReadyCmd: %w", err)
 	}
-	return oldValue.EnvdVersion, nil
+	return oldValue.ReadyCmd, nil
 }
 
-// ClearEnvdVersion clears the value of the "envd_version" field.
-func (m *EnvBuildMutation) ClearEnvdVersion() {
-	m.envd_version = nil
-	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+// ClearReadyCmd clears the value of the "ready_cmd" field.
+func (m *EnvBuildMutation) ClearReadyCmd() {
+	m.ready_cmd = nil
+	m.clearedFields[envbuild.FieldReadyCmd] = struct{}{}
 }
 
-// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvdVersionCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
+// ReadyCmdCleared returns if the "ready_cmd" field was cleared in this mutation.
+func (m *EnvBuildMutation) ReadyCmdCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldReadyCmd]
 	return ok
 }
 
-// ResetEnvdVersion resets all changes to the "envd_version" field.
-func (m *EnvBuildMutation) ResetEnvdVersion() {
-	m.envd_version = nil
-	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+// ResetReadyCmd resets all changes to the "ready_cmd" field.
+func (m *EnvBuildMutation) ResetReadyCmd() {
+	m.ready_cmd = nil
+	delete(m.clearedFields, envbuild.FieldReadyCmd)
 }
 
-// SetClusterNodeID sets the "cluster_node_id" field.
-func (m *EnvBuildMutation) SetClusterNodeID(s string) {
-	m.cluster_node_id = &s
+// SetVcpu sets the "vcpu" field.
+func (m *EnvBuildMutation) SetVcpu(i int64) {
+	m.vcpu = &i
+	m.addvcpu = nil
 }
 
-// ClusterNodeID returns the value of the "cluster_node_id" field in the mutation.
-func (m *EnvBuildMutation) ClusterNodeID() (r string, exists bool) {
-	v := m.cluster_node_id
+// Vcpu returns the value of the "vcpu" field in the mutation.
+func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
+	v := m.vcpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClusterNodeID returns the old "cluster_node_id" field's value of the EnvBuild entity.
+// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
 // If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldClusterNodeID(ctx context.Context) (v *string, err error) {
+func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterNodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterNodeID requires an ID field in the mutation")
+		return v, errors.New("OldVcpu requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterNodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
 	}
-	return oldValue.ClusterNodeID, nil
-}
-
-// ClearClusterNodeID clears the value of the "cluster_node_id" field.
-func (m *EnvBuildMutation) ClearClusterNodeID() {
-	m.cluster_node_id = nil
-	m.clearedFields[envbuild.FieldClusterNodeID] = struct{}{}
+	return oldValue.Vcpu, nil
 }
 
-// ClusterNodeIDCleared returns if the "cluster_node_id" field was cleared in this mutation.
-func (m *EnvBuildMutation) ClusterNodeIDCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldClusterNodeID]
-	return ok
+// AddVcpu adds i to the "vcpu" field.
+func (m *EnvBuildMutation) AddVcpu(i int64) {
+	if m.addvcpu != nil {
+		*m.addvcpu += i
+	} else {
+		m.addvcpu = &i
+	}
 }
 
-// ResetClusterNodeID resets all changes to the "cluster_node_id" field.
-func (m *EnvBuildMutation) ResetClusterNodeID() {
-	m.cluster_node_id = nil
-	delete(m.clearedFields, envbuild.FieldClusterNodeID)
+// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
+func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
+	v := m.addvcpu
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvBuildMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// ResetVcpu resets all changes to the "vcpu" field.
+func (m *EnvBuildMutation) ResetVcpu() {
+	m.vcpu = nil
+	m.addvcpu = nil
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvBuildMutation) EnvCleared() bool {
-	return m.EnvIDCleared() || m.clearedenv
+// SetRAMMB sets the "ram_mb" field.
+func (m *EnvBuildMutation) SetRAMMB(i int64) {
+	m.ram_mb = &i
+	m.addram_mb = nil
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvBuildMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// RAMMB returns the value of the "ram_mb" field in the mutation.
+func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
+	v := m.ram_mb
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvBuildMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+	}
+	return oldValue.RAMMB, nil
 }
 
-// Where appends a list predicates to the EnvBuildMutation builder.
-func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
-	m.predicates = append(m.predicates, ps...)
+// AddRAMMB adds i to the "ram_mb" field.
+func (m *EnvBuildMutation) AddRAMMB(i int64) {
+	if m.addram_mb != nil {
+		*m.addram_mb += i
+	} else {
+		m.addram_mb = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvBuild, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
+	v := m.addram_mb
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *EnvBuildMutation) Op() Op {
-	return m.op
+// ResetRAMMB resets all changes to the "ram_mb" field.
+func (m *EnvBuildMutation) ResetRAMMB() {
+	m.ram_mb = nil
+	m.addram_mb = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EnvBuildMutation) SetOp(op Op) {
-	m.op = op
+// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
+	m.free_disk_size_mb = &i
+	m.addfree_disk_size_mb = nil
 }
 
-// Type returns the node type of this mutation (EnvBuild).
-func (m *EnvBuildMutation) Type() string {
-	return m.typ
+// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
+	v := m.free_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+	}
+	return oldValue.FreeDiskSizeMB, nil
+}
+
+// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
+	if m.addfree_disk_size_mb != nil {
+		*m.addfree_disk_size_mb += i
+	} else {
+		m.addfree_disk_size_mb = &i
+	}
+}
+
+// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
+	v := m.addfree_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
+	m.free_disk_size_mb = nil
+	m.addfree_disk_size_mb = nil
+}
+
+// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
+	m.total_disk_size_mb = &i
+	m.addtotal_disk_size_mb = nil
+}
+
+// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
+	v := m.total_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
+	}
+	return oldValue.TotalDiskSizeMB, nil
+}
+
+// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
+	if m.addtotal_disk_size_mb != nil {
+		*m.addtotal_disk_size_mb += i
+	} else {
+		m.addtotal_disk_size_mb = &i
+	}
+}
+
+// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
+	v := m.addtotal_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
+}
+
+// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
+	return ok
+}
+
+// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (m *EnvBuildMutation) SetKernelVersion(s string) {
+	m.kernel_version = &s
+}
+
+// KernelVersion returns the value of the "kernel_version" field in the mutation.
+func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
+	v := m.kernel_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	}
+	return oldValue.KernelVersion, nil
+}
+
+// ResetKernelVersion resets all changes to the "kernel_version" field.
+func (m *EnvBuildMutation) ResetKernelVersion() {
+	m.kernel_version = nil
+}
+
+// SetFirecrackerVersion sets the "firecracker_version" field.
+func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
+	m.firecracker_version = &s
+}
+
+// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
+func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
+	v := m.firecracker_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+	}
+	return oldValue.FirecrackerVersion, nil
+}
+
+// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
+func (m *EnvBuildMutation) ResetFirecrackerVersion() {
+	m.firecracker_version = nil
+}
+
+// SetEnvdVersion sets the "envd_version" field.
+func (m *EnvBuildMutation) SetEnvdVersion(s string) {
+	m.envd_version = &s
+}
+
+// EnvdVersion returns the value of the "envd_version" field in the mutation.
+func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
+	v := m.envd_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+	}
+	return oldValue.EnvdVersion, nil
+}
+
+// ClearEnvdVersion clears the value of the "envd_version" field.
+func (m *EnvBuildMutation) ClearEnvdVersion() {
+	m.envd_version = nil
+	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+}
+
+// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvdVersionCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
+	return ok
+}
+
+// ResetEnvdVersion resets all changes to the "envd_version" field.
+func (m *EnvBuildMutation) ResetEnvdVersion() {
+	m.envd_version = nil
+	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+}
+
+// SetClusterNodeID sets the "cluster_node_id" field.
+func (m *EnvBuildMutation) SetClusterNodeID(s string) {
+	m.cluster_node_id = &s
+}
+
+// ClusterNodeID returns the value of the "cluster_node_id" field in the mutation.
+func (m *EnvBuildMutation) ClusterNodeID() (r string, exists bool) {
+	v := m.cluster_node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterNodeID returns the old "cluster_node_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldClusterNodeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterNodeID: %w", err)
+	}
+	return oldValue.ClusterNodeID, nil
+}
+
+// ClearClusterNodeID clears the value of the "cluster_node_id" field.
+func (m *EnvBuildMutation) ClearClusterNodeID() {
+	m.cluster_node_id = nil
+	m.clearedFields[envbuild.FieldClusterNodeID] = struct{}{}
+}
+
+// ClusterNodeIDCleared returns if the "cluster_node_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) ClusterNodeIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldClusterNodeID]
+	return ok
+}
+
+// ResetClusterNodeID resets all changes to the "cluster_node_id" field.
+func (m *EnvBuildMutation) ResetClusterNodeID() {
+	m.cluster_node_id = nil
+	delete(m.clearedFields, envbuild.FieldClusterNodeID)
+}
+
+// SetSourceBuildID sets the "source_build_id" field.
+func (m *EnvBuildMutation) SetSourceBuildID(u uuid.UUID) {
+	m.source_build_id = &u
+}
+
+// SourceBuildID returns the value of the "source_build_id" field in the mutation.
+func (m *EnvBuildMutation) SourceBuildID() (r uuid.UUID, exists bool) {
+	v := m.source_build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceBuildID returns the old "source_build_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldSourceBuildID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceBuildID: %w", err)
+	}
+	return oldValue.SourceBuildID, nil
+}
+
+// ClearSourceBuildID clears the value of the "source_build_id" field.
+func (m *EnvBuildMutation) ClearSourceBuildID() {
+	m.source_build_id = nil
+	m.clearedFields[envbuild.FieldSourceBuildID] = struct{}{}
+}
+
+// SourceBuildIDCleared returns if the "source_build_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) SourceBuildIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldSourceBuildID]
+	return ok
+}
+
+// ResetSourceBuildID resets all changes to the "source_build_id" field.
+func (m *EnvBuildMutation) ResetSourceBuildID() {
+	m.source_build_id = nil
+	delete(m.clearedFields, envbuild.FieldSourceBuildID)
+}
+
+// SetDatasets sets the "datasets" field.
+func (m *EnvBuildMutation) SetDatasets(value map[string]string) {
+	m.datasets = &value
+}
+
+// Datasets returns the value of the "datasets" field in the mutation.
+func (m *EnvBuildMutation) Datasets() (r map[string]string, exists bool) {
+	v := m.datasets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatasets returns the old "datasets" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldDatasets(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatasets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatasets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatasets: %w", err)
+	}
+	return oldValue.Datasets, nil
+}
+
+// ClearDatasets clears the value of the "datasets" field.
+func (m *EnvBuildMutation) ClearDatasets() {
+	m.datasets = nil
+	m.clearedFields[envbuild.FieldDatasets] = struct{}{}
+}
+
+// DatasetsCleared returns if the "datasets" field was cleared in this mutation.
+func (m *EnvBuildMutation) DatasetsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDatasets]
+	return ok
+}
+
+// ResetDatasets resets all changes to the "datasets" field.
+func (m *EnvBuildMutation) ResetDatasets() {
+	m.datasets = nil
+	delete(m.clearedFields, envbuild.FieldDatasets)
+}
+
+// SetMarkedForGcAt sets the "marked_for_gc_at" field.
+func (m *EnvBuildMutation) SetMarkedForGcAt(t time.Time) {
+	m.marked_for_gc_at = &t
+}
+
+// MarkedForGcAt returns the value of the "marked_for_gc_at" field in the mutation.
+func (m *EnvBuildMutation) MarkedForGcAt() (r time.Time, exists bool) {
+	v := m.marked_for_gc_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMarkedForGcAt returns the old "marked_for_gc_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldMarkedForGcAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMarkedForGcAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMarkedForGcAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMarkedForGcAt: %w", err)
+	}
+	return oldValue.MarkedForGcAt, nil
+}
+
+// ClearMarkedForGcAt clears the value of the "marked_for_gc_at" field.
+func (m *EnvBuildMutation) ClearMarkedForGcAt() {
+	m.marked_for_gc_at = nil
+	m.clearedFields[envbuild.FieldMarkedForGcAt] = struct{}{}
+}
+
+// MarkedForGcAtCleared returns if the "marked_for_gc_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) MarkedForGcAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldMarkedForGcAt]
+	return ok
+}
+
+// ResetMarkedForGcAt resets all changes to the "marked_for_gc_at" field.
+func (m *EnvBuildMutation) ResetMarkedForGcAt() {
+	m.marked_for_gc_at = nil
+	delete(m.clearedFields, envbuild.FieldMarkedForGcAt)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvBuildMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvBuildMutation) EnvCleared() bool {
+	return m.EnvIDCleared() || m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvBuildMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the EnvBuildMutation builder.
+func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuild, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvBuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvBuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvBuild).
+func (m *EnvBuildMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+	fields := make([]string, 0, 19)
 	if m.created_at != nil {
 		fields = append(fields, envbuild.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, envbuild.FieldUpdatedAt)
+	if m.updated_at != nil {
+		fields = append(fields, envbuild.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.status != nil {
+		fields = append(fields, envbuild.FieldStatus)
+	}
+	if m.dockerfile != nil {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.start_cmd != nil {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.ready_cmd != nil {
+		fields = append(fields, envbuild.FieldReadyCmd)
+	}
+	if m.vcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.ram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.free_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.total_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, envbuild.FieldKernelVersion)
+	}
+	if m.firecracker_version != nil {
+		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	}
+	if m.envd_version != nil {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.cluster_node_id != nil {
+		fields = append(fields, envbuild.FieldClusterNodeID)
+	}
+	if m.source_build_id != nil {
+		fields = append(fields, envbuild.FieldSourceBuildID)
+	}
+	if m.datasets != nil {
+		fields = append(fields, envbuild.FieldDatasets)
+	}
+	if m.marked_for_gc_at != nil {
+		fields = append(fields, envbuild.FieldMarkedForGcAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.CreatedAt()
+	case envbuild.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envbuild.FieldFinishedAt:
+		return m.FinishedAt()
+	case envbuild.FieldEnvID:
+		return m.EnvID()
+	case envbuild.FieldStatus:
+		return m.Status()
+	case envbuild.FieldDockerfile:
+		return m.Dockerfile()
+	case envbuild.FieldStartCmd:
+		return m.StartCmd()
+	case envbuild.FieldReadyCmd:
+		return m.ReadyCmd()
+	case envbuild.FieldVcpu:
+		return m.Vcpu()
+	case envbuild.FieldRAMMB:
+		return m.RAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.FreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.TotalDiskSizeMB()
+	case envbuild.FieldKernelVersion:
+		return m.KernelVersion()
+	case envbuild.FieldFirecrackerVersion:
+		return m.FirecrackerVersion()
+	case envbuild.FieldEnvdVersion:
+		return m.EnvdVersion()
+	case envbuild.FieldClusterNodeID:
+		return m.ClusterNodeID()
+	case envbuild.FieldSourceBuildID:
+		return m.SourceBuildID()
+	case envbuild.FieldDatasets:
+		return m.Datasets()
+	case envbuild.FieldMarkedForGcAt:
+		return m.MarkedForGcAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envbuild.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envbuild.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case envbuild.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuild.FieldStatus:
+		return m.OldStatus(ctx)
+	case envbuild.FieldDockerfile:
+		return m.OldDockerfile(ctx)
+	case envbuild.FieldStartCmd:
+		return m.OldStartCmd(ctx)
+	case envbuild.FieldReadyCmd:
+		return m.OldReadyCmd(ctx)
+	case envbuild.FieldVcpu:
+		return m.OldVcpu(ctx)
+	case envbuild.FieldRAMMB:
+		return m.OldRAMMB(ctx)
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.OldFreeDiskSizeMB(ctx)
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.OldTotalDiskSizeMB(ctx)
+	case envbuild.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case envbuild.FieldFirecrackerVersion:
+		return m.OldFirecrackerVersion(ctx)
+	case envbuild.FieldEnvdVersion:
+		return m.OldEnvdVersion(ctx)
+	case envbuild.FieldClusterNodeID:
+		return m.OldClusterNodeID(ctx)
+	case envbuild.FieldSourceBuildID:
+		return m.OldSourceBuildID(ctx)
+	case envbuild.FieldDatasets:
+		return m.OldDatasets(ctx)
+	case envbuild.FieldMarkedForGcAt:
+		return m.OldMarkedForGcAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuild.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envbuild.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case envbuild.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envbuild.FieldStatus:
+		v, ok := value.(envbuild.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case envbuild.FieldDockerfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerfile(v)
+		return nil
+	case envbuild.FieldStartCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartCmd(v)
+		return nil
+	case envbuild.FieldReadyCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadyCmd(v)
+		return nil
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirecrackerVersion(v)
+		return nil
+	case envbuild.FieldEnvdVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvdVersion(v)
+		return nil
+	case envbuild.FieldClusterNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterNodeID(v)
+		return nil
+	case envbuild.FieldSourceBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceBuildID(v)
+		return nil
+	case envbuild.FieldDatasets:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatasets(v)
+		return nil
+	case envbuild.FieldMarkedForGcAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMarkedForGcAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvBuildMutation) AddedFields() []string {
+	var fields []string
+	if m.addvcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.addram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.addfree_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.addtotal_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldVcpu:
+		return m.AddedVcpu()
+	case envbuild.FieldRAMMB:
+		return m.AddedRAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.AddedFreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.AddedTotalDiskSizeMB()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDiskSizeMB(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvBuildMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envbuild.FieldFinishedAt) {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.FieldCleared(envbuild.FieldEnvID) {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.FieldCleared(envbuild.FieldDockerfile) {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.FieldCleared(envbuild.FieldStartCmd) {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.FieldCleared(envbuild.FieldReadyCmd) {
+		fields = append(fields, envbuild.FieldReadyCmd)
+	}
+	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.FieldCleared(envbuild.FieldEnvdVersion) {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.FieldCleared(envbuild.FieldClusterNodeID) {
+		fields = append(fields, envbuild.FieldClusterNodeID)
+	}
+	if m.FieldCleared(envbuild.FieldSourceBuildID) {
+		fields = append(fields, envbuild.FieldSourceBuildID)
+	}
+	if m.FieldCleared(envbuild.FieldDatasets) {
+		fields = append(fields, envbuild.FieldDatasets)
+	}
+	if m.FieldCleared(envbuild.FieldMarkedForGcAt) {
+		fields = append(fields, envbuild.FieldMarkedForGcAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvBuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ClearField(name string) error {
+	switch name {
+	case envbuild.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ClearEnvID()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ClearDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ClearStartCmd()
+		return nil
+	case envbuild.FieldReadyCmd:
+		m.ClearReadyCmd()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ClearTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ClearEnvdVersion()
+		return nil
+	case envbuild.FieldClusterNodeID:
+		m.ClearClusterNodeID()
+		return nil
+	case envbuild.FieldSourceBuildID:
+		m.ClearSourceBuildID()
+		return nil
+	case envbuild.FieldDatasets:
+		m.ClearDatasets()
+		return nil
+	case envbuild.FieldMarkedForGcAt:
+		m.ClearMarkedForGcAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ResetField(name string) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envbuild.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envbuild.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envbuild.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ResetDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ResetStartCmd()
+		return nil
+	case envbuild.FieldReadyCmd:
+		m.ResetReadyCmd()
+		return nil
+	case envbuild.FieldVcpu:
+		m.ResetVcpu()
+		return nil
+	case envbuild.FieldRAMMB:
+		m.ResetRAMMB()
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		m.ResetFreeDiskSizeMB()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ResetTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		m.ResetFirecrackerVersion()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ResetEnvdVersion()
+		return nil
+	case envbuild.FieldClusterNodeID:
+		m.ResetClusterNodeID()
+		return nil
+	case envbuild.FieldSourceBuildID:
+		m.ResetSourceBuildID()
+		return nil
+	case envbuild.FieldDatasets:
+		m.ResetDatasets()
+		return nil
+	case envbuild.FieldMarkedForGcAt:
+		m.ResetMarkedForGcAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvBuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvBuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvBuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvBuildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envbuild.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvBuildMutation) ClearEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvBuildMutation) ResetEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild edge %s", name)
+}
+
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	base_env_id        *string
+	sandbox_id         *string
+	metadata           *map[string]string
+	sandbox_started_at *time.Time
+	env_secure         *bool
+	network            **types.SandboxNetworkConfig
+	clearedFields      map[string]struct{}
+	env                *string
+	clearedenv         bool
+	done               bool
+	oldValue           func(context.Context) (*Snapshot, error)
+	predicates         []predicate.Snapshot
+}
+
+var _ ent.Mutation = (*SnapshotMutation)(nil)
+
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
+
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id uuid.UUID) snapshotOption {
+	return func(m *SnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Snapshot
+		)
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Snapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Snapshot entities.
+func (m *SnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *SnapshotMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
+}
+
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	}
+	return oldValue.BaseEnvID, nil
+}
+
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *SnapshotMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *SnapshotMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *SnapshotMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *SnapshotMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *SnapshotMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
+}
+
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *SnapshotMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SnapshotMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	if m.finished_at != nil {
-		fields = append(fields, envbuild.FieldFinishedAt)
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SnapshotMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// SetSandboxStartedAt sets the "sandbox_started_at" field.
+func (m *SnapshotMutation) SetSandboxStartedAt(t time.Time) {
+	m.sandbox_started_at = &t
+}
+
+// SandboxStartedAt returns the value of the "sandbox_started_at" field in the mutation.
+func (m *SnapshotMutation) SandboxStartedAt() (r time.Time, exists bool) {
+	v := m.sandbox_started_at
+	if v == nil {
+		return
 	}
-	if m.env != nil {
-		fields = append(fields, envbuild.FieldEnvID)
+	return *v, true
+}
+
+// OldSandboxStartedAt returns the old "sandbox_started_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldSandboxStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxStartedAt is only allowed on UpdateOne operations")
 	}
-	if m.status != nil {
-		fields = append(fields, envbuild.FieldStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxStartedAt requires an ID field in the mutation")
 	}
-	if m.dockerfile != nil {
-		fields = append(fields, envbuild.FieldDockerfile)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxStartedAt: %w", err)
 	}
-	if m.start_cmd != nil {
-		fields = append(fields, envbuild.FieldStartCmd)
+	return oldValue.SandboxStartedAt, nil
+}
+
+// ResetSandboxStartedAt resets all changes to the "sandbox_started_at" field.
+func (m *SnapshotMutation) ResetSandboxStartedAt() {
+	m.sandbox_started_at = nil
+}
+
+// SetEnvSecure sets the "env_secure" field.
+func (m *SnapshotMutation) SetEnvSecure(b bool) {
+	m.env_secure = &b
+}
+
+// EnvSecure returns the value of the "env_secure" field in the mutation.
+func (m *SnapshotMutation) EnvSecure() (r bool, exists bool) {
+	v := m.env_secure
+	if v == nil {
+		return
 	}
-	if m.ready_cmd != nil {
-		fields = append(fields, envbuild.FieldReadyCmd)
+	return *v, true
+}
+
+// OldEnvSecure returns the old "env_secure" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEnvSecure(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvSecure is only allowed on UpdateOne operations")
 	}
-	if m.vcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvSecure requires an ID field in the mutation")
 	}
-	if m.ram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvSecure: %w", err)
 	}
-	if m.free_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	return oldValue.EnvSecure, nil
+}
+
+// ResetEnvSecure resets all changes to the "env_secure" field.
+func (m *SnapshotMutation) ResetEnvSecure() {
+	m.env_secure = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *SnapshotMutation) SetNetwork(tnc *types.SandboxNetworkConfig) {
+	m.network = &tnc
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *SnapshotMutation) Network() (r *types.SandboxNetworkConfig, exists bool) {
+	v := m.network
+	if v == nil {
+		return
 	}
-	if m.total_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldNetwork(ctx context.Context) (v *types.SandboxNetworkConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
 	}
-	if m.kernel_version != nil {
-		fields = append(fields, envbuild.FieldKernelVersion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
 	}
-	if m.firecracker_version != nil {
-		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
 	}
-	if m.envd_version != nil {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	return oldValue.Network, nil
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (m *SnapshotMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[snapshot.FieldNetwork] = struct{}{}
+}
+
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *SnapshotMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldNetwork]
+	return ok
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *SnapshotMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, snapshot.FieldNetwork)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *SnapshotMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *SnapshotMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *SnapshotMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.cluster_node_id != nil {
-		fields = append(fields, envbuild.FieldClusterNodeID)
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *SnapshotMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the SnapshotMutation builder.
+func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Snapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Snapshot).
+func (m *SnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, snapshot.FieldCreatedAt)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, snapshot.FieldBaseEnvID)
+	}
+	if m.env != nil {
+		fields = append(fields, snapshot.FieldEnvID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, snapshot.FieldSandboxID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, snapshot.FieldMetadata)
+	}
+	if m.sandbox_started_at != nil {
+		fields = append(fields, snapshot.FieldSandboxStartedAt)
+	}
+	if m.env_secure != nil {
+		fields = append(fields, snapshot.FieldEnvSecure)
+	}
+	if m.network != nil {
+		fields = append(fields, snapshot.FieldNetwork)
 	}
 	return fields
 }
@@ -4075,40 +6213,24 @@ func (m *EnvBuildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		return m.CreatedAt()
-	case envbuild.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case envbuild.FieldFinishedAt:
-		return m.FinishedAt()
-	case envbuild.FieldEnvID:
+	case snapshot.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case snapshot.FieldEnvID:
 		return m.EnvID()
-	case envbuild.FieldStatus:
-		return m.Status()
-	case envbuild.FieldDockerfile:
-		return m.Dockerfile()
-	case envbuild.FieldStartCmd:
-		return m.StartCmd()
-	case envbuild.FieldReadyCmd:
-		return m.ReadyCmd()
-	case envbuild.FieldVcpu:
-		return m.Vcpu()
-	case envbuild.FieldRAMMB:
-		return m.RAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.FreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.TotalDiskSizeMB()
-	case envbuild.FieldKernelVersion:
-		return m.KernelVersion()
-	case envbuild.FieldFirecrackerVersion:
-		return m.FirecrackerVersion()
-	case envbuild.FieldEnvdVersion:
-		return m.EnvdVersion()
-	case envbuild.FieldClusterNodeID:
-		return m.ClusterNodeID()
+	case snapshot.FieldSandboxID:
+		return m.SandboxID()
+	case snapshot.FieldMetadata:
+		return m.Metadata()
+	case snapshot.FieldSandboxStartedAt:
+		return m.SandboxStartedAt()
+	case snapshot.FieldEnvSecure:
+		return m.EnvSecure()
+	case snapshot.FieldNetwork:
+		return m.Network()
 	}
 	return nil, false
 }
@@ -4116,850 +6238,1048 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case envbuild.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case envbuild.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
-	case envbuild.FieldEnvID:
+	case snapshot.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case snapshot.FieldEnvID:
 		return m.OldEnvID(ctx)
-	case envbuild.FieldStatus:
-		return m.OldStatus(ctx)
-	case envbuild.FieldDockerfile:
-		return m.OldDockerfile(ctx)
-	case envbuild.FieldStartCmd:
-		return m.OldStartCmd(ctx)
-	case envbuild.FieldReadyCmd:
-		return m.OldReadyCmd(ctx)
-	case envbuild.FieldVcpu:
-		return m.OldVcpu(ctx)
-	case envbuild.FieldRAMMB:
-		return m.OldRAMMB(ctx)
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.OldFreeDiskSizeMB(ctx)
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.OldTotalDiskSizeMB(ctx)
-	case envbuild.FieldKernelVersion:
-		return m.OldKernelVersion(ctx)
-	case envbuild.FieldFirecrackerVersion:
-		return m.OldFirecrackerVersion(ctx)
-	case envbuild.FieldEnvdVersion:
-		return m.OldEnvdVersion(ctx)
-	case envbuild.FieldClusterNodeID:
-		return m.OldClusterNodeID(ctx)
+	case snapshot.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case snapshot.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case snapshot.FieldSandboxStartedAt:
+		return m.OldSandboxStartedAt(ctx)
+	case snapshot.FieldEnvSecure:
+		return m.OldEnvSecure(ctx)
+	case snapshot.FieldNetwork:
+		return m.OldNetwork(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case snapshot.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case envbuild.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case envbuild.FieldFinishedAt:
-		v, ok := value.(time.Time)
+	case snapshot.FieldBaseEnvID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFinishedAt(v)
+		m.SetBaseEnvID(v)
 		return nil
-	case envbuild.FieldEnvID:
+	case snapshot.FieldEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEnvID(v)
 		return nil
-	case envbuild.FieldStatus:
-		v, ok := value.(envbuild.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case envbuild.FieldDockerfile:
+	case snapshot.FieldSandboxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDockerfile(v)
+		m.SetSandboxID(v)
 		return nil
-	case envbuild.FieldStartCmd:
-		v, ok := value.(string)
+	case snapshot.FieldMetadata:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartCmd(v)
+		m.SetMetadata(v)
 		return nil
-	case envbuild.FieldReadyCmd:
-		v, ok := value.(string)
+	case snapshot.FieldSandboxStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReadyCmd(v)
+		m.SetSandboxStartedAt(v)
 		return nil
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
+	case snapshot.FieldEnvSecure:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVcpu(v)
+		m.SetEnvSecure(v)
 		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
+	case snapshot.FieldNetwork:
+		v, ok := value.(*types.SandboxNetworkConfig)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRAMMB(v)
+		m.SetNetwork(v)
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFreeDiskSizeMB(v)
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SnapshotMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(snapshot.FieldNetwork) {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SnapshotMutation) ClearField(name string) error {
+	switch name {
+	case snapshot.FieldNetwork:
+		m.ClearNetwork()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalDiskSizeMB(v)
+	}
+	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SnapshotMutation) ResetField(name string) error {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case envbuild.FieldKernelVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKernelVersion(v)
+	case snapshot.FieldBaseEnvID:
+		m.ResetBaseEnvID()
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirecrackerVersion(v)
+	case snapshot.FieldEnvID:
+		m.ResetEnvID()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case snapshot.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case snapshot.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case snapshot.FieldSandboxStartedAt:
+		m.ResetSandboxStartedAt()
+		return nil
+	case snapshot.FieldEnvSecure:
+		m.ResetEnvSecure()
+		return nil
+	case snapshot.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case snapshot.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetEnvdVersion(v)
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case snapshot.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ClearEnv()
 		return nil
-	case envbuild.FieldClusterNodeID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClusterNodeID(v)
+	}
+	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ResetEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown Snapshot edge %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EnvBuildMutation) AddedFields() []string {
-	var fields []string
-	if m.addvcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	is_banned            *bool
+	is_blocked           *bool
+	blocked_reason       *string
+	name                 *string
+	email                *string
+	cluster_id           *uuid.UUID
+	clearedFields        map[string]struct{}
+	users                map[uuid.UUID]struct{}
+	removedusers         map[uuid.UUID]struct{}
+	clearedusers         bool
+	team_api_keys        map[uuid.UUID]struct{}
+	removedteam_api_keys map[uuid.UUID]struct{}
+	clearedteam_api_keys bool
+	team_tier            *string
+	clearedteam_tier     bool
+	envs                 map[string]struct{}
+	removedenvs          map[string]struct{}
+	clearedenvs          bool
+	volumes              map[uuid.UUID]struct{}
+	removedvolumes       map[uuid.UUID]struct{}
+	clearedvolumes       bool
+	users_teams          map[int]struct{}
+	removedusers_teams   map[int]struct{}
+	clearedusers_teams   bool
+	done                 bool
+	oldValue             func(context.Context) (*Team, error)
+	predicates           []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.addram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.addfree_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.addtotal_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case envbuild.FieldVcpu:
-		return m.AddedVcpu()
-	case envbuild.FieldRAMMB:
-		return m.AddedRAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.AddedFreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.AddedTotalDiskSizeMB()
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
 	}
-	return nil, false
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVcpu(v)
-		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRAMMB(v)
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFreeDiskSizeMB(v)
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		m.AddTotalDiskSizeMB(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EnvBuildMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(envbuild.FieldFinishedAt) {
-		fields = append(fields, envbuild.FieldFinishedAt)
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(envbuild.FieldEnvID) {
-		fields = append(fields, envbuild.FieldEnvID)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(envbuild.FieldDockerfile) {
-		fields = append(fields, envbuild.FieldDockerfile)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(envbuild.FieldStartCmd) {
-		fields = append(fields, envbuild.FieldStartCmd)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.FieldCleared(envbuild.FieldReadyCmd) {
-		fields = append(fields, envbuild.FieldReadyCmd)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetIsBanned sets the "is_banned" field.
+func (m *TeamMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
+}
+
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *TeamMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	return *v, true
+}
+
+// OldIsBanned returns the old "is_banned" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(envbuild.FieldEnvdVersion) {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
 	}
-	if m.FieldCleared(envbuild.FieldClusterNodeID) {
-		fields = append(fields, envbuild.FieldClusterNodeID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
 	}
-	return fields
+	return oldValue.IsBanned, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EnvBuildMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearIsBanned clears the value of the "is_banned" field.
+func (m *TeamMutation) ClearIsBanned() {
+	m.is_banned = nil
+	m.clearedFields[team.FieldIsBanned] = struct{}{}
+}
+
+// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
+func (m *TeamMutation) IsBannedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBanned]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ClearField(name string) error {
-	switch name {
-	case envbuild.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ClearEnvID()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ClearDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ClearStartCmd()
-		return nil
-	case envbuild.FieldReadyCmd:
-		m.ClearReadyCmd()
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ClearTotalDiskSizeMB()
-		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ClearEnvdVersion()
-		return nil
-	case envbuild.FieldClusterNodeID:
-		m.ClearClusterNodeID()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *TeamMutation) ResetIsBanned() {
+	m.is_banned = nil
+	delete(m.clearedFields, team.FieldIsBanned)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ResetField(name string) error {
-	switch name {
-	case envbuild.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case envbuild.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case envbuild.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case envbuild.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ResetDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ResetStartCmd()
-		return nil
-	case envbuild.FieldReadyCmd:
-		m.ResetReadyCmd()
-		return nil
-	case envbuild.FieldVcpu:
-		m.ResetVcpu()
-		return nil
-	case envbuild.FieldRAMMB:
-		m.ResetRAMMB()
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		m.ResetFreeDiskSizeMB()
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ResetTotalDiskSizeMB()
-		return nil
-	case envbuild.FieldKernelVersion:
-		m.ResetKernelVersion()
-		return nil
-	case envbuild.FieldFirecrackerVersion:
-		m.ResetFirecrackerVersion()
-		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ResetEnvdVersion()
-		return nil
-	case envbuild.FieldClusterNodeID:
-		m.ResetClusterNodeID()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+// SetIsBlocked sets the "is_blocked" field.
+func (m *TeamMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvBuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envbuild.EdgeEnv)
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case envbuild.EdgeEnv:
-		if id := m.env; id != nil {
-			return []ent.Value{*id}
-		}
+// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvBuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearIsBlocked clears the value of the "is_blocked" field.
+func (m *TeamMutation) ClearIsBlocked() {
+	m.is_blocked = nil
+	m.clearedFields[team.FieldIsBlocked] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
+func (m *TeamMutation) IsBlockedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBlocked]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvBuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envbuild.EdgeEnv)
-	}
-	return edges
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *TeamMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+	delete(m.clearedFields, team.FieldIsBlocked)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EnvBuildMutation) EdgeCleared(name string) bool {
-	switch name {
-	case envbuild.EdgeEnv:
-		return m.clearedenv
-	}
-	return false
+// SetBlockedReason sets the "blocked_reason" field.
+func (m *TeamMutation) SetBlockedReason(s string) {
+	m.blocked_reason = &s
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EnvBuildMutation) ClearEdge(name string) error {
-	switch name {
-	case envbuild.EdgeEnv:
-		m.ClearEnv()
-		return nil
+// BlockedReason returns the value of the "blocked_reason" field in the mutation.
+func (m *TeamMutation) BlockedReason() (r string, exists bool) {
+	v := m.blocked_reason
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EnvBuildMutation) ResetEdge(name string) error {
-	switch name {
-	case envbuild.EdgeEnv:
-		m.ResetEnv()
-		return nil
+// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown EnvBuild edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+	}
+	return oldValue.BlockedReason, nil
+}
+
+// ClearBlockedReason clears the value of the "blocked_reason" field.
+func (m *TeamMutation) ClearBlockedReason() {
+	m.blocked_reason = nil
+	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+}
+
+// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
+func (m *TeamMutation) BlockedReasonCleared() bool {
+	_, ok := m.clearedFields[team.FieldBlockedReason]
+	return ok
+}
+
+// ResetBlockedReason resets all changes to the "blocked_reason" field.
+func (m *TeamMutation) ResetBlockedReason() {
+	m.blocked_reason = nil
+	delete(m.clearedFields, team.FieldBlockedReason)
 }
 
-// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
-type SnapshotMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	base_env_id        *string
-	sandbox_id         *string
-	metadata           *map[string]string
-	sandbox_started_at *time.Time
-	env_secure         *bool
-	clearedFields      map[string]struct{}
-	env                *string
-	clearedenv         bool
-	done               bool
-	oldValue           func(context.Context) (*Snapshot, error)
-	predicates         []predicate.Snapshot
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
 }
 
-var _ ent.Mutation = (*SnapshotMutation)(nil)
-
-// snapshotOption allows management of the mutation configuration using functional options.
-type snapshotOption func(*SnapshotMutation)
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newSnapshotMutation creates new mutation for the Snapshot entity.
-func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
-	m := &SnapshotMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSnapshot,
-		clearedFields: make(map[string]struct{}),
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// withSnapshotID sets the ID field of the mutation.
-func withSnapshotID(id uuid.UUID) snapshotOption {
-	return func(m *SnapshotMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Snapshot
-		)
-		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Snapshot.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
 }
 
-// withSnapshot sets the old Snapshot of the mutation.
-func withSnapshot(node *Snapshot) snapshotOption {
-	return func(m *SnapshotMutation) {
-		m.oldValue = func(context.Context) (*Snapshot, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetTier sets the "tier" field.
+func (m *TeamMutation) SetTier(s string) {
+	m.team_tier = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SnapshotMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Tier returns the value of the "tier" field in the mutation.
+func (m *TeamMutation) Tier() (r string, exists bool) {
+	v := m.team_tier
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SnapshotMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
+// OldTier returns the old "tier" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Snapshot entities.
-func (m *SnapshotMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetTier resets all changes to the "tier" field.
+func (m *TeamMutation) ResetTier() {
+	m.team_tier = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetEmail sets the "email" field.
+func (m *TeamMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *TeamMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldEmail returns the old "email" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
+	return oldValue.Email, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetEmail resets all changes to the "email" field.
+func (m *TeamMutation) ResetEmail() {
+	m.email = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetClusterID sets the "cluster_id" field.
+func (m *TeamMutation) SetClusterID(u uuid.UUID) {
+	m.cluster_id = &u
+}
+
+// ClusterID returns the value of the "cluster_id" field in the mutation.
+func (m *TeamMutation) ClusterID() (r uuid.UUID, exists bool) {
+	v := m.cluster_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldClusterID returns the old "cluster_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldClusterID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+	}
+	return oldValue.ClusterID, nil
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (m *TeamMutation) ClearClusterID() {
+	m.cluster_id = nil
+	m.clearedFields[team.FieldClusterID] = struct{}{}
+}
+
+// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
+func (m *TeamMutation) ClusterIDCleared() bool {
+	_, ok := m.clearedFields[team.FieldClusterID]
+	return ok
+}
+
+// ResetClusterID resets all changes to the "cluster_id" field.
+func (m *TeamMutation) ResetClusterID() {
+	m.cluster_id = nil
+	delete(m.clearedFields, team.FieldClusterID)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *TeamMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *TeamMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SnapshotMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetBaseEnvID sets the "base_env_id" field.
-func (m *SnapshotMutation) SetBaseEnvID(s string) {
-	m.base_env_id = &s
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BaseEnvID returns the value of the "base_env_id" field in the mutation.
-func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
-	v := m.base_env_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUsers resets all changes to the "users" edge.
+func (m *TeamMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.team_api_keys == nil {
+		m.team_api_keys = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	for i := range ids {
+		m.team_api_keys[ids[i]] = struct{}{}
 	}
-	return oldValue.BaseEnvID, nil
 }
 
-// ResetBaseEnvID resets all changes to the "base_env_id" field.
-func (m *SnapshotMutation) ResetBaseEnvID() {
-	m.base_env_id = nil
+// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) ClearTeamAPIKeys() {
+	m.clearedteam_api_keys = true
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *SnapshotMutation) SetEnvID(s string) {
-	m.env = &s
+// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *TeamMutation) TeamAPIKeysCleared() bool {
+	return m.clearedteam_api_keys
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *SnapshotMutation) EnvID() (r string, exists bool) {
-	v := m.env
-	if v == nil {
-		return
+// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedteam_api_keys == nil {
+		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.team_api_keys, ids[i])
+		m.removedteam_api_keys[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedteam_api_keys {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	return
+}
+
+// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
+func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.team_api_keys {
+		ids = append(ids, id)
 	}
-	return oldValue.EnvID, nil
+	return
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *SnapshotMutation) ResetEnvID() {
-	m.env = nil
+// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
+func (m *TeamMutation) ResetTeamAPIKeys() {
+	m.team_api_keys = nil
+	m.clearedteam_api_keys = false
+	m.removedteam_api_keys = nil
 }
 
-// SetSandboxID sets the "sandbox_id" field.
-func (m *SnapshotMutation) SetSandboxID(s string) {
-	m.sandbox_id = &s
+// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
+func (m *TeamMutation) SetTeamTierID(id string) {
+	m.team_tier = &id
 }
 
-// SandboxID returns the value of the "sandbox_id" field in the mutation.
-func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
-	v := m.sandbox_id
-	if v == nil {
-		return
+// ClearTeamTier clears the "team_tier" edge to the Tier entity.
+func (m *TeamMutation) ClearTeamTier() {
+	m.clearedteam_tier = true
+	m.clearedFields[team.FieldTier] = struct{}{}
+}
+
+// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
+func (m *TeamMutation) TeamTierCleared() bool {
+	return m.clearedteam_tier
+}
+
+// TeamTierID returns the "team_tier" edge ID in the mutation.
+func (m *TeamMutation) TeamTierID() (id string, exists bool) {
+	if m.team_tier != nil {
+		return *m.team_tier, true
 	}
-	return *v, true
+	return
 }
 
-// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamTierID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TeamTierIDs() (ids []string) {
+	if id := m.team_tier; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	return
+}
+
+// ResetTeamTier resets all changes to the "team_tier" edge.
+func (m *TeamMutation) ResetTeamTier() {
+	m.team_tier = nil
+	m.clearedteam_tier = false
+}
+
+// AddEnvIDs adds the "envs" edge to the Env entity by ids.
+func (m *TeamMutation) AddEnvIDs(ids ...string) {
+	if m.envs == nil {
+		m.envs = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	for i := range ids {
+		m.envs[ids[i]] = struct{}{}
 	}
-	return oldValue.SandboxID, nil
 }
 
-// ResetSandboxID resets all changes to the "sandbox_id" field.
-func (m *SnapshotMutation) ResetSandboxID() {
-	m.sandbox_id = nil
+// ClearEnvs clears the "envs" edge to the Env entity.
+func (m *TeamMutation) ClearEnvs() {
+	m.clearedenvs = true
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SnapshotMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
+func (m *TeamMutation) EnvsCleared() bool {
+	return m.clearedenvs
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
+// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
+func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
+	if m.removedenvs == nil {
+		m.removedenvs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.envs, ids[i])
+		m.removedenvs[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
+func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
+	for id := range m.removedenvs {
+		ids = append(ids, id)
 	}
-	return oldValue.Metadata, nil
+	return
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SnapshotMutation) ResetMetadata() {
-	m.metadata = nil
+// EnvsIDs returns the "envs" edge IDs in the mutation.
+func (m *TeamMutation) EnvsIDs() (ids []string) {
+	for id := range m.envs {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetSandboxStartedAt sets the "sandbox_started_at" field.
-func (m *SnapshotMutation) SetSandboxStartedAt(t time.Time) {
-	m.sandbox_started_at = &t
+// ResetEnvs resets all changes to the "envs" edge.
+func (m *TeamMutation) ResetEnvs() {
+	m.envs = nil
+	m.clearedenvs = false
+	m.removedenvs = nil
 }
 
-// SandboxStartedAt returns the value of the "sandbox_started_at" field in the mutation.
-func (m *SnapshotMutation) SandboxStartedAt() (r time.Time, exists bool) {
-	v := m.sandbox_started_at
-	if v == nil {
-		return
+// AddVolumeIDs adds the "volumes" edge to the Volume entity by ids.
+func (m *TeamMutation) AddVolumeIDs(ids ...uuid.UUID) {
+	if m.volumes == nil {
+		m.volumes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.volumes[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSandboxStartedAt returns the old "sandbox_started_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxStartedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxStartedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxStartedAt requires an ID field in the mutation")
+// ClearVolumes clears the "volumes" edge to the Volume entity.
+func (m *TeamMutation) ClearVolumes() {
+	m.clearedvolumes = true
+}
+
+// VolumesCleared reports if the "volumes" edge to the Volume entity was cleared.
+func (m *TeamMutation) VolumesCleared() bool {
+	return m.clearedvolumes
+}
+
+// RemoveVolumeIDs removes the "volumes" edge to the Volume entity by IDs.
+func (m *TeamMutation) RemoveVolumeIDs(ids ...uuid.UUID) {
+	if m.removedvolumes == nil {
+		m.removedvolumes = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxStartedAt: %w", err)
+	for i := range ids {
+		delete(m.volumes, ids[i])
+		m.removedvolumes[ids[i]] = struct{}{}
 	}
-	return oldValue.SandboxStartedAt, nil
 }
 
-// ResetSandboxStartedAt resets all changes to the "sandbox_started_at" field.
-func (m *SnapshotMutation) ResetSandboxStartedAt() {
-	m.sandbox_started_at = nil
+// RemovedVolumes returns the removed IDs of the "volumes" edge to the Volume entity.
+func (m *TeamMutation) RemovedVolumesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvolumes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetEnvSecure sets the "env_secure" field.
-func (m *SnapshotMutation) SetEnvSecure(b bool) {
-	m.env_secure = &b
+// VolumesIDs returns the "volumes" edge IDs in the mutation.
+func (m *TeamMutation) VolumesIDs() (ids []uuid.UUID) {
+	for id := range m.volumes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EnvSecure returns the value of the "env_secure" field in the mutation.
-func (m *SnapshotMutation) EnvSecure() (r bool, exists bool) {
-	v := m.env_secure
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetVolumes resets all changes to the "volumes" edge.
+func (m *TeamMutation) ResetVolumes() {
+	m.volumes = nil
+	m.clearedvolumes = false
+	m.removedvolumes = nil
 }
 
-// OldEnvSecure returns the old "env_secure" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvSecure(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvSecure is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvSecure requires an ID field in the mutation")
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvSecure: %w", err)
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
 	}
-	return oldValue.EnvSecure, nil
 }
 
-// ResetEnvSecure resets all changes to the "env_secure" field.
-func (m *SnapshotMutation) ResetEnvSecure() {
-	m.env_secure = nil
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *SnapshotMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *TeamMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *SnapshotMutation) EnvCleared() bool {
-	return m.clearedenv
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
+	}
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *SnapshotMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *SnapshotMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the SnapshotMutation builder.
-func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *TeamMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Snapshot, len(ps))
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4967,45 +7287,48 @@ func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SnapshotMutation) Op() Op {
+func (m *TeamMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SnapshotMutation) SetOp(op Op) {
+func (m *TeamMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Snapshot).
-func (m *SnapshotMutation) Type() string {
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, snapshot.FieldCreatedAt)
+		fields = append(fields, team.FieldCreatedAt)
 	}
-	if m.base_env_id != nil {
-		fields = append(fields, snapshot.FieldBaseEnvID)
+	if m.is_banned != nil {
+		fields = append(fields, team.FieldIsBanned)
 	}
-	if m.env != nil {
-		fields = append(fields, snapshot.FieldEnvID)
+	if m.is_blocked != nil {
+		fields = append(fields, team.FieldIsBlocked)
 	}
-	if m.sandbox_id != nil {
-		fields = append(fields, snapshot.FieldSandboxID)
+	if m.blocked_reason != nil {
+		fields = append(fields, team.FieldBlockedReason)
 	}
-	if m.metadata != nil {
-		fields = append(fields, snapshot.FieldMetadata)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
 	}
-	if m.sandbox_started_at != nil {
-		fields = append(fields, snapshot.FieldSandboxStartedAt)
+	if m.team_tier != nil {
+		fields = append(fields, team.FieldTier)
 	}
-	if m.env_secure != nil {
-		fields = append(fields, snapshot.FieldEnvSecure)
+	if m.email != nil {
+		fields = append(fields, team.FieldEmail)
+	}
+	if m.cluster_id != nil {
+		fields = append(fields, team.FieldClusterID)
 	}
 	return fields
 }
@@ -5013,22 +7336,24 @@ func (m *SnapshotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		return m.CreatedAt()
-	case snapshot.FieldBaseEnvID:
-		return m.BaseEnvID()
-	case snapshot.FieldEnvID:
-		return m.EnvID()
-	case snapshot.FieldSandboxID:
-		return m.SandboxID()
-	case snapshot.FieldMetadata:
-		return m.Metadata()
-	case snapshot.FieldSandboxStartedAt:
-		return m.SandboxStartedAt()
-	case snapshot.FieldEnvSecure:
-		return m.EnvSecure()
+	case team.FieldIsBanned:
+		return m.IsBanned()
+	case team.FieldIsBlocked:
+		return m.IsBlocked()
+	case team.FieldBlockedReason:
+		return m.BlockedReason()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldTier:
+		return m.Tier()
+	case team.FieldEmail:
+		return m.Email()
+	case team.FieldClusterID:
+		return m.ClusterID()
 	}
 	return nil, false
 }
@@ -5036,272 +7361,437 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case snapshot.FieldBaseEnvID:
-		return m.OldBaseEnvID(ctx)
-	case snapshot.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case snapshot.FieldSandboxID:
-		return m.OldSandboxID(ctx)
-	case snapshot.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case snapshot.FieldSandboxStartedAt:
-		return m.OldSandboxStartedAt(ctx)
-	case snapshot.FieldEnvSecure:
-		return m.OldEnvSecure(ctx)
+	case team.FieldIsBanned:
+		return m.OldIsBanned(ctx)
+	case team.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case team.FieldBlockedReason:
+		return m.OldBlockedReason(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldTier:
+		return m.OldTier(ctx)
+	case team.FieldEmail:
+		return m.OldEmail(ctx)
+	case team.FieldClusterID:
+		return m.OldClusterID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+	return nil, fmt.Errorf("unknown Team field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case snapshot.FieldBaseEnvID:
-		v, ok := value.(string)
+	case team.FieldIsBanned:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseEnvID(v)
+		m.SetIsBanned(v)
 		return nil
-	case snapshot.FieldEnvID:
+	case team.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case team.FieldBlockedReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
+		m.SetBlockedReason(v)
 		return nil
-	case snapshot.FieldSandboxID:
+	case team.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSandboxID(v)
+		m.SetName(v)
 		return nil
-	case snapshot.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case team.FieldTier:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetTier(v)
 		return nil
-	case snapshot.FieldSandboxStartedAt:
-		v, ok := value.(time.Time)
+	case team.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSandboxStartedAt(v)
+		m.SetEmail(v)
 		return nil
-	case snapshot.FieldEnvSecure:
-		v, ok := value.(bool)
+	case team.FieldClusterID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvSecure(v)
+		m.SetClusterID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SnapshotMutation) AddedFields() []string {
+func (m *TeamMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+	return fmt.Errorf("unknown Team numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SnapshotMutation) ClearedFields() []string {
-	return nil
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldIsBanned) {
+		fields = append(fields, team.FieldIsBanned)
+	}
+	if m.FieldCleared(team.FieldIsBlocked) {
+		fields = append(fields, team.FieldIsBlocked)
+	}
+	if m.FieldCleared(team.FieldBlockedReason) {
+		fields = append(fields, team.FieldBlockedReason)
+	}
+	if m.FieldCleared(team.FieldClusterID) {
+		fields = append(fields, team.FieldClusterID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SnapshotMutation) FieldCleared(name string) bool {
+func (m *TeamMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SnapshotMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldIsBanned:
+		m.ClearIsBanned()
+		return nil
+	case team.FieldIsBlocked:
+		m.ClearIsBlocked()
+		return nil
+	case team.FieldBlockedReason:
+		m.ClearBlockedReason()
+		return nil
+	case team.FieldClusterID:
+		m.ClearClusterID()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SnapshotMutation) ResetField(name string) error {
+func (m *TeamMutation) ResetField(name string) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case snapshot.FieldBaseEnvID:
-		m.ResetBaseEnvID()
+	case team.FieldIsBanned:
+		m.ResetIsBanned()
 		return nil
-	case snapshot.FieldEnvID:
-		m.ResetEnvID()
+	case team.FieldIsBlocked:
+		m.ResetIsBlocked()
 		return nil
-	case snapshot.FieldSandboxID:
-		m.ResetSandboxID()
+	case team.FieldBlockedReason:
+		m.ResetBlockedReason()
 		return nil
-	case snapshot.FieldMetadata:
-		m.ResetMetadata()
+	case team.FieldName:
+		m.ResetName()
 		return nil
-	case snapshot.FieldSandboxStartedAt:
-		m.ResetSandboxStartedAt()
+	case team.FieldTier:
+		m.ResetTier()
 		return nil
-	case snapshot.FieldEnvSecure:
-		m.ResetEnvSecure()
+	case team.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case team.FieldClusterID:
+		m.ResetClusterID()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SnapshotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, snapshot.EdgeEnv)
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.users != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.team_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.team_tier != nil {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.envs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.volumes != nil {
+		edges = append(edges, team.EdgeVolumes)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case snapshot.EdgeEnv:
-		if id := m.env; id != nil {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.team_api_keys))
+		for id := range m.team_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamTier:
+		if id := m.team_tier; id != nil {
 			return []ent.Value{*id}
 		}
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.envs))
+		for id := range m.envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeVolumes:
+		ids := make([]ent.Value, 0, len(m.volumes))
+		for id := range m.volumes {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SnapshotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedusers != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.removedteam_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.removedenvs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.removedvolumes != nil {
+		edges = append(edges, team.EdgeVolumes)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
+		for id := range m.removedteam_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.removedenvs))
+		for id := range m.removedenvs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeVolumes:
+		ids := make([]ent.Value, 0, len(m.removedvolumes))
+		for id := range m.removedvolumes {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SnapshotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, snapshot.EdgeEnv)
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedusers {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.clearedteam_api_keys {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.clearedteam_tier {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.clearedenvs {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.clearedvolumes {
+		edges = append(edges, team.EdgeVolumes)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, team.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SnapshotMutation) EdgeCleared(name string) bool {
+func (m *TeamMutation) EdgeCleared(name string) bool {
 	switch name {
-	case snapshot.EdgeEnv:
-		return m.clearedenv
+	case team.EdgeUsers:
+		return m.clearedusers
+	case team.EdgeTeamAPIKeys:
+		return m.clearedteam_api_keys
+	case team.EdgeTeamTier:
+		return m.clearedteam_tier
+	case team.EdgeEnvs:
+		return m.clearedenvs
+	case team.EdgeVolumes:
+		return m.clearedvolumes
+	case team.EdgeUsersTeams:
+		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SnapshotMutation) ClearEdge(name string) error {
+func (m *TeamMutation) ClearEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
-		m.ClearEnv()
+	case team.EdgeTeamTier:
+		m.ClearTeamTier()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+	return fmt.Errorf("unknown Team unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SnapshotMutation) ResetEdge(name string) error {
+func (m *TeamMutation) ResetEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
-		m.ResetEnv()
+	case team.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	}
-	return fmt.Errorf("unknown Snapshot edge %s", name)
-}
-
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	is_banned            *bool
-	is_blocked           *bool
-	blocked_reason       *string
-	name                 *string
-	email                *string
-	cluster_id           *uuid.UUID
-	clearedFields        map[string]struct{}
-	users                map[uuid.UUID]struct{}
-	removedusers         map[uuid.UUID]struct{}
-	clearedusers         bool
-	team_api_keys        map[uuid.UUID]struct{}
-	removedteam_api_keys map[uuid.UUID]struct{}
-	clearedteam_api_keys bool
-	team_tier            *string
-	clearedteam_tier     bool
-	envs                 map[string]struct{}
-	removedenvs          map[string]struct{}
-	clearedenvs          bool
-	users_teams          map[int]struct{}
-	removedusers_teams   map[int]struct{}
-	clearedusers_teams   bool
-	done                 bool
-	oldValue             func(context.Context) (*Team, error)
-	predicates           []predicate.Team
+	case team.EdgeTeamAPIKeys:
+		m.ResetTeamAPIKeys()
+		return nil
+	case team.EdgeTeamTier:
+		m.ResetTeamTier()
+		return nil
+	case team.EdgeEnvs:
+		m.ResetEnvs()
+		return nil
+	case team.EdgeVolumes:
+		m.ResetVolumes()
+		return nil
+	case team.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
 }
 
-var _ ent.Mutation = (*TeamMutation)(nil)
+// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
+type TeamAPIKeyMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	api_key             *string
+	api_key_hash        *string
+	api_key_prefix      *string
+	api_key_length      *int
+	addapi_key_length   *int
+	api_key_mask_prefix *string
+	api_key_mask_suffix *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	name                *string
+	last_used           *time.Time
+	clearedFields       map[string]struct{}
+	team                *uuid.UUID
+	clearedteam         bool
+	creator             *uuid.UUID
+	clearedcreator      bool
+	done                bool
+	oldValue            func(context.Context) (*TeamAPIKey, error)
+	predicates          []predicate.TeamAPIKey
+}
 
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
+var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
 
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+// teamapikeyOption allows management of the mutation configuration using functional options.
+type teamapikeyOption func(*TeamAPIKeyMutation)
+
+// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
+func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
+	m := &TeamAPIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeTeamAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5310,20 +7800,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id uuid.UUID) teamOption {
-	return func(m *TeamMutation) {
+// withTeamAPIKeyID sets the ID field of the mutation.
+func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *TeamAPIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().TeamAPIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5332,10 +7822,10 @@ func withTeamID(id uuid.UUID) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withTeamAPIKey sets the old TeamAPIKey of the mutation.
+func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5344,7 +7834,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m TeamAPIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5352,7 +7842,7 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -5362,14 +7852,14 @@ func (m TeamMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Team entities.
-func (m *TeamMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TeamAPIKey entities.
+func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5380,7 +7870,7 @@ func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5389,617 +7879,579 @@ func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAPIKey sets the "api_key" field.
+func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
+	m.api_key = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// APIKey returns the value of the "api_key" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
+	v := m.api_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAPIKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.APIKey, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAPIKey resets all changes to the "api_key" field.
+func (m *TeamAPIKeyMutation) ResetAPIKey() {
+	m.api_key = nil
 }
 
-// SetIsBanned sets the "is_banned" field.
-func (m *TeamMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyHash(s string) {
+	m.api_key_hash = &s
 }
 
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *TeamMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
+// APIKeyHash returns the value of the "api_key_hash" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyHash() (r string, exists bool) {
+	v := m.api_key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBanned returns the old "is_banned" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyHash returns the old "api_key_hash" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyHash: %w", err)
 	}
-	return oldValue.IsBanned, nil
-}
-
-// ClearIsBanned clears the value of the "is_banned" field.
-func (m *TeamMutation) ClearIsBanned() {
-	m.is_banned = nil
-	m.clearedFields[team.FieldIsBanned] = struct{}{}
-}
-
-// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
-func (m *TeamMutation) IsBannedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBanned]
-	return ok
+	return oldValue.APIKeyHash, nil
 }
 
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *TeamMutation) ResetIsBanned() {
-	m.is_banned = nil
-	delete(m.clearedFields, team.FieldIsBanned)
+// ResetAPIKeyHash resets all changes to the "api_key_hash" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyHash() {
+	m.api_key_hash = nil
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *TeamMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyPrefix(s string) {
+	m.api_key_prefix = &s
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// APIKeyPrefix returns the value of the "api_key_prefix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyPrefix() (r string, exists bool) {
+	v := m.api_key_prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyPrefix returns the old "api_key_prefix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKeyPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyPrefix: %w", err)
 	}
-	return oldValue.IsBlocked, nil
-}
-
-// ClearIsBlocked clears the value of the "is_blocked" field.
-func (m *TeamMutation) ClearIsBlocked() {
-	m.is_blocked = nil
-	m.clearedFields[team.FieldIsBlocked] = struct{}{}
-}
-
-// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
-func (m *TeamMutation) IsBlockedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBlocked]
-	return ok
+	return oldValue.APIKeyPrefix, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *TeamMutation) ResetIsBlocked() {
-	m.is_blocked = nil
-	delete(m.clearedFields, team.FieldIsBlocked)
+// ResetAPIKeyPrefix resets all changes to the "api_key_prefix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyPrefix() {
+	m.api_key_prefix = nil
 }
 
-// SetBlockedReason sets the "blocked_reason" field.
-func (m *TeamMutation) SetBlockedReason(s string) {
-	m.blocked_reason = &s
+// SetAPIKeyLength sets the "api_key_length" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyLength(i int) {
+	m.api_key_length = &i
+	m.addapi_key_length = nil
 }
 
-// BlockedReason returns the value of the "blocked_reason" field in the mutation.
-func (m *TeamMutation) BlockedReason() (r string, exists bool) {
-	v := m.blocked_reason
+// APIKeyLength returns the value of the "api_key_length" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyLength() (r int, exists bool) {
+	v := m.api_key_length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyLength returns the old "api_key_length" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKeyLength(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyLength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyLength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyLength: %w", err)
 	}
-	return oldValue.BlockedReason, nil
+	return oldValue.APIKeyLength, nil
 }
 
-// ClearBlockedReason clears the value of the "blocked_reason" field.
-func (m *TeamMutation) ClearBlockedReason() {
-	m.blocked_reason = nil
-	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+// AddAPIKeyLength adds i to the "api_key_length" field.
+func (m *TeamAPIKeyMutation) AddAPIKeyLength(i int) {
+	if m.addapi_key_length != nil {
+		*m.addapi_key_length += i
+	} else {
+		m.addapi_key_length = &i
+	}
 }
 
-// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
-func (m *TeamMutation) BlockedReasonCleared() bool {
-	_, ok := m.clearedFields[team.FieldBlockedReason]
-	return ok
+// AddedAPIKeyLength returns the value that was added to the "api_key_length" field in this mutation.
+func (m *TeamAPIKeyMutation) AddedAPIKeyLength() (r int, exists bool) {
+	v := m.addapi_key_length
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetBlockedReason resets all changes to the "blocked_reason" field.
-func (m *TeamMutation) ResetBlockedReason() {
-	m.blocked_reason = nil
-	delete(m.clearedFields, team.FieldBlockedReason)
+// ResetAPIKeyLength resets all changes to the "api_key_length" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyLength() {
+	m.api_key_length = nil
+	m.addapi_key_length = nil
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// SetAPIKeyMaskPrefix sets the "api_key_mask_prefix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyMaskPrefix(s string) {
+	m.api_key_mask_prefix = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
+// APIKeyMaskPrefix returns the value of the "api_key_mask_prefix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyMaskPrefix() (r string, exists bool) {
+	v := m.api_key_mask_prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyMaskPrefix returns the old "api_key_mask_prefix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKeyMaskPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyMaskPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyMaskPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyMaskPrefix: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.APIKeyMaskPrefix, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
+// ResetAPIKeyMaskPrefix resets all changes to the "api_key_mask_prefix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyMaskPrefix() {
+	m.api_key_mask_prefix = nil
 }
 
-// SetTier sets the "tier" field.
-func (m *TeamMutation) SetTier(s string) {
-	m.team_tier = &s
+// SetAPIKeyMaskSuffix sets the "api_key_mask_suffix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyMaskSuffix(s string) {
+	m.api_key_mask_suffix = &s
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *TeamMutation) Tier() (r string, exists bool) {
-	v := m.team_tier
+// APIKeyMaskSuffix returns the value of the "api_key_mask_suffix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyMaskSuffix() (r string, exists bool) {
+	v := m.api_key_mask_suffix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTier returns the old "tier" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyMaskSuffix returns the old "api_key_mask_suffix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldAPIKeyMaskSuffix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyMaskSuffix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyMaskSuffix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyMaskSuffix: %w", err)
 	}
-	return oldValue.Tier, nil
+	return oldValue.APIKeyMaskSuffix, nil
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *TeamMutation) ResetTier() {
-	m.team_tier = nil
+// ResetAPIKeyMaskSuffix resets all changes to the "api_key_mask_suffix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyMaskSuffix() {
+	m.api_key_mask_suffix = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *TeamMutation) SetEmail(s string) {
-	m.email = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *TeamMutation) Email() (r string, exists bool) {
-	v := m.email
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *TeamMutation) ResetEmail() {
-	m.email = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamAPIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetClusterID sets the "cluster_id" field.
-func (m *TeamMutation) SetClusterID(u uuid.UUID) {
-	m.cluster_id = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClusterID returns the value of the "cluster_id" field in the mutation.
-func (m *TeamMutation) ClusterID() (r uuid.UUID, exists bool) {
-	v := m.cluster_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClusterID returns the old "cluster_id" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ClusterID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearClusterID clears the value of the "cluster_id" field.
-func (m *TeamMutation) ClearClusterID() {
-	m.cluster_id = nil
-	m.clearedFields[team.FieldClusterID] = struct{}{}
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
 }
 
-// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
-func (m *TeamMutation) ClusterIDCleared() bool {
-	_, ok := m.clearedFields[team.FieldClusterID]
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
 	return ok
 }
 
-// ResetClusterID resets all changes to the "cluster_id" field.
-func (m *TeamMutation) ResetClusterID() {
-	m.cluster_id = nil
-	delete(m.clearedFields, team.FieldClusterID)
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *TeamMutation) ClearUsers() {
-	m.clearedusers = true
+// SetTeamID sets the "team_id" field.
+func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *TeamMutation) UsersCleared() bool {
-	return m.clearedusers
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
+// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
+	return oldValue.TeamID, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamAPIKeyMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
-	}
-	return
+// SetName sets the "name" field.
+func (m *TeamAPIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *TeamMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.team_api_keys == nil {
-		m.team_api_keys = make(map[uuid.UUID]struct{})
+// OldName returns the old "name" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.team_api_keys[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) ClearTeamAPIKeys() {
-	m.clearedteam_api_keys = true
+// ResetName resets all changes to the "name" field.
+func (m *TeamAPIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *TeamMutation) TeamAPIKeysCleared() bool {
-	return m.clearedteam_api_keys
+// SetCreatedBy sets the "created_by" field.
+func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
 }
 
-// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedteam_api_keys == nil {
-		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.team_api_keys, ids[i])
-		m.removedteam_api_keys[ids[i]] = struct{}{}
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedteam_api_keys {
-		ids = append(ids, id)
+// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
-func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.team_api_keys {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
-func (m *TeamMutation) ResetTeamAPIKeys() {
-	m.team_api_keys = nil
-	m.clearedteam_api_keys = false
-	m.removedteam_api_keys = nil
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *TeamAPIKeyMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
 }
 
-// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
-func (m *TeamMutation) SetTeamTierID(id string) {
-	m.team_tier = &id
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+	return ok
 }
 
-// ClearTeamTier clears the "team_tier" edge to the Tier entity.
-func (m *TeamMutation) ClearTeamTier() {
-	m.clearedteam_tier = true
-	m.clearedFields[team.FieldTier] = struct{}{}
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TeamAPIKeyMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, teamapikey.FieldCreatedBy)
 }
 
-// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
-func (m *TeamMutation) TeamTierCleared() bool {
-	return m.clearedteam_tier
+// SetLastUsed sets the "last_used" field.
+func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
 }
 
-// TeamTierID returns the "team_tier" edge ID in the mutation.
-func (m *TeamMutation) TeamTierID() (id string, exists bool) {
-	if m.team_tier != nil {
-		return *m.team_tier, true
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamTierID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TeamTierIDs() (ids []string) {
-	if id := m.team_tier; id != nil {
-		ids = append(ids, *id)
+// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetTeamTier resets all changes to the "team_tier" edge.
-func (m *TeamMutation) ResetTeamTier() {
-	m.team_tier = nil
-	m.clearedteam_tier = false
-}
-
-// AddEnvIDs adds the "envs" edge to the Env entity by ids.
-func (m *TeamMutation) AddEnvIDs(ids ...string) {
-	if m.envs == nil {
-		m.envs = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.envs[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
 	}
+	return oldValue.LastUsed, nil
 }
 
-// ClearEnvs clears the "envs" edge to the Env entity.
-func (m *TeamMutation) ClearEnvs() {
-	m.clearedenvs = true
+// ClearLastUsed clears the value of the "last_used" field.
+func (m *TeamAPIKeyMutation) ClearLastUsed() {
+	m.last_used = nil
+	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
 }
 
-// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
-func (m *TeamMutation) EnvsCleared() bool {
-	return m.clearedenvs
+// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
+	return ok
 }
 
-// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
-func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
-	if m.removedenvs == nil {
-		m.removedenvs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.envs, ids[i])
-		m.removedenvs[ids[i]] = struct{}{}
-	}
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *TeamAPIKeyMutation) ResetLastUsed() {
+	m.last_used = nil
+	delete(m.clearedFields, teamapikey.FieldLastUsed)
 }
 
-// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
-func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
-	for id := range m.removedenvs {
-		ids = append(ids, id)
-	}
-	return
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamAPIKeyMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
 }
 
-// EnvsIDs returns the "envs" edge IDs in the mutation.
-func (m *TeamMutation) EnvsIDs() (ids []string) {
-	for id := range m.envs {
-		ids = append(ids, id)
-	}
-	return
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamAPIKeyMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// ResetEnvs resets all changes to the "envs" edge.
-func (m *TeamMutation) ResetEnvs() {
-	m.envs = nil
-	m.clearedenvs = false
-	m.removedenvs = nil
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
-	}
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamAPIKeyMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *TeamMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TeamAPIKeyMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
-	}
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TeamAPIKeyMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
 	}
 	return
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *TeamMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TeamAPIKeyMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
+// Where appends a list predicates to the TeamAPIKeyMutation builder.
+func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Team, len(ps))
+func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamAPIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6007,48 +8459,60 @@ func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamMutation) Op() Op {
+func (m *TeamAPIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamMutation) SetOp(op Op) {
+func (m *TeamAPIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
+// Type returns the node type of this mutation (TeamAPIKey).
+func (m *TeamAPIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, team.FieldCreatedAt)
+func (m *TeamAPIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.api_key != nil {
+		fields = append(fields, teamapikey.FieldAPIKey)
 	}
-	if m.is_banned != nil {
-		fields = append(fields, team.FieldIsBanned)
+	if m.api_key_hash != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyHash)
 	}
-	if m.is_blocked != nil {
-		fields = append(fields, team.FieldIsBlocked)
+	if m.api_key_prefix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyPrefix)
 	}
-	if m.blocked_reason != nil {
-		fields = append(fields, team.FieldBlockedReason)
+	if m.api_key_length != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyLength)
 	}
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
+	if m.api_key_mask_prefix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyMaskPrefix)
 	}
-	if m.team_tier != nil {
-		fields = append(fields, team.FieldTier)
+	if m.api_key_mask_suffix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyMaskSuffix)
 	}
-	if m.email != nil {
-		fields = append(fields, team.FieldEmail)
+	if m.created_at != nil {
+		fields = append(fields, teamapikey.FieldCreatedAt)
 	}
-	if m.cluster_id != nil {
-		fields = append(fields, team.FieldClusterID)
+	if m.updated_at != nil {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, teamapikey.FieldTeamID)
+	}
+	if m.name != nil {
+		fields = append(fields, teamapikey.FieldName)
+	}
+	if m.creator != nil {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.last_used != nil {
+		fields = append(fields, teamapikey.FieldLastUsed)
 	}
 	return fields
 }
@@ -6056,24 +8520,32 @@ func (m *TeamMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		return m.APIKey()
+	case teamapikey.FieldAPIKeyHash:
+		return m.APIKeyHash()
+	case teamapikey.FieldAPIKeyPrefix:
+		return m.APIKeyPrefix()
+	case teamapikey.FieldAPIKeyLength:
+		return m.APIKeyLength()
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		return m.APIKeyMaskPrefix()
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		return m.APIKeyMaskSuffix()
+	case teamapikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case team.FieldIsBanned:
-		return m.IsBanned()
-	case team.FieldIsBlocked:
-		return m.IsBlocked()
-	case team.FieldBlockedReason:
-		return m.BlockedReason()
-	case team.FieldName:
+	case teamapikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teamapikey.FieldTeamID:
+		return m.TeamID()
+	case teamapikey.FieldName:
 		return m.Name()
-	case team.FieldTier:
-		return m.Tier()
-	case team.FieldEmail:
-		return m.Email()
-	case team.FieldClusterID:
-		return m.ClusterID()
+	case teamapikey.FieldCreatedBy:
+		return m.CreatedBy()
+	case teamapikey.FieldLastUsed:
+		return m.LastUsed()
 	}
 	return nil, false
 }
@@ -6081,411 +8553,381 @@ func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		return m.OldAPIKey(ctx)
+	case teamapikey.FieldAPIKeyHash:
+		return m.OldAPIKeyHash(ctx)
+	case teamapikey.FieldAPIKeyPrefix:
+		return m.OldAPIKeyPrefix(ctx)
+	case teamapikey.FieldAPIKeyLength:
+		return m.OldAPIKeyLength(ctx)
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		return m.OldAPIKeyMaskPrefix(ctx)
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		return m.OldAPIKeyMaskSuffix(ctx)
+	case teamapikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case team.FieldIsBanned:
-		return m.OldIsBanned(ctx)
-	case team.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case team.FieldBlockedReason:
-		return m.OldBlockedReason(ctx)
-	case team.FieldName:
+	case teamapikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teamapikey.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamapikey.FieldName:
 		return m.OldName(ctx)
-	case team.FieldTier:
-		return m.OldTier(ctx)
-	case team.FieldEmail:
-		return m.OldEmail(ctx)
-	case team.FieldClusterID:
-		return m.OldClusterID(ctx)
+	case teamapikey.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case teamapikey.FieldLastUsed:
+		return m.OldLastUsed(ctx)
 	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
+func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case teamapikey.FieldAPIKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAPIKey(v)
 		return nil
-	case team.FieldIsBanned:
-		v, ok := value.(bool)
+	case teamapikey.FieldAPIKeyHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsBanned(v)
+		m.SetAPIKeyHash(v)
 		return nil
-	case team.FieldIsBlocked:
-		v, ok := value.(bool)
+	case teamapikey.FieldAPIKeyPrefix:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsBlocked(v)
+		m.SetAPIKeyPrefix(v)
 		return nil
-	case team.FieldBlockedReason:
-		v, ok := value.(string)
+	case teamapikey.FieldAPIKeyLength:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlockedReason(v)
+		m.SetAPIKeyLength(v)
 		return nil
-	case team.FieldName:
+	case teamapikey.FieldAPIKeyMaskPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetAPIKeyMaskPrefix(v)
 		return nil
-	case team.FieldTier:
+	case teamapikey.FieldAPIKeyMaskSuffix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTier(v)
+		m.SetAPIKeyMaskSuffix(v)
 		return nil
-	case team.FieldEmail:
+	case teamapikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case teamapikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case teamapikey.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case teamapikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetName(v)
 		return nil
-	case team.FieldClusterID:
+	case teamapikey.FieldCreatedBy:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClusterID(v)
+		m.SetCreatedBy(v)
+		return nil
+	case teamapikey.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMutation) AddedFields() []string {
-	return nil
+func (m *TeamAPIKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addapi_key_length != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyLength)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamapikey.FieldAPIKeyLength:
+		return m.AddedAPIKeyLength()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
+func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case teamapikey.FieldAPIKeyLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPIKeyLength(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMutation) ClearedFields() []string {
+func (m *TeamAPIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(team.FieldIsBanned) {
-		fields = append(fields, team.FieldIsBanned)
-	}
-	if m.FieldCleared(team.FieldIsBlocked) {
-		fields = append(fields, team.FieldIsBlocked)
+	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
 	}
-	if m.FieldCleared(team.FieldBlockedReason) {
-		fields = append(fields, team.FieldBlockedReason)
+	if m.FieldCleared(teamapikey.FieldCreatedBy) {
+		fields = append(fields, teamapikey.FieldCreatedBy)
 	}
-	if m.FieldCleared(team.FieldClusterID) {
-		fields = append(fields, team.FieldClusterID)
+	if m.FieldCleared(teamapikey.FieldLastUsed) {
+		fields = append(fields, teamapikey.FieldLastUsed)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
+func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
-	switch name {
-	case team.FieldIsBanned:
-		m.ClearIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ClearIsBlocked()
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamAPIKeyMutation) ClearField(name string) error {
+	switch name {
+	case teamapikey.FieldUpdatedAt:
+		m.ClearUpdatedAt()
 		return nil
-	case team.FieldBlockedReason:
-		m.ClearBlockedReason()
+	case teamapikey.FieldCreatedBy:
+		m.ClearCreatedBy()
 		return nil
-	case team.FieldClusterID:
-		m.ClearClusterID()
+	case teamapikey.FieldLastUsed:
+		m.ClearLastUsed()
 		return nil
 	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
+func (m *TeamAPIKeyMutation) ResetField(name string) error {
 	switch name {
-	case team.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case teamapikey.FieldAPIKey:
+		m.ResetAPIKey()
 		return nil
-	case team.FieldIsBanned:
-		m.ResetIsBanned()
+	case teamapikey.FieldAPIKeyHash:
+		m.ResetAPIKeyHash()
 		return nil
-	case team.FieldIsBlocked:
-		m.ResetIsBlocked()
+	case teamapikey.FieldAPIKeyPrefix:
+		m.ResetAPIKeyPrefix()
 		return nil
-	case team.FieldBlockedReason:
-		m.ResetBlockedReason()
+	case teamapikey.FieldAPIKeyLength:
+		m.ResetAPIKeyLength()
 		return nil
-	case team.FieldName:
-		m.ResetName()
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		m.ResetAPIKeyMaskPrefix()
 		return nil
-	case team.FieldTier:
-		m.ResetTier()
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		m.ResetAPIKeyMaskSuffix()
 		return nil
-	case team.FieldEmail:
-		m.ResetEmail()
+	case teamapikey.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case team.FieldClusterID:
-		m.ResetClusterID()
+	case teamapikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case teamapikey.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teamapikey.FieldName:
+		m.ResetName()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ResetLastUsed()
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.users != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.team_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.team_tier != nil {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.envs != nil {
-		edges = append(edges, team.EdgeEnvs)
+func (m *TeamAPIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, teamapikey.EdgeTeam)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
+	if m.creator != nil {
+		edges = append(edges, teamapikey.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.team_api_keys))
-		for id := range m.team_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamTier:
-		if id := m.team_tier; id != nil {
+	case teamapikey.EdgeTeam:
+		if id := m.team; id != nil {
 			return []ent.Value{*id}
 		}
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.envs))
-		for id := range m.envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
-			ids = append(ids, id)
+	case teamapikey.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedusers != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.removedteam_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.removedenvs != nil {
-		edges = append(edges, team.EdgeEnvs)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
-	}
+func (m *TeamAPIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
-		for id := range m.removedteam_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.removedenvs))
-		for id := range m.removedenvs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedusers {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.clearedteam_api_keys {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.clearedteam_tier {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.clearedenvs {
-		edges = append(edges, team.EdgeEnvs)
+func (m *TeamAPIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, teamapikey.EdgeTeam)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, team.EdgeUsersTeams)
+	if m.clearedcreator {
+		edges = append(edges, teamapikey.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
+func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case team.EdgeUsers:
-		return m.clearedusers
-	case team.EdgeTeamAPIKeys:
-		return m.clearedteam_api_keys
-	case team.EdgeTeamTier:
-		return m.clearedteam_tier
-	case team.EdgeEnvs:
-		return m.clearedenvs
-	case team.EdgeUsersTeams:
-		return m.clearedusers_teams
+	case teamapikey.EdgeTeam:
+		return m.clearedteam
+	case teamapikey.EdgeCreator:
+		return m.clearedcreator
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
-	switch name {
-	case team.EdgeTeamTier:
-		m.ClearTeamTier()
-		return nil
-	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
+func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case team.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case team.EdgeTeamAPIKeys:
-		m.ResetTeamAPIKeys()
-		return nil
-	case team.EdgeTeamTier:
-		m.ResetTeamTier()
-		return nil
-	case team.EdgeEnvs:
-		m.ResetEnvs()
+	case teamapikey.EdgeTeam:
+		m.ClearTeam()
 		return nil
-	case team.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case teamapikey.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Team edge %s", name)
-}
-
-// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
-type TeamAPIKeyMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	api_key             *string
-	api_key_hash        *string
-	api_key_prefix      *string
-	api_key_length      *int
-	addapi_key_length   *int
-	api_key_mask_prefix *string
-	api_key_mask_suffix *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	name                *string
-	last_used           *time.Time
-	clearedFields       map[string]struct{}
-	team                *uuid.UUID
-	clearedteam         bool
-	creator             *uuid.UUID
-	clearedcreator      bool
-	done                bool
-	oldValue            func(context.Context) (*TeamAPIKey, error)
-	predicates          []predicate.TeamAPIKey
+	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
 }
 
-var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case teamapikey.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+}
 
-// teamapikeyOption allows management of the mutation configuration using functional options.
-type teamapikeyOption func(*TeamAPIKeyMutation)
+// TierMutation represents an operation that mutates the Tier nodes in the graph.
+type TierMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	name                    *string
+	disk_mb                 *int64
+	adddisk_mb              *int64
+	concurrent_instances    *int64
+	addconcurrent_instances *int64
+	max_length_hours        *int64
+	addmax_length_hours     *int64
+	disk_bandwidth_mb       *int64
+	adddisk_bandwidth_mb    *int64
+	disk_iops               *int64
+	adddisk_iops            *int64
+	network_bandwidth_mb    *int64
+	addnetwork_bandwidth_mb *int64
+	clearedFields           map[string]struct{}
+	teams                   map[uuid.UUID]struct{}
+	removedteams            map[uuid.UUID]struct{}
+	clearedteams            bool
+	done                    bool
+	oldValue                func(context.Context) (*Tier, error)
+	predicates              []predicate.Tier
+}
 
-// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
-func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
-	m := &TeamAPIKeyMutation{
+var _ ent.Mutation = (*TierMutation)(nil)
+
+// tierOption allows management of the mutation configuration using functional options.
+type tierOption func(*TierMutation)
+
+// newTierMutation creates new mutation for the Tier entity.
+func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
+	m := &TierMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeamAPIKey,
+		typ:           TypeTier,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6494,20 +8936,20 @@ func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKe
 	return m
 }
 
-// withTeamAPIKeyID sets the ID field of the mutation.
-func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
+// withTierID sets the ID field of the mutation.
+func withTierID(id string) tierOption {
+	return func(m *TierMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TeamAPIKey
+			value *Tier
 		)
-		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
+		m.oldValue = func(ctx context.Context) (*Tier, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TeamAPIKey.Get(ctx, id)
+					value, err = m.Client().Tier.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6516,10 +8958,10 @@ func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
 	}
 }
 
-// withTeamAPIKey sets the old TeamAPIKey of the mutation.
-func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
-		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
+// withTier sets the old Tier of the mutation.
+func withTier(node *Tier) tierOption {
+	return func(m *TierMutation) {
+		m.oldValue = func(context.Context) (*Tier, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6528,7 +8970,7 @@ func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamAPIKeyMutation) Client() *Client {
+func (m TierMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6536,7 +8978,7 @@ func (m TeamAPIKeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
+func (m TierMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -6545,607 +8987,475 @@ func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TeamAPIKey entities.
-func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetAPIKey sets the "api_key" field.
-func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
-	m.api_key = &s
-}
-
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
-	v := m.api_key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
-	}
-	return oldValue.APIKey, nil
-}
-
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *TeamAPIKeyMutation) ResetAPIKey() {
-	m.api_key = nil
-}
-
-// SetAPIKeyHash sets the "api_key_hash" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyHash(s string) {
-	m.api_key_hash = &s
-}
-
-// APIKeyHash returns the value of the "api_key_hash" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyHash() (r string, exists bool) {
-	v := m.api_key_hash
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAPIKeyHash returns the old "api_key_hash" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyHash(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyHash requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyHash: %w", err)
-	}
-	return oldValue.APIKeyHash, nil
-}
-
-// ResetAPIKeyHash resets all changes to the "api_key_hash" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyHash() {
-	m.api_key_hash = nil
-}
-
-// SetAPIKeyPrefix sets the "api_key_prefix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyPrefix(s string) {
-	m.api_key_prefix = &s
-}
-
-// APIKeyPrefix returns the value of the "api_key_prefix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyPrefix() (r string, exists bool) {
-	v := m.api_key_prefix
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAPIKeyPrefix returns the old "api_key_prefix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyPrefix(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyPrefix is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyPrefix requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyPrefix: %w", err)
-	}
-	return oldValue.APIKeyPrefix, nil
-}
-
-// ResetAPIKeyPrefix resets all changes to the "api_key_prefix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyPrefix() {
-	m.api_key_prefix = nil
-}
-
-// SetAPIKeyLength sets the "api_key_length" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyLength(i int) {
-	m.api_key_length = &i
-	m.addapi_key_length = nil
-}
-
-// APIKeyLength returns the value of the "api_key_length" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyLength() (r int, exists bool) {
-	v := m.api_key_length
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAPIKeyLength returns the old "api_key_length" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyLength(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyLength is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyLength requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyLength: %w", err)
-	}
-	return oldValue.APIKeyLength, nil
-}
-
-// AddAPIKeyLength adds i to the "api_key_length" field.
-func (m *TeamAPIKeyMutation) AddAPIKeyLength(i int) {
-	if m.addapi_key_length != nil {
-		*m.addapi_key_length += i
-	} else {
-		m.addapi_key_length = &i
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tier entities.
+func (m *TierMutation) SetID(id string) {
+	m.id = &id
 }
 
-// AddedAPIKeyLength returns the value that was added to the "api_key_length" field in this mutation.
-func (m *TeamAPIKeyMutation) AddedAPIKeyLength() (r int, exists bool) {
-	v := m.addapi_key_length
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TierMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetAPIKeyLength resets all changes to the "api_key_length" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyLength() {
-	m.api_key_length = nil
-	m.addapi_key_length = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetAPIKeyMaskPrefix sets the "api_key_mask_prefix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyMaskPrefix(s string) {
-	m.api_key_mask_prefix = &s
+// SetName sets the "name" field.
+func (m *TierMutation) SetName(s string) {
+	m.name = &s
 }
 
-// APIKeyMaskPrefix returns the value of the "api_key_mask_prefix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyMaskPrefix() (r string, exists bool) {
-	v := m.api_key_mask_prefix
+// Name returns the value of the "name" field in the mutation.
+func (m *TierMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKeyMaskPrefix returns the old "api_key_mask_prefix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyMaskPrefix(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyMaskPrefix is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyMaskPrefix requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyMaskPrefix: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.APIKeyMaskPrefix, nil
+	return oldValue.Name, nil
 }
 
-// ResetAPIKeyMaskPrefix resets all changes to the "api_key_mask_prefix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyMaskPrefix() {
-	m.api_key_mask_prefix = nil
+// ResetName resets all changes to the "name" field.
+func (m *TierMutation) ResetName() {
+	m.name = nil
 }
 
-// SetAPIKeyMaskSuffix sets the "api_key_mask_suffix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyMaskSuffix(s string) {
-	m.api_key_mask_suffix = &s
+// SetDiskMB sets the "disk_mb" field.
+func (m *TierMutation) SetDiskMB(i int64) {
+	m.disk_mb = &i
+	m.adddisk_mb = nil
 }
 
-// APIKeyMaskSuffix returns the value of the "api_key_mask_suffix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyMaskSuffix() (r string, exists bool) {
-	v := m.api_key_mask_suffix
+// DiskMB returns the value of the "disk_mb" field in the mutation.
+func (m *TierMutation) DiskMB() (r int64, exists bool) {
+	v := m.disk_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKeyMaskSuffix returns the old "api_key_mask_suffix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyMaskSuffix(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyMaskSuffix is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyMaskSuffix requires an ID field in the mutation")
+		return v, errors.New("OldDiskMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyMaskSuffix: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
 	}
-	return oldValue.APIKeyMaskSuffix, nil
-}
-
-// ResetAPIKeyMaskSuffix resets all changes to the "api_key_mask_suffix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyMaskSuffix() {
-	m.api_key_mask_suffix = nil
+	return oldValue.DiskMB, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddDiskMB adds i to the "disk_mb" field.
+func (m *TierMutation) AddDiskMB(i int64) {
+	if m.adddisk_mb != nil {
+		*m.adddisk_mb += i
+	} else {
+		m.adddisk_mb = &i
+	}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
+func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
+	v := m.adddisk_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamAPIKeyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDiskMB resets all changes to the "disk_mb" field.
+func (m *TierMutation) ResetDiskMB() {
+	m.disk_mb = nil
+	m.adddisk_mb = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetConcurrentInstances sets the "concurrent_instances" field.
+func (m *TierMutation) SetConcurrentInstances(i int64) {
+	m.concurrent_instances = &i
+	m.addconcurrent_instances = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
+func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
+	v := m.concurrent_instances
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ConcurrentInstances, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+// AddConcurrentInstances adds i to the "concurrent_instances" field.
+func (m *TierMutation) AddConcurrentInstances(i int64) {
+	if m.addconcurrent_instances != nil {
+		*m.addconcurrent_instances += i
+	} else {
+		m.addconcurrent_instances = &i
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
-	return ok
+// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
+func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
+	v := m.addconcurrent_instances
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
+func (m *TierMutation) ResetConcurrentInstances() {
+	m.concurrent_instances = nil
+	m.addconcurrent_instances = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetMaxLengthHours sets the "max_length_hours" field.
+func (m *TierMutation) SetMaxLengthHours(i int64) {
+	m.max_length_hours = &i
+	m.addmax_length_hours = nil
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
+func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
+	v := m.max_length_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.MaxLengthHours, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *TeamAPIKeyMutation) ResetTeamID() {
-	m.team = nil
+// AddMaxLengthHours adds i to the "max_length_hours" field.
+func (m *TierMutation) AddMaxLengthHours(i int64) {
+	if m.addmax_length_hours != nil {
+		*m.addmax_length_hours += i
+	} else {
+		m.addmax_length_hours = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *TeamAPIKeyMutation) SetName(s string) {
-	m.name = &s
+// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
+func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
+	v := m.addmax_length_hours
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
+func (m *TierMutation) ResetMaxLengthHours() {
+	m.max_length_hours = nil
+	m.addmax_length_hours = nil
+}
+
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (m *TierMutation) SetDiskBandwidthMB(i int64) {
+	m.disk_bandwidth_mb = &i
+	m.adddisk_bandwidth_mb = nil
+}
+
+// DiskBandwidthMB returns the value of the "disk_bandwidth_mb" field in the mutation.
+func (m *TierMutation) DiskBandwidthMB() (r int64, exists bool) {
+	v := m.disk_bandwidth_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldDiskBandwidthMB returns the old "disk_bandwidth_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldDiskBandwidthMB(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiskBandwidthMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDiskBandwidthMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiskBandwidthMB: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DiskBandwidthMB, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamAPIKeyMutation) ResetName() {
-	m.name = nil
+// AddDiskBandwidthMB adds i to the "disk_bandwidth_mb" field.
+func (m *TierMutation) AddDiskBandwidthMB(i int64) {
+	if m.adddisk_bandwidth_mb != nil {
+		*m.adddisk_bandwidth_mb += i
+	} else {
+		m.adddisk_bandwidth_mb = &i
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// AddedDiskBandwidthMB returns the value that was added to the "disk_bandwidth_mb" field in this mutation.
+func (m *TierMutation) AddedDiskBandwidthMB() (r int64, exists bool) {
+	v := m.adddisk_bandwidth_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// ResetDiskBandwidthMB resets all changes to the "disk_bandwidth_mb" field.
+func (m *TierMutation) ResetDiskBandwidthMB() {
+	m.disk_bandwidth_mb = nil
+	m.adddisk_bandwidth_mb = nil
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (m *TierMutation) SetDiskIops(i int64) {
+	m.disk_iops = &i
+	m.adddisk_iops = nil
+}
+
+// DiskIops returns the value of the "disk_iops" field in the mutation.
+func (m *TierMutation) DiskIops() (r int64, exists bool) {
+	v := m.disk_iops
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldDiskIops returns the old "disk_iops" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *TierMutation) OldDiskIops(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiskIops is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldDiskIops requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiskIops: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.DiskIops, nil
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *TeamAPIKeyMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// AddDiskIops adds i to the "disk_iops" field.
+func (m *TierMutation) AddDiskIops(i int64) {
+	if m.adddisk_iops != nil {
+		*m.adddisk_iops += i
+	} else {
+		m.adddisk_iops = &i
+	}
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
-	return ok
+// AddedDiskIops returns the value that was added to the "disk_iops" field in this mutation.
+func (m *TierMutation) AddedDiskIops() (r int64, exists bool) {
+	v := m.adddisk_iops
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *TeamAPIKeyMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+// ResetDiskIops resets all changes to the "disk_iops" field.
+func (m *TierMutation) ResetDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
 }
 
-// SetLastUsed sets the "last_used" field.
-func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (m *TierMutation) SetNetworkBandwidthMB(i int64) {
+	m.network_bandwidth_mb = &i
+	m.addnetwork_bandwidth_mb = nil
 }
 
-// LastUsed returns the value of the "last_used" field in the mutation.
-func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
+// NetworkBandwidthMB returns the value of the "network_bandwidth_mb" field in the mutation.
+func (m *TierMutation) NetworkBandwidthMB() (r int64, exists bool) {
+	v := m.network_bandwidth_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldNetworkBandwidthMB returns the old "network_bandwidth_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+func (m *TierMutation) OldNetworkBandwidthMB(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldNetworkBandwidthMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+		return v, errors.New("OldNetworkBandwidthMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldNetworkBandwidthMB: %w", err)
 	}
-	return oldValue.LastUsed, nil
-}
-
-// ClearLastUsed clears the value of the "last_used" field.
-func (m *TeamAPIKeyMutation) ClearLastUsed() {
-	m.last_used = nil
-	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
-}
-
-// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
-	return ok
+	return oldValue.NetworkBandwidthMB, nil
 }
 
-// ResetLastUsed resets all changes to the "last_used" field.
-func (m *TeamAPIKeyMutation) ResetLastUsed() {
-	m.last_used = nil
-	delete(m.clearedFields, teamapikey.FieldLastUsed)
+// AddNetworkBandwidthMB adds i to the "network_bandwidth_mb" field.
+func (m *TierMutation) AddNetworkBandwidthMB(i int64) {
+	if m.addnetwork_bandwidth_mb != nil {
+		*m.addnetwork_bandwidth_mb += i
+	} else {
+		m.addnetwork_bandwidth_mb = &i
+	}
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *TeamAPIKeyMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+// AddedNetworkBandwidthMB returns the value that was added to the "network_bandwidth_mb" field in this mutation.
+func (m *TierMutation) AddedNetworkBandwidthMB() (r int64, exists bool) {
+	v := m.addnetwork_bandwidth_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *TeamAPIKeyMutation) TeamCleared() bool {
-	return m.clearedteam
+// ResetNetworkBandwidthMB resets all changes to the "network_bandwidth_mb" field.
+func (m *TierMutation) ResetNetworkBandwidthMB() {
+	m.network_bandwidth_mb = nil
+	m.addnetwork_bandwidth_mb = nil
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetTeam resets all changes to the "team" edge.
-func (m *TeamAPIKeyMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *TierMutation) ClearTeams() {
+	m.clearedteams = true
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TeamAPIKeyMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *TierMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TeamAPIKeyMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TeamAPIKeyMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// ResetTeams resets all changes to the "teams" edge.
+func (m *TierMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
 }
 
-// Where appends a list predicates to the TeamAPIKeyMutation builder.
-func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+// Where appends a list predicates to the TierMutation builder.
+func (m *TierMutation) Where(ps ...predicate.Tier) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TeamAPIKey, len(ps))
+func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tier, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7153,60 +9463,45 @@ func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamAPIKeyMutation) Op() Op {
+func (m *TierMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamAPIKeyMutation) SetOp(op Op) {
+func (m *TierMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TeamAPIKey).
-func (m *TeamAPIKeyMutation) Type() string {
+// Type returns the node type of this mutation (Tier).
+func (m *TierMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamAPIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.api_key != nil {
-		fields = append(fields, teamapikey.FieldAPIKey)
-	}
-	if m.api_key_hash != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyHash)
-	}
-	if m.api_key_prefix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyPrefix)
-	}
-	if m.api_key_length != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyLength)
-	}
-	if m.api_key_mask_prefix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyMaskPrefix)
-	}
-	if m.api_key_mask_suffix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyMaskSuffix)
+func (m *TierMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, tier.FieldName)
 	}
-	if m.created_at != nil {
-		fields = append(fields, teamapikey.FieldCreatedAt)
+	if m.disk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
+	if m.concurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
 	}
-	if m.team != nil {
-		fields = append(fields, teamapikey.FieldTeamID)
+	if m.max_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
 	}
-	if m.name != nil {
-		fields = append(fields, teamapikey.FieldName)
+	if m.disk_bandwidth_mb != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMB)
 	}
-	if m.creator != nil {
-		fields = append(fields, teamapikey.FieldCreatedBy)
+	if m.disk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
 	}
-	if m.last_used != nil {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.network_bandwidth_mb != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMB)
 	}
 	return fields
 }
@@ -7214,32 +9509,22 @@ func (m *TeamAPIKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *TierMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.APIKey()
-	case teamapikey.FieldAPIKeyHash:
-		return m.APIKeyHash()
-	case teamapikey.FieldAPIKeyPrefix:
-		return m.APIKeyPrefix()
-	case teamapikey.FieldAPIKeyLength:
-		return m.APIKeyLength()
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		return m.APIKeyMaskPrefix()
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		return m.APIKeyMaskSuffix()
-	case teamapikey.FieldCreatedAt:
-		return m.CreatedAt()
-	case teamapikey.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case teamapikey.FieldTeamID:
-		return m.TeamID()
-	case teamapikey.FieldName:
+	case tier.FieldName:
 		return m.Name()
-	case teamapikey.FieldCreatedBy:
-		return m.CreatedBy()
-	case teamapikey.FieldLastUsed:
-		return m.LastUsed()
+	case tier.FieldDiskMB:
+		return m.DiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.ConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.MaxLengthHours()
+	case tier.FieldDiskBandwidthMB:
+		return m.DiskBandwidthMB()
+	case tier.FieldDiskIops:
+		return m.DiskIops()
+	case tier.FieldNetworkBandwidthMB:
+		return m.NetworkBandwidthMB()
 	}
 	return nil, false
 }
@@ -7247,135 +9532,105 @@ func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.OldAPIKey(ctx)
-	case teamapikey.FieldAPIKeyHash:
-		return m.OldAPIKeyHash(ctx)
-	case teamapikey.FieldAPIKeyPrefix:
-		return m.OldAPIKeyPrefix(ctx)
-	case teamapikey.FieldAPIKeyLength:
-		return m.OldAPIKeyLength(ctx)
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		return m.OldAPIKeyMaskPrefix(ctx)
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		return m.OldAPIKeyMaskSuffix(ctx)
-	case teamapikey.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case teamapikey.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case teamapikey.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case teamapikey.FieldName:
+	case tier.FieldName:
 		return m.OldName(ctx)
-	case teamapikey.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case teamapikey.FieldLastUsed:
-		return m.OldLastUsed(ctx)
+	case tier.FieldDiskMB:
+		return m.OldDiskMB(ctx)
+	case tier.FieldConcurrentInstances:
+		return m.OldConcurrentInstances(ctx)
+	case tier.FieldMaxLengthHours:
+		return m.OldMaxLengthHours(ctx)
+	case tier.FieldDiskBandwidthMB:
+		return m.OldDiskBandwidthMB(ctx)
+	case tier.FieldDiskIops:
+		return m.OldDiskIops(ctx)
+	case tier.FieldNetworkBandwidthMB:
+		return m.OldNetworkBandwidthMB(ctx)
 	}
-	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return nil, fmt.Errorf("unknown Tier field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+func (m *TierMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKey(v)
-		return nil
-	case teamapikey.FieldAPIKeyHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyHash(v)
-		return nil
-	case teamapikey.FieldAPIKeyPrefix:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyPrefix(v)
-		return nil
-	case teamapikey.FieldAPIKeyLength:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyLength(v)
-		return nil
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyMaskPrefix(v)
-		return nil
-	case teamapikey.FieldAPIKeyMaskSuffix:
+	case tier.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKeyMaskSuffix(v)
+		m.SetName(v)
 		return nil
-	case teamapikey.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDiskMB(v)
 		return nil
-	case teamapikey.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetConcurrentInstances(v)
 		return nil
-	case teamapikey.FieldTeamID:
-		v, ok := value.(uuid.UUID)
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetMaxLengthHours(v)
 		return nil
-	case teamapikey.FieldName:
-		v, ok := value.(string)
+	case tier.FieldDiskBandwidthMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDiskBandwidthMB(v)
 		return nil
-	case teamapikey.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetDiskIops(v)
 		return nil
-	case teamapikey.FieldLastUsed:
-		v, ok := value.(time.Time)
+	case tier.FieldNetworkBandwidthMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsed(v)
+		m.SetNetworkBandwidthMB(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown Tier field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamAPIKeyMutation) AddedFields() []string {
+func (m *TierMutation) AddedFields() []string {
 	var fields []string
-	if m.addapi_key_length != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyLength)
+	if m.adddisk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
+	}
+	if m.addconcurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
+	}
+	if m.addmax_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
+	}
+	if m.adddisk_bandwidth_mb != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMB)
+	}
+	if m.adddisk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
+	}
+	if m.addnetwork_bandwidth_mb != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMB)
 	}
 	return fields
 }
@@ -7383,10 +9638,20 @@ func (m *TeamAPIKeyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case teamapikey.FieldAPIKeyLength:
-		return m.AddedAPIKeyLength()
+	case tier.FieldDiskMB:
+		return m.AddedDiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.AddedConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.AddedMaxLengthHours()
+	case tier.FieldDiskBandwidthMB:
+		return m.AddedDiskBandwidthMB()
+	case tier.FieldDiskIops:
+		return m.AddedDiskIops()
+	case tier.FieldNetworkBandwidthMB:
+		return m.AddedNetworkBandwidthMB()
 	}
 	return nil, false
 }
@@ -7394,228 +9659,225 @@ func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
+func (m *TierMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKeyLength:
-		v, ok := value.(int)
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAPIKeyLength(v)
+		m.AddDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLengthHours(v)
+		return nil
+	case tier.FieldDiskBandwidthMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskBandwidthMB(v)
+		return nil
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskIops(v)
+		return nil
+	case tier.FieldNetworkBandwidthMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkBandwidthMB(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+	return fmt.Errorf("unknown Tier numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamAPIKeyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
-	}
-	if m.FieldCleared(teamapikey.FieldCreatedBy) {
-		fields = append(fields, teamapikey.FieldCreatedBy)
-	}
-	if m.FieldCleared(teamapikey.FieldLastUsed) {
-		fields = append(fields, teamapikey.FieldLastUsed)
-	}
-	return fields
+func (m *TierMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+func (m *TierMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearField(name string) error {
-	switch name {
-	case teamapikey.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case teamapikey.FieldLastUsed:
-		m.ClearLastUsed()
-		return nil
-	}
-	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+func (m *TierMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tier nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetField(name string) error {
+func (m *TierMutation) ResetField(name string) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		m.ResetAPIKey()
-		return nil
-	case teamapikey.FieldAPIKeyHash:
-		m.ResetAPIKeyHash()
-		return nil
-	case teamapikey.FieldAPIKeyPrefix:
-		m.ResetAPIKeyPrefix()
-		return nil
-	case teamapikey.FieldAPIKeyLength:
-		m.ResetAPIKeyLength()
-		return nil
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		m.ResetAPIKeyMaskPrefix()
-		return nil
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		m.ResetAPIKeyMaskSuffix()
+	case tier.FieldName:
+		m.ResetName()
 		return nil
-	case teamapikey.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case tier.FieldDiskMB:
+		m.ResetDiskMB()
 		return nil
-	case teamapikey.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case tier.FieldConcurrentInstances:
+		m.ResetConcurrentInstances()
 		return nil
-	case teamapikey.FieldTeamID:
-		m.ResetTeamID()
+	case tier.FieldMaxLengthHours:
+		m.ResetMaxLengthHours()
 		return nil
-	case teamapikey.FieldName:
-		m.ResetName()
+	case tier.FieldDiskBandwidthMB:
+		m.ResetDiskBandwidthMB()
 		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case tier.FieldDiskIops:
+		m.ResetDiskIops()
 		return nil
-	case teamapikey.FieldLastUsed:
-		m.ResetLastUsed()
+	case tier.FieldNetworkBandwidthMB:
+		m.ResetNetworkBandwidthMB()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown Tier field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamAPIKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, teamapikey.EdgeCreator)
+func (m *TierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.teams != nil {
+		edges = append(edges, tier.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *TierMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case teamapikey.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case teamapikey.EdgeCreator:
-		if id := m.creator; id != nil {
-			return []ent.Value{*id}
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamAPIKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedteams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamAPIKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, teamapikey.EdgeCreator)
+func (m *TierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteams {
+		edges = append(edges, tier.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
+func (m *TierMutation) EdgeCleared(name string) bool {
 	switch name {
-	case teamapikey.EdgeTeam:
-		return m.clearedteam
-	case teamapikey.EdgeCreator:
-		return m.clearedcreator
+	case tier.EdgeTeams:
+		return m.clearedteams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
+func (m *TierMutation) ClearEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ClearCreator()
-		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+	return fmt.Errorf("unknown Tier unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+func (m *TierMutation) ResetEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ResetCreator()
+	case tier.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+	return fmt.Errorf("unknown Tier edge %s", name)
 }
 
-// TierMutation represents an operation that mutates the Tier nodes in the graph.
-type TierMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
 	op                      Op
 	typ                     string
-	id                      *string
-	name                    *string
-	disk_mb                 *int64
-	adddisk_mb              *int64
-	concurrent_instances    *int64
-	addconcurrent_instances *int64
-	max_length_hours        *int64
-	addmax_length_hours     *int64
+	id                      *uuid.UUID
+	email                   *string
 	clearedFields           map[string]struct{}
 	teams                   map[uuid.UUID]struct{}
 	removedteams            map[uuid.UUID]struct{}
 	clearedteams            bool
+	created_envs            map[string]struct{}
+	removedcreated_envs     map[string]struct{}
+	clearedcreated_envs     bool
+	access_tokens           map[uuid.UUID]struct{}
+	removedaccess_tokens    map[uuid.UUID]struct{}
+	clearedaccess_tokens    bool
+	created_api_keys        map[uuid.UUID]struct{}
+	removedcreated_api_keys map[uuid.UUID]struct{}
+	clearedcreated_api_keys bool
+	users_teams             map[int]struct{}
+	removedusers_teams      map[int]struct{}
+	clearedusers_teams      bool
 	done                    bool
-	oldValue                func(context.Context) (*Tier, error)
-	predicates              []predicate.Tier
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
 }
 
-var _ ent.Mutation = (*TierMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// tierOption allows management of the mutation configuration using functional options.
-type tierOption func(*TierMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTierMutation creates new mutation for the Tier entity.
-func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
-	m := &TierMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTier,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7624,20 +9886,20 @@ func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
 	return m
 }
 
-// withTierID sets the ID field of the mutation.
-func withTierID(id string) tierOption {
-	return func(m *TierMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tier
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Tier, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tier.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7646,10 +9908,10 @@ func withTierID(id string) tierOption {
 	}
 }
 
-// withTier sets the old Tier of the mutation.
-func withTier(node *Tier) tierOption {
-	return func(m *TierMutation) {
-		m.oldValue = func(context.Context) (*Tier, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7658,7 +9920,7 @@ func withTier(node *Tier) tierOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TierMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7666,7 +9928,7 @@ func (m TierMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TierMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -7676,14 +9938,14 @@ func (m TierMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tier entities.
-func (m *TierMutation) SetID(id string) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TierMutation) ID() (id string, exists bool) {
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7694,288 +9956,336 @@ func (m *TierMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TierMutation) SetName(s string) {
-	m.name = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TierMutation) Name() (r string, exists bool) {
-	v := m.name
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Email, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TierMutation) ResetName() {
-	m.name = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetDiskMB sets the "disk_mb" field.
-func (m *TierMutation) SetDiskMB(i int64) {
-	m.disk_mb = &i
-	m.adddisk_mb = nil
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
 }
 
-// DiskMB returns the value of the "disk_mb" field in the mutation.
-func (m *TierMutation) DiskMB() (r int64, exists bool) {
-	v := m.disk_mb
-	if v == nil {
-		return
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UserMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UserMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
 	}
-	return *v, true
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UserMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
+func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
+	if m.created_envs == nil {
+		m.created_envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.created_envs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
+func (m *UserMutation) ClearCreatedEnvs() {
+	m.clearedcreated_envs = true
 }
 
-// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiskMB requires an ID field in the mutation")
+// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
+func (m *UserMutation) CreatedEnvsCleared() bool {
+	return m.clearedcreated_envs
+}
+
+// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
+func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
+	if m.removedcreated_envs == nil {
+		m.removedcreated_envs = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
+	for i := range ids {
+		delete(m.created_envs, ids[i])
+		m.removedcreated_envs[ids[i]] = struct{}{}
 	}
-	return oldValue.DiskMB, nil
 }
 
-// AddDiskMB adds i to the "disk_mb" field.
-func (m *TierMutation) AddDiskMB(i int64) {
-	if m.adddisk_mb != nil {
-		*m.adddisk_mb += i
-	} else {
-		m.adddisk_mb = &i
+// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
+func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
+	for id := range m.removedcreated_envs {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
-func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
-	v := m.adddisk_mb
-	if v == nil {
-		return
+// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
+func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
+	for id := range m.created_envs {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetDiskMB resets all changes to the "disk_mb" field.
-func (m *TierMutation) ResetDiskMB() {
-	m.disk_mb = nil
-	m.adddisk_mb = nil
+// ResetCreatedEnvs resets all changes to the "created_envs" edge.
+func (m *UserMutation) ResetCreatedEnvs() {
+	m.created_envs = nil
+	m.clearedcreated_envs = false
+	m.removedcreated_envs = nil
 }
 
-// SetConcurrentInstances sets the "concurrent_instances" field.
-func (m *TierMutation) SetConcurrentInstances(i int64) {
-	m.concurrent_instances = &i
-	m.addconcurrent_instances = nil
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.access_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
-func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
-	v := m.concurrent_instances
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
 }
 
-// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
+}
+
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
+	for i := range ids {
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.ConcurrentInstances, nil
 }
 
-// AddConcurrentInstances adds i to the "concurrent_instances" field.
-func (m *TierMutation) AddConcurrentInstances(i int64) {
-	if m.addconcurrent_instances != nil {
-		*m.addconcurrent_instances += i
-	} else {
-		m.addconcurrent_instances = &i
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccess_tokens {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
-func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
-	v := m.addconcurrent_instances
-	if v == nil {
-		return
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.access_tokens {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
-func (m *TierMutation) ResetConcurrentInstances() {
-	m.concurrent_instances = nil
-	m.addconcurrent_instances = nil
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// SetMaxLengthHours sets the "max_length_hours" field.
-func (m *TierMutation) SetMaxLengthHours(i int64) {
-	m.max_length_hours = &i
-	m.addmax_length_hours = nil
+// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.created_api_keys == nil {
+		m.created_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_api_keys[ids[i]] = struct{}{}
+	}
 }
 
-// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
-func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
-	v := m.max_length_hours
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) ClearCreatedAPIKeys() {
+	m.clearedcreated_api_keys = true
 }
 
-// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
+// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *UserMutation) CreatedAPIKeysCleared() bool {
+	return m.clearedcreated_api_keys
+}
+
+// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedcreated_api_keys == nil {
+		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
+	for i := range ids {
+		delete(m.created_api_keys, ids[i])
+		m.removedcreated_api_keys[ids[i]] = struct{}{}
 	}
-	return oldValue.MaxLengthHours, nil
 }
 
-// AddMaxLengthHours adds i to the "max_length_hours" field.
-func (m *TierMutation) AddMaxLengthHours(i int64) {
-	if m.addmax_length_hours != nil {
-		*m.addmax_length_hours += i
-	} else {
-		m.addmax_length_hours = &i
+// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_api_keys {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
-func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
-	v := m.addmax_length_hours
-	if v == nil {
-		return
+// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
+func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.created_api_keys {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
-func (m *TierMutation) ResetMaxLengthHours() {
-	m.max_length_hours = nil
-	m.addmax_length_hours = nil
+// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
+func (m *UserMutation) ResetCreatedAPIKeys() {
+	m.created_api_keys = nil
+	m.clearedcreated_api_keys = false
+	m.removedcreated_api_keys = nil
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
+		m.users_teams[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *TierMutation) ClearTeams() {
-	m.clearedteams = true
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *TierMutation) TeamsCleared() bool {
-	return m.clearedteams
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *UserMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
 		ids = append(ids, id)
 	}
 	return
 }
-
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
+
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *UserMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *TierMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *UserMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
 }
 
-// Where appends a list predicates to the TierMutation builder.
-func (m *TierMutation) Where(ps ...predicate.Tier) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tier, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7983,36 +10293,27 @@ func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TierMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TierMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tier).
-func (m *TierMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TierMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, tier.FieldName)
-	}
-	if m.disk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
-	}
-	if m.concurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
-	}
-	if m.max_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
 	return fields
 }
@@ -8020,16 +10321,10 @@ func (m *TierMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TierMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tier.FieldName:
-		return m.Name()
-	case tier.FieldDiskMB:
-		return m.DiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.ConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.MaxLengthHours()
+	case user.FieldEmail:
+		return m.Email()
 	}
 	return nil, false
 }
@@ -8037,280 +10332,298 @@ func (m *TierMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tier.FieldName:
-		return m.OldName(ctx)
-	case tier.FieldDiskMB:
-		return m.OldDiskMB(ctx)
-	case tier.FieldConcurrentInstances:
-		return m.OldConcurrentInstances(ctx)
-	case tier.FieldMaxLengthHours:
-		return m.OldMaxLengthHours(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tier field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldName:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxLengthHours(v)
+		m.SetEmail(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TierMutation) AddedFields() []string {
-	var fields []string
-	if m.adddisk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
-	}
-	if m.addconcurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
-	}
-	if m.addmax_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tier.FieldDiskMB:
-		return m.AddedDiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.AddedConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.AddedMaxLengthHours()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxLengthHours(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tier numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TierMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TierMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tier nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TierMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case tier.FieldName:
-		m.ResetName()
-		return nil
-	case tier.FieldDiskMB:
-		m.ResetDiskMB()
-		return nil
-	case tier.FieldConcurrentInstances:
-		m.ResetConcurrentInstances()
-		return nil
-	case tier.FieldMaxLengthHours:
-		m.ResetMaxLengthHours()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.teams != nil {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.created_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.created_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TierMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		ids := make([]ent.Value, 0, len(m.teams))
 		for id := range m.teams {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.created_envs))
+		for id := range m.created_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.created_api_keys))
+		for id := range m.created_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.removedteams != nil {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.removedcreated_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.removedcreated_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		ids := make([]ent.Value, 0, len(m.removedteams))
 		for id := range m.removedteams {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
+		for id := range m.removedcreated_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
+		for id := range m.removedcreated_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.clearedteams {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.clearedcreated_envs {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedcreated_api_keys {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TierMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		return m.clearedteams
+	case user.EdgeCreatedEnvs:
+		return m.clearedcreated_envs
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgeCreatedAPIKeys:
+		return m.clearedcreated_api_keys
+	case user.EdgeUsersTeams:
+		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TierMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Tier unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TierMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		m.ResetTeams()
 		return nil
+	case user.EdgeCreatedEnvs:
+		m.ResetCreatedEnvs()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgeCreatedAPIKeys:
+		m.ResetCreatedAPIKeys()
+		return nil
+	case user.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
 	}
-	return fmt.Errorf("unknown Tier edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
+type UsersTeamsMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	email                   *string
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	created_envs            map[string]struct{}
-	removedcreated_envs     map[string]struct{}
-	clearedcreated_envs     bool
-	access_tokens           map[uuid.UUID]struct{}
-	removedaccess_tokens    map[uuid.UUID]struct{}
-	clearedaccess_tokens    bool
-	created_api_keys        map[uuid.UUID]struct{}
-	removedcreated_api_keys map[uuid.UUID]struct{}
-	clearedcreated_api_keys bool
-	users_teams             map[int]struct{}
-	removedusers_teams      map[int]struct{}
-	clearedusers_teams      bool
-	done                    bool
-	oldValue                func(context.Context) (*User, error)
-	predicates              []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	is_default    *bool
+	clearedFields map[string]struct{}
+	users         *uuid.UUID
+	clearedusers  bool
+	teams         *uuid.UUID
+	clearedteams  bool
+	done          bool
+	oldValue      func(context.Context) (*UsersTeams, error)
+	predicates    []predicate.UsersTeams
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UsersTeamsMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// usersteamsOption allows management of the mutation configuration using functional options.
+type usersteamsOption func(*UsersTeamsMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
+func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
+	m := &UsersTeamsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUsersTeams,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8319,20 +10632,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withUsersTeamsID sets the ID field of the mutation.
+func withUsersTeamsID(id int) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UsersTeams
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UsersTeams.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8341,10 +10654,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUsersTeams sets the old UsersTeams of the mutation.
+func withUsersTeams(node *UsersTeams) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		m.oldValue = func(context.Context) (*UsersTeams, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8353,7 +10666,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UsersTeamsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8361,7 +10674,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -8370,15 +10683,9 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8389,336 +10696,218 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UserMutation) ClearTeams() {
-	m.clearedteams = true
-}
-
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UserMutation) TeamsCleared() bool {
-	return m.clearedteams
-}
-
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UserMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
-}
-
-// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
-func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
-	if m.created_envs == nil {
-		m.created_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.created_envs[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
-func (m *UserMutation) ClearCreatedEnvs() {
-	m.clearedcreated_envs = true
+// SetUserID sets the "user_id" field.
+func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
 }
 
-// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
-func (m *UserMutation) CreatedEnvsCleared() bool {
-	return m.clearedcreated_envs
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
-func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
-	if m.removedcreated_envs == nil {
-		m.removedcreated_envs = make(map[string]struct{})
+// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.created_envs, ids[i])
-		m.removedcreated_envs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
-func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
-	for id := range m.removedcreated_envs {
-		ids = append(ids, id)
-	}
-	return
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsersTeamsMutation) ResetUserID() {
+	m.users = nil
 }
 
-// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
-func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
-	for id := range m.created_envs {
-		ids = append(ids, id)
-	}
-	return
+// SetTeamID sets the "team_id" field.
+func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
+	m.teams = &u
 }
 
-// ResetCreatedEnvs resets all changes to the "created_envs" edge.
-func (m *UserMutation) ResetCreatedEnvs() {
-	m.created_envs = nil
-	m.clearedcreated_envs = false
-	m.removedcreated_envs = nil
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.teams
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
-func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
-	if m.access_tokens == nil {
-		m.access_tokens = make(map[uuid.UUID]struct{})
+// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.access_tokens[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
 }
 
-// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) ClearAccessTokens() {
-	m.clearedaccess_tokens = true
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *UsersTeamsMutation) ResetTeamID() {
+	m.teams = nil
 }
 
-// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
-func (m *UserMutation) AccessTokensCleared() bool {
-	return m.clearedaccess_tokens
+// SetIsDefault sets the "is_default" field.
+func (m *UsersTeamsMutation) SetIsDefault(b bool) {
+	m.is_default = &b
 }
 
-// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
-func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
-	if m.removedaccess_tokens == nil {
-		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.access_tokens, ids[i])
-		m.removedaccess_tokens[ids[i]] = struct{}{}
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccess_tokens {
-		ids = append(ids, id)
+// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
-func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.access_tokens {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetAccessTokens resets all changes to the "access_tokens" edge.
-func (m *UserMutation) ResetAccessTokens() {
-	m.access_tokens = nil
-	m.clearedaccess_tokens = false
-	m.removedaccess_tokens = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
 }
 
-// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.created_api_keys == nil {
-		m.created_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.created_api_keys[ids[i]] = struct{}{}
-	}
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *UsersTeamsMutation) ResetIsDefault() {
+	m.is_default = nil
 }
 
-// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) ClearCreatedAPIKeys() {
-	m.clearedcreated_api_keys = true
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
 }
 
-// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *UserMutation) CreatedAPIKeysCleared() bool {
-	return m.clearedcreated_api_keys
+// ClearUsers clears the "users" edge to the User entity.
+func (m *UsersTeamsMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[usersteams.FieldUserID] = struct{}{}
 }
 
-// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedcreated_api_keys == nil {
-		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.created_api_keys, ids[i])
-		m.removedcreated_api_keys[ids[i]] = struct{}{}
-	}
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *UsersTeamsMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_api_keys {
-		ids = append(ids, id)
+// UsersID returns the "users" edge ID in the mutation.
+func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
 	}
 	return
 }
 
-// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
-func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.created_api_keys {
-		ids = append(ids, id)
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
-func (m *UserMutation) ResetCreatedAPIKeys() {
-	m.created_api_keys = nil
-	m.clearedcreated_api_keys = false
-	m.removedcreated_api_keys = nil
-}
-
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
-	}
+// ResetUsers resets all changes to the "users" edge.
+func (m *UsersTeamsMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// SetTeamsID sets the "teams" edge to the Team entity by id.
+func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
+	m.teams = &id
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *UserMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UsersTeamsMutation) ClearTeams() {
+	m.clearedteams = true
+	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
-	}
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UsersTeamsMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// TeamsID returns the "teams" edge ID in the mutation.
+func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
+	if m.teams != nil {
+		return *m.teams, true
 	}
-	return
-}
-
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *UserMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamsID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
+	if id := m.teams; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *UserMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UsersTeamsMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UsersTeamsMutation builder.
+func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsersTeams, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8726,27 +10915,33 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UsersTeamsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UsersTeamsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UsersTeams).
+func (m *UsersTeamsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *UsersTeamsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.users != nil {
+		fields = append(fields, usersteams.FieldUserID)
+	}
+	if m.teams != nil {
+		fields = append(fields, usersteams.FieldTeamID)
+	}
+	if m.is_default != nil {
+		fields = append(fields, usersteams.FieldIsDefault)
 	}
 	return fields
 }
@@ -8754,10 +10949,14 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
+	case usersteams.FieldUserID:
+		return m.UserID()
+	case usersteams.FieldTeamID:
+		return m.TeamID()
+	case usersteams.FieldIsDefault:
+		return m.IsDefault()
 	}
 	return nil, false
 }
@@ -8765,298 +10964,230 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
+	case usersteams.FieldUserID:
+		return m.OldUserID(ctx)
+	case usersteams.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case usersteams.FieldIsDefault:
+		return m.OldIsDefault(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case usersteams.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUserID(v)
+		return nil
+	case usersteams.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case usersteams.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UsersTeamsMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UsersTeamsMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UsersTeamsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UsersTeamsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UsersTeamsMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case usersteams.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case usersteams.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case usersteams.FieldIsDefault:
+		m.ResetIsDefault()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.teams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.created_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.access_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.created_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.teams != nil {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.created_envs))
-		for id := range m.created_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.access_tokens))
-		for id := range m.access_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.created_api_keys))
-		for id := range m.created_api_keys {
-			ids = append(ids, id)
+	case usersteams.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
-			ids = append(ids, id)
+	case usersteams.EdgeTeams:
+		if id := m.teams; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedteams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.removedcreated_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.removedaccess_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.removedcreated_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
-		for id := range m.removedcreated_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
-		for id := range m.removedaccess_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
-		for id := range m.removedcreated_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteams {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.clearedcreated_envs {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.clearedaccess_tokens {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.clearedcreated_api_keys {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UsersTeamsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.clearedteams {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeTeams:
+	case usersteams.EdgeUsers:
+		return m.clearedusers
+	case usersteams.EdgeTeams:
 		return m.clearedteams
-	case user.EdgeCreatedEnvs:
-		return m.clearedcreated_envs
-	case user.EdgeAccessTokens:
-		return m.clearedaccess_tokens
-	case user.EdgeCreatedAPIKeys:
-		return m.clearedcreated_api_keys
-	case user.EdgeUsersTeams:
-		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UsersTeamsMutation) ClearEdge(name string) error {
 	switch name {
+	case usersteams.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	case usersteams.EdgeTeams:
+		m.ClearTeams()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UsersTeamsMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	case user.EdgeCreatedEnvs:
-		m.ResetCreatedEnvs()
-		return nil
-	case user.EdgeAccessTokens:
-		m.ResetAccessTokens()
-		return nil
-	case user.EdgeCreatedAPIKeys:
-		m.ResetCreatedAPIKeys()
+	case usersteams.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case user.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case usersteams.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UsersTeams edge %s", name)
 }
 
-// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
-type UsersTeamsMutation struct {
+// VolumeMutation represents an operation that mutates the Volume nodes in the graph.
+type VolumeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	is_default    *bool
-	clearedFields map[string]struct{}
-	users         *uuid.UUID
-	clearedusers  bool
-	teams         *uuid.UUID
-	clearedteams  bool
-	done          bool
-	oldValue      func(context.Context) (*UsersTeams, error)
-	predicates    []predicate.UsersTeams
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	name               *string
+	size_mb            *int64
+	addsize_mb         *int64
+	generation_id      *uuid.UUID
+	sandbox_id         *string
+	next_generation_id *uuid.UUID
+	clearedFields      map[string]struct{}
+	team               *uuid.UUID
+	clearedteam        bool
+	done               bool
+	oldValue           func(context.Context) (*Volume, error)
+	predicates         []predicate.Volume
 }
 
-var _ ent.Mutation = (*UsersTeamsMutation)(nil)
+var _ ent.Mutation = (*VolumeMutation)(nil)
 
-// usersteamsOption allows management of the mutation configuration using functional options.
-type usersteamsOption func(*UsersTeamsMutation)
+// volumeOption allows management of the mutation configuration using functional options.
+type volumeOption func(*VolumeMutation)
 
-// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
-func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
-	m := &UsersTeamsMutation{
+// newVolumeMutation creates new mutation for the Volume entity.
+func newVolumeMutation(c config, op Op, opts ...volumeOption) *VolumeMutation {
+	m := &VolumeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsersTeams,
+		typ:           TypeVolume,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9065,20 +11196,20 @@ func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeam
 	return m
 }
 
-// withUsersTeamsID sets the ID field of the mutation.
-func withUsersTeamsID(id int) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
+// withVolumeID sets the ID field of the mutation.
+func withVolumeID(id uuid.UUID) volumeOption {
+	return func(m *VolumeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsersTeams
+			value *Volume
 		)
-		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+		m.oldValue = func(ctx context.Context) (*Volume, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsersTeams.Get(ctx, id)
+					value, err = m.Client().Volume.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9087,10 +11218,10 @@ func withUsersTeamsID(id int) usersteamsOption {
 	}
 }
 
-// withUsersTeams sets the old UsersTeams of the mutation.
-func withUsersTeams(node *UsersTeams) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
-		m.oldValue = func(context.Context) (*UsersTeams, error) {
+// withVolume sets the old Volume of the mutation.
+func withVolume(node *Volume) volumeOption {
+	return func(m *VolumeMutation) {
+		m.oldValue = func(context.Context) (*Volume, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9099,7 +11230,7 @@ func withUsersTeams(node *UsersTeams) usersteamsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsersTeamsMutation) Client() *Client {
+func (m VolumeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9107,7 +11238,7 @@ func (m UsersTeamsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsersTeamsMutation) Tx() (*Tx, error) {
+func (m VolumeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -9116,9 +11247,15 @@ func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Volume entities.
+func (m *VolumeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+func (m *VolumeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9129,75 +11266,75 @@ func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VolumeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Volume.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *VolumeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VolumeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VolumeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsersTeamsMutation) ResetUserID() {
-	m.users = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VolumeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetTeamID sets the "team_id" field.
-func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
-	m.teams = &u
+func (m *VolumeMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
 // TeamID returns the value of the "team_id" field in the mutation.
-func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.teams
+func (m *VolumeMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VolumeMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
@@ -9212,135 +11349,285 @@ func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err er
 }
 
 // ResetTeamID resets all changes to the "team_id" field.
-func (m *UsersTeamsMutation) ResetTeamID() {
-	m.teams = nil
+func (m *VolumeMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *UsersTeamsMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// SetName sets the "name" field.
+func (m *VolumeMutation) SetName(s string) {
+	m.name = &s
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
+// Name returns the value of the "name" field in the mutation.
+func (m *VolumeMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+func (m *VolumeMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VolumeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSizeMB sets the "size_mb" field.
+func (m *VolumeMutation) SetSizeMB(i int64) {
+	m.size_mb = &i
+	m.addsize_mb = nil
+}
+
+// SizeMB returns the value of the "size_mb" field in the mutation.
+func (m *VolumeMutation) SizeMB() (r int64, exists bool) {
+	v := m.size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeMB returns the old "size_mb" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldSizeMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeMB: %w", err)
+	}
+	return oldValue.SizeMB, nil
+}
+
+// AddSizeMB adds i to the "size_mb" field.
+func (m *VolumeMutation) AddSizeMB(i int64) {
+	if m.addsize_mb != nil {
+		*m.addsize_mb += i
+	} else {
+		m.addsize_mb = &i
+	}
+}
+
+// AddedSizeMB returns the value that was added to the "size_mb" field in this mutation.
+func (m *VolumeMutation) AddedSizeMB() (r int64, exists bool) {
+	v := m.addsize_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSizeMB resets all changes to the "size_mb" field.
+func (m *VolumeMutation) ResetSizeMB() {
+	m.size_mb = nil
+	m.addsize_mb = nil
+}
+
+// SetGenerationID sets the "generation_id" field.
+func (m *VolumeMutation) SetGenerationID(u uuid.UUID) {
+	m.generation_id = &u
+}
+
+// GenerationID returns the value of the "generation_id" field in the mutation.
+func (m *VolumeMutation) GenerationID() (r uuid.UUID, exists bool) {
+	v := m.generation_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenerationID returns the old "generation_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldGenerationID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenerationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenerationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenerationID: %w", err)
+	}
+	return oldValue.GenerationID, nil
+}
+
+// ClearGenerationID clears the value of the "generation_id" field.
+func (m *VolumeMutation) ClearGenerationID() {
+	m.generation_id = nil
+	m.clearedFields[volume.FieldGenerationID] = struct{}{}
+}
+
+// GenerationIDCleared returns if the "generation_id" field was cleared in this mutation.
+func (m *VolumeMutation) GenerationIDCleared() bool {
+	_, ok := m.clearedFields[volume.FieldGenerationID]
+	return ok
+}
+
+// ResetGenerationID resets all changes to the "generation_id" field.
+func (m *VolumeMutation) ResetGenerationID() {
+	m.generation_id = nil
+	delete(m.clearedFields, volume.FieldGenerationID)
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *VolumeMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *VolumeMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldSandboxID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
 	}
-	return oldValue.IsDefault, nil
+	return oldValue.SandboxID, nil
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *UsersTeamsMutation) ResetIsDefault() {
-	m.is_default = nil
+// ClearSandboxID clears the value of the "sandbox_id" field.
+func (m *VolumeMutation) ClearSandboxID() {
+	m.sandbox_id = nil
+	m.clearedFields[volume.FieldSandboxID] = struct{}{}
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// SandboxIDCleared returns if the "sandbox_id" field was cleared in this mutation.
+func (m *VolumeMutation) SandboxIDCleared() bool {
+	_, ok := m.clearedFields[volume.FieldSandboxID]
+	return ok
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *UsersTeamsMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[usersteams.FieldUserID] = struct{}{}
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *VolumeMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+	delete(m.clearedFields, volume.FieldSandboxID)
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *UsersTeamsMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetNextGenerationID sets the "next_generation_id" field.
+func (m *VolumeMutation) SetNextGenerationID(u uuid.UUID) {
+	m.next_generation_id = &u
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// NextGenerationID returns the value of the "next_generation_id" field in the mutation.
+func (m *VolumeMutation) NextGenerationID() (r uuid.UUID, exists bool) {
+	v := m.next_generation_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldNextGenerationID returns the old "next_generation_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldNextGenerationID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextGenerationID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextGenerationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextGenerationID: %w", err)
+	}
+	return oldValue.NextGenerationID, nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *UsersTeamsMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ClearNextGenerationID clears the value of the "next_generation_id" field.
+func (m *VolumeMutation) ClearNextGenerationID() {
+	m.next_generation_id = nil
+	m.clearedFields[volume.FieldNextGenerationID] = struct{}{}
 }
 
-// SetTeamsID sets the "teams" edge to the Team entity by id.
-func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
-	m.teams = &id
+// NextGenerationIDCleared returns if the "next_generation_id" field was cleared in this mutation.
+func (m *VolumeMutation) NextGenerationIDCleared() bool {
+	_, ok := m.clearedFields[volume.FieldNextGenerationID]
+	return ok
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UsersTeamsMutation) ClearTeams() {
-	m.clearedteams = true
-	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
+// ResetNextGenerationID resets all changes to the "next_generation_id" field.
+func (m *VolumeMutation) ResetNextGenerationID() {
+	m.next_generation_id = nil
+	delete(m.clearedFields, volume.FieldNextGenerationID)
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UsersTeamsMutation) TeamsCleared() bool {
-	return m.clearedteams
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *VolumeMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[volume.FieldTeamID] = struct{}{}
 }
 
-// TeamsID returns the "teams" edge ID in the mutation.
-func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
-	if m.teams != nil {
-		return *m.teams, true
-	}
-	return
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *VolumeMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
+// TeamIDs returns the "team" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamsID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
-	if id := m.teams; id != nil {
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *VolumeMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UsersTeamsMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
+// ResetTeam resets all changes to the "team" edge.
+func (m *VolumeMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// Where appends a list predicates to the UsersTeamsMutation builder.
-func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
+// Where appends a list predicates to the VolumeMutation builder.
+func (m *VolumeMutation) Where(ps ...predicate.Volume) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VolumeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsersTeams, len(ps))
+func (m *VolumeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Volume, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9348,33 +11635,45 @@ func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UsersTeamsMutation) Op() Op {
+func (m *VolumeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UsersTeamsMutation) SetOp(op Op) {
+func (m *VolumeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UsersTeams).
-func (m *UsersTeamsMutation) Type() string {
+// Type returns the node type of this mutation (Volume).
+func (m *VolumeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UsersTeamsMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.users != nil {
-		fields = append(fields, usersteams.FieldUserID)
+func (m *VolumeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, volume.FieldCreatedAt)
 	}
-	if m.teams != nil {
-		fields = append(fields, usersteams.FieldTeamID)
+	if m.team != nil {
+		fields = append(fields, volume.FieldTeamID)
 	}
-	if m.is_default != nil {
-		fields = append(fields, usersteams.FieldIsDefault)
+	if m.name != nil {
+		fields = append(fields, volume.FieldName)
+	}
+	if m.size_mb != nil {
+		fields = append(fields, volume.FieldSizeMB)
+	}
+	if m.generation_id != nil {
+		fields = append(fields, volume.FieldGenerationID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, volume.FieldSandboxID)
+	}
+	if m.next_generation_id != nil {
+		fields = append(fields, volume.FieldNextGenerationID)
 	}
 	return fields
 }
@@ -9382,14 +11681,22 @@ func (m *UsersTeamsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
+func (m *VolumeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.UserID()
-	case usersteams.FieldTeamID:
+	case volume.FieldCreatedAt:
+		return m.CreatedAt()
+	case volume.FieldTeamID:
 		return m.TeamID()
-	case usersteams.FieldIsDefault:
-		return m.IsDefault()
+	case volume.FieldName:
+		return m.Name()
+	case volume.FieldSizeMB:
+		return m.SizeMB()
+	case volume.FieldGenerationID:
+		return m.GenerationID()
+	case volume.FieldSandboxID:
+		return m.SandboxID()
+	case volume.FieldNextGenerationID:
+		return m.NextGenerationID()
 	}
 	return nil, false
 }
@@ -9397,128 +11704,205 @@ func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VolumeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.OldUserID(ctx)
-	case usersteams.FieldTeamID:
+	case volume.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case volume.FieldTeamID:
 		return m.OldTeamID(ctx)
-	case usersteams.FieldIsDefault:
-		return m.OldIsDefault(ctx)
+	case volume.FieldName:
+		return m.OldName(ctx)
+	case volume.FieldSizeMB:
+		return m.OldSizeMB(ctx)
+	case volume.FieldGenerationID:
+		return m.OldGenerationID(ctx)
+	case volume.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case volume.FieldNextGenerationID:
+		return m.OldNextGenerationID(ctx)
 	}
-	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
+	return nil, fmt.Errorf("unknown Volume field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
+func (m *VolumeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case usersteams.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case volume.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case usersteams.FieldTeamID:
+	case volume.FieldTeamID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTeamID(v)
 		return nil
-	case usersteams.FieldIsDefault:
-		v, ok := value.(bool)
+	case volume.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetName(v)
+		return nil
+	case volume.FieldSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeMB(v)
+		return nil
+	case volume.FieldGenerationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenerationID(v)
+		return nil
+	case volume.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case volume.FieldNextGenerationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextGenerationID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown Volume field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UsersTeamsMutation) AddedFields() []string {
-	return nil
+func (m *VolumeMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_mb != nil {
+		fields = append(fields, volume.FieldSizeMB)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VolumeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case volume.FieldSizeMB:
+		return m.AddedSizeMB()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
+func (m *VolumeMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case volume.FieldSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeMB(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
+	return fmt.Errorf("unknown Volume numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UsersTeamsMutation) ClearedFields() []string {
-	return nil
+func (m *VolumeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(volume.FieldGenerationID) {
+		fields = append(fields, volume.FieldGenerationID)
+	}
+	if m.FieldCleared(volume.FieldSandboxID) {
+		fields = append(fields, volume.FieldSandboxID)
+	}
+	if m.FieldCleared(volume.FieldNextGenerationID) {
+		fields = append(fields, volume.FieldNextGenerationID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UsersTeamsMutation) FieldCleared(name string) bool {
+func (m *VolumeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
+func (m *VolumeMutation) ClearField(name string) error {
+	switch name {
+	case volume.FieldGenerationID:
+		m.ClearGenerationID()
+		return nil
+	case volume.FieldSandboxID:
+		m.ClearSandboxID()
+		return nil
+	case volume.FieldNextGenerationID:
+		m.ClearNextGenerationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Volume nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ResetField(name string) error {
+func (m *VolumeMutation) ResetField(name string) error {
 	switch name {
-	case usersteams.FieldUserID:
-		m.ResetUserID()
+	case volume.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case usersteams.FieldTeamID:
+	case volume.FieldTeamID:
 		m.ResetTeamID()
 		return nil
-	case usersteams.FieldIsDefault:
-		m.ResetIsDefault()
+	case volume.FieldName:
+		m.ResetName()
+		return nil
+	case volume.FieldSizeMB:
+		m.ResetSizeMB()
+		return nil
+	case volume.FieldGenerationID:
+		m.ResetGenerationID()
+		return nil
+	case volume.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case volume.FieldNextGenerationID:
+		m.ResetNextGenerationID()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown Volume field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsersTeamsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.teams != nil {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *VolumeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.team != nil {
+		edges = append(edges, volume.EdgeTeam)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
+func (m *VolumeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case usersteams.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case usersteams.EdgeTeams:
-		if id := m.teams; id != nil {
+	case volume.EdgeTeam:
+		if id := m.team; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9526,65 +11910,54 @@ func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsersTeamsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VolumeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
+func (m *VolumeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsersTeamsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.clearedteams {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *VolumeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteam {
+		edges = append(edges, volume.EdgeTeam)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
+func (m *VolumeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case usersteams.EdgeUsers:
-		return m.clearedusers
-	case usersteams.EdgeTeams:
-		return m.clearedteams
+	case volume.EdgeTeam:
+		return m.clearedteam
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UsersTeamsMutation) ClearEdge(name string) error {
+func (m *VolumeMutation) ClearEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ClearTeams()
+	case volume.EdgeTeam:
+		m.ClearTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
+	return fmt.Errorf("unknown Volume unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UsersTeamsMutation) ResetEdge(name string) error {
+func (m *VolumeMutation) ResetEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ResetTeams()
+	case volume.EdgeTeam:
+		m.ResetTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams edge %s", name)
+	return fmt.Errorf("unknown Volume edge %s", name)
 }
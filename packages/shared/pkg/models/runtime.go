@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
@@ -15,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
@@ -28,6 +30,16 @@ func init() {
 	accesstokenDescName := accesstokenFields[7].Descriptor()
 	// accesstoken.DefaultName holds the default value on creation for the name field.
 	accesstoken.DefaultName = accesstokenDescName.Default.(string)
+	checkpointFields := schema.Checkpoint{}.Fields()
+	_ = checkpointFields
+	// checkpointDescCreatedAt is the schema descriptor for created_at field.
+	checkpointDescCreatedAt := checkpointFields[1].Descriptor()
+	// checkpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checkpoint.DefaultCreatedAt = checkpointDescCreatedAt.Default.(func() time.Time)
+	// checkpointDescEnvSecure is the schema descriptor for env_secure field.
+	checkpointDescEnvSecure := checkpointFields[8].Descriptor()
+	// checkpoint.DefaultEnvSecure holds the default value on creation for the env_secure field.
+	checkpoint.DefaultEnvSecure = checkpointDescEnvSecure.Default.(bool)
 	clusterFields := schema.Cluster{}.Fields()
 	_ = clusterFields
 	// clusterDescEndpoint is the schema descriptor for endpoint field.
@@ -126,4 +138,10 @@ func init() {
 	usersteamsDescIsDefault := usersteamsFields[2].Descriptor()
 	// usersteams.DefaultIsDefault holds the default value on creation for the is_default field.
 	usersteams.DefaultIsDefault = usersteamsDescIsDefault.Default.(bool)
+	volumeFields := schema.Volume{}.Fields()
+	_ = volumeFields
+	// volumeDescCreatedAt is the schema descriptor for created_at field.
+	volumeDescCreatedAt := volumeFields[1].Descriptor()
+	// volume.DefaultCreatedAt holds the default value on creation for the created_at field.
+	volume.DefaultCreatedAt = volumeDescCreatedAt.Default.(func() time.Time)
 }
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/google/uuid"
@@ -34,6 +35,8 @@ type Snapshot struct {
 	SandboxStartedAt time.Time `json:"sandbox_started_at,omitempty"`
 	// EnvSecure holds the value of the "env_secure" field.
 	EnvSecure bool `json:"env_secure,omitempty"`
+	// Network holds the value of the "network" field.
+	Network *types.SandboxNetworkConfig `json:"network,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -67,7 +70,7 @@ func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldMetadata:
+		case snapshot.FieldMetadata, snapshot.FieldNetwork:
 			values[i] = new([]byte)
 		case snapshot.FieldEnvSecure:
 			values[i] = new(sql.NullBool)
@@ -142,6 +145,14 @@ func (s *Snapshot) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.EnvSecure = value.Bool
 			}
+		case snapshot.FieldNetwork:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Network); err != nil {
+					return fmt.Errorf("unmarshal field network: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +214,9 @@ func (s *Snapshot) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("env_secure=")
 	builder.WriteString(fmt.Sprintf("%v", s.EnvSecure))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fmt.Sprintf("%v", s.Network))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,8 @@ const (
 	FieldSandboxStartedAt = "sandbox_started_at"
 	// FieldEnvSecure holds the string denoting the env_secure field in the database.
 	FieldEnvSecure = "env_secure"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the snapshot in the database.
@@ -51,6 +53,7 @@ var Columns = []string{
 	FieldMetadata,
 	FieldSandboxStartedAt,
 	FieldEnvSecure,
+	FieldNetwork,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
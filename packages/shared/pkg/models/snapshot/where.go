@@ -372,6 +372,16 @@ func EnvSecureNEQ(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldNEQ(FieldEnvSecure, v))
 }
 
+// NetworkIsNil applies the IsNil predicate on the "network" field.
+func NetworkIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldNetwork))
+}
+
+// NetworkNotNil applies the NotNil predicate on the "network" field.
+func NetworkNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldNetwork))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/google/uuid"
@@ -83,6 +84,12 @@ func (sc *SnapshotCreate) SetNillableEnvSecure(b *bool) *SnapshotCreate {
 	return sc
 }
 
+// SetNetwork sets the "network" field.
+func (sc *SnapshotCreate) SetNetwork(tnc *types.SandboxNetworkConfig) *SnapshotCreate {
+	sc.mutation.SetNetwork(tnc)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SnapshotCreate) SetID(u uuid.UUID) *SnapshotCreate {
 	sc.mutation.SetID(u)
@@ -226,6 +233,10 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 		_node.EnvSecure = value
 	}
+	if value, ok := sc.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+		_node.Network = value
+	}
 	if nodes := sc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -368,6 +379,24 @@ func (u *SnapshotUpsert) UpdateEnvSecure() *SnapshotUpsert {
 	return u
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsert) SetNetwork(v *types.SandboxNetworkConfig) *SnapshotUpsert {
+	u.Set(snapshot.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateNetwork() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldNetwork)
+	return u
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsert) ClearNetwork() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldNetwork)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -503,6 +532,27 @@ func (u *SnapshotUpsertOne) UpdateEnvSecure() *SnapshotUpsertOne {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertOne) SetNetwork(v *types.SandboxNetworkConfig) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertOne) ClearNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -805,6 +855,27 @@ func (u *SnapshotUpsertBulk) UpdateEnvSecure() *SnapshotUpsertBulk {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertBulk) SetNetwork(v *types.SandboxNetworkConfig) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertBulk) ClearNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
@@ -107,6 +108,18 @@ func (su *SnapshotUpdate) SetNillableEnvSecure(b *bool) *SnapshotUpdate {
 	return su
 }
 
+// SetNetwork sets the "network" field.
+func (su *SnapshotUpdate) SetNetwork(tnc *types.SandboxNetworkConfig) *SnapshotUpdate {
+	su.mutation.SetNetwork(tnc)
+	return su
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (su *SnapshotUpdate) ClearNetwork() *SnapshotUpdate {
+	su.mutation.ClearNetwork()
+	return su
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (su *SnapshotUpdate) SetEnv(e *Env) *SnapshotUpdate {
 	return su.SetEnvID(e.ID)
@@ -191,6 +204,12 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := su.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if su.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
 	if su.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -322,6 +341,18 @@ func (suo *SnapshotUpdateOne) SetNillableEnvSecure(b *bool) *SnapshotUpdateOne {
 	return suo
 }
 
+// SetNetwork sets the "network" field.
+func (suo *SnapshotUpdateOne) SetNetwork(tnc *types.SandboxNetworkConfig) *SnapshotUpdateOne {
+	suo.mutation.SetNetwork(tnc)
+	return suo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (suo *SnapshotUpdateOne) ClearNetwork() *SnapshotUpdateOne {
+	suo.mutation.ClearNetwork()
+	return suo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (suo *SnapshotUpdateOne) SetEnv(e *Env) *SnapshotUpdateOne {
 	return suo.SetEnvID(e.ID)
@@ -436,6 +467,12 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 	if value, ok := suo.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := suo.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if suo.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
 	if suo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -51,11 +51,13 @@ type TeamEdges struct {
 	TeamTier *Tier `json:"team_tier,omitempty"`
 	// Envs holds the value of the envs edge.
 	Envs []*Env `json:"envs,omitempty"`
+	// Volumes holds the value of the volumes edge.
+	Volumes []*Volume `json:"volumes,omitempty"`
 	// UsersTeams holds the value of the users_teams edge.
 	UsersTeams []*UsersTeams `json:"users_teams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -98,10 +100,19 @@ func (e TeamEdges) EnvsOrErr() ([]*Env, error) {
 	return nil, &NotLoadedError{edge: "envs"}
 }
 
+// VolumesOrErr returns the Volumes value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) VolumesOrErr() ([]*Volume, error) {
+	if e.loadedTypes[4] {
+		return e.Volumes, nil
+	}
+	return nil, &NotLoadedError{edge: "volumes"}
+}
+
 // UsersTeamsOrErr returns the UsersTeams value or an error if the edge
 // was not loaded in eager-loading.
 func (e TeamEdges) UsersTeamsOrErr() ([]*UsersTeams, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.UsersTeams, nil
 	}
 	return nil, &NotLoadedError{edge: "users_teams"}
@@ -226,6 +237,11 @@ func (t *Team) QueryEnvs() *EnvQuery {
 	return NewTeamClient(t.config).QueryEnvs(t)
 }
 
+// QueryVolumes queries the "volumes" edge of the Team entity.
+func (t *Team) QueryVolumes() *VolumeQuery {
+	return NewTeamClient(t.config).QueryVolumes(t)
+}
+
 // QueryUsersTeams queries the "users_teams" edge of the Team entity.
 func (t *Team) QueryUsersTeams() *UsersTeamsQuery {
 	return NewTeamClient(t.config).QueryUsersTeams(t)
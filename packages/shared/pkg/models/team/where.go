@@ -613,6 +613,35 @@ func HasEnvsWith(preds ...predicate.Env) predicate.Team {
 	})
 }
 
+// HasVolumes applies the HasEdge predicate on the "volumes" edge.
+func HasVolumes() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VolumesTable, VolumesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Volume
+		step.Edge.Schema = schemaConfig.Volume
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVolumesWith applies the HasEdge predicate on the "volumes" edge with a given conditions (other predicates).
+func HasVolumesWith(preds ...predicate.Volume) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newVolumesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Volume
+		step.Edge.Schema = schemaConfig.Volume
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUsersTeams applies the HasEdge predicate on the "users_teams" edge.
 func HasUsersTeams() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/google/uuid"
 )
 
@@ -179,6 +180,21 @@ func (tc *TeamCreate) AddEnvs(e ...*Env) *TeamCreate {
 	return tc.AddEnvIDs(ids...)
 }
 
+// AddVolumeIDs adds the "volumes" edge to the Volume entity by IDs.
+func (tc *TeamCreate) AddVolumeIDs(ids ...uuid.UUID) *TeamCreate {
+	tc.mutation.AddVolumeIDs(ids...)
+	return tc
+}
+
+// AddVolumes adds the "volumes" edges to the Volume entity.
+func (tc *TeamCreate) AddVolumes(v ...*Volume) *TeamCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tc.AddVolumeIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tc *TeamCreate) AddUsersTeamIDs(ids ...int) *TeamCreate {
 	tc.mutation.AddUsersTeamIDs(ids...)
@@ -395,6 +411,23 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.VolumesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tc.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.UsersTeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -19,6 +19,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/google/uuid"
 )
 
@@ -33,6 +34,7 @@ type TeamQuery struct {
 	withTeamAPIKeys *TeamAPIKeyQuery
 	withTeamTier    *TierQuery
 	withEnvs        *EnvQuery
+	withVolumes     *VolumeQuery
 	withUsersTeams  *UsersTeamsQuery
 	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -171,6 +173,31 @@ func (tq *TeamQuery) QueryEnvs() *EnvQuery {
 	return query
 }
 
+// QueryVolumes chains the current query on the "volumes" edge.
+func (tq *TeamQuery) QueryVolumes() *VolumeQuery {
+	query := (&VolumeClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(volume.Table, volume.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.VolumesTable, team.VolumesColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.Volume
+		step.Edge.Schema = schemaConfig.Volume
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUsersTeams chains the current query on the "users_teams" edge.
 func (tq *TeamQuery) QueryUsersTeams() *UsersTeamsQuery {
 	query := (&UsersTeamsClient{config: tq.config}).Query()
@@ -392,6 +419,7 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		withTeamAPIKeys: tq.withTeamAPIKeys.Clone(),
 		withTeamTier:    tq.withTeamTier.Clone(),
 		withEnvs:        tq.withEnvs.Clone(),
+		withVolumes:     tq.withVolumes.Clone(),
 		withUsersTeams:  tq.withUsersTeams.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
@@ -443,6 +471,17 @@ func (tq *TeamQuery) WithEnvs(opts ...func(*EnvQuery)) *TeamQuery {
 	return tq
 }
 
+// WithVolumes tells the query-builder to eager-load the nodes that are connected to
+// the "volumes" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithVolumes(opts ...func(*VolumeQuery)) *TeamQuery {
+	query := (&VolumeClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withVolumes = query
+	return tq
+}
+
 // WithUsersTeams tells the query-builder to eager-load the nodes that are connected to
 // the "users_teams" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithUsersTeams(opts ...func(*UsersTeamsQuery)) *TeamQuery {
@@ -532,11 +571,12 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	var (
 		nodes       = []*Team{}
 		_spec       = tq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			tq.withUsers != nil,
 			tq.withTeamAPIKeys != nil,
 			tq.withTeamTier != nil,
 			tq.withEnvs != nil,
+			tq.withVolumes != nil,
 			tq.withUsersTeams != nil,
 		}
 	)
@@ -590,6 +630,13 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 			return nil, err
 		}
 	}
+	if query := tq.withVolumes; query != nil {
+		if err := tq.loadVolumes(ctx, query, nodes,
+			func(n *Team) { n.Edges.Volumes = []*Volume{} },
+			func(n *Team, e *Volume) { n.Edges.Volumes = append(n.Edges.Volumes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withUsersTeams; query != nil {
 		if err := tq.loadUsersTeams(ctx, query, nodes,
 			func(n *Team) { n.Edges.UsersTeams = []*UsersTeams{} },
@@ -751,6 +798,36 @@ func (tq *TeamQuery) loadEnvs(ctx context.Context, query *EnvQuery, nodes []*Tea
 	}
 	return nil
 }
+func (tq *TeamQuery) loadVolumes(ctx context.Context, query *VolumeQuery, nodes []*Team, init func(*Team), assign func(*Team, *Volume)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(volume.FieldTeamID)
+	}
+	query.Where(predicate.Volume(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.VolumesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TeamQuery) loadUsersTeams(ctx context.Context, query *UsersTeamsQuery, nodes []*Team, init func(*Team), assign func(*Team, *UsersTeams)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Team)
@@ -24,6 +24,12 @@ type Tier struct {
 	ConcurrentInstances int64 `json:"concurrent_instances,omitempty"`
 	// MaxLengthHours holds the value of the "max_length_hours" field.
 	MaxLengthHours int64 `json:"max_length_hours,omitempty"`
+	// The rootfs disk bandwidth limit of the team sandboxes in MB/s, 0 means unlimited
+	DiskBandwidthMB int64 `json:"disk_bandwidth_mb,omitempty"`
+	// The rootfs disk IOPS limit of the team sandboxes, 0 means unlimited
+	DiskIops int64 `json:"disk_iops,omitempty"`
+	// The network bandwidth limit of the team sandboxes in MB/s for each direction, 0 means unlimited
+	NetworkBandwidthMB int64 `json:"network_bandwidth_mb,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TierQuery when eager-loading is set.
 	Edges        TierEdges `json:"edges"`
@@ -53,7 +59,7 @@ func (*Tier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours:
+		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours, tier.FieldDiskBandwidthMB, tier.FieldDiskIops, tier.FieldNetworkBandwidthMB:
 			values[i] = new(sql.NullInt64)
 		case tier.FieldID, tier.FieldName:
 			values[i] = new(sql.NullString)
@@ -102,6 +108,24 @@ func (t *Tier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.MaxLengthHours = value.Int64
 			}
+		case tier.FieldDiskBandwidthMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_bandwidth_mb", values[i])
+			} else if value.Valid {
+				t.DiskBandwidthMB = value.Int64
+			}
+		case tier.FieldDiskIops:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_iops", values[i])
+			} else if value.Valid {
+				t.DiskIops = value.Int64
+			}
+		case tier.FieldNetworkBandwidthMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_bandwidth_mb", values[i])
+			} else if value.Valid {
+				t.NetworkBandwidthMB = value.Int64
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +178,15 @@ func (t *Tier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_length_hours=")
 	builder.WriteString(fmt.Sprintf("%v", t.MaxLengthHours))
+	builder.WriteString(", ")
+	builder.WriteString("disk_bandwidth_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.DiskBandwidthMB))
+	builder.WriteString(", ")
+	builder.WriteString("disk_iops=")
+	builder.WriteString(fmt.Sprintf("%v", t.DiskIops))
+	builder.WriteString(", ")
+	builder.WriteString("network_bandwidth_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.NetworkBandwidthMB))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,6 +20,12 @@ const (
 	FieldConcurrentInstances = "concurrent_instances"
 	// FieldMaxLengthHours holds the string denoting the max_length_hours field in the database.
 	FieldMaxLengthHours = "max_length_hours"
+	// FieldDiskBandwidthMB holds the string denoting the disk_bandwidth_mb field in the database.
+	FieldDiskBandwidthMB = "disk_bandwidth_mb"
+	// FieldDiskIops holds the string denoting the disk_iops field in the database.
+	FieldDiskIops = "disk_iops"
+	// FieldNetworkBandwidthMB holds the string denoting the network_bandwidth_mb field in the database.
+	FieldNetworkBandwidthMB = "network_bandwidth_mb"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// Table holds the table name of the tier in the database.
@@ -40,6 +46,9 @@ var Columns = []string{
 	FieldDiskMB,
 	FieldConcurrentInstances,
 	FieldMaxLengthHours,
+	FieldDiskBandwidthMB,
+	FieldDiskIops,
+	FieldNetworkBandwidthMB,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +89,21 @@ func ByMaxLengthHours(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxLengthHours, opts...).ToFunc()
 }
 
+// ByDiskBandwidthMB orders the results by the disk_bandwidth_mb field.
+func ByDiskBandwidthMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskBandwidthMB, opts...).ToFunc()
+}
+
+// ByDiskIops orders the results by the disk_iops field.
+func ByDiskIops(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskIops, opts...).ToFunc()
+}
+
+// ByNetworkBandwidthMB orders the results by the network_bandwidth_mb field.
+func ByNetworkBandwidthMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkBandwidthMB, opts...).ToFunc()
+}
+
 // ByTeamsCount orders the results by teams count.
 func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
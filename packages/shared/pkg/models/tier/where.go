@@ -84,6 +84,21 @@ func MaxLengthHours(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldMaxLengthHours, v))
 }
 
+// DiskBandwidthMB applies equality check predicate on the "disk_bandwidth_mb" field. It's identical to DiskBandwidthMBEQ.
+func DiskBandwidthMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMB, v))
+}
+
+// DiskIops applies equality check predicate on the "disk_iops" field. It's identical to DiskIopsEQ.
+func DiskIops(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// NetworkBandwidthMB applies equality check predicate on the "network_bandwidth_mb" field. It's identical to NetworkBandwidthMBEQ.
+func NetworkBandwidthMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMB, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldName, v))
@@ -269,6 +284,126 @@ func MaxLengthHoursLTE(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldLTE(FieldMaxLengthHours, v))
 }
 
+// DiskBandwidthMBEQ applies the EQ predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMB, v))
+}
+
+// DiskBandwidthMBNEQ applies the NEQ predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskBandwidthMB, v))
+}
+
+// DiskBandwidthMBIn applies the In predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskBandwidthMB, vs...))
+}
+
+// DiskBandwidthMBNotIn applies the NotIn predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskBandwidthMB, vs...))
+}
+
+// DiskBandwidthMBGT applies the GT predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskBandwidthMB, v))
+}
+
+// DiskBandwidthMBGTE applies the GTE predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskBandwidthMB, v))
+}
+
+// DiskBandwidthMBLT applies the LT predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskBandwidthMB, v))
+}
+
+// DiskBandwidthMBLTE applies the LTE predicate on the "disk_bandwidth_mb" field.
+func DiskBandwidthMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskBandwidthMB, v))
+}
+
+// DiskIopsEQ applies the EQ predicate on the "disk_iops" field.
+func DiskIopsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// DiskIopsNEQ applies the NEQ predicate on the "disk_iops" field.
+func DiskIopsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskIops, v))
+}
+
+// DiskIopsIn applies the In predicate on the "disk_iops" field.
+func DiskIopsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsNotIn applies the NotIn predicate on the "disk_iops" field.
+func DiskIopsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsGT applies the GT predicate on the "disk_iops" field.
+func DiskIopsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskIops, v))
+}
+
+// DiskIopsGTE applies the GTE predicate on the "disk_iops" field.
+func DiskIopsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskIops, v))
+}
+
+// DiskIopsLT applies the LT predicate on the "disk_iops" field.
+func DiskIopsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskIops, v))
+}
+
+// DiskIopsLTE applies the LTE predicate on the "disk_iops" field.
+func DiskIopsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskIops, v))
+}
+
+// NetworkBandwidthMBEQ applies the EQ predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMB, v))
+}
+
+// NetworkBandwidthMBNEQ applies the NEQ predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldNetworkBandwidthMB, v))
+}
+
+// NetworkBandwidthMBIn applies the In predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldNetworkBandwidthMB, vs...))
+}
+
+// NetworkBandwidthMBNotIn applies the NotIn predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldNetworkBandwidthMB, vs...))
+}
+
+// NetworkBandwidthMBGT applies the GT predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldNetworkBandwidthMB, v))
+}
+
+// NetworkBandwidthMBGTE applies the GTE predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldNetworkBandwidthMB, v))
+}
+
+// NetworkBandwidthMBLT applies the LT predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldNetworkBandwidthMB, v))
+}
+
+// NetworkBandwidthMBLTE applies the LTE predicate on the "network_bandwidth_mb" field.
+func NetworkBandwidthMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldNetworkBandwidthMB, v))
+}
+
 // HasTeams applies the HasEdge predicate on the "teams" edge.
 func HasTeams() predicate.Tier {
 	return predicate.Tier(func(s *sql.Selector) {
@@ -48,6 +48,24 @@ func (tc *TierCreate) SetMaxLengthHours(i int64) *TierCreate {
 	return tc
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (tc *TierCreate) SetDiskBandwidthMB(i int64) *TierCreate {
+	tc.mutation.SetDiskBandwidthMB(i)
+	return tc
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tc *TierCreate) SetDiskIops(i int64) *TierCreate {
+	tc.mutation.SetDiskIops(i)
+	return tc
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (tc *TierCreate) SetNetworkBandwidthMB(i int64) *TierCreate {
+	tc.mutation.SetNetworkBandwidthMB(i)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TierCreate) SetID(s string) *TierCreate {
 	tc.mutation.SetID(s)
@@ -115,6 +133,15 @@ func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.MaxLengthHours(); !ok {
 		return &ValidationError{Name: "max_length_hours", err: errors.New(`models: missing required field "Tier.max_length_hours"`)}
 	}
+	if _, ok := tc.mutation.DiskBandwidthMB(); !ok {
+		return &ValidationError{Name: "disk_bandwidth_mb", err: errors.New(`models: missing required field "Tier.disk_bandwidth_mb"`)}
+	}
+	if _, ok := tc.mutation.DiskIops(); !ok {
+		return &ValidationError{Name: "disk_iops", err: errors.New(`models: missing required field "Tier.disk_iops"`)}
+	}
+	if _, ok := tc.mutation.NetworkBandwidthMB(); !ok {
+		return &ValidationError{Name: "network_bandwidth_mb", err: errors.New(`models: missing required field "Tier.network_bandwidth_mb"`)}
+	}
 	return nil
 }
 
@@ -168,6 +195,18 @@ func (tc *TierCreate) createSpec() (*Tier, *sqlgraph.CreateSpec) {
 		_spec.SetField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 		_node.MaxLengthHours = value
 	}
+	if value, ok := tc.mutation.DiskBandwidthMB(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMB, field.TypeInt64, value)
+		_node.DiskBandwidthMB = value
+	}
+	if value, ok := tc.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+		_node.DiskIops = value
+	}
+	if value, ok := tc.mutation.NetworkBandwidthMB(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMB, field.TypeInt64, value)
+		_node.NetworkBandwidthMB = value
+	}
 	if nodes := tc.mutation.TeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -303,6 +342,60 @@ func (u *TierUpsert) AddMaxLengthHours(v int64) *TierUpsert {
 	return u
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (u *TierUpsert) SetDiskBandwidthMB(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskBandwidthMB, v)
+	return u
+}
+
+// UpdateDiskBandwidthMB sets the "disk_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskBandwidthMB() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskBandwidthMB)
+	return u
+}
+
+// AddDiskBandwidthMB adds v to the "disk_bandwidth_mb" field.
+func (u *TierUpsert) AddDiskBandwidthMB(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskBandwidthMB, v)
+	return u
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsert) SetDiskIops(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskIops, v)
+	return u
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskIops() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskIops)
+	return u
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsert) AddDiskIops(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskIops, v)
+	return u
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (u *TierUpsert) SetNetworkBandwidthMB(v int64) *TierUpsert {
+	u.Set(tier.FieldNetworkBandwidthMB, v)
+	return u
+}
+
+// UpdateNetworkBandwidthMB sets the "network_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateNetworkBandwidthMB() *TierUpsert {
+	u.SetExcluded(tier.FieldNetworkBandwidthMB)
+	return u
+}
+
+// AddNetworkBandwidthMB adds v to the "network_bandwidth_mb" field.
+func (u *TierUpsert) AddNetworkBandwidthMB(v int64) *TierUpsert {
+	u.Add(tier.FieldNetworkBandwidthMB, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -428,6 +521,69 @@ func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
 	})
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (u *TierUpsertOne) SetDiskBandwidthMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMB(v)
+	})
+}
+
+// AddDiskBandwidthMB adds v to the "disk_bandwidth_mb" field.
+func (u *TierUpsertOne) AddDiskBandwidthMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMB(v)
+	})
+}
+
+// UpdateDiskBandwidthMB sets the "disk_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskBandwidthMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMB()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertOne) SetDiskIops(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertOne) AddDiskIops(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskIops() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (u *TierUpsertOne) SetNetworkBandwidthMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMB(v)
+	})
+}
+
+// AddNetworkBandwidthMB adds v to the "network_bandwidth_mb" field.
+func (u *TierUpsertOne) AddNetworkBandwidthMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMB(v)
+	})
+}
+
+// UpdateNetworkBandwidthMB sets the "network_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateNetworkBandwidthMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -719,6 +875,69 @@ func (u *TierUpsertBulk) UpdateMaxLengthHours() *TierUpsertBulk {
 	})
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (u *TierUpsertBulk) SetDiskBandwidthMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMB(v)
+	})
+}
+
+// AddDiskBandwidthMB adds v to the "disk_bandwidth_mb" field.
+func (u *TierUpsertBulk) AddDiskBandwidthMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMB(v)
+	})
+}
+
+// UpdateDiskBandwidthMB sets the "disk_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskBandwidthMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMB()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertBulk) SetDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertBulk) AddDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskIops() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (u *TierUpsertBulk) SetNetworkBandwidthMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMB(v)
+	})
+}
+
+// AddNetworkBandwidthMB adds v to the "network_bandwidth_mb" field.
+func (u *TierUpsertBulk) AddNetworkBandwidthMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMB(v)
+	})
+}
+
+// UpdateNetworkBandwidthMB sets the "network_bandwidth_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateNetworkBandwidthMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
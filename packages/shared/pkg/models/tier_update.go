@@ -108,6 +108,69 @@ func (tu *TierUpdate) AddMaxLengthHours(i int64) *TierUpdate {
 	return tu
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (tu *TierUpdate) SetDiskBandwidthMB(i int64) *TierUpdate {
+	tu.mutation.ResetDiskBandwidthMB()
+	tu.mutation.SetDiskBandwidthMB(i)
+	return tu
+}
+
+// SetNillableDiskBandwidthMB sets the "disk_bandwidth_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskBandwidthMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskBandwidthMB(*i)
+	}
+	return tu
+}
+
+// AddDiskBandwidthMB adds i to the "disk_bandwidth_mb" field.
+func (tu *TierUpdate) AddDiskBandwidthMB(i int64) *TierUpdate {
+	tu.mutation.AddDiskBandwidthMB(i)
+	return tu
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tu *TierUpdate) SetDiskIops(i int64) *TierUpdate {
+	tu.mutation.ResetDiskIops()
+	tu.mutation.SetDiskIops(i)
+	return tu
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskIops(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskIops(*i)
+	}
+	return tu
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tu *TierUpdate) AddDiskIops(i int64) *TierUpdate {
+	tu.mutation.AddDiskIops(i)
+	return tu
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (tu *TierUpdate) SetNetworkBandwidthMB(i int64) *TierUpdate {
+	tu.mutation.ResetNetworkBandwidthMB()
+	tu.mutation.SetNetworkBandwidthMB(i)
+	return tu
+}
+
+// SetNillableNetworkBandwidthMB sets the "network_bandwidth_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableNetworkBandwidthMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetNetworkBandwidthMB(*i)
+	}
+	return tu
+}
+
+// AddNetworkBandwidthMB adds i to the "network_bandwidth_mb" field.
+func (tu *TierUpdate) AddNetworkBandwidthMB(i int64) *TierUpdate {
+	tu.mutation.AddNetworkBandwidthMB(i)
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TierUpdate) AddTeamIDs(ids ...uuid.UUID) *TierUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -212,6 +275,24 @@ func (tu *TierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tu.mutation.DiskBandwidthMB(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskBandwidthMB(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.NetworkBandwidthMB(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedNetworkBandwidthMB(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMB, field.TypeInt64, value)
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -361,6 +442,69 @@ func (tuo *TierUpdateOne) AddMaxLengthHours(i int64) *TierUpdateOne {
 	return tuo
 }
 
+// SetDiskBandwidthMB sets the "disk_bandwidth_mb" field.
+func (tuo *TierUpdateOne) SetDiskBandwidthMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskBandwidthMB()
+	tuo.mutation.SetDiskBandwidthMB(i)
+	return tuo
+}
+
+// SetNillableDiskBandwidthMB sets the "disk_bandwidth_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskBandwidthMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskBandwidthMB(*i)
+	}
+	return tuo
+}
+
+// AddDiskBandwidthMB adds i to the "disk_bandwidth_mb" field.
+func (tuo *TierUpdateOne) AddDiskBandwidthMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskBandwidthMB(i)
+	return tuo
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tuo *TierUpdateOne) SetDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskIops()
+	tuo.mutation.SetDiskIops(i)
+	return tuo
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskIops(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskIops(*i)
+	}
+	return tuo
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tuo *TierUpdateOne) AddDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskIops(i)
+	return tuo
+}
+
+// SetNetworkBandwidthMB sets the "network_bandwidth_mb" field.
+func (tuo *TierUpdateOne) SetNetworkBandwidthMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetNetworkBandwidthMB()
+	tuo.mutation.SetNetworkBandwidthMB(i)
+	return tuo
+}
+
+// SetNillableNetworkBandwidthMB sets the "network_bandwidth_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableNetworkBandwidthMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetNetworkBandwidthMB(*i)
+	}
+	return tuo
+}
+
+// AddNetworkBandwidthMB adds i to the "network_bandwidth_mb" field.
+func (tuo *TierUpdateOne) AddNetworkBandwidthMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddNetworkBandwidthMB(i)
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TierUpdateOne) AddTeamIDs(ids ...uuid.UUID) *TierUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -495,6 +639,24 @@ func (tuo *TierUpdateOne) sqlSave(ctx context.Context) (_node *Tier, err error)
 	if value, ok := tuo.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tuo.mutation.DiskBandwidthMB(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskBandwidthMB(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.NetworkBandwidthMB(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedNetworkBandwidthMB(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMB, field.TypeInt64, value)
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
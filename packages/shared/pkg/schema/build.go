@@ -44,6 +44,9 @@ func (EnvBuild) Fields() []ent.Field {
 		field.String("firecracker_version").Default(DefaultFirecrackerVersion).SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("envd_version").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
 		field.String("cluster_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		field.UUID("source_build_id", uuid.UUID{}).Optional().Nillable(),
+		field.JSON("datasets", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
+		field.Time("marked_for_gc_at").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
+)
+
+// Checkpoint is a named snapshot of a running sandbox, a sandbox can have many checkpoints.
+type Checkpoint struct {
+	ent.Schema
+}
+
+func (Checkpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("base_env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("sandbox_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.JSON("metadata", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.Time("sandbox_started_at"),
+		field.Bool("env_secure").Default(false),
+		field.JSON("network", &types.SandboxNetworkConfig{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
+	}
+}
+
+func (Checkpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("env", Env.Type).Ref("checkpoints").Unique().Field("env_id").Required(),
+	}
+}
+
+func (Checkpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sandbox_id", "name").Unique(),
+	}
+}
+
+func (Checkpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
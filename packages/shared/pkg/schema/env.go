@@ -41,6 +41,7 @@ func (Env) Edges() []ent.Edge {
 		edge.To("env_aliases", EnvAlias.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("builds", EnvBuild.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("snapshots", Snapshot.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("checkpoints", Checkpoint.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/types"
 )
 
 type Snapshot struct {
@@ -28,6 +30,7 @@ func (Snapshot) Fields() []ent.Field {
 		field.JSON("metadata", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 		field.Time("sandbox_started_at"),
 		field.Bool("env_secure").Default(false),
+		field.JSON("network", &types.SandboxNetworkConfig{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
 	}
 }
 
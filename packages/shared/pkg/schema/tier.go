@@ -20,6 +20,9 @@ func (Tier) Fields() []ent.Field {
 		field.Int64("disk_mb").Annotations(entsql.Check("disk_mb > 0"), entsql.Default("512")),
 		field.Int64("concurrent_instances").Annotations(entsql.Check("concurrent_instances > 0")).Comment("The number of instances the team can run concurrently"),
 		field.Int64("max_length_hours"),
+		field.Int64("disk_bandwidth_mb").Annotations(entsql.Default("0")).Comment("The rootfs disk bandwidth limit of the team sandboxes in MB/s, 0 means unlimited"),
+		field.Int64("disk_iops").Annotations(entsql.Default("0")).Comment("The rootfs disk IOPS limit of the team sandboxes, 0 means unlimited"),
+		field.Int64("network_bandwidth_mb").Annotations(entsql.Default("0")).Comment("The network bandwidth limit of the team sandboxes in MB/s for each direction, 0 means unlimited"),
 	}
 }
 
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Volume is a named team disk that persists across sandboxes, its data is stored as a chain of generations.
+type Volume struct {
+	ent.Schema
+}
+
+func (Volume) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int64("size_mb"),
+		// The last generation written back, nil until the volume is used by a sandbox for the first time.
+		field.UUID("generation_id", uuid.UUID{}).Optional().Nillable(),
+		// The sandbox the volume is attached to, the volume can be used by one running or paused sandbox at a time.
+		field.String("sandbox_id").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// The generation the attached sandbox writes the volume back to.
+		field.UUID("next_generation_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Volume) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("volumes").Unique().Field("team_id").Required(),
+	}
+}
+
+func (Volume) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "name").Unique(),
+	}
+}
+
+func (Volume) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
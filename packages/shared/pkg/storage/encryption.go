@@ -0,0 +1,308 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/kms"
+)
+
+const (
+	dataKeysDir = "keys"
+	teamKeysDir = "teams"
+
+	dataKeySize = 32
+)
+
+// wrappedDataKey is the data key wrapped by the KMS master key, as it is stored in the storage.
+type wrappedDataKey struct {
+	MasterKeyID string `json:"masterKeyId"`
+	WrappedKey  []byte `json:"wrappedKey"`
+}
+
+// DataKeys manages the per-team data keys the template and snapshot data are encrypted with.
+// The data keys are stored wrapped by the KMS master key, the unwrapped keys are cached for the lifetime of the process.
+type DataKeys struct {
+	kms kms.KMS
+
+	mu    sync.Mutex
+	keys  map[uuid.UUID]cipher.AEAD
+	teams map[string]uuid.UUID
+}
+
+var getDataKeys = sync.OnceValues(func() (*DataKeys, error) {
+	k, err := kms.GetKMS()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KMS: %w", err)
+	}
+
+	if k == nil {
+		return nil, nil
+	}
+
+	return NewDataKeys(k), nil
+})
+
+// GetDataKeys returns the data keys shared by the process, it returns nil if the encryption is disabled.
+func GetDataKeys() (*DataKeys, error) {
+	return getDataKeys()
+}
+
+func NewDataKeys(k kms.KMS) *DataKeys {
+	return &DataKeys{
+		kms:   k,
+		keys:  make(map[uuid.UUID]cipher.AEAD),
+		teams: make(map[string]uuid.UUID),
+	}
+}
+
+func storageDataKeyPath(keyID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s", dataKeysDir, keyID)
+}
+
+func storageTeamKeyPath(teamID string) string {
+	return fmt.Sprintf("%s/%s/%s", dataKeysDir, teamKeysDir, teamID)
+}
+
+// TeamKey returns the data key of the team, the key is created when the team uses it for the first time.
+func (d *DataKeys) TeamKey(ctx context.Context, persistence StorageProvider, teamID string) (uuid.UUID, cipher.AEAD, error) {
+	d.mu.Lock()
+	keyID, ok := d.teams[teamID]
+	d.mu.Unlock()
+
+	if !ok {
+		var err error
+
+		keyID, err = d.teamKeyID(ctx, persistence, teamID)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+
+		d.mu.Lock()
+		d.teams[teamID] = keyID
+		d.mu.Unlock()
+	}
+
+	aead, err := d.Key(ctx, persistence, keyID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return keyID, aead, nil
+}
+
+func (d *DataKeys) teamKeyID(ctx context.Context, persistence StorageProvider, teamID string) (uuid.UUID, error) {
+	object, err := persistence.OpenObject(ctx, storageTeamKeyPath(teamID))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var buf bytes.Buffer
+
+	_, err = object.WriteTo(&buf)
+	if errors.Is(err, ErrorObjectNotExist) {
+		return d.createTeamKey(ctx, persistence, teamID)
+	}
+
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to read data key of team %s: %w", teamID, err)
+	}
+
+	keyID, err := uuid.Parse(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse data key ID of team %s: %w", teamID, err)
+	}
+
+	return keyID, nil
+}
+
+// createTeamKey stores a new data key and sets it as the key of the team.
+// If two nodes create the key for the team at the same time, both keys stay valid, because the data record the ID of their key.
+func (d *DataKeys) createTeamKey(ctx context.Context, persistence StorageProvider, teamID string) (uuid.UUID, error) {
+	key := make([]byte, dataKeySize)
+
+	_, err := rand.Read(key)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	masterKeyID, wrapped, err := d.kms.WrapKey(ctx, key)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	content, err := json.Marshal(&wrappedDataKey{
+		MasterKeyID: masterKeyID,
+		WrappedKey:  wrapped,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to serialize data key: %w", err)
+	}
+
+	keyID := uuid.New()
+
+	// The key is stored before the team references it.
+	keyObject, err := persistence.OpenObject(ctx, storageDataKeyPath(keyID))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	_, err = keyObject.ReadFrom(bytes.NewReader(content))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to upload data key: %w", err)
+	}
+
+	teamObject, err := persistence.OpenObject(ctx, storageTeamKeyPath(teamID))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	_, err = teamObject.ReadFrom(strings.NewReader(keyID.String()))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to upload data key ID of team %s: %w", teamID, err)
+	}
+
+	return keyID, nil
+}
+
+// Key returns the AES-GCM cipher of the data key with the ID.
+func (d *DataKeys) Key(ctx context.Context, persistence StorageProvider, keyID uuid.UUID) (cipher.AEAD, error) {
+	d.mu.Lock()
+	aead, ok := d.keys[keyID]
+	d.mu.Unlock()
+
+	if ok {
+		return aead, nil
+	}
+
+	object, err := persistence.OpenObject(ctx, storageDataKeyPath(keyID))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	_, err = object.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data key %s: %w", keyID, err)
+	}
+
+	var wrapped wrappedDataKey
+
+	err = json.Unmarshal(buf.Bytes(), &wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse data key %s: %w", keyID, err)
+	}
+
+	key, err := d.kms.UnwrapKey(ctx, wrapped.MasterKeyID, wrapped.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key %s: %w", keyID, err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher of data key %s: %w", keyID, err)
+	}
+
+	aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AEAD of data key %s: %w", keyID, err)
+	}
+
+	d.mu.Lock()
+	d.keys[keyID] = aead
+	d.mu.Unlock()
+
+	return aead, nil
+}
+
+// sealedObjectMagic marks the object sealed by SealObject, the key ID the object is encrypted with follows it.
+const sealedObjectMagic = "E2BSEAL1"
+
+// SealObject encrypts the small object without a header, e.g. the snapfile, as a single frame.
+// The ID of the data key is stored before the frame, so the object can be opened on its own.
+func SealObject(keyID uuid.UUID, aead cipher.AEAD, scope headers.EncryptionScope, data []byte) ([]byte, error) {
+	sealed := make([]byte, 0, len(sealedObjectMagic)+len(keyID)+aead.NonceSize()+len(data)+aead.Overhead())
+	sealed = append(sealed, sealedObjectMagic...)
+	sealed = append(sealed, keyID[:]...)
+
+	frame, err := headers.SealFrame(aead, scope, 0, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sealed, frame...), nil
+}
+
+// sealedObjectKey returns the data key ID and the frame of the object sealed by SealObject.
+func sealedObjectKey(data []byte) (uuid.UUID, []byte, bool) {
+	if !bytes.HasPrefix(data, []byte(sealedObjectMagic)) || len(data) < len(sealedObjectMagic)+len(uuid.UUID{}) {
+		return uuid.Nil, nil, false
+	}
+
+	data = data[len(sealedObjectMagic):]
+
+	keyID, err := uuid.FromBytes(data[:len(uuid.UUID{})])
+	if err != nil {
+		return uuid.Nil, nil, false
+	}
+
+	return keyID, data[len(uuid.UUID{}):], true
+}
+
+// UnsealObject returns the data of the object sealed by SealObject, the objects uploaded unencrypted are returned unchanged.
+// The keys are nil if the encryption is disabled, the sealed objects can't be opened then.
+func UnsealObject(ctx context.Context, keys *DataKeys, persistence StorageProvider, scope headers.EncryptionScope, data []byte) ([]byte, error) {
+	keyID, frame, ok := sealedObjectKey(data)
+	if !ok {
+		return data, nil
+	}
+
+	if keys == nil {
+		return nil, fmt.Errorf("object is encrypted with data key %s, but no KMS is configured", keyID)
+	}
+
+	aead, err := keys.Key(ctx, persistence, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return headers.OpenFrame(aead, scope, 0, frame)
+}
+
+// ResealObject re-encrypts the object sealed by SealObject for another scope with the same data key, e.g. when the object is copied to another build.
+// The objects uploaded unencrypted are returned unchanged.
+func ResealObject(ctx context.Context, keys *DataKeys, persistence StorageProvider, from, to headers.EncryptionScope, data []byte) ([]byte, error) {
+	keyID, _, ok := sealedObjectKey(data)
+	if !ok {
+		return data, nil
+	}
+
+	plain, err := UnsealObject(ctx, keys, persistence, from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := keys.Key(ctx, persistence, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return SealObject(keyID, aead, to, plain)
+}
+
+// SnapfileScope is the scope the snapfile of the build is encrypted for.
+func SnapfileScope(buildID string) headers.EncryptionScope {
+	return headers.FileScope(buildID, SnapfileName)
+}
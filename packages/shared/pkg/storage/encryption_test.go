@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/kms"
+)
+
+func newTestKMS(t *testing.T) kms.KMS {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(bytes.Repeat([]byte{1}, 32))), 0o600))
+
+	k, err := kms.NewLocalKMS(path)
+	require.NoError(t, err)
+
+	return k
+}
+
+func TestDataKeys_TeamKey(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+	k := newTestKMS(t)
+
+	keyID, aead, err := NewDataKeys(k).TeamKey(ctx, p, "team")
+	require.NoError(t, err)
+
+	nonce := make([]byte, aead.NonceSize())
+	sealed := aead.Seal(nil, nonce, []byte("data"), nil)
+
+	// Another node reads the key of the team from the storage.
+	other := NewDataKeys(k)
+
+	otherKeyID, _, err := other.TeamKey(ctx, p, "team")
+	require.NoError(t, err)
+	require.Equal(t, keyID, otherKeyID)
+
+	otherAEAD, err := other.Key(ctx, p, keyID)
+	require.NoError(t, err)
+
+	data, err := otherAEAD.Open(nil, nonce, sealed, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	// The teams have different keys.
+	otherTeamKeyID, _, err := other.TeamKey(ctx, p, "other-team")
+	require.NoError(t, err)
+	require.NotEqual(t, keyID, otherTeamKeyID)
+}
+
+func TestSealObject(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+	keys := NewDataKeys(newTestKMS(t))
+
+	keyID, aead, err := keys.TeamKey(ctx, p, "team")
+	require.NoError(t, err)
+
+	sealed, err := SealObject(keyID, aead, SnapfileScope("build"), []byte("snapfile"))
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "snapfile")
+
+	data, err := UnsealObject(ctx, keys, p, SnapfileScope("build"), sealed)
+	require.NoError(t, err)
+	require.Equal(t, []byte("snapfile"), data)
+
+	// The snapfile of another build can't be swapped in.
+	_, err = UnsealObject(ctx, keys, p, SnapfileScope("other-build"), sealed)
+	require.Error(t, err)
+
+	_, err = UnsealObject(ctx, nil, p, SnapfileScope("build"), sealed)
+	require.Error(t, err)
+
+	resealed, err := ResealObject(ctx, keys, p, SnapfileScope("build"), SnapfileScope("other-build"), sealed)
+	require.NoError(t, err)
+
+	data, err = UnsealObject(ctx, keys, p, SnapfileScope("other-build"), resealed)
+	require.NoError(t, err)
+	require.Equal(t, []byte("snapfile"), data)
+
+	// The objects uploaded without encryption are returned unchanged.
+	data, err = UnsealObject(ctx, nil, p, SnapfileScope("build"), []byte("snapfile"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("snapfile"), data)
+
+	data, err = ResealObject(ctx, nil, p, SnapfileScope("build"), SnapfileScope("other-build"), []byte("snapfile"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("snapfile"), data)
+}
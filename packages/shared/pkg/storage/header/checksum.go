@@ -0,0 +1,203 @@
+package header
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// ChecksumChunkSize is the size of the build diff ranges covered by one checksum.
+// It matches the size of the chunks fetched by the orchestrator, so every fetched chunk is verified as a whole.
+const ChecksumChunkSize = 4 * 1024 * 1024
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Checksum returns the checksum of the build diff chunk.
+func Checksum(data []byte) uint32 {
+	return crc32.Checksum(data, castagnoli)
+}
+
+// ChecksumMismatchError is returned when the data of the diff chunk don't match the checksum recorded when the diff was created.
+type ChecksumMismatchError struct {
+	Offset int64
+	Length int64
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch in range [%d, %d)", e.Offset, e.Offset+e.Length)
+}
+
+// ChecksumWriter computes the checksums of the data written through it by ChecksumChunkSize chunks.
+type ChecksumWriter struct {
+	w io.Writer
+
+	current   hash.Hash32
+	written   int64
+	checksums []uint32
+}
+
+func NewChecksumWriter(w io.Writer) *ChecksumWriter {
+	return &ChecksumWriter{
+		w:       w,
+		current: crc32.New(castagnoli),
+	}
+}
+
+func (c *ChecksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+
+	for data := p[:n]; len(data) > 0; {
+		part := data[:min(int64(len(data)), ChecksumChunkSize-c.written%ChecksumChunkSize)]
+
+		c.current.Write(part)
+		c.written += int64(len(part))
+
+		if c.written%ChecksumChunkSize == 0 {
+			c.checksums = append(c.checksums, c.current.Sum32())
+			c.current.Reset()
+		}
+
+		data = data[len(part):]
+	}
+
+	return n, err
+}
+
+// Checksums returns the checksums of the data written so far, the last checksum covers the incomplete chunk.
+func (c *ChecksumWriter) Checksums() []uint32 {
+	if c.written%ChecksumChunkSize == 0 {
+		return c.checksums
+	}
+
+	return append(c.checksums[:len(c.checksums):len(c.checksums)], c.current.Sum32())
+}
+
+// ComputeChecksums returns the checksums of the whole diff.
+func ComputeChecksums(diff io.Reader) ([]uint32, error) {
+	w := NewChecksumWriter(io.Discard)
+
+	_, err := io.Copy(w, diff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read diff: %w", err)
+	}
+
+	return w.Checksums(), nil
+}
+
+type checksumReader struct {
+	checksums []uint32
+	size      int64
+	base      io.ReaderAt
+}
+
+// NewChecksumReader returns the reader of the diff verifying the data against the checksums.
+// Only the ChecksumChunkSize chunks read as a whole are verified, the chunker always reads the whole chunks.
+func NewChecksumReader(checksums []uint32, size int64, base io.ReaderAt) (io.ReaderAt, error) {
+	if int64(len(checksums)) != TotalBlocks(size, ChecksumChunkSize) {
+		return nil, fmt.Errorf("diff of size %d doesn't match %d checksums", size, len(checksums))
+	}
+
+	return &checksumReader{
+		checksums: checksums,
+		size:      size,
+		base:      base,
+	}, nil
+}
+
+func (r *checksumReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.base.ReadAt(p, off)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+
+	end := off + int64(n)
+
+	for idx := BlockIdx(off+ChecksumChunkSize-1, ChecksumChunkSize); ; idx++ {
+		chunkStart := BlockOffset(idx, ChecksumChunkSize)
+		chunkEnd := min(chunkStart+ChecksumChunkSize, r.size)
+
+		if chunkStart >= r.size || chunkEnd > end {
+			break
+		}
+
+		if Checksum(p[chunkStart-off:chunkEnd-off]) != r.checksums[idx] {
+			return n, &ChecksumMismatchError{
+				Offset: chunkStart,
+				Length: chunkEnd - chunkStart,
+			}
+		}
+	}
+
+	// The diff is shorter than the checksums expect.
+	if expectedEnd := min(off+int64(len(p)), r.size); end < expectedEnd {
+		return n, &ChecksumMismatchError{
+			Offset: end,
+			Length: expectedEnd - end,
+		}
+	}
+
+	return n, err
+}
+
+type contentChunkReader struct {
+	hash ChunkHash
+	base io.ReaderAt
+}
+
+// NewContentChunkReader returns the reader of the content-addressed chunk verifying the data against the hash of the chunk.
+// The chunk is verified when it is read as a whole, the chunker reads the chunk at once.
+func NewContentChunkReader(hash ChunkHash, base io.ReaderAt) io.ReaderAt {
+	return &contentChunkReader{
+		hash: hash,
+		base: base,
+	}
+}
+
+func (r *contentChunkReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.base.ReadAt(p, off)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+
+	// The read covers the whole chunk if it ended before the end of the buffer or if it read the biggest possible chunk.
+	if off == 0 && (n < len(p) || n == ContentChunkSize) && sha256.Sum256(p[:n]) != r.hash {
+		return n, &ChecksumMismatchError{
+			Offset: 0,
+			Length: int64(n),
+		}
+	}
+
+	return n, err
+}
+
+// VerifyDiff reads the whole diff and returns the ranges whose data don't match the checksums.
+func VerifyDiff(checksums []uint32, size int64, diff io.ReaderAt) ([]*ChecksumMismatchError, error) {
+	reader, err := NewChecksumReader(checksums, size, diff)
+	if err != nil {
+		return nil, err
+	}
+
+	var corrupted []*ChecksumMismatchError
+
+	b := make([]byte, ChecksumChunkSize)
+
+	for off := int64(0); off < size; off += ChecksumChunkSize {
+		_, err := reader.ReadAt(b[:min(ChecksumChunkSize, size-off)], off)
+
+		var mismatch *ChecksumMismatchError
+		if errors.As(err, &mismatch) {
+			corrupted = append(corrupted, mismatch)
+
+			continue
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to read diff at %d: %w", off, err)
+		}
+	}
+
+	return corrupted, nil
+}
@@ -0,0 +1,118 @@
+package header
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumWriter(t *testing.T) {
+	diff := createCompressibleDiff(2*ChecksumChunkSize + 3*4096)
+
+	var out bytes.Buffer
+
+	w := NewChecksumWriter(&out)
+
+	// Write by blocks that don't align with the checksum chunks.
+	for off := 0; off < len(diff); off += 3 * 4096 {
+		_, err := w.Write(diff[off:min(off+3*4096, len(diff))])
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, diff, out.Bytes())
+	require.Equal(t, []uint32{
+		Checksum(diff[:ChecksumChunkSize]),
+		Checksum(diff[ChecksumChunkSize : 2*ChecksumChunkSize]),
+		Checksum(diff[2*ChecksumChunkSize:]),
+	}, w.Checksums())
+
+	checksums, err := ComputeChecksums(bytes.NewReader(diff))
+	require.NoError(t, err)
+	require.Equal(t, w.Checksums(), checksums)
+}
+
+func TestChecksumReader(t *testing.T) {
+	diff := createCompressibleDiff(2*ChecksumChunkSize + 3*4096)
+
+	checksums, err := ComputeChecksums(bytes.NewReader(diff))
+	require.NoError(t, err)
+
+	corrupted := bytes.Clone(diff)
+	corrupted[ChecksumChunkSize+100] ^= 0xff
+
+	reader, err := NewChecksumReader(checksums, int64(len(diff)), bytes.NewReader(corrupted))
+	require.NoError(t, err)
+
+	// Read the chunks as the chunker does.
+	b := make([]byte, ChecksumChunkSize)
+
+	_, err = reader.ReadAt(b, 0)
+	require.NoError(t, err)
+
+	_, err = reader.ReadAt(b, ChecksumChunkSize)
+
+	var mismatch *ChecksumMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, int64(ChecksumChunkSize), mismatch.Offset)
+
+	n, err := reader.ReadAt(b, 2*ChecksumChunkSize)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 3*4096, n)
+
+	result, err := VerifyDiff(checksums, int64(len(diff)), bytes.NewReader(corrupted))
+	require.NoError(t, err)
+	require.Equal(t, []*ChecksumMismatchError{{Offset: ChecksumChunkSize, Length: ChecksumChunkSize}}, result)
+
+	// The truncated diff is reported too.
+	result, err = VerifyDiff(checksums, int64(len(diff)), bytes.NewReader(diff[:2*ChecksumChunkSize]))
+	require.NoError(t, err)
+	require.Equal(t, []*ChecksumMismatchError{{Offset: 2 * ChecksumChunkSize, Length: 3 * 4096}}, result)
+
+	_, err = NewChecksumReader(checksums[:1], int64(len(diff)), bytes.NewReader(diff))
+	require.Error(t, err)
+}
+
+func TestContentChunkReader(t *testing.T) {
+	chunk := createCompressibleDiff(3 * 4096)
+	hash := ChunkHash(sha256.Sum256(chunk))
+
+	b := make([]byte, ContentChunkSize)
+
+	n, err := NewContentChunkReader(hash, bytes.NewReader(chunk)).ReadAt(b, 0)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, chunk, b[:n])
+
+	corrupted := bytes.Clone(chunk)
+	corrupted[0] ^= 0xff
+
+	_, err = NewContentChunkReader(hash, bytes.NewReader(corrupted)).ReadAt(b, 0)
+
+	var mismatch *ChecksumMismatchError
+	require.ErrorAs(t, err, &mismatch)
+}
+
+func TestSerializeChecksums(t *testing.T) {
+	metadata := NewTemplateMetadata(baseID, blockSize, size)
+
+	h := NewHeader(metadata, simpleBase)
+	h.Checksums = []uint32{1, 2, 3}
+
+	_, err := SerializeHeader(h)
+	require.Error(t, err)
+
+	h.SetChecksums([]uint32{1, 2, 3})
+	require.Equal(t, uint64(ChecksumVersion), h.Metadata.Version)
+
+	serialized, err := SerializeHeader(h)
+	require.NoError(t, err)
+
+	deserialized, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+
+	require.Equal(t, []uint32{1, 2, 3}, deserialized.Checksums)
+	require.Nil(t, deserialized.Compression)
+	require.True(t, Equal(simpleBase, deserialized.Mapping))
+}
@@ -0,0 +1,251 @@
+package header
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// CompressionFrameSize is the uncompressed size of the independently compressed frames of the build diff.
+// It matches the size of the chunks fetched by the orchestrator, so every fetch decompresses one frame.
+const CompressionFrameSize = 4 * 1024 * 1024
+
+type CompressionType uint64
+
+const (
+	CompressionNone CompressionType = iota
+	CompressionZstd
+	CompressionLZ4
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+func ParseCompressionType(s string) (CompressionType, error) {
+	switch s {
+	case "", "none":
+		return CompressionNone, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "lz4":
+		return CompressionLZ4, nil
+	}
+
+	return CompressionNone, fmt.Errorf("unknown compression type: %s", s)
+}
+
+func (c CompressionType) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionLZ4:
+		return "lz4"
+	}
+
+	return fmt.Sprintf("unknown(%d)", uint64(c))
+}
+
+// Suffix is added to the storage path of the content-addressed chunks, so the chunks with the same data compressed differently don't collide.
+func (c CompressionType) Suffix() string {
+	switch c {
+	case CompressionZstd:
+		return ".zst"
+	case CompressionLZ4:
+		return ".lz4"
+	}
+
+	return ""
+}
+
+// CompressionIndex describes the build diff stored as the compressed or encrypted frames.
+type CompressionIndex struct {
+	Type CompressionType
+	// Size is the uncompressed size of the diff.
+	Size uint64
+	// Offsets are the offsets of the frames in the compressed diff, the last offset is the size of the compressed diff.
+	Offsets []uint64
+}
+
+// CompressFrame compresses the frame data.
+func CompressFrame(c CompressionType, src []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return src, nil
+	case CompressionZstd:
+		return zstdEncoder.EncodeAll(src, nil), nil
+	case CompressionLZ4:
+		var buf bytes.Buffer
+
+		w := lz4.NewWriter(&buf)
+
+		_, err := w.Write(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress frame: %w", err)
+		}
+
+		err = w.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to finish compressed frame: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported compression type: %s", c)
+}
+
+// DecompressFrame decompresses the frame to the dst and returns the size of the decompressed data.
+// The dst can be bigger than the decompressed data.
+func DecompressFrame(c CompressionType, src, dst []byte) (int, error) {
+	switch c {
+	case CompressionNone:
+		if len(src) > len(dst) {
+			return 0, fmt.Errorf("frame is bigger than expected: %d > %d", len(src), len(dst))
+		}
+
+		return copy(dst, src), nil
+	case CompressionZstd:
+		data, err := zstdDecoder.DecodeAll(src, nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decompress frame: %w", err)
+		}
+
+		if len(data) > len(dst) {
+			return 0, fmt.Errorf("decompressed frame is bigger than expected: %d > %d", len(data), len(dst))
+		}
+
+		return copy(dst, data), nil
+	case CompressionLZ4:
+		n, err := io.ReadFull(lz4.NewReader(bytes.NewReader(src)), dst)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("failed to decompress frame: %w", err)
+		}
+
+		return n, nil
+	}
+
+	return 0, fmt.Errorf("unsupported compression type: %s", c)
+}
+
+// EncodeFrames compresses the diff by CompressionFrameSize frames to the w and returns the index of the frames.
+// If the aead is not nil, every frame is encrypted for the scope after the compression.
+func EncodeFrames(c CompressionType, aead cipher.AEAD, scope EncryptionScope, diff io.Reader, w io.Writer) (*CompressionIndex, error) {
+	index := &CompressionIndex{
+		Type:    c,
+		Offsets: []uint64{0},
+	}
+
+	frame := make([]byte, CompressionFrameSize)
+
+	var offset uint64
+
+	for {
+		n, err := io.ReadFull(diff, frame)
+		if n > 0 {
+			compressed, compressErr := CompressFrame(c, frame[:n])
+			if compressErr != nil {
+				return nil, compressErr
+			}
+
+			if aead != nil {
+				compressed, compressErr = SealFrame(aead, scope, uint64(len(index.Offsets)-1), compressed)
+				if compressErr != nil {
+					return nil, compressErr
+				}
+			}
+
+			_, writeErr := w.Write(compressed)
+			if writeErr != nil {
+				return nil, fmt.Errorf("failed to write compressed frame: %w", writeErr)
+			}
+
+			offset += uint64(len(compressed))
+			index.Size += uint64(n)
+			index.Offsets = append(index.Offsets, offset)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read diff: %w", err)
+		}
+	}
+
+	return index, nil
+}
+
+type frameReader struct {
+	index *CompressionIndex
+	aead  cipher.AEAD
+	scope EncryptionScope
+	base  io.ReaderAt
+}
+
+// NewFrameReader returns the reader of the diff data stored as the frames.
+// Every read fetches the frames it overlaps, decrypts them for the scope if the aead is not nil and decompresses them.
+func NewFrameReader(index *CompressionIndex, aead cipher.AEAD, scope EncryptionScope, base io.ReaderAt) io.ReaderAt {
+	return &frameReader{
+		index: index,
+		aead:  aead,
+		scope: scope,
+		base:  base,
+	}
+}
+
+func (r *frameReader) ReadAt(p []byte, off int64) (n int, err error) {
+	frames := int64(len(r.index.Offsets) - 1)
+
+	for n < len(p) {
+		current := off + int64(n)
+		if current >= int64(r.index.Size) {
+			return n, io.EOF
+		}
+
+		frameIdx := BlockIdx(current, CompressionFrameSize)
+		if frameIdx >= frames {
+			return n, io.EOF
+		}
+
+		compressed := make([]byte, r.index.Offsets[frameIdx+1]-r.index.Offsets[frameIdx])
+
+		_, err := r.base.ReadAt(compressed, int64(r.index.Offsets[frameIdx]))
+		if err != nil && !errors.Is(err, io.EOF) {
+			return n, fmt.Errorf("failed to read frame %d: %w", frameIdx, err)
+		}
+
+		if r.aead != nil {
+			compressed, err = OpenFrame(r.aead, r.scope, uint64(frameIdx), compressed)
+			if err != nil {
+				return n, err
+			}
+		}
+
+		frameStart := BlockOffset(frameIdx, CompressionFrameSize)
+		frame := make([]byte, min(CompressionFrameSize, int64(r.index.Size)-frameStart))
+
+		frameN, err := DecompressFrame(r.index.Type, compressed, frame)
+		if err != nil {
+			return n, fmt.Errorf("failed to decompress frame %d: %w", frameIdx, err)
+		}
+
+		shift := current - frameStart
+		if shift >= int64(frameN) {
+			return n, io.EOF
+		}
+
+		n += copy(p[n:], frame[shift:frameN])
+	}
+
+	return n, nil
+}
@@ -0,0 +1,105 @@
+package header
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createCompressibleDiff(size int) []byte {
+	diff := make([]byte, size)
+	for i := range diff {
+		diff[i] = byte(i / 4096)
+	}
+
+	return diff
+}
+
+func TestCompressDecompress(t *testing.T) {
+	diff := createCompressibleDiff(2*CompressionFrameSize + 3*4096)
+
+	for _, c := range []CompressionType{CompressionZstd, CompressionLZ4} {
+		t.Run(c.String(), func(t *testing.T) {
+			var compressed bytes.Buffer
+
+			index, err := EncodeFrames(c, nil, nil, bytes.NewReader(diff), &compressed)
+			require.NoError(t, err)
+
+			require.Equal(t, c, index.Type)
+			require.Equal(t, uint64(len(diff)), index.Size)
+			require.Len(t, index.Offsets, 4)
+			require.Equal(t, uint64(compressed.Len()), index.Offsets[3])
+			require.Less(t, compressed.Len(), len(diff))
+
+			reader := NewFrameReader(index, nil, nil, bytes.NewReader(compressed.Bytes()))
+
+			// Read across the frame boundary.
+			b := make([]byte, 2*4096)
+			n, err := reader.ReadAt(b, CompressionFrameSize-4096)
+			require.NoError(t, err)
+			require.Equal(t, len(b), n)
+			require.Equal(t, diff[CompressionFrameSize-4096:CompressionFrameSize+4096], b)
+
+			// Read the last frame as the chunker does, with the buffer bigger than the rest of the diff.
+			b = make([]byte, CompressionFrameSize)
+			n, err = reader.ReadAt(b, 2*CompressionFrameSize)
+			require.ErrorIs(t, err, io.EOF)
+			require.Equal(t, 3*4096, n)
+			require.Equal(t, diff[2*CompressionFrameSize:], b[:n])
+		})
+	}
+}
+
+func TestSerializeCompressedHeader(t *testing.T) {
+	metadata := NewTemplateMetadata(baseID, blockSize, size)
+
+	mappings := make([]*BuildMap, 0, len(simpleBase))
+	for _, m := range simpleBase {
+		mapping := *m
+		if mapping.BuildId == baseID {
+			mapping.Compression = CompressionZstd
+		}
+
+		mappings = append(mappings, &mapping)
+	}
+
+	index := &CompressionIndex{
+		Type:    CompressionZstd,
+		Size:    4 * blockSize,
+		Offsets: []uint64{0, 1024, 2048},
+	}
+
+	h := NewHeader(metadata, mappings)
+	h.Compression = index
+
+	_, err := SerializeHeader(h)
+	require.Error(t, err)
+
+	metadata.Version = CompressedVersion
+
+	serialized, err := SerializeHeader(h)
+	require.NoError(t, err)
+
+	deserialized, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+
+	require.Equal(t, index, deserialized.Compression)
+	require.True(t, Equal(mappings, deserialized.Mapping))
+	require.Equal(t, CompressionZstd, deserialized.Mapping[1].Compression)
+}
+
+func TestSerializeCompressedHeaderWithoutIndex(t *testing.T) {
+	metadata := NewTemplateMetadata(baseID, blockSize, size)
+	metadata.Version = CompressedVersion
+
+	serialized, err := Serialize(metadata, simpleBase)
+	require.NoError(t, err)
+
+	deserialized, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+
+	require.Nil(t, deserialized.Compression)
+	require.True(t, Equal(simpleBase, deserialized.Mapping))
+}
@@ -0,0 +1,173 @@
+package header
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// ContentChunkSize is the size of the device range whose build data are stored in one content-addressed chunk.
+const ContentChunkSize = 4 * 1024 * 1024
+
+// ChunkHash is the SHA-256 of the content-addressed chunk data.
+type ChunkHash [sha256.Size]byte
+
+func (h ChunkHash) IsZero() bool {
+	return h == ChunkHash{}
+}
+
+func (h ChunkHash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// ContentChunk is the part of the build diff that is stored under the hash of its data.
+type ContentChunk struct {
+	Hash        ChunkHash
+	Size        uint64
+	Compression CompressionType
+	KeyId       uuid.UUID
+
+	// parts are the ranges of the build diff the chunk consists of, in order.
+	parts []*BuildMap
+}
+
+// Name is the name of the chunk in the storage.
+func (c *ContentChunk) Name() string {
+	return chunkName(c.Hash, c.Compression, c.KeyId)
+}
+
+// ChunkName is the name of the content-addressed chunk referenced by the mapping in the storage.
+func (mapping *BuildMap) ChunkName() string {
+	return chunkName(mapping.Hash, mapping.Compression, mapping.KeyId)
+}
+
+// chunkName separates the chunks with the same data encrypted with different data keys,
+// so the chunks are shared only by the builds using the same key.
+func chunkName(hash ChunkHash, compression CompressionType, keyID uuid.UUID) string {
+	name := hash.String()
+	if keyID != uuid.Nil {
+		name = fmt.Sprintf("%s.%s", name, keyID)
+	}
+
+	return name + compression.Suffix()
+}
+
+// Reader returns the chunk data read from the build diff.
+func (c *ContentChunk) Reader(diff io.ReaderAt) io.Reader {
+	readers := make([]io.Reader, 0, len(c.parts))
+
+	for _, part := range c.parts {
+		readers = append(readers, io.NewSectionReader(diff, int64(part.BuildStorageOffset), int64(part.Length)))
+	}
+
+	return io.MultiReader(readers...)
+}
+
+// ContentAddress moves the data of the build from its diff to the content-addressed chunks.
+//
+// The mappings of the build are split at the ContentChunkSize boundaries of the device
+// and the build data in every ContentChunkSize range of the device are stored as one chunk,
+// so a change in a block changes only the chunk of its range and the other chunks can be shared with the previous builds.
+// The mappings of the other builds are not changed.
+//
+// The chunks are stored with the compression and encrypted with the data key if the key ID is not nil, every chunk is stored as one frame.
+//
+// It returns the mappings referencing the chunks and the unique chunks of the build.
+func ContentAddress(mappings []*BuildMap, buildID uuid.UUID, diff io.ReaderAt, compression CompressionType, keyID uuid.UUID) ([]*BuildMap, []*ContentChunk, error) {
+	result := make([]*BuildMap, 0, len(mappings))
+	chunks := make([]*ContentChunk, 0)
+	seen := make(map[ChunkHash]struct{})
+
+	var current *ContentChunk
+	var currentIdx uint64
+	// currentMappings reference the current chunk, the hash is set when the chunk is finished.
+	var currentMappings []*BuildMap
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+
+		h := sha256.New()
+
+		_, err := io.Copy(h, current.Reader(diff))
+		if err != nil {
+			return fmt.Errorf("failed to hash chunk at offset %d: %w", currentIdx*ContentChunkSize, err)
+		}
+
+		copy(current.Hash[:], h.Sum(nil))
+
+		for _, mapping := range currentMappings {
+			mapping.Hash = current.Hash
+		}
+
+		if _, ok := seen[current.Hash]; !ok {
+			seen[current.Hash] = struct{}{}
+			chunks = append(chunks, current)
+		}
+
+		current = nil
+		currentMappings = nil
+
+		return nil
+	}
+
+	for _, mapping := range mappings {
+		if mapping.BuildId != buildID || !mapping.Hash.IsZero() {
+			result = append(result, mapping)
+
+			continue
+		}
+
+		end := mapping.Offset + mapping.Length
+
+		for off := mapping.Offset; off < end; {
+			idx := off / ContentChunkSize
+			partEnd := min((idx+1)*ContentChunkSize, end)
+
+			if current != nil && idx != currentIdx {
+				err := finish()
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			if current == nil {
+				current = &ContentChunk{Compression: compression, KeyId: keyID}
+				currentIdx = idx
+			}
+
+			current.parts = append(current.parts, &BuildMap{
+				Offset:             off,
+				Length:             partEnd - off,
+				BuildId:            buildID,
+				BuildStorageOffset: mapping.BuildStorageOffset + (off - mapping.Offset),
+			})
+
+			m := &BuildMap{
+				Offset:             off,
+				Length:             partEnd - off,
+				BuildId:            buildID,
+				BuildStorageOffset: current.Size,
+				Compression:        compression,
+				KeyId:              keyID,
+			}
+
+			current.Size += m.Length
+			currentMappings = append(currentMappings, m)
+			result = append(result, m)
+
+			off = partEnd
+		}
+	}
+
+	err := finish()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, chunks, nil
+}
@@ -0,0 +1,108 @@
+package header
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentAddressSplitsByChunkRange(t *testing.T) {
+	buildID := uuid.New()
+	parentID := uuid.New()
+
+	// The build mapping crosses the boundary of the first and the second chunk range.
+	diff := bytes.Repeat([]byte{1}, 2*HugepageSize)
+	diff[HugepageSize] = 2
+
+	mappings := []*BuildMap{
+		{Offset: 0, Length: HugepageSize, BuildId: parentID, BuildStorageOffset: 0},
+		{Offset: HugepageSize, Length: 2 * HugepageSize, BuildId: buildID, BuildStorageOffset: 0},
+		{Offset: 3 * HugepageSize, Length: 5 * HugepageSize, BuildId: parentID, BuildStorageOffset: 3 * HugepageSize},
+	}
+
+	result, chunks, err := ContentAddress(mappings, buildID, bytes.NewReader(diff), CompressionNone, uuid.Nil)
+	require.NoError(t, err)
+
+	require.Len(t, chunks, 2)
+	require.Equal(t, uint64(HugepageSize), chunks[0].Size)
+	require.Equal(t, uint64(HugepageSize), chunks[1].Size)
+	require.NotEqual(t, chunks[0].Hash, chunks[1].Hash)
+
+	require.Len(t, result, 4)
+	require.NoError(t, ValidateMappings(result, 8*HugepageSize, HugepageSize))
+
+	require.Equal(t, parentID, result[0].BuildId)
+	require.True(t, result[0].Hash.IsZero())
+
+	require.Equal(t, chunks[0].Hash, result[1].Hash)
+	require.Equal(t, uint64(0), result[1].BuildStorageOffset)
+
+	require.Equal(t, chunks[1].Hash, result[2].Hash)
+	require.Equal(t, uint64(0), result[2].BuildStorageOffset)
+
+	require.Equal(t, mappings[2], result[3])
+
+	data, err := io.ReadAll(chunks[1].Reader(bytes.NewReader(diff)))
+	require.NoError(t, err)
+	require.Equal(t, diff[HugepageSize:], data)
+}
+
+func TestContentAddressSharesChunksAcrossBuilds(t *testing.T) {
+	diff := bytes.Repeat([]byte{3}, 2*ContentChunkSize)
+
+	first := []*BuildMap{{Offset: 0, Length: 2 * ContentChunkSize, BuildId: baseID}}
+	second := []*BuildMap{{Offset: 0, Length: 2 * ContentChunkSize, BuildId: diffID}}
+
+	_, firstChunks, err := ContentAddress(first, baseID, bytes.NewReader(diff), CompressionNone, uuid.Nil)
+	require.NoError(t, err)
+
+	_, secondChunks, err := ContentAddress(second, diffID, bytes.NewReader(diff), CompressionNone, uuid.Nil)
+	require.NoError(t, err)
+
+	// Both chunk ranges have the same data, so the build has only one unique chunk.
+	require.Len(t, firstChunks, 1)
+	require.Len(t, secondChunks, 1)
+	require.Equal(t, firstChunks[0].Hash, secondChunks[0].Hash)
+}
+
+func TestSerializeContentAddressedHeader(t *testing.T) {
+	metadata := NewTemplateMetadata(baseID, HugepageSize, 2*ContentChunkSize)
+
+	mappings, _, err := ContentAddress(
+		[]*BuildMap{{Offset: 0, Length: 2 * ContentChunkSize, BuildId: baseID}},
+		baseID,
+		bytes.NewReader(make([]byte, 2*ContentChunkSize)),
+		CompressionNone,
+		uuid.Nil,
+	)
+	require.NoError(t, err)
+
+	_, err = Serialize(metadata, mappings)
+	require.Error(t, err)
+
+	metadata.Version = ContentAddressedVersion
+
+	serialized, err := Serialize(metadata, mappings)
+	require.NoError(t, err)
+
+	h, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+	require.True(t, Equal(mappings, h.Mapping))
+	require.Equal(t, mappings[1].BuildStorageOffset, h.Mapping[1].BuildStorageOffset)
+}
+
+func TestSerializeHeaderV1(t *testing.T) {
+	metadata := NewTemplateMetadata(baseID, blockSize, size)
+
+	serialized, err := Serialize(metadata, simpleBase)
+	require.NoError(t, err)
+
+	require.Equal(t, 64+len(simpleBase)*40, serialized.(*bytes.Buffer).Len())
+
+	h, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+	require.True(t, Equal(simpleBase, h.Mapping))
+}
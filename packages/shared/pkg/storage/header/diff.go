@@ -36,6 +36,8 @@ func WriteDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff i
 
 	empty := bitset.New(0)
 
+	checksums := NewChecksumWriter(diff)
+
 	for i, e := dirty.NextSet(0); e; i, e = dirty.NextSet(i + 1) {
 		_, err := source.ReadAt(b, int64(i)*blockSize)
 		if err != nil {
@@ -55,7 +57,7 @@ func WriteDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff i
 			continue
 		}
 
-		_, err = diff.Write(b)
+		_, err = checksums.Write(b)
 		if err != nil {
 			return nil, fmt.Errorf("error writing to diff: %w", err)
 		}
@@ -66,6 +68,7 @@ func WriteDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff i
 		Empty: empty,
 
 		BlockSize: blockSize,
+		Checksums: checksums.Checksums(),
 	}, nil
 }
 
@@ -0,0 +1,77 @@
+package header
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// EncryptionScope identifies the data the frames are encrypted for.
+// It's part of the additional data of every frame, so the frames can't be moved to another build, file or position.
+type EncryptionScope []byte
+
+const (
+	fileScope  byte = 'f'
+	chunkScope byte = 'c'
+	peerScope  byte = 'p'
+)
+
+// FileScope is the scope of the build file, the file name is the name of the file in the storage, e.g. memfile or rootfs.
+func FileScope(buildID string, fileName string) EncryptionScope {
+	scope := []byte{fileScope}
+	scope = binary.LittleEndian.AppendUint16(scope, uint16(len(buildID)))
+	scope = append(scope, buildID...)
+	scope = binary.LittleEndian.AppendUint16(scope, uint16(len(fileName)))
+	scope = append(scope, fileName...)
+
+	return scope
+}
+
+// ChunkScope is the scope of the content-addressed chunk.
+// The chunk is shared by the builds and files with the same data, so it's bound to its hash instead.
+func ChunkScope(hash ChunkHash) EncryptionScope {
+	return append([]byte{chunkScope}, hash[:]...)
+}
+
+// PeerScope is the scope of the data sent to the other orchestrator, the frames are indexed by the offset of the data.
+// It differs from the scope of the stored frames, so the sent data can't be passed off as the stored frames.
+func PeerScope(scope EncryptionScope) EncryptionScope {
+	return append([]byte{peerScope}, scope...)
+}
+
+// frameAdditionalData binds the encrypted frame to its scope and position, so the frames can't be reordered or moved to other data.
+func frameAdditionalData(scope EncryptionScope, frameIdx uint64) []byte {
+	aad := make([]byte, 0, len(scope)+8)
+	aad = append(aad, scope...)
+
+	return binary.LittleEndian.AppendUint64(aad, frameIdx)
+}
+
+// SealFrame encrypts the frame with the data key, the random nonce is stored before the ciphertext.
+func SealFrame(aead cipher.AEAD, scope EncryptionScope, frameIdx uint64, frame []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(frame)+aead.Overhead())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, frame, frameAdditionalData(scope, frameIdx)), nil
+}
+
+// OpenFrame decrypts the frame sealed by SealFrame.
+func OpenFrame(aead cipher.AEAD, scope EncryptionScope, frameIdx uint64, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted frame %d is too short", frameIdx)
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	frame, err := aead.Open(nil, nonce, ciphertext, frameAdditionalData(scope, frameIdx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt frame %d: %w", frameIdx, err)
+	}
+
+	return frame, nil
+}
@@ -0,0 +1,135 @@
+package header
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAEAD(t *testing.T) cipher.AEAD {
+	t.Helper()
+
+	block, err := aes.NewCipher(bytes.Repeat([]byte{7}, 32))
+	require.NoError(t, err)
+
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	return aead
+}
+
+func TestEncryptedFrames(t *testing.T) {
+	aead := newTestAEAD(t)
+	buildID := uuid.NewString()
+	scope := FileScope(buildID, "memfile")
+	diff := createCompressibleDiff(CompressionFrameSize + 4096)
+
+	for _, c := range []CompressionType{CompressionNone, CompressionZstd} {
+		t.Run(c.String(), func(t *testing.T) {
+			var encrypted bytes.Buffer
+
+			index, err := EncodeFrames(c, aead, scope, bytes.NewReader(diff), &encrypted)
+			require.NoError(t, err)
+			require.Len(t, index.Offsets, 3)
+			require.NotContains(t, encrypted.String(), string(diff[:4096]))
+
+			b := make([]byte, len(diff))
+			n, err := NewFrameReader(index, aead, scope, bytes.NewReader(encrypted.Bytes())).ReadAt(b, 0)
+			require.NoError(t, err)
+			require.Equal(t, len(diff), n)
+			require.Equal(t, diff, b)
+
+			// The frames can't be read in a different order.
+			swapped := &CompressionIndex{
+				Type:    c,
+				Size:    index.Size,
+				Offsets: []uint64{index.Offsets[1], index.Offsets[2], index.Offsets[2]},
+			}
+
+			_, err = NewFrameReader(swapped, aead, scope, bytes.NewReader(encrypted.Bytes())).ReadAt(make([]byte, 4096), 0)
+			require.Error(t, err)
+
+			// The frames can't be read as the data of another build or file.
+			for _, other := range []EncryptionScope{FileScope(uuid.NewString(), "memfile"), FileScope(buildID, "rootfs.ext4")} {
+				_, err = NewFrameReader(index, aead, other, bytes.NewReader(encrypted.Bytes())).ReadAt(make([]byte, 4096), 0)
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEncryptionScope(t *testing.T) {
+	aead := newTestAEAD(t)
+	frame := []byte("frame")
+
+	var hash ChunkHash
+	hash[0] = 1
+
+	scopes := []EncryptionScope{
+		FileScope("build", "memfile"),
+		FileScope("build", "rootfs.ext4"),
+		FileScope("other", "memfile"),
+		// The length prefixes keep the build ID and the file name apart.
+		FileScope("buil", "dmemfile"),
+		ChunkScope(hash),
+		ChunkScope(ChunkHash{}),
+	}
+
+	for i, scope := range scopes {
+		sealed, err := SealFrame(aead, scope, 0, frame)
+		require.NoError(t, err)
+
+		opened, err := OpenFrame(aead, scope, 0, sealed)
+		require.NoError(t, err)
+		require.Equal(t, frame, opened)
+
+		_, err = OpenFrame(aead, scope, 1, sealed)
+		require.Error(t, err, "frame opened at another position")
+
+		for j, other := range scopes {
+			if i == j {
+				continue
+			}
+
+			_, err = OpenFrame(aead, other, 0, sealed)
+			require.Error(t, err, "frame of scope %d opened with scope %d", i, j)
+		}
+	}
+}
+
+func TestSerializeEncryptedHeader(t *testing.T) {
+	keyID := uuid.New()
+
+	metadata := NewTemplateMetadata(baseID, blockSize, size)
+	metadata.KeyId = keyID
+
+	mappings := make([]*BuildMap, 0, len(simpleBase))
+	for _, m := range simpleBase {
+		mapping := *m
+		if mapping.BuildId == baseID {
+			mapping.KeyId = keyID
+		}
+
+		mappings = append(mappings, &mapping)
+	}
+
+	_, err := Serialize(metadata, mappings)
+	require.Error(t, err)
+
+	metadata.Version = EncryptedVersion
+
+	serialized, err := Serialize(metadata, mappings)
+	require.NoError(t, err)
+
+	h, err := Deserialize(serialized.(io.WriterTo))
+	require.NoError(t, err)
+
+	require.Equal(t, metadata, h.Metadata)
+	require.True(t, Equal(mappings, h.Mapping))
+	require.Equal(t, keyID, h.Mapping[1].KeyId)
+}
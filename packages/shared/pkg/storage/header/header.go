@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/bits-and-blooms/bitset"
-	"github.com/google/uuid"
 )
 
 type Header struct {
@@ -13,6 +12,11 @@ type Header struct {
 	startMap    map[int64]*BuildMap
 
 	Mapping []*BuildMap
+
+	// Compression is the index of the compressed diff of the build, it is nil if the diff is not compressed.
+	Compression *CompressionIndex
+	// Checksums are the checksums of the build diff by ChecksumChunkSize chunks, they are nil for the headers created before the checksums were added.
+	Checksums []uint32
 }
 
 func NewHeader(metadata *Metadata, mapping []*BuildMap) *Header {
@@ -45,13 +49,21 @@ func NewHeader(metadata *Metadata, mapping []*BuildMap) *Header {
 	}
 }
 
-func (t *Header) GetShiftedMapping(offset int64) (mappedOffset int64, mappedLength int64, buildID *uuid.UUID, err error) {
+// SetChecksums sets the checksums of the build diff and raises the header version so the checksums are stored.
+func (t *Header) SetChecksums(checksums []uint32) {
+	t.Checksums = checksums
+	t.Metadata.Version = max(t.Metadata.Version, ChecksumVersion)
+}
+
+// GetShiftedMapping returns the mapping containing the offset.
+// The mapped offset and length are shifted to the offset in the build diff or the content-addressed chunk.
+func (t *Header) GetShiftedMapping(offset int64) (mappedOffset int64, mappedLength int64, mapping *BuildMap, err error) {
 	mapping, shift, err := t.getMapping(offset)
 	if err != nil {
 		return 0, 0, nil, err
 	}
 
-	return int64(mapping.BuildStorageOffset) + shift, int64(mapping.Length) - shift, &mapping.BuildId, nil
+	return int64(mapping.BuildStorageOffset) + shift, int64(mapping.Length) - shift, mapping, nil
 }
 
 func (t *Header) getMapping(offset int64) (*BuildMap, int64, error) {
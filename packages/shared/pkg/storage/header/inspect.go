@@ -11,15 +11,31 @@ import (
 //
 // startBlock-endBlock [offset, offset+length) := [buildStorageOffset, buildStorageOffset+length) ⊂ buildId, length in bytes
 //
+// If the mapping references the content-addressed chunk, the storage offsets are in the chunk and the chunk hash is added after the buildId.
+// If the data are compressed or encrypted in the storage, the compression type and the data key ID are added at the end.
+//
 // It is used for debugging and visualization.
 func (mapping *BuildMap) Format(blockSize uint64) string {
 	rangeMessage := fmt.Sprintf("%d-%d", mapping.Offset/blockSize, (mapping.Offset+mapping.Length)/blockSize)
 
+	source := mapping.BuildId.String()
+	if !mapping.Hash.IsZero() {
+		source = fmt.Sprintf("%s (chunk %s)", source, mapping.Hash)
+	}
+
+	if mapping.Compression != CompressionNone {
+		source = fmt.Sprintf("%s [%s]", source, mapping.Compression)
+	}
+
+	if mapping.KeyId != uuid.Nil {
+		source = fmt.Sprintf("%s [key %s]", source, mapping.KeyId)
+	}
+
 	return fmt.Sprintf(
 		"%-14s [%11d,%11d) := [%11d,%11d) ⊂ %s, %d B",
 		rangeMessage,
 		mapping.Offset, mapping.Offset+mapping.Length,
-		mapping.BuildStorageOffset, mapping.BuildStorageOffset+mapping.Length, mapping.BuildId.String(), mapping.Length,
+		mapping.BuildStorageOffset, mapping.BuildStorageOffset+mapping.Length, source, mapping.Length,
 	)
 }
 
@@ -112,7 +128,7 @@ func ValidateMappings(mappings []*BuildMap, size, blockSize uint64) error {
 }
 
 func (mapping *BuildMap) Equal(other *BuildMap) bool {
-	return mapping.Offset == other.Offset && mapping.Length == other.Length && mapping.BuildId == other.BuildId
+	return mapping.Offset == other.Offset && mapping.Length == other.Length && mapping.BuildId == other.BuildId && mapping.Hash == other.Hash && mapping.Compression == other.Compression && mapping.KeyId == other.KeyId
 }
 
 func Equal(a, b []*BuildMap) bool {
@@ -17,6 +17,13 @@ type BuildMap struct {
 	Length             uint64
 	BuildId            uuid.UUID
 	BuildStorageOffset uint64
+	// Hash is set if the data is stored in the content-addressed chunk instead of the build diff.
+	// BuildStorageOffset is then the offset in the chunk.
+	Hash ChunkHash
+	// Compression is how the build diff or the content-addressed chunk with the data is compressed in the storage.
+	Compression CompressionType
+	// KeyId is the ID of the data key the build diff or the content-addressed chunk is encrypted with.
+	KeyId uuid.UUID
 }
 
 func CreateMapping(
@@ -150,6 +157,9 @@ func MergeMappings(
 					BuildId: base.BuildId,
 					// the build storage offset is the same as the base mapping
 					BuildStorageOffset: base.BuildStorageOffset,
+					Hash:               base.Hash,
+					Compression:        base.Compression,
+					KeyId:              base.KeyId,
 				}
 
 				mappings = append(mappings, leftBase)
@@ -168,6 +178,9 @@ func MergeMappings(
 					Length:             uint64(rightBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset + uint64(rightBaseShift),
+					Hash:               base.Hash,
+					Compression:        base.Compression,
+					KeyId:              base.KeyId,
 				}
 
 				baseMapping[baseIdx] = rightBase
@@ -195,6 +208,9 @@ func MergeMappings(
 					Length:             uint64(rightBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset + uint64(rightBaseShift),
+					Hash:               base.Hash,
+					Compression:        base.Compression,
+					KeyId:              base.KeyId,
 				}
 
 				baseMapping[baseIdx] = rightBase
@@ -216,6 +232,9 @@ func MergeMappings(
 					Length:             uint64(leftBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset,
+					Hash:               base.Hash,
+					Compression:        base.Compression,
+					KeyId:              base.KeyId,
 				}
 
 				mappings = append(mappings, leftBase)
@@ -236,9 +255,10 @@ func MergeMappings(
 }
 
 // NormalizeMappings joins adjacent mappings that have the same buildId.
+// The mappings to the content-addressed chunks are joined only if they are continuous in the same chunk.
 func NormalizeMappings(mappings []*BuildMap) []*BuildMap {
 	for i := 0; i < len(mappings); i++ {
-		if i+1 < len(mappings) && mappings[i].BuildId == mappings[i+1].BuildId {
+		if i+1 < len(mappings) && mappings[i].BuildId == mappings[i+1].BuildId && mappings[i].continuousWith(mappings[i+1]) {
 			mappings[i].Length += mappings[i+1].Length
 			mappings = append(mappings[:i+1], mappings[i+2:]...)
 		}
@@ -246,3 +266,11 @@ func NormalizeMappings(mappings []*BuildMap) []*BuildMap {
 
 	return mappings
 }
+
+func (mapping *BuildMap) continuousWith(next *BuildMap) bool {
+	if mapping.Hash != next.Hash || mapping.Compression != next.Compression || mapping.KeyId != next.KeyId {
+		return false
+	}
+
+	return mapping.Hash.IsZero() || mapping.BuildStorageOffset+mapping.Length == next.BuildStorageOffset
+}
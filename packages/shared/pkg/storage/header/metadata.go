@@ -16,6 +16,9 @@ type DiffMetadata struct {
 	Empty *bitset.BitSet
 
 	BlockSize int64
+
+	// Checksums are the checksums of the written diff by ChecksumChunkSize chunks.
+	Checksums []uint32
 }
 
 func (d *DiffMetadata) CreateMapping(
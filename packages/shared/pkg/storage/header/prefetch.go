@@ -0,0 +1,151 @@
+package header
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+const prefetchProfileVersion = 1
+
+// PrefetchMetadata describes the memfile the prefetch profile was recorded for.
+type PrefetchMetadata struct {
+	Version   uint64
+	BlockSize uint64
+	Size      uint64
+}
+
+// PrefetchRange is a continuous range of the memfile blocks in the prefetch profile.
+type PrefetchRange struct {
+	Offset uint64
+	Length uint64
+}
+
+// PrefetchProfile lists the memfile ranges the sandbox accessed shortly after it was resumed from the build.
+// The ranges are fetched ahead of the page faults when the next sandbox is resumed from the same build.
+type PrefetchProfile struct {
+	Metadata *PrefetchMetadata
+	Ranges   []PrefetchRange
+}
+
+// NewPrefetchProfile merges the consecutive accessed blocks to ranges.
+func NewPrefetchProfile(blockSize, size uint64, blocks *bitset.BitSet) *PrefetchProfile {
+	ranges := make([]PrefetchRange, 0)
+
+	for start, ok := blocks.NextSet(0); ok; start, ok = blocks.NextSet(start) {
+		end, found := blocks.NextClear(start)
+		if !found {
+			end = blocks.Len()
+		}
+
+		ranges = append(ranges, PrefetchRange{
+			Offset: uint64(start) * blockSize,
+			Length: uint64(end-start) * blockSize,
+		})
+
+		start = end
+	}
+
+	return &PrefetchProfile{
+		Metadata: &PrefetchMetadata{
+			Version:   prefetchProfileVersion,
+			BlockSize: blockSize,
+			Size:      size,
+		},
+		Ranges: ranges,
+	}
+}
+
+// Blocks returns the indexes of the blocks in the profile.
+func (p *PrefetchProfile) Blocks() *bitset.BitSet {
+	blocks := bitset.New(uint(TotalBlocks(int64(p.Metadata.Size), int64(p.Metadata.BlockSize))))
+
+	for _, r := range p.Ranges {
+		start := uint(r.Offset / p.Metadata.BlockSize)
+		end := uint((r.Offset + r.Length) / p.Metadata.BlockSize)
+
+		for i := start; i < end; i++ {
+			blocks.Set(i)
+		}
+	}
+
+	return blocks
+}
+
+// Compare returns how many of the accessed blocks are in the profile (hits) and how many are not (misses).
+func (p *PrefetchProfile) Compare(accessed *bitset.BitSet) (hits, misses uint) {
+	blocks := p.Blocks()
+
+	hits = accessed.IntersectionCardinality(blocks)
+	misses = accessed.Count() - hits
+
+	return hits, misses
+}
+
+func SerializePrefetchProfile(p *PrefetchProfile) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	err := binary.Write(&buf, binary.LittleEndian, p.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write prefetch metadata: %w", err)
+	}
+
+	for _, r := range p.Ranges {
+		err := binary.Write(&buf, binary.LittleEndian, r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write prefetch range: %w", err)
+		}
+	}
+
+	return &buf, nil
+}
+
+func DeserializePrefetchProfile(in io.WriterTo) (*PrefetchProfile, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	reader := bytes.NewReader(buf.Bytes())
+
+	var metadata PrefetchMetadata
+
+	err = binary.Read(reader, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prefetch metadata: %w", err)
+	}
+
+	if metadata.Version != prefetchProfileVersion {
+		return nil, fmt.Errorf("unsupported prefetch profile version: %d", metadata.Version)
+	}
+
+	if metadata.BlockSize == 0 {
+		return nil, fmt.Errorf("invalid prefetch profile block size: %d", metadata.BlockSize)
+	}
+
+	ranges := make([]PrefetchRange, 0)
+
+	for {
+		var r PrefetchRange
+		err := binary.Read(reader, binary.LittleEndian, &r)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read prefetch range: %w", err)
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return &PrefetchProfile{
+		Metadata: &metadata,
+		Ranges:   ranges,
+	}, nil
+}
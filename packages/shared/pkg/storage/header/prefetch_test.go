@@ -0,0 +1,53 @@
+package header
+
+import (
+	"io"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrefetchProfileMergesRanges(t *testing.T) {
+	blocks := bitset.New(8)
+	blocks.Set(0).Set(1).Set(4).Set(6).Set(7)
+
+	p := NewPrefetchProfile(blockSize, size, blocks)
+
+	require.Equal(t, []PrefetchRange{
+		{Offset: 0, Length: 2 * blockSize},
+		{Offset: 4 * blockSize, Length: blockSize},
+		{Offset: 6 * blockSize, Length: 2 * blockSize},
+	}, p.Ranges)
+	require.True(t, blocks.Equal(p.Blocks()))
+}
+
+func TestPrefetchProfileSerialization(t *testing.T) {
+	blocks := bitset.New(8)
+	blocks.Set(2).Set(3).Set(5)
+
+	p := NewPrefetchProfile(blockSize, size, blocks)
+
+	serialized, err := SerializePrefetchProfile(p)
+	require.NoError(t, err)
+
+	deserialized, err := DeserializePrefetchProfile(serialized.(io.WriterTo))
+	require.NoError(t, err)
+
+	require.Equal(t, p.Metadata, deserialized.Metadata)
+	require.Equal(t, p.Ranges, deserialized.Ranges)
+}
+
+func TestPrefetchProfileCompare(t *testing.T) {
+	blocks := bitset.New(8)
+	blocks.Set(1).Set(2).Set(3)
+
+	p := NewPrefetchProfile(blockSize, size, blocks)
+
+	accessed := bitset.New(8)
+	accessed.Set(2).Set(3).Set(6)
+
+	hits, misses := p.Compare(accessed)
+	require.Equal(t, uint(2), hits)
+	require.Equal(t, uint(1), misses)
+}
@@ -17,11 +17,26 @@ type Metadata struct {
 	BuildId    uuid.UUID
 	// TODO: Use the base build id when setting up the snapshot rootfs
 	BaseBuildId uuid.UUID
+	// KeyId is the ID of the data key the build data are encrypted with, it is nil if the data are not encrypted.
+	KeyId uuid.UUID
 }
 
+const (
+	// metadataVersion is the version of the headers with the mappings referencing only the build diffs.
+	metadataVersion = 1
+	// ContentAddressedVersion is the version of the headers with the mappings that can reference the content-addressed chunks.
+	ContentAddressedVersion = 2
+	// CompressedVersion is the version of the headers with the compression index of the build diff and the compression of the mapped data.
+	CompressedVersion = 3
+	// EncryptedVersion is the version of the headers with the data key IDs of the build and of the mapped data.
+	EncryptedVersion = 4
+	// ChecksumVersion is the version of the headers with the checksums of the build diff.
+	ChecksumVersion = 5
+)
+
 func NewTemplateMetadata(buildId uuid.UUID, blockSize, size uint64) *Metadata {
 	return &Metadata{
-		Version:     1,
+		Version:     metadataVersion,
 		Generation:  0,
 		BlockSize:   blockSize,
 		Size:        size,
@@ -32,7 +47,7 @@ func NewTemplateMetadata(buildId uuid.UUID, blockSize, size uint64) *Metadata {
 
 func (m *Metadata) NextGeneration(buildID uuid.UUID) *Metadata {
 	return &Metadata{
-		Version:     1,
+		Version:     m.Version,
 		Generation:  m.Generation + 1,
 		BlockSize:   m.BlockSize,
 		Size:        m.Size,
@@ -41,16 +56,109 @@ func (m *Metadata) NextGeneration(buildID uuid.UUID) *Metadata {
 	}
 }
 
+// metadataV1 is the serialized metadata in the headers before the encrypted version.
+type metadataV1 struct {
+	Version     uint64
+	BlockSize   uint64
+	Size        uint64
+	Generation  uint64
+	BuildId     uuid.UUID
+	BaseBuildId uuid.UUID
+}
+
+// buildMapV1 is the serialized mapping in the headers before the content-addressed version.
+type buildMapV1 struct {
+	Offset             uint64
+	Length             uint64
+	BuildId            uuid.UUID
+	BuildStorageOffset uint64
+}
+
+// buildMapV2 is the serialized mapping in the headers before the compressed version.
+type buildMapV2 struct {
+	Offset             uint64
+	Length             uint64
+	BuildId            uuid.UUID
+	BuildStorageOffset uint64
+	Hash               ChunkHash
+}
+
+// buildMapV3 is the serialized mapping in the headers before the encrypted version.
+type buildMapV3 struct {
+	Offset             uint64
+	Length             uint64
+	BuildId            uuid.UUID
+	BuildStorageOffset uint64
+	Hash               ChunkHash
+	Compression        CompressionType
+}
+
+// compressionHeader precedes the compression index offsets in the compressed version of the headers.
+type compressionHeader struct {
+	Type    CompressionType
+	Size    uint64
+	Offsets uint64
+}
+
 func Serialize(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
+	return serialize(metadata, mappings, nil, nil)
+}
+
+// SerializeHeader serializes the header together with the compression index and the checksums of the build diff.
+func SerializeHeader(h *Header) (io.Reader, error) {
+	return serialize(h.Metadata, h.Mapping, h.Compression, h.Checksums)
+}
+
+func serialize(metadata *Metadata, mappings []*BuildMap, index *CompressionIndex, checksums []uint32) (io.Reader, error) {
 	var buf bytes.Buffer
 
-	err := binary.Write(&buf, binary.LittleEndian, metadata)
+	var serializedMetadata any = metadata
+
+	if metadata.Version < EncryptedVersion {
+		if metadata.KeyId != uuid.Nil {
+			return nil, fmt.Errorf("the build data are encrypted, but the header version is %d", metadata.Version)
+		}
+
+		serializedMetadata = &metadataV1{
+			Version:     metadata.Version,
+			BlockSize:   metadata.BlockSize,
+			Size:        metadata.Size,
+			Generation:  metadata.Generation,
+			BuildId:     metadata.BuildId,
+			BaseBuildId: metadata.BaseBuildId,
+		}
+	}
+
+	err := binary.Write(&buf, binary.LittleEndian, serializedMetadata)
 	if err != nil {
 		return nil, fmt.Errorf("failed to write metadata: %w", err)
 	}
 
+	if metadata.Version >= CompressedVersion {
+		err = writeCompressionIndex(&buf, index)
+		if err != nil {
+			return nil, err
+		}
+	} else if index != nil {
+		return nil, fmt.Errorf("the build diff is compressed, but the header version is %d", metadata.Version)
+	}
+
+	if metadata.Version >= ChecksumVersion {
+		err = writeChecksums(&buf, checksums)
+		if err != nil {
+			return nil, err
+		}
+	} else if checksums != nil {
+		return nil, fmt.Errorf("the build diff has checksums, but the header version is %d", metadata.Version)
+	}
+
 	for _, mapping := range mappings {
-		err := binary.Write(&buf, binary.LittleEndian, mapping)
+		serialized, err := serializedMapping(metadata.Version, mapping)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Write(&buf, binary.LittleEndian, serialized)
 		if err != nil {
 			return nil, fmt.Errorf("failed to write block mapping: %w", err)
 		}
@@ -59,6 +167,195 @@ func Serialize(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
 	return &buf, nil
 }
 
+func writeCompressionIndex(w io.Writer, index *CompressionIndex) error {
+	if index == nil {
+		index = &CompressionIndex{Type: CompressionNone}
+	}
+
+	err := binary.Write(w, binary.LittleEndian, &compressionHeader{
+		Type:    index.Type,
+		Size:    index.Size,
+		Offsets: uint64(len(index.Offsets)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write compression index: %w", err)
+	}
+
+	err = binary.Write(w, binary.LittleEndian, index.Offsets)
+	if err != nil {
+		return fmt.Errorf("failed to write compression index offsets: %w", err)
+	}
+
+	return nil
+}
+
+func writeChecksums(w io.Writer, checksums []uint32) error {
+	err := binary.Write(w, binary.LittleEndian, uint64(len(checksums)))
+	if err != nil {
+		return fmt.Errorf("failed to write checksums count: %w", err)
+	}
+
+	err = binary.Write(w, binary.LittleEndian, checksums)
+	if err != nil {
+		return fmt.Errorf("failed to write checksums: %w", err)
+	}
+
+	return nil
+}
+
+// serializedMapping returns the mapping in the format of the header version.
+func serializedMapping(version uint64, mapping *BuildMap) (any, error) {
+	if version < CompressedVersion && mapping.Compression != CompressionNone {
+		return nil, fmt.Errorf("mapping at offset %d references compressed data, but the header version is %d", mapping.Offset, version)
+	}
+
+	if version < EncryptedVersion && mapping.KeyId != uuid.Nil {
+		return nil, fmt.Errorf("mapping at offset %d references encrypted data, but the header version is %d", mapping.Offset, version)
+	}
+
+	if version < ContentAddressedVersion && !mapping.Hash.IsZero() {
+		return nil, fmt.Errorf("mapping at offset %d references a content-addressed chunk, but the header version is %d", mapping.Offset, version)
+	}
+
+	switch {
+	case version < ContentAddressedVersion:
+		return &buildMapV1{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            mapping.BuildId,
+			BuildStorageOffset: mapping.BuildStorageOffset,
+		}, nil
+	case version < CompressedVersion:
+		return &buildMapV2{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            mapping.BuildId,
+			BuildStorageOffset: mapping.BuildStorageOffset,
+			Hash:               mapping.Hash,
+		}, nil
+	case version < EncryptedVersion:
+		return &buildMapV3{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            mapping.BuildId,
+			BuildStorageOffset: mapping.BuildStorageOffset,
+			Hash:               mapping.Hash,
+			Compression:        mapping.Compression,
+		}, nil
+	default:
+		return mapping, nil
+	}
+}
+
+// readMapping reads the mapping in the format of the header version.
+func readMapping(reader io.Reader, version uint64) (*BuildMap, error) {
+	switch {
+	case version < ContentAddressedVersion:
+		var m buildMapV1
+
+		err := binary.Read(reader, binary.LittleEndian, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &BuildMap{
+			Offset:             m.Offset,
+			Length:             m.Length,
+			BuildId:            m.BuildId,
+			BuildStorageOffset: m.BuildStorageOffset,
+		}, nil
+	case version < CompressedVersion:
+		var m buildMapV2
+
+		err := binary.Read(reader, binary.LittleEndian, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &BuildMap{
+			Offset:             m.Offset,
+			Length:             m.Length,
+			BuildId:            m.BuildId,
+			BuildStorageOffset: m.BuildStorageOffset,
+			Hash:               m.Hash,
+		}, nil
+	case version < EncryptedVersion:
+		var m buildMapV3
+
+		err := binary.Read(reader, binary.LittleEndian, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &BuildMap{
+			Offset:             m.Offset,
+			Length:             m.Length,
+			BuildId:            m.BuildId,
+			BuildStorageOffset: m.BuildStorageOffset,
+			Hash:               m.Hash,
+			Compression:        m.Compression,
+		}, nil
+	default:
+		var m BuildMap
+
+		err := binary.Read(reader, binary.LittleEndian, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		return &m, nil
+	}
+}
+
+func readCompressionIndex(reader io.Reader) (*CompressionIndex, error) {
+	var h compressionHeader
+
+	err := binary.Read(reader, binary.LittleEndian, &h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compression index: %w", err)
+	}
+
+	offsets := make([]uint64, h.Offsets)
+
+	err = binary.Read(reader, binary.LittleEndian, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compression index offsets: %w", err)
+	}
+
+	// The index is stored also for the encrypted frames without compression.
+	if len(offsets) == 0 {
+		return nil, nil
+	}
+
+	return &CompressionIndex{
+		Type:    h.Type,
+		Size:    h.Size,
+		Offsets: offsets,
+	}, nil
+}
+
+func readChecksums(reader io.Reader) ([]uint32, error) {
+	var count uint64
+
+	err := binary.Read(reader, binary.LittleEndian, &count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checksums count: %w", err)
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	checksums := make([]uint32, count)
+
+	err = binary.Read(reader, binary.LittleEndian, checksums)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checksums: %w", err)
+	}
+
+	return checksums, nil
+}
+
 func Deserialize(in io.WriterTo) (*Header, error) {
 	var buf bytes.Buffer
 
@@ -69,18 +366,51 @@ func Deserialize(in io.WriterTo) (*Header, error) {
 
 	reader := bytes.NewReader(buf.Bytes())
 
-	var metadata Metadata
+	var v1 metadataV1
 
-	err = binary.Read(reader, binary.LittleEndian, &metadata)
+	err = binary.Read(reader, binary.LittleEndian, &v1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read metadata: %w", err)
 	}
 
+	metadata := Metadata{
+		Version:     v1.Version,
+		BlockSize:   v1.BlockSize,
+		Size:        v1.Size,
+		Generation:  v1.Generation,
+		BuildId:     v1.BuildId,
+		BaseBuildId: v1.BaseBuildId,
+	}
+
+	if metadata.Version >= EncryptedVersion {
+		err = binary.Read(reader, binary.LittleEndian, &metadata.KeyId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata key ID: %w", err)
+		}
+	}
+
+	var index *CompressionIndex
+
+	if metadata.Version >= CompressedVersion {
+		index, err = readCompressionIndex(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var checksums []uint32
+
+	if metadata.Version >= ChecksumVersion {
+		checksums, err = readChecksums(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	mappings := make([]*BuildMap, 0)
 
 	for {
-		var m BuildMap
-		err := binary.Read(reader, binary.LittleEndian, &m)
+		m, err := readMapping(reader, metadata.Version)
 		if err == io.EOF {
 			break
 		}
@@ -89,8 +419,12 @@ func Deserialize(in io.WriterTo) (*Header, error) {
 			return nil, fmt.Errorf("failed to read block mapping: %w", err)
 		}
 
-		mappings = append(mappings, &m)
+		mappings = append(mappings, m)
 	}
 
-	return NewHeader(&metadata, mappings), nil
+	h := NewHeader(&metadata, mappings)
+	h.Compression = index
+	h.Checksums = checksums
+
+	return h, nil
 }
@@ -0,0 +1,46 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+type Provider string
+
+const (
+	// NoProvider disables the encryption of the stored artifacts.
+	NoProvider    Provider = ""
+	LocalProvider Provider = "Local"
+
+	kmsProviderEnv        = "STORAGE_KMS_PROVIDER"
+	localMasterKeyPathEnv = "STORAGE_KMS_LOCAL_MASTER_KEY_PATH"
+)
+
+// KMS wraps the data keys with the master key, so only the wrapped data keys are stored next to the encrypted data.
+type KMS interface {
+	// WrapKey encrypts the data key with the current master key and returns the ID of the master key used.
+	WrapKey(ctx context.Context, key []byte) (masterKeyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts the data key wrapped by the master key with the ID.
+	UnwrapKey(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error)
+}
+
+// GetKMS returns nil if the encryption is disabled.
+func GetKMS() (KMS, error) {
+	provider := Provider(env.GetEnv(kmsProviderEnv, string(NoProvider)))
+
+	switch provider {
+	case NoProvider:
+		return nil, nil
+	case LocalProvider:
+		path := env.GetEnv(localMasterKeyPathEnv, "")
+		if path == "" {
+			return nil, fmt.Errorf("%s must be set for the %s KMS provider", localMasterKeyPathEnv, provider)
+		}
+
+		return NewLocalKMS(path)
+	}
+
+	return nil, fmt.Errorf("unknown KMS provider: %s", provider)
+}
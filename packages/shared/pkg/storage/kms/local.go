@@ -0,0 +1,88 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// LocalKMS keeps the master key in a local file. It is meant for testing and local development.
+type LocalKMS struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewLocalKMS reads the master key from the file, the key is 32 bytes encoded as hex.
+func NewLocalKMS(path string) (*LocalKMS, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read master key: %w", err)
+	}
+
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode master key: %w", err)
+	}
+
+	if len(key) != 32 {
+		return nil, fmt.Errorf("master key must be 32 bytes, got %d", len(key))
+	}
+
+	return newLocalKMS(key)
+}
+
+func newLocalKMS(key []byte) (*LocalKMS, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create master key cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create master key AEAD: %w", err)
+	}
+
+	// The ID identifies the master key without revealing it.
+	sum := sha256.Sum256(key)
+
+	return &LocalKMS{
+		id:   "local-" + hex.EncodeToString(sum[:8]),
+		aead: aead,
+	}, nil
+}
+
+func (l *LocalKMS) WrapKey(_ context.Context, key []byte) (string, []byte, error) {
+	nonce := make([]byte, l.aead.NonceSize())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return l.id, l.aead.Seal(nonce, nonce, key, []byte(l.id)), nil
+}
+
+func (l *LocalKMS) UnwrapKey(_ context.Context, masterKeyID string, wrapped []byte) ([]byte, error) {
+	if masterKeyID != l.id {
+		return nil, fmt.Errorf("data key is wrapped by the master key %s, local master key is %s", masterKeyID, l.id)
+	}
+
+	if len(wrapped) < l.aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key is too short")
+	}
+
+	nonce, ciphertext := wrapped[:l.aead.NonceSize()], wrapped[l.aead.NonceSize():]
+
+	key, err := l.aead.Open(nil, nonce, ciphertext, []byte(l.id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	return key, nil
+}
@@ -31,6 +31,22 @@ func (c *TemplateCacheFiles) NewSandboxFiles(sandboxID string) *SandboxFiles {
 	}
 }
 
+// OpenSandboxFiles returns the files of the already started sandbox, the random ID is the one returned by RandomID.
+// The sandbox files don't depend on the template cache entry, so they can be opened before the template is fetched.
+func (t *TemplateFiles) OpenSandboxFiles(sandboxID string, randomID string) *SandboxFiles {
+	return &SandboxFiles{
+		TemplateCacheFiles: &TemplateCacheFiles{TemplateFiles: t},
+		SandboxID:          sandboxID,
+		randomID:           randomID,
+		tmpDir:             os.TempDir(),
+	}
+}
+
+// RandomID distinguishes the files of the sandbox from the files of its other runs.
+func (s *SandboxFiles) RandomID() string {
+	return s.randomID
+}
+
 func (s *SandboxFiles) SandboxCacheRootfsPath() string {
 	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.cow", s.SandboxID, s.randomID))
 }
@@ -39,6 +55,16 @@ func (s *SandboxFiles) SandboxFirecrackerSocketPath() string {
 	return filepath.Join(s.tmpDir, fmt.Sprintf("fc-%s-%s.sock", s.SandboxID, s.randomID))
 }
 
+// SandboxFirecrackerStdoutPath is the FIFO the FC process writes its stdout to, it outlives the orchestrator process like the FC socket.
+func (s *SandboxFiles) SandboxFirecrackerStdoutPath() string {
+	return filepath.Join(s.tmpDir, fmt.Sprintf("fc-%s-%s.stdout", s.SandboxID, s.randomID))
+}
+
+// SandboxFirecrackerStderrPath is the FIFO the FC process writes its stderr to.
+func (s *SandboxFiles) SandboxFirecrackerStderrPath() string {
+	return filepath.Join(s.tmpDir, fmt.Sprintf("fc-%s-%s.stderr", s.SandboxID, s.randomID))
+}
+
 func (s *SandboxFiles) SandboxUffdSocketPath() string {
 	return filepath.Join(s.tmpDir, fmt.Sprintf("uffd-%s-%s.sock", s.SandboxID, s.randomID))
 }
@@ -46,3 +72,15 @@ func (s *SandboxFiles) SandboxUffdSocketPath() string {
 func (s *SandboxFiles) SandboxCacheRootfsLinkPath() string {
 	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.link", s.SandboxID, s.randomID))
 }
+
+func (s *SandboxFiles) SandboxCacheVolumePath() string {
+	return filepath.Join(sandboxCacheDir, fmt.Sprintf("volume-%s-%s.cow", s.SandboxID, s.randomID))
+}
+
+func (s *SandboxFiles) SandboxCacheVolumeLinkPath() string {
+	return filepath.Join(sandboxCacheDir, fmt.Sprintf("volume-%s-%s.link", s.SandboxID, s.randomID))
+}
+
+func (s *SandboxFiles) SandboxCacheDatasetLinkPath(index int) string {
+	return filepath.Join(sandboxCacheDir, fmt.Sprintf("dataset-%d-%s-%s.link", index, s.SandboxID, s.randomID))
+}
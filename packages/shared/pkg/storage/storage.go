@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -26,10 +27,20 @@ const (
 
 type StorageProvider interface {
 	DeleteObjectsWithPrefix(ctx context.Context, prefix string) error
+	// ListDirectories returns the names of the top-level directories, the builds are stored in the directories named by their IDs.
+	ListDirectories(ctx context.Context) ([]string, error)
+	ListObjectsWithPrefix(ctx context.Context, prefix string) ([]ObjectAttributes, error)
 	OpenObject(ctx context.Context, path string) (StorageObjectProvider, error)
 	GetDetails() string
 }
 
+// ObjectAttributes are the attributes of the stored object, the path is relative to the storage root.
+type ObjectAttributes struct {
+	Path      string
+	Size      int64
+	UpdatedAt time.Time
+}
+
 type StorageObjectProvider interface {
 	WriteTo(dst io.Writer) (int64, error)
 	WriteFromFileSystem(path string) error
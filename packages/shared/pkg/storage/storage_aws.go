@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -74,6 +75,53 @@ func (a *AWSBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return err
 }
 
+func (a *AWSBucketStorageProvider) ListDirectories(ctx context.Context) ([]string, error) {
+	paginator := s3.NewListObjectsV2Paginator(a.client, &s3.ListObjectsV2Input{
+		Bucket:    &a.bucketName,
+		Prefix:    &a.keyPrefix,
+		Delimiter: aws.String("/"),
+	})
+
+	var directories []string
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error when listing directories: %w", err)
+		}
+
+		for _, prefix := range page.CommonPrefixes {
+			directories = append(directories, strings.TrimSuffix(strings.TrimPrefix(*prefix.Prefix, a.keyPrefix), "/"))
+		}
+	}
+
+	return directories, nil
+}
+
+func (a *AWSBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]ObjectAttributes, error) {
+	fullPrefix := a.keyPrefix + prefix + "/"
+	paginator := s3.NewListObjectsV2Paginator(a.client, &s3.ListObjectsV2Input{Bucket: &a.bucketName, Prefix: &fullPrefix})
+
+	var attributes []ObjectAttributes
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error when listing objects: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			attributes = append(attributes, ObjectAttributes{
+				Path:      strings.TrimPrefix(*obj.Key, a.keyPrefix),
+				Size:      aws.ToInt64(obj.Size),
+				UpdatedAt: aws.ToTime(obj.LastModified),
+			})
+		}
+	}
+
+	return attributes, nil
+}
+
 func (a *AWSBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[AWS Storage, bucket set to %s]", a.bucketName)
 }
@@ -187,6 +235,11 @@ func (a *AWSBucketStorageObjectProvider) Size() (int64, error) {
 
 	resp, err := a.client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: &a.bucketName, Key: &a.path})
 	if err != nil {
+		var nf *types.NotFound
+		if errors.As(err, &nf) {
+			return 0, ErrorObjectNotExist
+		}
+
 		return 0, err
 	}
 
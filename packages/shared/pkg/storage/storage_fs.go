@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -32,6 +33,60 @@ func (fs *FileSystemStorageProvider) DeleteObjectsWithPrefix(_ context.Context,
 	return os.RemoveAll(filePath)
 }
 
+func (fs *FileSystemStorageProvider) ListDirectories(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(fs.basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var directories []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories = append(directories, entry.Name())
+		}
+	}
+
+	return directories, nil
+}
+
+func (fs *FileSystemStorageProvider) ListObjectsWithPrefix(_ context.Context, prefix string) ([]ObjectAttributes, error) {
+	var attributes []ObjectAttributes
+
+	err := filepath.WalkDir(fs.getPath(prefix), func(path string, entry os.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return filepath.SkipDir
+		}
+
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		relative, err := filepath.Rel(fs.basePath, path)
+		if err != nil {
+			return err
+		}
+
+		attributes = append(attributes, ObjectAttributes{
+			Path:      filepath.ToSlash(relative),
+			Size:      info.Size(),
+			UpdatedAt: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
 func (fs *FileSystemStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[Local file storage, base path set to %s]", fs.basePath)
 }
@@ -126,3 +126,31 @@ func TestWriteToNonExistentObject(t *testing.T) {
 	_, err = obj.WriteTo(&sink)
 	require.ErrorIs(t, err, ErrorObjectNotExist)
 }
+
+func TestListDirectories_ListObjectsWithPrefix(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+
+	for _, path := range []string{"build-a/memfile", "build-a/rootfs.ext4", "build-b/snapfile"} {
+		obj, err := p.OpenObject(ctx, path)
+		require.NoError(t, err)
+
+		_, err = obj.ReadFrom(strings.NewReader("data"))
+		require.NoError(t, err)
+	}
+
+	directories, err := p.ListDirectories(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"build-a", "build-b"}, directories)
+
+	objects, err := p.ListObjectsWithPrefix(ctx, "build-a")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.ElementsMatch(t, []string{"build-a/memfile", "build-a/rootfs.ext4"}, []string{objects[0].Path, objects[1].Path})
+	require.Equal(t, int64(4), objects[0].Size)
+
+	// the missing prefix has no objects
+	objects, err = p.ListObjectsWithPrefix(ctx, "missing")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
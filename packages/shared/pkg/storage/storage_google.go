@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -69,6 +70,55 @@ func (g *GCPBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return nil
 }
 
+func (g *GCPBucketStorageProvider) ListDirectories(ctx context.Context) ([]string, error) {
+	objects := g.bucket.Objects(ctx, &storage.Query{Delimiter: "/"})
+
+	var directories []string
+
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over directories: %w", err)
+		}
+
+		// Only the directories have the prefix set when listing with the delimiter.
+		if object.Prefix != "" {
+			directories = append(directories, strings.TrimSuffix(object.Prefix, "/"))
+		}
+	}
+
+	return directories, nil
+}
+
+func (g *GCPBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]ObjectAttributes, error) {
+	objects := g.bucket.Objects(ctx, &storage.Query{Prefix: prefix + "/"})
+
+	var attributes []ObjectAttributes
+
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over objects: %w", err)
+		}
+
+		attributes = append(attributes, ObjectAttributes{
+			Path:      object.Name,
+			Size:      object.Size,
+			UpdatedAt: object.Updated,
+		})
+	}
+
+	return attributes, nil
+}
+
 func (g *GCPBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[GCP Storage, bucket set to %s]", g.bucket.BucketName())
 }
@@ -107,6 +157,10 @@ func (g *GCPBucketStorageObjectProvider) Size() (int64, error) {
 
 	attrs, err := g.handle.Attrs(ctx)
 	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return 0, ErrorObjectNotExist
+		}
+
 		return 0, fmt.Errorf("failed to get GCS object (%s) attributes: %w", g.path, err)
 	}
 
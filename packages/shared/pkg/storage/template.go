@@ -20,11 +20,16 @@ const (
 
 	buildDirName = "builds"
 
+	// ContentChunksDir is the storage directory of the content-addressed chunks shared by all builds.
+	ContentChunksDir = "chunks"
+
 	MemfileName  = "memfile"
 	RootfsName   = "rootfs.ext4"
 	SnapfileName = "snapfile"
+	VolumeName   = "volume.ext4"
 
-	HeaderSuffix = ".header"
+	HeaderSuffix   = ".header"
+	PrefetchSuffix = ".prefetch"
 )
 
 type TemplateFiles struct {
@@ -85,6 +90,10 @@ func (t *TemplateFiles) StorageMemfileHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, HeaderSuffix)
 }
 
+func (t *TemplateFiles) StorageMemfilePrefetchPath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, PrefetchSuffix)
+}
+
 func (t *TemplateFiles) StorageRootfsPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), RootfsName)
 }
@@ -93,10 +102,19 @@ func (t *TemplateFiles) StorageRootfsHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), RootfsName, HeaderSuffix)
 }
 
+func (t *TemplateFiles) StorageRootfsPrefetchPath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), RootfsName, PrefetchSuffix)
+}
+
 func (t *TemplateFiles) StorageSnapfilePath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), SnapfileName)
 }
 
+// StorageContentChunkPath returns the storage path of the content-addressed chunk with the hash.
+func StorageContentChunkPath(hash string) string {
+	return fmt.Sprintf("%s/%s", ContentChunksDir, hash)
+}
+
 func (t *TemplateFiles) SandboxBuildDir() string {
 	return filepath.Join(EnvsDisk, t.TemplateId, buildDirName, t.BuildId)
 }
@@ -104,3 +122,11 @@ func (t *TemplateFiles) SandboxBuildDir() string {
 func (t *TemplateFiles) SandboxRootfsPath() string {
 	return filepath.Join(t.SandboxBuildDir(), RootfsName)
 }
+
+func (t *TemplateFiles) SandboxVolumePath() string {
+	return filepath.Join(t.SandboxBuildDir(), VolumeName)
+}
+
+func (t *TemplateFiles) SandboxDatasetPath(index int) string {
+	return filepath.Join(t.SandboxBuildDir(), fmt.Sprintf("dataset-%d.ext4", index))
+}
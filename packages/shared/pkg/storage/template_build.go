@@ -1,20 +1,44 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"crypto/cipher"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
+// contentChunkUploadWorkers is the number of the content-addressed chunks checked and uploaded in parallel.
+const contentChunkUploadWorkers = 16
+
+// ContentChunkRefreshAge is the age after which the existing chunk reused by the build is uploaded again.
+// The garbage collection keeps the unreferenced chunks updated in its grace period, so the grace period has to be longer,
+// otherwise the chunk reused by the build being uploaded can be deleted before the header referencing it is uploaded.
+const ContentChunkRefreshAge = 24 * time.Hour
+
+// contentAddressed enables storing the build data as the content-addressed chunks instead of the build memfile and rootfs objects.
+// The headers uploaded in this mode reference the chunks, the builds uploaded before keep working.
+var contentAddressed = env.GetEnv("TEMPLATE_CONTENT_ADDRESSED_STORAGE", "false") == "true"
+
+// compression is the compression of the uploaded build data: "none", "zstd" or "lz4".
+// The mappings in the uploaded headers record how their data are compressed, so the builds uploaded before stay readable.
+var compression = env.GetEnv("TEMPLATE_STORAGE_COMPRESSION", "none")
+
 type TemplateBuild struct {
 	files       *TemplateFiles
 	persistence StorageProvider
 
+	// teamID selects the data key the build data are encrypted with, the data are not encrypted without it.
+	teamID string
+
 	memfileHeader *headers.Header
 	rootfsHeader  *headers.Header
 }
@@ -29,6 +53,13 @@ func NewTemplateBuild(memfileHeader *headers.Header, rootfsHeader *headers.Heade
 	}
 }
 
+// WithTeamID sets the team whose data key encrypts the uploaded build data when the storage encryption is enabled.
+func (t *TemplateBuild) WithTeamID(teamID string) *TemplateBuild {
+	t.teamID = teamID
+
+	return t
+}
+
 func (t *TemplateBuild) Remove(ctx context.Context) error {
 	err := t.persistence.DeleteObjectsWithPrefix(ctx, t.files.StorageDir())
 	if err != nil {
@@ -44,7 +75,7 @@ func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *headers.Head
 		return err
 	}
 
-	serialized, err := headers.Serialize(h.Metadata, h.Mapping)
+	serialized, err := headers.SerializeHeader(h)
 	if err != nil {
 		return fmt.Errorf("error when serializing memfile header: %w", err)
 	}
@@ -57,6 +88,35 @@ func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *headers.Head
 	return nil
 }
 
+// UploadMemfilePrefetch uploads the prefetch profile next to the memfile header of the build.
+func (t *TemplateBuild) UploadMemfilePrefetch(ctx context.Context, p *headers.PrefetchProfile) error {
+	return t.uploadPrefetch(ctx, t.files.StorageMemfilePrefetchPath(), p)
+}
+
+// UploadRootfsPrefetch uploads the prefetch profile next to the rootfs header of the build.
+func (t *TemplateBuild) UploadRootfsPrefetch(ctx context.Context, p *headers.PrefetchProfile) error {
+	return t.uploadPrefetch(ctx, t.files.StorageRootfsPrefetchPath(), p)
+}
+
+func (t *TemplateBuild) uploadPrefetch(ctx context.Context, path string, p *headers.PrefetchProfile) error {
+	object, err := t.persistence.OpenObject(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := headers.SerializePrefetchProfile(p)
+	if err != nil {
+		return fmt.Errorf("error when serializing prefetch profile: %w", err)
+	}
+
+	_, err = object.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("error when uploading prefetch profile: %w", err)
+	}
+
+	return nil
+}
+
 func (t *TemplateBuild) uploadMemfile(ctx context.Context, memfilePath string) error {
 	object, err := t.persistence.OpenObject(ctx, t.files.StorageMemfilePath())
 	if err != nil {
@@ -77,7 +137,7 @@ func (t *TemplateBuild) uploadRootfsHeader(ctx context.Context, h *headers.Heade
 		return err
 	}
 
-	serialized, err := headers.Serialize(h.Metadata, h.Mapping)
+	serialized, err := headers.SerializeHeader(h)
 	if err != nil {
 		return fmt.Errorf("error when serializing memfile header: %w", err)
 	}
@@ -104,13 +164,274 @@ func (t *TemplateBuild) uploadRootfs(ctx context.Context, rootfsPath string) err
 	return nil
 }
 
+// withChecksums returns the header with the checksums of the diff, the headers created when the diff is exported already have them.
+func withChecksums(h *headers.Header, diffPath *string) (*headers.Header, error) {
+	if h == nil || diffPath == nil || h.Checksums != nil {
+		return h, nil
+	}
+
+	diff, err := os.Open(*diffPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer diff.Close()
+
+	checksums, err := headers.ComputeChecksums(diff)
+	if err != nil {
+		return nil, fmt.Errorf("error when computing diff checksums: %w", err)
+	}
+
+	metadata := *h.Metadata
+
+	checksummed := headers.NewHeader(&metadata, h.Mapping)
+	checksummed.Compression = h.Compression
+	checksummed.SetChecksums(checksums)
+
+	return checksummed, nil
+}
+
+// packed returns true if the header depends on how the diff data are stored, so the header is uploaded after the data.
+func (t *TemplateBuild) packed(h *headers.Header, diffPath *string) bool {
+	return h != nil && diffPath != nil && (contentAddressed || (compression != "" && compression != "none") || t.encrypted())
+}
+
+// encrypted returns true if the build data are encrypted with the data key of the team.
+// If the KMS can't be initialized, the upload fails instead of storing the data unencrypted.
+func (t *TemplateBuild) encrypted() bool {
+	if t.teamID == "" {
+		return false
+	}
+
+	keys, err := GetDataKeys()
+
+	return err != nil || keys != nil
+}
+
+// uploadPacked uploads the diff data as the content-addressed chunks or as the compressed object
+// and returns the header referencing the uploaded data.
+// The file name is the name of the diff in the storage, the encrypted data are bound to it.
+func (t *TemplateBuild) uploadPacked(ctx context.Context, h *headers.Header, diffPath string, objectPath string, fileName string) (*headers.Header, error) {
+	c, err := headers.ParseCompressionType(compression)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := uuid.Nil
+
+	var aead cipher.AEAD
+
+	if t.encrypted() {
+		keys, err := GetDataKeys()
+		if err != nil {
+			return nil, err
+		}
+
+		keyID, aead, err = keys.TeamKey(ctx, t.persistence, t.teamID)
+		if err != nil {
+			return nil, fmt.Errorf("error when getting data key: %w", err)
+		}
+	}
+
+	if contentAddressed {
+		return t.uploadContentAddressed(ctx, h, diffPath, c, keyID, aead)
+	}
+
+	return t.uploadCompressed(ctx, h, diffPath, objectPath, headers.FileScope(h.Metadata.BuildId.String(), fileName), c, keyID, aead)
+}
+
+// packedMetadata returns the metadata of the header referencing the packed data, with the version supporting how the data are stored.
+func packedMetadata(h *headers.Header, c headers.CompressionType, keyID uuid.UUID) *headers.Metadata {
+	metadata := *h.Metadata
+
+	if c != headers.CompressionNone {
+		metadata.Version = max(metadata.Version, headers.CompressedVersion)
+	}
+
+	if keyID != uuid.Nil {
+		metadata.Version = max(metadata.Version, headers.EncryptedVersion)
+		metadata.KeyId = keyID
+	}
+
+	return &metadata
+}
+
+// uploadCompressed uploads the diff compressed and encrypted by frames and returns the header with the frame index.
+func (t *TemplateBuild) uploadCompressed(ctx context.Context, h *headers.Header, diffPath string, objectPath string, scope headers.EncryptionScope, c headers.CompressionType, keyID uuid.UUID, aead cipher.AEAD) (*headers.Header, error) {
+	diff, err := os.Open(diffPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer diff.Close()
+
+	object, err := t.persistence.OpenObject(ctx, objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	indexCh := make(chan *headers.CompressionIndex, 1)
+
+	go func() {
+		index, compressErr := headers.EncodeFrames(c, aead, scope, diff, writer)
+		indexCh <- index
+
+		writer.CloseWithError(compressErr)
+	}()
+
+	_, err = object.ReadFrom(reader)
+	// Stop the compression if the upload failed.
+	reader.CloseWithError(err)
+
+	index := <-indexCh
+	if err != nil {
+		return nil, fmt.Errorf("error when uploading compressed diff: %w", err)
+	}
+
+	mappings := make([]*headers.BuildMap, 0, len(h.Mapping))
+
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == h.Metadata.BuildId && mapping.Hash.IsZero() {
+			compressed := *mapping
+			compressed.Compression = c
+			compressed.KeyId = keyID
+
+			mapping = &compressed
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	metadata := packedMetadata(h, c, keyID)
+	// The index is needed to read the encrypted frames even if the data are not compressed.
+	metadata.Version = max(metadata.Version, headers.CompressedVersion)
+
+	compressed := headers.NewHeader(metadata, mappings)
+	compressed.Compression = index
+	// The checksums are of the uncompressed data, so they stay valid.
+	compressed.Checksums = h.Checksums
+
+	return compressed, nil
+}
+
+// uploadContentAddressed uploads the data of the build diff as the content-addressed chunks, skipping the chunks that already exist in the storage,
+// and returns the header with the mappings referencing the chunks.
+func (t *TemplateBuild) uploadContentAddressed(ctx context.Context, h *headers.Header, diffPath string, c headers.CompressionType, keyID uuid.UUID, aead cipher.AEAD) (*headers.Header, error) {
+	diff, err := os.Open(diffPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer diff.Close()
+
+	mappings, chunks, err := headers.ContentAddress(h.Mapping, h.Metadata.BuildId, diff, c, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("error when splitting diff to chunks: %w", err)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(contentChunkUploadWorkers)
+
+	for _, chunk := range chunks {
+		eg.Go(func() error {
+			return t.uploadContentChunk(ctx, chunk, diff, aead)
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := packedMetadata(h, c, keyID)
+	metadata.Version = max(metadata.Version, headers.ContentAddressedVersion)
+
+	return headers.NewHeader(metadata, mappings), nil
+}
+
+func (t *TemplateBuild) uploadContentChunk(ctx context.Context, chunk *headers.ContentChunk, diff io.ReaderAt, aead cipher.AEAD) error {
+	object, err := t.persistence.OpenObject(ctx, StorageContentChunkPath(chunk.Name()))
+	if err != nil {
+		return err
+	}
+
+	// The chunk with the same hash has the same data, so it doesn't have to be uploaded again unless it's old.
+	fresh, err := t.contentChunkFresh(ctx, chunk)
+	if err != nil {
+		return fmt.Errorf("error when checking chunk %s: %w", chunk.Name(), err)
+	}
+
+	if fresh {
+		return nil
+	}
+
+	data := chunk.Reader(diff)
+
+	if chunk.Compression != headers.CompressionNone || aead != nil {
+		raw, err := io.ReadAll(data)
+		if err != nil {
+			return fmt.Errorf("error when reading chunk %s: %w", chunk.Name(), err)
+		}
+
+		frame, err := headers.CompressFrame(chunk.Compression, raw)
+		if err != nil {
+			return fmt.Errorf("error when compressing chunk %s: %w", chunk.Name(), err)
+		}
+
+		// The chunk is stored as the single frame.
+		if aead != nil {
+			frame, err = headers.SealFrame(aead, headers.ChunkScope(chunk.Hash), 0, frame)
+			if err != nil {
+				return fmt.Errorf("error when encrypting chunk %s: %w", chunk.Name(), err)
+			}
+		}
+
+		data = bytes.NewReader(frame)
+	}
+
+	_, err = object.ReadFrom(data)
+	if err != nil {
+		return fmt.Errorf("error when uploading chunk %s: %w", chunk.Name(), err)
+	}
+
+	return nil
+}
+
+// contentChunkFresh reports whether the chunk exists in the storage and was updated after the ContentChunkRefreshAge.
+func (t *TemplateBuild) contentChunkFresh(ctx context.Context, chunk *headers.ContentChunk) (bool, error) {
+	path := StorageContentChunkPath(chunk.Name())
+
+	objects, err := t.persistence.ListObjectsWithPrefix(ctx, path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, object := range objects {
+		// The prefix matches also the chunks with the same hash encrypted with other keys.
+		if object.Path == path {
+			return time.Since(object.UpdatedAt) < ContentChunkRefreshAge, nil
+		}
+	}
+
+	return false, nil
+}
+
 // Snap-file is small enough so we don't use composite upload.
+// The encrypted snap-file is sealed as a whole with the data key of the team.
 func (t *TemplateBuild) uploadSnapfile(ctx context.Context, snapfile io.Reader) error {
 	object, err := t.persistence.OpenObject(ctx, t.files.StorageSnapfilePath())
 	if err != nil {
 		return err
 	}
 
+	if t.encrypted() {
+		snapfile, err = t.sealSnapfile(ctx, snapfile)
+		if err != nil {
+			return fmt.Errorf("error when encrypting snapfile: %w", err)
+		}
+	}
+
 	n, err := object.ReadFrom(snapfile)
 	if err != nil {
 		return fmt.Errorf("error when uploading snapfile (%d bytes): %w", n, err)
@@ -119,6 +440,30 @@ func (t *TemplateBuild) uploadSnapfile(ctx context.Context, snapfile io.Reader)
 	return nil
 }
 
+func (t *TemplateBuild) sealSnapfile(ctx context.Context, snapfile io.Reader) (io.Reader, error) {
+	keys, err := GetDataKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, aead, err := keys.TeamKey(ctx, t.persistence, t.teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error when getting data key: %w", err)
+	}
+
+	data, err := io.ReadAll(snapfile)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := SealObject(keyID, aead, SnapfileScope(t.files.BuildId), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(sealed), nil
+}
+
 func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfilePath *string, rootfsPath *string) chan error {
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -127,7 +472,19 @@ func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfile
 			return nil
 		}
 
-		err := t.uploadRootfsHeader(ctx, t.rootfsHeader)
+		h, err := withChecksums(t.rootfsHeader, rootfsPath)
+		if err != nil {
+			return fmt.Errorf("error when uploading rootfs header: %w", err)
+		}
+
+		if t.packed(h, rootfsPath) {
+			h, err = t.uploadPacked(ctx, h, *rootfsPath, t.files.StorageRootfsPath(), RootfsName)
+			if err != nil {
+				return fmt.Errorf("error when uploading rootfs data: %w", err)
+			}
+		}
+
+		err = t.uploadRootfsHeader(ctx, h)
 		if err != nil {
 			return err
 		}
@@ -136,7 +493,8 @@ func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfile
 	})
 
 	eg.Go(func() error {
-		if rootfsPath == nil {
+		// The packed rootfs data are uploaded together with the header.
+		if rootfsPath == nil || t.packed(t.rootfsHeader, rootfsPath) {
 			return nil
 		}
 
@@ -153,7 +511,19 @@ func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfile
 			return nil
 		}
 
-		err := t.uploadMemfileHeader(ctx, t.memfileHeader)
+		h, err := withChecksums(t.memfileHeader, memfilePath)
+		if err != nil {
+			return fmt.Errorf("error when uploading memfile header: %w", err)
+		}
+
+		if t.packed(h, memfilePath) {
+			h, err = t.uploadPacked(ctx, h, *memfilePath, t.files.StorageMemfilePath(), MemfileName)
+			if err != nil {
+				return fmt.Errorf("error when uploading memfile data: %w", err)
+			}
+		}
+
+		err = t.uploadMemfileHeader(ctx, h)
 		if err != nil {
 			return err
 		}
@@ -162,7 +532,8 @@ func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfile
 	})
 
 	eg.Go(func() error {
-		if memfilePath == nil {
+		// The packed memfile data are uploaded together with the header.
+		if memfilePath == nil || t.packed(t.memfileHeader, memfilePath) {
 			return nil
 		}
 
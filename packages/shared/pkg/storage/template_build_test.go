@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestTemplateBuild_UploadContentChunk(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+
+	buildID := uuid.New()
+	diff := bytes.NewReader(bytes.Repeat([]byte("a"), 4096))
+
+	_, chunks, err := headers.ContentAddress([]*headers.BuildMap{{Length: 4096, BuildId: buildID}}, buildID, diff, headers.CompressionNone, uuid.Nil)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+
+	build := NewTemplateBuild(nil, nil, p, &TemplateFiles{BuildId: buildID.String()})
+	chunkPath := filepath.Join(p.basePath, StorageContentChunkPath(chunks[0].Name()))
+
+	require.NoError(t, build.uploadContentChunk(ctx, chunks[0], diff, nil))
+
+	data, err := os.ReadFile(chunkPath)
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte("a"), 4096), data)
+
+	// The recent chunk is reused.
+	require.NoError(t, os.WriteFile(chunkPath, []byte("existing"), 0o644))
+	require.NoError(t, build.uploadContentChunk(ctx, chunks[0], diff, nil))
+
+	data, err = os.ReadFile(chunkPath)
+	require.NoError(t, err)
+	require.Equal(t, []byte("existing"), data)
+
+	// The old chunk is uploaded again, so the garbage collection doesn't delete it before the header referencing it is uploaded.
+	old := time.Now().Add(-ContentChunkRefreshAge - time.Hour)
+	require.NoError(t, os.Chtimes(chunkPath, old, old))
+	require.NoError(t, build.uploadContentChunk(ctx, chunks[0], diff, nil))
+
+	info, err := os.Stat(chunkPath)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), info.ModTime(), time.Minute)
+	require.Equal(t, int64(4096), info.Size())
+}
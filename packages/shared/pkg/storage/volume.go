@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// VolumeGeneration is a snapshot of the volume data, it is stored like a template build with the volume diff and its header.
+type VolumeGeneration struct {
+	GenerationId string
+	persistence  StorageProvider
+
+	header *headers.Header
+}
+
+func NewVolumeGeneration(generationId string, header *headers.Header, persistence StorageProvider) *VolumeGeneration {
+	return &VolumeGeneration{
+		GenerationId: generationId,
+		persistence:  persistence,
+		header:       header,
+	}
+}
+
+func (v *VolumeGeneration) StorageDir() string {
+	return v.GenerationId
+}
+
+func (v *VolumeGeneration) StorageVolumePath() string {
+	return fmt.Sprintf("%s/%s", v.StorageDir(), VolumeName)
+}
+
+func (v *VolumeGeneration) StorageVolumeHeaderPath() string {
+	return fmt.Sprintf("%s/%s%s", v.StorageDir(), VolumeName, HeaderSuffix)
+}
+
+// Upload stores the generation, the diff path is nil if no blocks were changed.
+// The header is uploaded last, so the generation can't be read before its diff is complete.
+func (v *VolumeGeneration) Upload(ctx context.Context, diffPath *string) error {
+	if diffPath != nil {
+		object, err := v.persistence.OpenObject(ctx, v.StorageVolumePath())
+		if err != nil {
+			return err
+		}
+
+		err = object.WriteFromFileSystem(*diffPath)
+		if err != nil {
+			return fmt.Errorf("error when uploading volume: %w", err)
+		}
+	}
+
+	object, err := v.persistence.OpenObject(ctx, v.StorageVolumeHeaderPath())
+	if err != nil {
+		return err
+	}
+
+	serialized, err := headers.Serialize(v.header.Metadata, v.header.Mapping)
+	if err != nil {
+		return fmt.Errorf("error when serializing volume header: %w", err)
+	}
+
+	_, err = object.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("error when uploading volume header: %w", err)
+	}
+
+	return nil
+}
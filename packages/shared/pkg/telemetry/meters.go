@@ -45,6 +45,14 @@ const (
 	SandboxRamUsedGaugeName  GaugeIntType = "e2b.sandbox.ram.used"
 	SandboxRamTotalGaugeName GaugeIntType = "e2b.sandbox.ram.total"
 	SandboxCpuTotalGaugeName GaugeIntType = "e2b.sandbox.cpu.total"
+
+	SandboxNetworkEgressBytesGaugeName    GaugeIntType = "e2b.sandbox.network.egress.bytes"
+	SandboxNetworkEgressPacketsGaugeName  GaugeIntType = "e2b.sandbox.network.egress.packets"
+	SandboxNetworkIngressBytesGaugeName   GaugeIntType = "e2b.sandbox.network.ingress.bytes"
+	SandboxNetworkIngressPacketsGaugeName GaugeIntType = "e2b.sandbox.network.ingress.packets"
+
+	SandboxRamBalloonGaugeName GaugeIntType = "e2b.sandbox.ram.balloon"
+	SandboxRamFreeGaugeName    GaugeIntType = "e2b.sandbox.ram.free"
 )
 
 var counterDesc = map[CounterType]string{
@@ -104,6 +112,14 @@ var gaugeIntDesc = map[GaugeIntType]string{
 	SandboxRamUsedGaugeName:       "Amount of RAM used by the sandbox.",
 	SandboxRamTotalGaugeName:      "Amount of RAM available to the sandbox.",
 	SandboxCpuTotalGaugeName:      "Amount of CPU available to the sandbox.",
+
+	SandboxNetworkEgressBytesGaugeName:    "Bytes sent by the sandbox since it started.",
+	SandboxNetworkEgressPacketsGaugeName:  "Packets sent by the sandbox since it started.",
+	SandboxNetworkIngressBytesGaugeName:   "Bytes received by the sandbox since it started.",
+	SandboxNetworkIngressPacketsGaugeName: "Packets received by the sandbox since it started.",
+
+	SandboxRamBalloonGaugeName: "Amount of RAM reclaimed from the sandbox by the memory balloon.",
+	SandboxRamFreeGaugeName:    "Amount of free RAM reported by the sandbox guest.",
 }
 
 var gaugeIntUnits = map[GaugeIntType]string{
@@ -111,6 +127,14 @@ var gaugeIntUnits = map[GaugeIntType]string{
 	SandboxRamUsedGaugeName:       "{By}",
 	SandboxRamTotalGaugeName:      "{By}",
 	SandboxCpuTotalGaugeName:      "{count}",
+
+	SandboxNetworkEgressBytesGaugeName:    "{By}",
+	SandboxNetworkEgressPacketsGaugeName:  "{packet}",
+	SandboxNetworkIngressBytesGaugeName:   "{By}",
+	SandboxNetworkIngressPacketsGaugeName: "{packet}",
+
+	SandboxRamBalloonGaugeName: "{By}",
+	SandboxRamFreeGaugeName:    "{By}",
 }
 
 func GetCounter(meter metric.Meter, name CounterType) (metric.Int64Counter, error) {